@@ -0,0 +1,10 @@
+// +build !windows
+
+package pluginaction
+
+import "os"
+
+// isExecutable returns true when any of the execute bits are set.
+func isExecutable(mode os.FileMode) bool {
+	return mode&0111 != 0
+}
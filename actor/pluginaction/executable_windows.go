@@ -0,0 +1,11 @@
+// +build windows
+
+package pluginaction
+
+import "os"
+
+// isExecutable always returns true because Windows does not track execute
+// permissions in file modes.
+func isExecutable(mode os.FileMode) bool {
+	return true
+}
@@ -0,0 +1,46 @@
+package pluginaction
+
+import (
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// PluginBinaryMissingError is returned when the binary of an installed plugin
+// no longer exists.
+type PluginBinaryMissingError struct {
+	Path string
+}
+
+func (e PluginBinaryMissingError) Error() string {
+	return fmt.Sprintf("Plugin binary %s does not exist.", e.Path)
+}
+
+// PluginBinaryNotExecutableError is returned when the binary of an installed
+// plugin is not an executable file.
+type PluginBinaryNotExecutableError struct {
+	Path string
+}
+
+func (e PluginBinaryNotExecutableError) Error() string {
+	return fmt.Sprintf("Plugin binary %s is not executable.", e.Path)
+}
+
+// ValidatePluginBinary checks that the binary of an installed plugin still
+// exists and can be executed.
+func (actor Actor) ValidatePluginBinary(plugin configv3.Plugin) error {
+	info, err := os.Stat(plugin.Location)
+	if os.IsNotExist(err) {
+		return PluginBinaryMissingError{Path: plugin.Location}
+	}
+	if err != nil {
+		return err
+	}
+
+	if !info.Mode().IsRegular() || !isExecutable(info.Mode()) {
+		return PluginBinaryNotExecutableError{Path: plugin.Location}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package pluginaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("validate actions", func() {
+	var (
+		actor   *Actor
+		tempDir string
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(new(pluginactionfakes.FakeConfig), nil)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	Describe("ValidatePluginBinary", func() {
+		It("accepts an executable binary", func() {
+			path := filepath.Join(tempDir, "some-plugin")
+			Expect(ioutil.WriteFile(path, []byte("binary"), 0700)).To(Succeed())
+
+			Expect(actor.ValidatePluginBinary(configv3.Plugin{Name: "some-plugin", Location: path})).To(Succeed())
+		})
+
+		Context("when the binary does not exist", func() {
+			It("returns a PluginBinaryMissingError", func() {
+				path := filepath.Join(tempDir, "missing-plugin")
+				err := actor.ValidatePluginBinary(configv3.Plugin{Name: "missing-plugin", Location: path})
+				Expect(err).To(MatchError(PluginBinaryMissingError{Path: path}))
+			})
+		})
+
+		Context("when the location is a directory", func() {
+			It("returns a PluginBinaryNotExecutableError", func() {
+				err := actor.ValidatePluginBinary(configv3.Plugin{Name: "some-plugin", Location: tempDir})
+				Expect(err).To(MatchError(PluginBinaryNotExecutableError{Path: tempDir}))
+			})
+		})
+	})
+})
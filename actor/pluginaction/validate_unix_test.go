@@ -0,0 +1,35 @@
+// +build !windows
+
+package pluginaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// Checks file permissions for UNIX platforms
+var _ = Describe("validate actions", func() {
+	Describe("ValidatePluginBinary", func() {
+		Context("when the binary is not executable", func() {
+			It("returns a PluginBinaryNotExecutableError", func() {
+				tempDir, err := ioutil.TempDir("", "")
+				Expect(err).ToNot(HaveOccurred())
+				defer os.RemoveAll(tempDir)
+
+				path := filepath.Join(tempDir, "some-plugin")
+				Expect(ioutil.WriteFile(path, []byte("binary"), 0600)).To(Succeed())
+
+				actor := NewActor(new(pluginactionfakes.FakeConfig), nil)
+				err = actor.ValidatePluginBinary(configv3.Plugin{Name: "some-plugin", Location: path})
+				Expect(err).To(MatchError(PluginBinaryNotExecutableError{Path: path}))
+			})
+		})
+	})
+})
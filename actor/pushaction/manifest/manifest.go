@@ -1,6 +1,7 @@
 package manifest
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
@@ -13,11 +14,16 @@ import (
 
 type Manifest struct {
 	Applications []Application `yaml:"applications"`
+	// ServiceInstances are the service instances declared in the top-level
+	// services block.
+	ServiceInstances []ServiceInstance `yaml:"services,omitempty"`
 }
 
 type Application struct {
 	BuildpackName string
-	Command       string
+	// Buildpacks is the list of buildpacks used by V3 applications.
+	Buildpacks []string
+	Command    string
 	// DiskQuota is the disk size in megabytes.
 	DiskQuota   uint64
 	DockerImage string
@@ -31,13 +37,84 @@ type Application struct {
 	HealthCheckType    string
 	Instances          int
 	// Memory is the amount of memory in megabytes.
-	Memory    uint64
-	Name      string
-	Path      string
+	Memory uint64
+	Name   string
+	Path   string
+	// Processes contains the per process type configuration of V3
+	// applications.
+	Processes []Process
+	Routes    []string
 	Services  []string
 	StackName string
 }
 
+type Process struct {
+	Command string
+	// DiskQuota is the disk size in megabytes.
+	DiskQuota               uint64
+	HealthCheckHTTPEndpoint string
+	// HealthCheckTimeout attribute defines the number of seconds that is
+	// allocated for starting the process.
+	HealthCheckTimeout int
+	HealthCheckType    string
+	Instances          int
+	// Memory is the amount of memory in megabytes.
+	Memory uint64
+	Type   string
+}
+
+type manifestRoute struct {
+	Route string `yaml:"route"`
+}
+
+type manifestProcess struct {
+	Type                    string `yaml:"type"`
+	Command                 string `yaml:"command,omitempty"`
+	DiskQuota               string `yaml:"disk_quota,omitempty"`
+	HealthCheckHTTPEndpoint string `yaml:"health-check-http-endpoint,omitempty"`
+	HealthCheckType         string `yaml:"health-check-type,omitempty"`
+	Instances               int    `yaml:"instances"`
+	Memory                  string `yaml:"memory,omitempty"`
+	Timeout                 int    `yaml:"timeout,omitempty"`
+}
+
+func (process Process) MarshalYAML() (interface{}, error) {
+	return manifestProcess{
+		Type:                    process.Type,
+		Command:                 process.Command,
+		DiskQuota:               megabytesToString(process.DiskQuota),
+		HealthCheckHTTPEndpoint: process.HealthCheckHTTPEndpoint,
+		HealthCheckType:         process.HealthCheckType,
+		Instances:               process.Instances,
+		Memory:                  megabytesToString(process.Memory),
+		Timeout:                 process.HealthCheckTimeout,
+	}, nil
+}
+
+func (process *Process) UnmarshalYAML(unmarshaller func(interface{}) error) error {
+	var manifestProc manifestProcess
+
+	err := unmarshaller(&manifestProc)
+	if err != nil {
+		return err
+	}
+
+	process.Type = manifestProc.Type
+	process.Command = manifestProc.Command
+	process.HealthCheckHTTPEndpoint = manifestProc.HealthCheckHTTPEndpoint
+	process.HealthCheckType = manifestProc.HealthCheckType
+	process.HealthCheckTimeout = manifestProc.Timeout
+	process.Instances = manifestProc.Instances
+
+	process.DiskQuota, err = stringToMegabytes(manifestProc.DiskQuota)
+	if err != nil {
+		return err
+	}
+
+	process.Memory, err = stringToMegabytes(manifestProc.Memory)
+	return err
+}
+
 func (app Application) String() string {
 	return fmt.Sprintf(
 		"App Name: '%s', Buildpack: '%s', Command: '%s', Disk Quota: '%d', Docker Image: '%s', Health Check HTTP Endpoint: '%s', Health Check Timeout: '%d', Health Check Type: '%s', Instances: '%d', Memory: '%d', Path: '%s', Services: [%s], Stack Name: '%s'",
@@ -60,6 +137,7 @@ func (app Application) String() string {
 func (app *Application) UnmarshalYAML(unmarshaller func(interface{}) error) error {
 	var manifestApp struct {
 		Buildpack               string            `yaml:"buildpack"`
+		Buildpacks              []string          `yaml:"buildpacks"`
 		Command                 string            `yaml:"command"`
 		DiskQuota               string            `yaml:"disk_quota"`
 		EnvironmentVariables    map[string]string `yaml:"env"`
@@ -69,6 +147,8 @@ func (app *Application) UnmarshalYAML(unmarshaller func(interface{}) error) erro
 		Memory                  string            `yaml:"memory"`
 		Name                    string            `yaml:"name"`
 		Path                    string            `yaml:"path"`
+		Processes               []Process         `yaml:"processes"`
+		Routes                  []manifestRoute   `yaml:"routes"`
 		Services                []string          `yaml:"services"`
 		StackName               string            `yaml:"stack"`
 		Timeout                 int               `yaml:"timeout"`
@@ -80,45 +160,81 @@ func (app *Application) UnmarshalYAML(unmarshaller func(interface{}) error) erro
 	}
 
 	app.BuildpackName = manifestApp.Buildpack
+	app.Buildpacks = manifestApp.Buildpacks
 	app.Command = manifestApp.Command
 	app.HealthCheckHTTPEndpoint = manifestApp.HealthCheckHTTPEndpoint
 	app.HealthCheckType = manifestApp.HealthCheckType
 	app.Instances = manifestApp.Instances
 	app.Name = manifestApp.Name
 	app.Path = manifestApp.Path
+	app.Processes = manifestApp.Processes
 	app.Services = manifestApp.Services
 	app.StackName = manifestApp.StackName
 	app.HealthCheckTimeout = manifestApp.Timeout
 	app.EnvironmentVariables = manifestApp.EnvironmentVariables
 
-	if manifestApp.DiskQuota != "" {
-		disk, err := bytefmt.ToMegabytes(manifestApp.DiskQuota)
-		if err != nil {
-			return err
-		}
-		app.DiskQuota = disk
+	for _, route := range manifestApp.Routes {
+		app.Routes = append(app.Routes, route.Route)
 	}
 
-	if manifestApp.Memory != "" {
-		memory, err := bytefmt.ToMegabytes(manifestApp.Memory)
-		if err != nil {
-			return err
-		}
-		app.Memory = memory
+	app.DiskQuota, err = stringToMegabytes(manifestApp.DiskQuota)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	app.Memory, err = stringToMegabytes(manifestApp.Memory)
+	return err
 }
 
-func ReadAndMergeManifests(pathToManifest string) ([]Application, error) {
-	// Read all manifest files
-	raw, err := ioutil.ReadFile(pathToManifest)
-	if err != nil {
-		return nil, err
+func (app Application) MarshalYAML() (interface{}, error) {
+	var manifestApp struct {
+		Name                    string            `yaml:"name"`
+		Buildpack               string            `yaml:"buildpack,omitempty"`
+		Buildpacks              []string          `yaml:"buildpacks,omitempty"`
+		Command                 string            `yaml:"command,omitempty"`
+		DiskQuota               string            `yaml:"disk_quota,omitempty"`
+		EnvironmentVariables    map[string]string `yaml:"env,omitempty"`
+		HealthCheckHTTPEndpoint string            `yaml:"health-check-http-endpoint,omitempty"`
+		HealthCheckType         string            `yaml:"health-check-type,omitempty"`
+		Instances               *int              `yaml:"instances,omitempty"`
+		Memory                  string            `yaml:"memory,omitempty"`
+		Path                    string            `yaml:"path,omitempty"`
+		Processes               []Process         `yaml:"processes,omitempty"`
+		Routes                  []manifestRoute   `yaml:"routes,omitempty"`
+		Services                []string          `yaml:"services,omitempty"`
+		StackName               string            `yaml:"stack,omitempty"`
+		Timeout                 int               `yaml:"timeout,omitempty"`
 	}
 
-	var manifest Manifest
-	err = yaml.Unmarshal(raw, &manifest)
+	manifestApp.Name = app.Name
+	manifestApp.Buildpack = app.BuildpackName
+	manifestApp.Buildpacks = app.Buildpacks
+	manifestApp.Command = app.Command
+	manifestApp.DiskQuota = megabytesToString(app.DiskQuota)
+	manifestApp.EnvironmentVariables = app.EnvironmentVariables
+	manifestApp.HealthCheckHTTPEndpoint = app.HealthCheckHTTPEndpoint
+	manifestApp.HealthCheckType = app.HealthCheckType
+	// Processes carry their own instances, including the ones scaled to 0.
+	if len(app.Processes) == 0 {
+		manifestApp.Instances = &app.Instances
+	}
+	manifestApp.Memory = megabytesToString(app.Memory)
+	manifestApp.Path = app.Path
+	manifestApp.Processes = app.Processes
+	manifestApp.Services = app.Services
+	manifestApp.StackName = app.StackName
+	manifestApp.Timeout = app.HealthCheckTimeout
+
+	for _, route := range app.Routes {
+		manifestApp.Routes = append(manifestApp.Routes, manifestRoute{Route: route})
+	}
+
+	return manifestApp, nil
+}
+
+func ReadAndMergeManifests(pathToManifest string) ([]Application, error) {
+	// Read all manifest files
+	manifest, err := readManifest(pathToManifest)
 	if err != nil {
 		return nil, err
 	}
@@ -132,3 +248,81 @@ func ReadAndMergeManifests(pathToManifest string) ([]Application, error) {
 	// Merge all manifest files
 	return manifest.Applications, err
 }
+
+// ReadServiceInstances returns the service instances declared in the
+// top-level services block of the manifest. Plain service instance names in
+// that block are skipped. Parameter files are read relative to the manifest's
+// directory.
+func ReadServiceInstances(pathToManifest string) ([]ServiceInstance, error) {
+	manifest, err := readManifest(pathToManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	var serviceInstances []ServiceInstance
+	for _, serviceInstance := range manifest.ServiceInstances {
+		if serviceInstance.nameOnly {
+			continue
+		}
+		if serviceInstance.ParametersPath == "" {
+			serviceInstances = append(serviceInstances, serviceInstance)
+			continue
+		}
+
+		path := serviceInstance.ParametersPath
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(pathToManifest), path)
+		}
+
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var parameters map[string]interface{}
+		err = json.Unmarshal(raw, &parameters)
+		if err != nil {
+			return nil, InvalidServiceInstanceParametersError{Name: serviceInstance.Name, Path: path}
+		}
+		serviceInstance.Parameters = parameters
+		serviceInstances = append(serviceInstances, serviceInstance)
+	}
+
+	return serviceInstances, nil
+}
+
+// WriteApplicationManifest writes the provided applications to
+// pathToManifest in a format readable by ReadAndMergeManifests.
+func WriteApplicationManifest(pathToManifest string, apps []Application) error {
+	raw, err := yaml.Marshal(Manifest{Applications: apps})
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(pathToManifest, append([]byte("---\n"), raw...), 0666)
+}
+
+func readManifest(pathToManifest string) (Manifest, error) {
+	raw, err := ioutil.ReadFile(pathToManifest)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	var manifest Manifest
+	err = yaml.Unmarshal(raw, &manifest)
+	return manifest, err
+}
+
+func megabytesToString(megabytes uint64) string {
+	if megabytes == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%dM", megabytes)
+}
+
+func stringToMegabytes(value string) (uint64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return bytefmt.ToMegabytes(value)
+}
@@ -3,6 +3,7 @@ package manifest_test
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	. "code.cloudfoundry.org/cli/actor/pushaction/manifest"
 
@@ -67,6 +68,25 @@ applications:
     env_2: 182837403930483038
     env_3: true
     env_4: 1.00001
+- name: "app-4"
+  buildpacks:
+  - some-buildpack
+  - another-buildpack
+  routes:
+  - route: some-host.some-domain.com
+  - route: some-domain.com/some-path
+  processes:
+  - type: web
+    command: some-web-command
+    disk_quota: 512M
+    health-check-http-endpoint: /health
+    health-check-type: http
+    instances: 2
+    memory: 1G
+    timeout: 60
+  - type: worker
+    command: some-worker-command
+    health-check-type: process
 `
 		})
 
@@ -100,7 +120,281 @@ applications:
 						"env_4": "1.00001",
 					},
 				},
+				Application{
+					Name:       "app-4",
+					Buildpacks: []string{"some-buildpack", "another-buildpack"},
+					Routes:     []string{"some-host.some-domain.com", "some-domain.com/some-path"},
+					Processes: []Process{
+						{
+							Type:                    "web",
+							Command:                 "some-web-command",
+							DiskQuota:               512,
+							HealthCheckHTTPEndpoint: "/health",
+							HealthCheckType:         "http",
+							Instances:               2,
+							Memory:                  1024,
+							HealthCheckTimeout:      60,
+						},
+						{
+							Type:            "worker",
+							Command:         "some-worker-command",
+							HealthCheckType: "process",
+						},
+					},
+				},
 			))
 		})
+
+		Context("when the manifest lists service instance names in a top-level services block", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: "app-1"
+services:
+- my-db
+- my-cache
+`
+			})
+
+			It("reads the applications", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(Application{Name: "app-1"}))
+			})
+		})
+	})
+
+	Describe("ReadServiceInstances", func() {
+		var (
+			serviceInstances []ServiceInstance
+			executeErr       error
+		)
+
+		JustBeforeEach(func() {
+			serviceInstances, executeErr = ReadServiceInstances(pathToManifest)
+		})
+
+		Context("when the manifest has a services block", func() {
+			var pathToParameters string
+
+			BeforeEach(func() {
+				tempFile, err := ioutil.TempFile("", "parameters-test-")
+				Expect(err).ToNot(HaveOccurred())
+				_, err = tempFile.WriteString(`{"cluster_nodes": {"count": 5}}`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tempFile.Close()).ToNot(HaveOccurred())
+				pathToParameters = tempFile.Name()
+
+				manifest = `---
+applications:
+- name: "app-1"
+  services:
+  - some-db
+services:
+- name: some-db
+  service: some-service
+  plan: some-plan
+  parameters:
+    ram_gb: 4
+    zones:
+    - name: z1
+  tags:
+  - tag-1
+  - tag-2
+  update: true
+- name: some-cache
+  service: other-service
+  plan: other-plan
+  parameters: ` + filepath.Base(pathToParameters) + `
+`
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(pathToParameters)).ToNot(HaveOccurred())
+			})
+
+			It("reads the service instances and their parameters", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(serviceInstances).To(Equal([]ServiceInstance{
+					{
+						Name:    "some-db",
+						Service: "some-service",
+						Plan:    "some-plan",
+						Parameters: map[string]interface{}{
+							"ram_gb": 4,
+							"zones":  []interface{}{map[string]interface{}{"name": "z1"}},
+						},
+						Tags:   []string{"tag-1", "tag-2"},
+						Update: true,
+					},
+					{
+						Name:           "some-cache",
+						Service:        "other-service",
+						Plan:           "other-plan",
+						Parameters:     map[string]interface{}{"cluster_nodes": map[string]interface{}{"count": float64(5)}},
+						ParametersPath: filepath.Base(pathToParameters),
+					},
+				}))
+			})
+		})
+
+		Context("when the parameters file cannot be read", func() {
+			BeforeEach(func() {
+				manifest = `---
+services:
+- name: some-db
+  service: some-service
+  plan: some-plan
+  parameters: /does/not/exist.json
+`
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(HaveOccurred())
+			})
+		})
+
+		Context("when the parameters are neither an object nor a path", func() {
+			BeforeEach(func() {
+				manifest = `---
+services:
+- name: some-db
+  service: some-service
+  plan: some-plan
+  parameters:
+  - not-an-object
+`
+			})
+
+			It("returns an InvalidServiceInstanceParametersError", func() {
+				Expect(executeErr).To(MatchError(InvalidServiceInstanceParametersError{Name: "some-db"}))
+			})
+		})
+
+		Context("when the services block lists service instance names", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: "app-1"
+services:
+- some-existing-db
+- name: some-db
+  service: some-service
+  plan: some-plan
+`
+			})
+
+			It("returns only the declared service instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(serviceInstances).To(Equal([]ServiceInstance{
+					{Name: "some-db", Service: "some-service", Plan: "some-plan"},
+				}))
+			})
+		})
+
+		Context("when the manifest has no services block", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: "app-1"
+`
+			})
+
+			It("returns no service instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(serviceInstances).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("WriteApplicationManifest", func() {
+		var (
+			apps       []Application
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			manifest = ""
+			apps = []Application{
+				{
+					Name:                 "app-1",
+					Buildpacks:           []string{"some-buildpack", "another-buildpack"},
+					EnvironmentVariables: map[string]string{"SOME_VAR": "some-value"},
+					Routes:               []string{"some-host.some-domain.com"},
+					StackName:            "some-stack",
+					Processes: []Process{
+						{
+							Type:                    "web",
+							Command:                 "some-web-command",
+							DiskQuota:               1100,
+							HealthCheckHTTPEndpoint: "/health",
+							HealthCheckType:         "http",
+							Instances:               3,
+							Memory:                  256,
+							HealthCheckTimeout:      90,
+						},
+						{
+							Type:            "worker",
+							HealthCheckType: "process",
+							Instances:       1,
+							Memory:          64,
+						},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			executeErr = WriteApplicationManifest(pathToManifest, apps)
+		})
+
+		It("writes a manifest that can be read back", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			readApps, err := ReadAndMergeManifests(pathToManifest)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readApps).To(Equal(apps))
+		})
+
+		It("omits unset attributes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			raw, err := ioutil.ReadFile(pathToManifest)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).ToNot(ContainSubstring("buildpack:"))
+			Expect(string(raw)).ToNot(ContainSubstring("services:"))
+			Expect(string(raw)).To(ContainSubstring("disk_quota: 1100M"))
+		})
+
+		Context("when a process is scaled to 0 instances", func() {
+			BeforeEach(func() {
+				apps[0].Processes[1].Instances = 0
+			})
+
+			It("writes the instances of the process", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				raw, err := ioutil.ReadFile(pathToManifest)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).To(ContainSubstring("  - type: worker\n    health-check-type: process\n    instances: 0\n"))
+
+				readApps, err := ReadAndMergeManifests(pathToManifest)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(readApps).To(Equal(apps))
+			})
+		})
+
+		Context("when the application has no processes", func() {
+			BeforeEach(func() {
+				apps[0].Processes = nil
+			})
+
+			It("writes the instances of the application", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				raw, err := ioutil.ReadFile(pathToManifest)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).To(ContainSubstring("\n  instances: 0\n"))
+			})
+		})
 	})
 })
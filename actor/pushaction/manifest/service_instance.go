@@ -0,0 +1,127 @@
+package manifest
+
+import "fmt"
+
+// ServiceInstance is a service instance declared in the top-level services
+// block of a manifest. Push creates it when it does not exist in the space.
+type ServiceInstance struct {
+	Name string
+	// Service is the label of the service offering in the marketplace.
+	Service string
+	Plan    string
+	// Parameters are the service-specific configuration parameters sent to
+	// the service broker. They are either given inline or read from the JSON
+	// file at ParametersPath.
+	Parameters     map[string]interface{}
+	ParametersPath string
+	Tags           []string
+	// Update makes push change the plan, parameters and tags of an existing
+	// service instance to the ones in the manifest.
+	Update bool
+
+	// nameOnly is set for entries given as a plain service instance name, as
+	// in the legacy global services list. They are not declared by the
+	// manifest.
+	nameOnly bool
+}
+
+// InvalidServiceInstanceParametersError is returned when the parameters of a
+// service instance are neither an object nor the path to a file containing a
+// JSON object.
+type InvalidServiceInstanceParametersError struct {
+	Name string
+	Path string
+}
+
+func (e InvalidServiceInstanceParametersError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("parameters of service instance %s must be an object or the path to a JSON file", e.Name)
+	}
+	return fmt.Sprintf("parameters file %s of service instance %s is not a valid JSON object", e.Path, e.Name)
+}
+
+type manifestServiceInstance struct {
+	Name       string      `yaml:"name"`
+	Service    string      `yaml:"service"`
+	Plan       string      `yaml:"plan"`
+	Parameters interface{} `yaml:"parameters,omitempty"`
+	Tags       []string    `yaml:"tags,omitempty"`
+	Update     bool        `yaml:"update,omitempty"`
+}
+
+func (serviceInstance ServiceInstance) MarshalYAML() (interface{}, error) {
+	if serviceInstance.nameOnly {
+		return serviceInstance.Name, nil
+	}
+
+	manifestInstance := manifestServiceInstance{
+		Name:    serviceInstance.Name,
+		Service: serviceInstance.Service,
+		Plan:    serviceInstance.Plan,
+		Tags:    serviceInstance.Tags,
+		Update:  serviceInstance.Update,
+	}
+
+	if serviceInstance.ParametersPath != "" {
+		manifestInstance.Parameters = serviceInstance.ParametersPath
+	} else if serviceInstance.Parameters != nil {
+		manifestInstance.Parameters = serviceInstance.Parameters
+	}
+
+	return manifestInstance, nil
+}
+
+func (serviceInstance *ServiceInstance) UnmarshalYAML(unmarshaller func(interface{}) error) error {
+	var name string
+	if err := unmarshaller(&name); err == nil {
+		serviceInstance.Name = name
+		serviceInstance.nameOnly = true
+		return nil
+	}
+
+	var manifestInstance manifestServiceInstance
+
+	err := unmarshaller(&manifestInstance)
+	if err != nil {
+		return err
+	}
+
+	serviceInstance.Name = manifestInstance.Name
+	serviceInstance.Service = manifestInstance.Service
+	serviceInstance.Plan = manifestInstance.Plan
+	serviceInstance.Tags = manifestInstance.Tags
+	serviceInstance.Update = manifestInstance.Update
+
+	switch parameters := manifestInstance.Parameters.(type) {
+	case nil:
+	case string:
+		serviceInstance.ParametersPath = parameters
+	case map[interface{}]interface{}:
+		serviceInstance.Parameters = stringifyKeys(parameters).(map[string]interface{})
+	default:
+		return InvalidServiceInstanceParametersError{Name: manifestInstance.Name}
+	}
+
+	return nil
+}
+
+// stringifyKeys converts the map[interface{}]interface{} values produced by
+// the YAML decoder into map[string]interface{} so they can be sent as JSON.
+func stringifyKeys(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[interface{}]interface{}:
+		converted := map[string]interface{}{}
+		for key, nested := range typed {
+			converted[fmt.Sprint(key)] = stringifyKeys(nested)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(typed))
+		for i, nested := range typed {
+			converted[i] = stringifyKeys(nested)
+		}
+		return converted
+	default:
+		return value
+	}
+}
@@ -4,6 +4,7 @@ package pushactionfakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
@@ -69,6 +70,25 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	CreateServiceInstanceStub        func(spaceGUID string, servicePlanGUID string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (v2action.ServiceInstance, v2action.Warnings, error)
+	createServiceInstanceMutex       sync.RWMutex
+	createServiceInstanceArgsForCall []struct {
+		spaceGUID           string
+		servicePlanGUID     string
+		serviceInstanceName string
+		parameters          map[string]interface{}
+		tags                []string
+	}
+	createServiceInstanceReturns struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	createServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
 	FindRouteBoundToSpaceWithSettingsStub        func(route v2action.Route) (v2action.Route, v2action.Warnings, error)
 	findRouteBoundToSpaceWithSettingsMutex       sync.RWMutex
 	findRouteBoundToSpaceWithSettingsArgsForCall []struct {
@@ -187,6 +207,23 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetServicePlanByNameAndServiceAndSpaceStub        func(planName string, serviceName string, spaceGUID string) (v2action.ServicePlan, v2action.Warnings, error)
+	getServicePlanByNameAndServiceAndSpaceMutex       sync.RWMutex
+	getServicePlanByNameAndServiceAndSpaceArgsForCall []struct {
+		planName    string
+		serviceName string
+		spaceGUID   string
+	}
+	getServicePlanByNameAndServiceAndSpaceReturns struct {
+		result1 v2action.ServicePlan
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServicePlanByNameAndServiceAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.ServicePlan
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetStackStub        func(guid string) (v2action.Stack, v2action.Warnings, error)
 	getStackMutex       sync.RWMutex
 	getStackArgsForCall []struct {
@@ -230,6 +267,22 @@ type FakeV2Actor struct {
 		result1 v2action.Warnings
 		result2 error
 	}
+	PollServiceInstanceOperationStub        func(serviceInstance v2action.ServiceInstance, timeout time.Duration) (<-chan v2action.ServiceInstance, <-chan string, <-chan error)
+	pollServiceInstanceOperationMutex       sync.RWMutex
+	pollServiceInstanceOperationArgsForCall []struct {
+		serviceInstance v2action.ServiceInstance
+		timeout         time.Duration
+	}
+	pollServiceInstanceOperationReturns struct {
+		result1 <-chan v2action.ServiceInstance
+		result2 <-chan string
+		result3 <-chan error
+	}
+	pollServiceInstanceOperationReturnsOnCall map[int]struct {
+		result1 <-chan v2action.ServiceInstance
+		result2 <-chan string
+		result3 <-chan error
+	}
 	ResourceMatchStub        func(allResources []v2action.Resource) ([]v2action.Resource, []v2action.Resource, v2action.Warnings, error)
 	resourceMatchMutex       sync.RWMutex
 	resourceMatchArgsForCall []struct {
@@ -262,6 +315,24 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	UpdateServiceInstanceStub        func(serviceInstance v2action.ServiceInstance, servicePlanGUID string, parameters map[string]interface{}, tags *[]string) (v2action.ServiceInstance, v2action.Warnings, error)
+	updateServiceInstanceMutex       sync.RWMutex
+	updateServiceInstanceArgsForCall []struct {
+		serviceInstance v2action.ServiceInstance
+		servicePlanGUID string
+		parameters      map[string]interface{}
+		tags            *[]string
+	}
+	updateServiceInstanceReturns struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	updateServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
 	UploadApplicationPackageStub        func(appGUID string, existingResources []v2action.Resource, newResources io.Reader, newResourcesLength int64) (v2action.Job, v2action.Warnings, error)
 	uploadApplicationPackageMutex       sync.RWMutex
 	uploadApplicationPackageArgsForCall []struct {
@@ -525,6 +596,69 @@ func (fake *FakeV2Actor) CreateRouteReturnsOnCall(i int, result1 v2action.Route,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) CreateServiceInstance(spaceGUID string, servicePlanGUID string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (v2action.ServiceInstance, v2action.Warnings, error) {
+	var tagsCopy []string
+	if tags != nil {
+		tagsCopy = make([]string, len(tags))
+		copy(tagsCopy, tags)
+	}
+	fake.createServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createServiceInstanceReturnsOnCall[len(fake.createServiceInstanceArgsForCall)]
+	fake.createServiceInstanceArgsForCall = append(fake.createServiceInstanceArgsForCall, struct {
+		spaceGUID           string
+		servicePlanGUID     string
+		serviceInstanceName string
+		parameters          map[string]interface{}
+		tags                []string
+	}{spaceGUID, servicePlanGUID, serviceInstanceName, parameters, tagsCopy})
+	fake.recordInvocation("CreateServiceInstance", []interface{}{spaceGUID, servicePlanGUID, serviceInstanceName, parameters, tagsCopy})
+	fake.createServiceInstanceMutex.Unlock()
+	if fake.CreateServiceInstanceStub != nil {
+		return fake.CreateServiceInstanceStub(spaceGUID, servicePlanGUID, serviceInstanceName, parameters, tags)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createServiceInstanceReturns.result1, fake.createServiceInstanceReturns.result2, fake.createServiceInstanceReturns.result3
+}
+
+func (fake *FakeV2Actor) CreateServiceInstanceCallCount() int {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return len(fake.createServiceInstanceArgsForCall)
+}
+
+func (fake *FakeV2Actor) CreateServiceInstanceArgsForCall(i int) (string, string, string, map[string]interface{}, []string) {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return fake.createServiceInstanceArgsForCall[i].spaceGUID, fake.createServiceInstanceArgsForCall[i].servicePlanGUID, fake.createServiceInstanceArgsForCall[i].serviceInstanceName, fake.createServiceInstanceArgsForCall[i].parameters, fake.createServiceInstanceArgsForCall[i].tags
+}
+
+func (fake *FakeV2Actor) CreateServiceInstanceReturns(result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.CreateServiceInstanceStub = nil
+	fake.createServiceInstanceReturns = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) CreateServiceInstanceReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.CreateServiceInstanceStub = nil
+	if fake.createServiceInstanceReturnsOnCall == nil {
+		fake.createServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.createServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) FindRouteBoundToSpaceWithSettings(route v2action.Route) (v2action.Route, v2action.Warnings, error) {
 	fake.findRouteBoundToSpaceWithSettingsMutex.Lock()
 	ret, specificReturn := fake.findRouteBoundToSpaceWithSettingsReturnsOnCall[len(fake.findRouteBoundToSpaceWithSettingsArgsForCall)]
@@ -953,6 +1087,62 @@ func (fake *FakeV2Actor) GetServiceInstancesByApplicationReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) GetServicePlanByNameAndServiceAndSpace(planName string, serviceName string, spaceGUID string) (v2action.ServicePlan, v2action.Warnings, error) {
+	fake.getServicePlanByNameAndServiceAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getServicePlanByNameAndServiceAndSpaceReturnsOnCall[len(fake.getServicePlanByNameAndServiceAndSpaceArgsForCall)]
+	fake.getServicePlanByNameAndServiceAndSpaceArgsForCall = append(fake.getServicePlanByNameAndServiceAndSpaceArgsForCall, struct {
+		planName    string
+		serviceName string
+		spaceGUID   string
+	}{planName, serviceName, spaceGUID})
+	fake.recordInvocation("GetServicePlanByNameAndServiceAndSpace", []interface{}{planName, serviceName, spaceGUID})
+	fake.getServicePlanByNameAndServiceAndSpaceMutex.Unlock()
+	if fake.GetServicePlanByNameAndServiceAndSpaceStub != nil {
+		return fake.GetServicePlanByNameAndServiceAndSpaceStub(planName, serviceName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicePlanByNameAndServiceAndSpaceReturns.result1, fake.getServicePlanByNameAndServiceAndSpaceReturns.result2, fake.getServicePlanByNameAndServiceAndSpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetServicePlanByNameAndServiceAndSpaceCallCount() int {
+	fake.getServicePlanByNameAndServiceAndSpaceMutex.RLock()
+	defer fake.getServicePlanByNameAndServiceAndSpaceMutex.RUnlock()
+	return len(fake.getServicePlanByNameAndServiceAndSpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetServicePlanByNameAndServiceAndSpaceArgsForCall(i int) (string, string, string) {
+	fake.getServicePlanByNameAndServiceAndSpaceMutex.RLock()
+	defer fake.getServicePlanByNameAndServiceAndSpaceMutex.RUnlock()
+	return fake.getServicePlanByNameAndServiceAndSpaceArgsForCall[i].planName, fake.getServicePlanByNameAndServiceAndSpaceArgsForCall[i].serviceName, fake.getServicePlanByNameAndServiceAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetServicePlanByNameAndServiceAndSpaceReturns(result1 v2action.ServicePlan, result2 v2action.Warnings, result3 error) {
+	fake.GetServicePlanByNameAndServiceAndSpaceStub = nil
+	fake.getServicePlanByNameAndServiceAndSpaceReturns = struct {
+		result1 v2action.ServicePlan
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServicePlanByNameAndServiceAndSpaceReturnsOnCall(i int, result1 v2action.ServicePlan, result2 v2action.Warnings, result3 error) {
+	fake.GetServicePlanByNameAndServiceAndSpaceStub = nil
+	if fake.getServicePlanByNameAndServiceAndSpaceReturnsOnCall == nil {
+		fake.getServicePlanByNameAndServiceAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServicePlan
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlanByNameAndServiceAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.ServicePlan
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) GetStack(guid string) (v2action.Stack, v2action.Warnings, error) {
 	fake.getStackMutex.Lock()
 	ret, specificReturn := fake.getStackReturnsOnCall[len(fake.getStackArgsForCall)]
@@ -1112,6 +1302,61 @@ func (fake *FakeV2Actor) PollJobReturnsOnCall(i int, result1 v2action.Warnings,
 	}{result1, result2}
 }
 
+func (fake *FakeV2Actor) PollServiceInstanceOperation(serviceInstance v2action.ServiceInstance, timeout time.Duration) (<-chan v2action.ServiceInstance, <-chan string, <-chan error) {
+	fake.pollServiceInstanceOperationMutex.Lock()
+	ret, specificReturn := fake.pollServiceInstanceOperationReturnsOnCall[len(fake.pollServiceInstanceOperationArgsForCall)]
+	fake.pollServiceInstanceOperationArgsForCall = append(fake.pollServiceInstanceOperationArgsForCall, struct {
+		serviceInstance v2action.ServiceInstance
+		timeout         time.Duration
+	}{serviceInstance, timeout})
+	fake.recordInvocation("PollServiceInstanceOperation", []interface{}{serviceInstance, timeout})
+	fake.pollServiceInstanceOperationMutex.Unlock()
+	if fake.PollServiceInstanceOperationStub != nil {
+		return fake.PollServiceInstanceOperationStub(serviceInstance, timeout)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.pollServiceInstanceOperationReturns.result1, fake.pollServiceInstanceOperationReturns.result2, fake.pollServiceInstanceOperationReturns.result3
+}
+
+func (fake *FakeV2Actor) PollServiceInstanceOperationCallCount() int {
+	fake.pollServiceInstanceOperationMutex.RLock()
+	defer fake.pollServiceInstanceOperationMutex.RUnlock()
+	return len(fake.pollServiceInstanceOperationArgsForCall)
+}
+
+func (fake *FakeV2Actor) PollServiceInstanceOperationArgsForCall(i int) (v2action.ServiceInstance, time.Duration) {
+	fake.pollServiceInstanceOperationMutex.RLock()
+	defer fake.pollServiceInstanceOperationMutex.RUnlock()
+	return fake.pollServiceInstanceOperationArgsForCall[i].serviceInstance, fake.pollServiceInstanceOperationArgsForCall[i].timeout
+}
+
+func (fake *FakeV2Actor) PollServiceInstanceOperationReturns(result1 <-chan v2action.ServiceInstance, result2 <-chan string, result3 <-chan error) {
+	fake.PollServiceInstanceOperationStub = nil
+	fake.pollServiceInstanceOperationReturns = struct {
+		result1 <-chan v2action.ServiceInstance
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) PollServiceInstanceOperationReturnsOnCall(i int, result1 <-chan v2action.ServiceInstance, result2 <-chan string, result3 <-chan error) {
+	fake.PollServiceInstanceOperationStub = nil
+	if fake.pollServiceInstanceOperationReturnsOnCall == nil {
+		fake.pollServiceInstanceOperationReturnsOnCall = make(map[int]struct {
+			result1 <-chan v2action.ServiceInstance
+			result2 <-chan string
+			result3 <-chan error
+		})
+	}
+	fake.pollServiceInstanceOperationReturnsOnCall[i] = struct {
+		result1 <-chan v2action.ServiceInstance
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) ResourceMatch(allResources []v2action.Resource) ([]v2action.Resource, []v2action.Resource, v2action.Warnings, error) {
 	var allResourcesCopy []v2action.Resource
 	if allResources != nil {
@@ -1228,6 +1473,63 @@ func (fake *FakeV2Actor) UpdateApplicationReturnsOnCall(i int, result1 v2action.
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) UpdateServiceInstance(serviceInstance v2action.ServiceInstance, servicePlanGUID string, parameters map[string]interface{}, tags *[]string) (v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.updateServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.updateServiceInstanceReturnsOnCall[len(fake.updateServiceInstanceArgsForCall)]
+	fake.updateServiceInstanceArgsForCall = append(fake.updateServiceInstanceArgsForCall, struct {
+		serviceInstance v2action.ServiceInstance
+		servicePlanGUID string
+		parameters      map[string]interface{}
+		tags            *[]string
+	}{serviceInstance, servicePlanGUID, parameters, tags})
+	fake.recordInvocation("UpdateServiceInstance", []interface{}{serviceInstance, servicePlanGUID, parameters, tags})
+	fake.updateServiceInstanceMutex.Unlock()
+	if fake.UpdateServiceInstanceStub != nil {
+		return fake.UpdateServiceInstanceStub(serviceInstance, servicePlanGUID, parameters, tags)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateServiceInstanceReturns.result1, fake.updateServiceInstanceReturns.result2, fake.updateServiceInstanceReturns.result3
+}
+
+func (fake *FakeV2Actor) UpdateServiceInstanceCallCount() int {
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	return len(fake.updateServiceInstanceArgsForCall)
+}
+
+func (fake *FakeV2Actor) UpdateServiceInstanceArgsForCall(i int) (v2action.ServiceInstance, string, map[string]interface{}, *[]string) {
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	return fake.updateServiceInstanceArgsForCall[i].serviceInstance, fake.updateServiceInstanceArgsForCall[i].servicePlanGUID, fake.updateServiceInstanceArgsForCall[i].parameters, fake.updateServiceInstanceArgsForCall[i].tags
+}
+
+func (fake *FakeV2Actor) UpdateServiceInstanceReturns(result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.UpdateServiceInstanceStub = nil
+	fake.updateServiceInstanceReturns = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) UpdateServiceInstanceReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.UpdateServiceInstanceStub = nil
+	if fake.updateServiceInstanceReturnsOnCall == nil {
+		fake.updateServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.updateServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) UploadApplicationPackage(appGUID string, existingResources []v2action.Resource, newResources io.Reader, newResourcesLength int64) (v2action.Job, v2action.Warnings, error) {
 	var existingResourcesCopy []v2action.Resource
 	if existingResources != nil {
@@ -1415,6 +1717,8 @@ func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	defer fake.createApplicationMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
 	fake.findRouteBoundToSpaceWithSettingsMutex.RLock()
 	defer fake.findRouteBoundToSpaceWithSettingsMutex.RUnlock()
 	fake.gatherArchiveResourcesMutex.RLock()
@@ -1431,16 +1735,22 @@ func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
 	fake.getServiceInstancesByApplicationMutex.RLock()
 	defer fake.getServiceInstancesByApplicationMutex.RUnlock()
+	fake.getServicePlanByNameAndServiceAndSpaceMutex.RLock()
+	defer fake.getServicePlanByNameAndServiceAndSpaceMutex.RUnlock()
 	fake.getStackMutex.RLock()
 	defer fake.getStackMutex.RUnlock()
 	fake.getStackByNameMutex.RLock()
 	defer fake.getStackByNameMutex.RUnlock()
 	fake.pollJobMutex.RLock()
 	defer fake.pollJobMutex.RUnlock()
+	fake.pollServiceInstanceOperationMutex.RLock()
+	defer fake.pollServiceInstanceOperationMutex.RUnlock()
 	fake.resourceMatchMutex.RLock()
 	defer fake.resourceMatchMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
 	fake.uploadApplicationPackageMutex.RLock()
 	defer fake.uploadApplicationPackageMutex.RUnlock()
 	fake.zipArchiveResourcesMutex.RLock()
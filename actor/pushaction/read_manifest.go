@@ -6,3 +6,8 @@ func (*Actor) ReadManifest(pathToManifest string) ([]manifest.Application, error
 	// Cover method to make testing easier
 	return manifest.ReadAndMergeManifests(pathToManifest)
 }
+
+func (*Actor) ReadManifestServiceInstances(pathToManifest string) ([]manifest.ServiceInstance, error) {
+	// Cover method to make testing easier
+	return manifest.ReadServiceInstances(pathToManifest)
+}
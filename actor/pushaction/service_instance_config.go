@@ -0,0 +1,166 @@
+package pushaction
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	log "github.com/sirupsen/logrus"
+)
+
+// MissingServiceInstanceFieldError is returned when a service instance in the
+// manifest services block does not specify a required attribute.
+type MissingServiceInstanceFieldError struct {
+	Name  string
+	Field string
+}
+
+func (e MissingServiceInstanceFieldError) Error() string {
+	return fmt.Sprintf("service instance %s is missing %s", e.Name, e.Field)
+}
+
+// ServiceInstanceConfig is the current and desired state of a service
+// instance declared in the manifest services block.
+type ServiceInstanceConfig struct {
+	CurrentServiceInstance v2action.ServiceInstance
+	DesiredServiceInstance v2action.ServiceInstance
+
+	// DesiredParameters are sent to the service broker when the service
+	// instance is created or updated.
+	DesiredParameters map[string]interface{}
+
+	TargetedSpaceGUID string
+}
+
+// CreatingServiceInstance returns true if the service instance does not exist
+// in the space yet.
+func (config ServiceInstanceConfig) CreatingServiceInstance() bool {
+	return config.CurrentServiceInstance.GUID == ""
+}
+
+// UpdatingServiceInstance returns true if the service instance exists but its
+// plan, parameters or tags need to be changed. Since the Cloud Controller
+// does not return the current parameters, any desired parameters count as a
+// change.
+func (config ServiceInstanceConfig) UpdatingServiceInstance() bool {
+	if config.CreatingServiceInstance() {
+		return false
+	}
+
+	return config.CurrentServiceInstance.ServicePlanGUID != config.DesiredServiceInstance.ServicePlanGUID ||
+		!reflect.DeepEqual(config.CurrentServiceInstance.Tags, config.DesiredServiceInstance.Tags) ||
+		config.DesiredParameters != nil
+}
+
+// ConvertToServiceInstanceConfigs looks up the service instances declared in
+// the manifest services block. Missing service instances are configured to be
+// created; existing ones are only changed when their manifest entry sets
+// update.
+func (actor Actor) ConvertToServiceInstanceConfigs(spaceGUID string, serviceInstances []manifest.ServiceInstance) ([]ServiceInstanceConfig, Warnings, error) {
+	var configs []ServiceInstanceConfig
+	var warnings Warnings
+
+	log.Infof("iterating through %d service instance configuration(s)", len(serviceInstances))
+	for _, serviceInstance := range serviceInstances {
+		err := validateServiceInstance(serviceInstance)
+		if err != nil {
+			return nil, warnings, err
+		}
+
+		config := ServiceInstanceConfig{TargetedSpaceGUID: spaceGUID}
+
+		log.Infoln("searching for service instance", serviceInstance.Name)
+		currentInstance, v2Warnings, err := actor.V2Actor.GetServiceInstanceByNameAndSpace(serviceInstance.Name, spaceGUID)
+		warnings = append(warnings, v2Warnings...)
+		switch err.(type) {
+		case nil:
+			log.Debugln("found service instance:", currentInstance.GUID)
+			config.CurrentServiceInstance = currentInstance
+			config.DesiredServiceInstance = currentInstance
+			if !serviceInstance.Update {
+				configs = append(configs, config)
+				continue
+			}
+		case v2action.ServiceInstanceNotFoundError:
+			log.Debugln("service instance not found, creating:", serviceInstance.Name)
+			config.DesiredServiceInstance.Name = serviceInstance.Name
+		default:
+			log.Errorln("service instance lookup:", err)
+			return nil, warnings, err
+		}
+
+		plan, v2Warnings, err := actor.V2Actor.GetServicePlanByNameAndServiceAndSpace(serviceInstance.Plan, serviceInstance.Service, spaceGUID)
+		warnings = append(warnings, v2Warnings...)
+		if err != nil {
+			log.Errorln("service plan lookup:", err)
+			return nil, warnings, err
+		}
+
+		config.DesiredServiceInstance.ServicePlanGUID = plan.GUID
+		if serviceInstance.Tags != nil {
+			config.DesiredServiceInstance.Tags = serviceInstance.Tags
+		}
+		config.DesiredParameters = serviceInstance.Parameters
+
+		configs = append(configs, config)
+	}
+
+	return configs, warnings, nil
+}
+
+// ApplyServiceInstance creates or updates the service instance so it matches
+// the desired configuration. The returned service instance may still have an
+// operation in progress; use PollServiceInstanceOperation to wait for it.
+func (actor Actor) ApplyServiceInstance(config ServiceInstanceConfig) (v2action.ServiceInstance, Warnings, error) {
+	desired := config.DesiredServiceInstance
+
+	switch {
+	case config.CreatingServiceInstance():
+		log.Infoln("creating service instance", desired.Name)
+		instance, warnings, err := actor.V2Actor.CreateServiceInstance(config.TargetedSpaceGUID, desired.ServicePlanGUID, desired.Name, config.DesiredParameters, desired.Tags)
+		if _, ok := err.(v2action.ServiceInstanceAlreadyExistsError); ok {
+			log.Debugln("service instance created concurrently:", desired.Name)
+			return instance, Warnings(warnings), nil
+		}
+		return instance, Warnings(warnings), err
+	case config.UpdatingServiceInstance():
+		log.Infoln("updating service instance", desired.Name)
+		current := config.CurrentServiceInstance
+
+		var planGUID string
+		if current.ServicePlanGUID != desired.ServicePlanGUID {
+			planGUID = desired.ServicePlanGUID
+		}
+
+		var tags *[]string
+		if !reflect.DeepEqual(current.Tags, desired.Tags) {
+			tags = &desired.Tags
+		}
+
+		instance, warnings, err := actor.V2Actor.UpdateServiceInstance(current, planGUID, config.DesiredParameters, tags)
+		return instance, Warnings(warnings), err
+	default:
+		log.Debugln("service instance unchanged:", desired.Name)
+		return config.CurrentServiceInstance, nil, nil
+	}
+}
+
+// PollServiceInstanceOperation waits for the service broker to finish the
+// last operation on the service instance.
+func (actor Actor) PollServiceInstanceOperation(serviceInstance v2action.ServiceInstance, timeout time.Duration) (<-chan v2action.ServiceInstance, <-chan string, <-chan error) {
+	return actor.V2Actor.PollServiceInstanceOperation(serviceInstance, timeout)
+}
+
+func validateServiceInstance(serviceInstance manifest.ServiceInstance) error {
+	switch {
+	case serviceInstance.Name == "":
+		return MissingServiceInstanceFieldError{Field: "name"}
+	case serviceInstance.Service == "":
+		return MissingServiceInstanceFieldError{Name: serviceInstance.Name, Field: "service"}
+	case serviceInstance.Plan == "":
+		return MissingServiceInstanceFieldError{Name: serviceInstance.Name, Field: "plan"}
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+package pushaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Config", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		actor = NewActor(fakeV2Actor)
+	})
+
+	Describe("UpdatingServiceInstance", func() {
+		var config ServiceInstanceConfig
+
+		BeforeEach(func() {
+			config = ServiceInstanceConfig{
+				CurrentServiceInstance: v2action.ServiceInstance{GUID: "some-guid", ServicePlanGUID: "plan-guid", Tags: []string{"tag"}},
+				DesiredServiceInstance: v2action.ServiceInstance{GUID: "some-guid", ServicePlanGUID: "plan-guid", Tags: []string{"tag"}},
+			}
+		})
+
+		It("returns false when nothing changed", func() {
+			Expect(config.UpdatingServiceInstance()).To(BeFalse())
+		})
+
+		It("returns true when the plan changed", func() {
+			config.DesiredServiceInstance.ServicePlanGUID = "other-plan-guid"
+			Expect(config.UpdatingServiceInstance()).To(BeTrue())
+		})
+
+		It("returns true when the tags changed", func() {
+			config.DesiredServiceInstance.Tags = []string{"other-tag"}
+			Expect(config.UpdatingServiceInstance()).To(BeTrue())
+		})
+
+		It("returns true when parameters are desired", func() {
+			config.DesiredParameters = map[string]interface{}{"some": "parameter"}
+			Expect(config.UpdatingServiceInstance()).To(BeTrue())
+		})
+
+		It("returns false when the service instance is being created", func() {
+			config.CurrentServiceInstance = v2action.ServiceInstance{}
+			Expect(config.CreatingServiceInstance()).To(BeTrue())
+			Expect(config.UpdatingServiceInstance()).To(BeFalse())
+		})
+	})
+
+	Describe("ConvertToServiceInstanceConfigs", func() {
+		var (
+			serviceInstances []manifest.ServiceInstance
+
+			configs    []ServiceInstanceConfig
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			serviceInstances = []manifest.ServiceInstance{{
+				Name:       "some-instance",
+				Service:    "some-service",
+				Plan:       "some-plan",
+				Parameters: map[string]interface{}{"some": "parameter"},
+				Tags:       []string{"tag-1"},
+			}}
+
+			fakeV2Actor.GetServicePlanByNameAndServiceAndSpaceReturns(v2action.ServicePlan{GUID: "some-plan-guid"}, v2action.Warnings{"plan-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			configs, warnings, executeErr = actor.ConvertToServiceInstanceConfigs("some-space-guid", serviceInstances)
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetServiceInstanceByNameAndSpaceReturns(v2action.ServiceInstance{}, v2action.Warnings{"instance-warning"}, v2action.ServiceInstanceNotFoundError{Name: "some-instance"})
+			})
+
+			It("configures the service instance to be created", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("instance-warning", "plan-warning"))
+				Expect(configs).To(Equal([]ServiceInstanceConfig{{
+					DesiredServiceInstance: v2action.ServiceInstance{
+						Name:            "some-instance",
+						ServicePlanGUID: "some-plan-guid",
+						Tags:            []string{"tag-1"},
+					},
+					DesiredParameters: map[string]interface{}{"some": "parameter"},
+					TargetedSpaceGUID: "some-space-guid",
+				}}))
+				Expect(configs[0].CreatingServiceInstance()).To(BeTrue())
+
+				name, spaceGUID := fakeV2Actor.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+				Expect(name).To(Equal("some-instance"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				planName, serviceName, spaceGUID := fakeV2Actor.GetServicePlanByNameAndServiceAndSpaceArgsForCall(0)
+				Expect(planName).To(Equal("some-plan"))
+				Expect(serviceName).To(Equal("some-service"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+
+			Context("when the plan cannot be found", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetServicePlanByNameAndServiceAndSpaceReturns(v2action.ServicePlan{}, v2action.Warnings{"plan-warning"}, v2action.ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"})
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError(v2action.ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"}))
+					Expect(warnings).To(ConsistOf("instance-warning", "plan-warning"))
+				})
+			})
+		})
+
+		Context("when the service instance exists", func() {
+			var existing v2action.ServiceInstance
+
+			BeforeEach(func() {
+				existing = v2action.ServiceInstance{GUID: "some-instance-guid", Name: "some-instance", ServicePlanGUID: "old-plan-guid"}
+				fakeV2Actor.GetServiceInstanceByNameAndSpaceReturns(existing, v2action.Warnings{"instance-warning"}, nil)
+			})
+
+			Context("when update is not set", func() {
+				It("leaves the service instance unchanged", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(configs).To(HaveLen(1))
+					Expect(configs[0].CreatingServiceInstance()).To(BeFalse())
+					Expect(configs[0].UpdatingServiceInstance()).To(BeFalse())
+					Expect(fakeV2Actor.GetServicePlanByNameAndServiceAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when update is set", func() {
+				BeforeEach(func() {
+					serviceInstances[0].Update = true
+				})
+
+				It("configures the service instance to be updated", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("instance-warning", "plan-warning"))
+					Expect(configs).To(HaveLen(1))
+					Expect(configs[0].CurrentServiceInstance).To(Equal(existing))
+					Expect(configs[0].DesiredServiceInstance.ServicePlanGUID).To(Equal("some-plan-guid"))
+					Expect(configs[0].DesiredServiceInstance.Tags).To(Equal([]string{"tag-1"}))
+					Expect(configs[0].UpdatingServiceInstance()).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when the service instance lookup fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("lookup error")
+				fakeV2Actor.GetServiceInstanceByNameAndSpaceReturns(v2action.ServiceInstance{}, v2action.Warnings{"instance-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("instance-warning"))
+			})
+		})
+
+		Context("when the plan is missing from the manifest", func() {
+			BeforeEach(func() {
+				serviceInstances[0].Plan = ""
+			})
+
+			It("returns a MissingServiceInstanceFieldError", func() {
+				Expect(executeErr).To(MatchError(MissingServiceInstanceFieldError{Name: "some-instance", Field: "plan"}))
+				Expect(fakeV2Actor.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("ApplyServiceInstance", func() {
+		var (
+			config ServiceInstanceConfig
+
+			instance   v2action.ServiceInstance
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			config = ServiceInstanceConfig{
+				DesiredServiceInstance: v2action.ServiceInstance{Name: "some-instance", ServicePlanGUID: "some-plan-guid", Tags: []string{"tag-1"}},
+				DesiredParameters:      map[string]interface{}{"some": "parameter"},
+				TargetedSpaceGUID:      "some-space-guid",
+			}
+		})
+
+		JustBeforeEach(func() {
+			instance, warnings, executeErr = actor.ApplyServiceInstance(config)
+		})
+
+		Context("when creating the service instance", func() {
+			BeforeEach(func() {
+				fakeV2Actor.CreateServiceInstanceReturns(v2action.ServiceInstance{GUID: "some-instance-guid"}, v2action.Warnings{"create-warning"}, nil)
+			})
+
+			It("creates it with the desired plan, parameters and tags", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(instance).To(Equal(v2action.ServiceInstance{GUID: "some-instance-guid"}))
+				Expect(warnings).To(ConsistOf("create-warning"))
+
+				spaceGUID, planGUID, name, parameters, tags := fakeV2Actor.CreateServiceInstanceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(planGUID).To(Equal("some-plan-guid"))
+				Expect(name).To(Equal("some-instance"))
+				Expect(parameters).To(Equal(map[string]interface{}{"some": "parameter"}))
+				Expect(tags).To(Equal([]string{"tag-1"}))
+			})
+
+			Context("when the service instance was created in the meantime", func() {
+				BeforeEach(func() {
+					fakeV2Actor.CreateServiceInstanceReturns(v2action.ServiceInstance{GUID: "existing-guid"}, v2action.Warnings{"create-warning"}, v2action.ServiceInstanceAlreadyExistsError{Name: "some-instance"})
+				})
+
+				It("returns the existing service instance", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(instance.GUID).To(Equal("existing-guid"))
+				})
+			})
+		})
+
+		Context("when updating the service instance", func() {
+			BeforeEach(func() {
+				config.CurrentServiceInstance = v2action.ServiceInstance{GUID: "some-instance-guid", Name: "some-instance", ServicePlanGUID: "old-plan-guid", Tags: []string{"tag-1"}}
+				config.DesiredServiceInstance.GUID = "some-instance-guid"
+				fakeV2Actor.UpdateServiceInstanceReturns(v2action.ServiceInstance{GUID: "some-instance-guid"}, v2action.Warnings{"update-warning"}, nil)
+			})
+
+			It("only sends the settings that changed", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("update-warning"))
+
+				current, planGUID, parameters, tags := fakeV2Actor.UpdateServiceInstanceArgsForCall(0)
+				Expect(current.GUID).To(Equal("some-instance-guid"))
+				Expect(planGUID).To(Equal("some-plan-guid"))
+				Expect(parameters).To(Equal(map[string]interface{}{"some": "parameter"}))
+				Expect(tags).To(BeNil())
+			})
+		})
+
+		Context("when the service instance is unchanged", func() {
+			BeforeEach(func() {
+				config.CurrentServiceInstance = config.DesiredServiceInstance
+				config.CurrentServiceInstance.GUID = "some-instance-guid"
+				config.DesiredServiceInstance = config.CurrentServiceInstance
+				config.DesiredParameters = nil
+			})
+
+			It("does not call the cloud controller", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(instance.GUID).To(Equal("some-instance-guid"))
+				Expect(fakeV2Actor.CreateServiceInstanceCallCount()).To(Equal(0))
+				Expect(fakeV2Actor.UpdateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -2,6 +2,7 @@ package pushaction
 
 import (
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 )
@@ -13,6 +14,7 @@ type V2Actor interface {
 	BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error)
 	CreateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
 	CreateRoute(route v2action.Route, generatePort bool) (v2action.Route, v2action.Warnings, error)
+	CreateServiceInstance(spaceGUID string, servicePlanGUID string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (v2action.ServiceInstance, v2action.Warnings, error)
 	FindRouteBoundToSpaceWithSettings(route v2action.Route) (v2action.Route, v2action.Warnings, error)
 	GatherArchiveResources(archivePath string) ([]v2action.Resource, error)
 	GatherDirectoryResources(sourceDir string) ([]v2action.Resource, error)
@@ -21,11 +23,14 @@ type V2Actor interface {
 	GetOrganizationDomains(orgGUID string) ([]v2action.Domain, v2action.Warnings, error)
 	GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
 	GetServiceInstancesByApplication(appGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+	GetServicePlanByNameAndServiceAndSpace(planName string, serviceName string, spaceGUID string) (v2action.ServicePlan, v2action.Warnings, error)
 	GetStack(guid string) (v2action.Stack, v2action.Warnings, error)
 	GetStackByName(stackName string) (v2action.Stack, v2action.Warnings, error)
 	PollJob(job v2action.Job) (v2action.Warnings, error)
+	PollServiceInstanceOperation(serviceInstance v2action.ServiceInstance, timeout time.Duration) (<-chan v2action.ServiceInstance, <-chan string, <-chan error)
 	ResourceMatch(allResources []v2action.Resource) ([]v2action.Resource, []v2action.Resource, v2action.Warnings, error)
 	UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
+	UpdateServiceInstance(serviceInstance v2action.ServiceInstance, servicePlanGUID string, parameters map[string]interface{}, tags *[]string) (v2action.ServiceInstance, v2action.Warnings, error)
 	UploadApplicationPackage(appGUID string, existingResources []v2action.Resource, newResources io.Reader, newResourcesLength int64) (v2action.Job, v2action.Warnings, error)
 	ZipArchiveResources(sourceArchivePath string, filesToInclude []v2action.Resource) (string, error)
 	ZipDirectoryResources(sourceDir string, filesToInclude []v2action.Resource) (string, error)
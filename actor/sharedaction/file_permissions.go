@@ -0,0 +1,32 @@
+package sharedaction
+
+import (
+	"fmt"
+	"os"
+)
+
+// FilePermissionsTooOpenError is returned when a file holding credentials can
+// be accessed by users other than its owner.
+type FilePermissionsTooOpenError struct {
+	Path string
+	Mode os.FileMode
+}
+
+func (e FilePermissionsTooOpenError) Error() string {
+	return fmt.Sprintf("Permissions %04o for %s are too open.", uint32(e.Mode.Perm()), e.Path)
+}
+
+// CheckFilePermissions returns a FilePermissionsTooOpenError when the file
+// can be read or written by users other than its owner.
+func (Actor) CheckFilePermissions(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	if permissionsTooOpen(info.Mode()) {
+		return FilePermissionsTooOpenError{Path: path, Mode: info.Mode()}
+	}
+
+	return nil
+}
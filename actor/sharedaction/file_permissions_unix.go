@@ -0,0 +1,11 @@
+// +build !windows
+
+package sharedaction
+
+import "os"
+
+// permissionsTooOpen returns true when the group or other users have any
+// permissions on the file.
+func permissionsTooOpen(mode os.FileMode) bool {
+	return mode.Perm()&0077 != 0
+}
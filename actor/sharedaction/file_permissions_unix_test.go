@@ -0,0 +1,64 @@
+// +build !windows
+
+package sharedaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckFilePermissions", func() {
+	var (
+		actor   *Actor
+		tempDir string
+		path    string
+	)
+
+	BeforeEach(func() {
+		actor = NewActor()
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(tempDir, "config.json")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	Context("when only the owner can access the file", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(path, []byte("{}"), 0600)).To(Succeed())
+		})
+
+		It("returns no error", func() {
+			Expect(actor.CheckFilePermissions(path)).To(Succeed())
+		})
+	})
+
+	Context("when other users can read the file", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(path, []byte("{}"), 0600)).To(Succeed())
+			Expect(os.Chmod(path, 0644)).To(Succeed())
+		})
+
+		It("returns a FilePermissionsTooOpenError", func() {
+			err := actor.CheckFilePermissions(path)
+			Expect(err).To(MatchError(FilePermissionsTooOpenError{Path: path, Mode: 0644}))
+			Expect(err.Error()).To(Equal("Permissions 0644 for " + path + " are too open."))
+		})
+	})
+
+	Context("when the file does not exist", func() {
+		It("returns the error", func() {
+			err := actor.CheckFilePermissions(path)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
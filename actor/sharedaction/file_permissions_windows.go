@@ -0,0 +1,11 @@
+// +build windows
+
+package sharedaction
+
+import "os"
+
+// permissionsTooOpen always returns false because Windows file modes do not
+// reflect the ACLs that control access to the file.
+func permissionsTooOpen(mode os.FileMode) bool {
+	return false
+}
@@ -0,0 +1,68 @@
+package v2action
+
+import (
+	"crypto/tls"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// CheckEndpoint verifies that the endpoint can be reached by opening a
+// connection to it. For https and wss endpoints a TLS handshake is completed
+// as well, validating the certificate unless SSL validation is skipped in the
+// config. Endpoints without a scheme, such as the SSH proxy, are dialed as
+// host:port.
+func (actor Actor) CheckEndpoint(endpoint string) error {
+	address, useTLS, err := endpointAddress(endpoint)
+	if err != nil {
+		return err
+	}
+
+	dialer := &net.Dialer{Timeout: actor.Config.DialTimeout()}
+	if !useTLS {
+		conn, dialErr := dialer.Dial("tcp", address)
+		if dialErr != nil {
+			return dialErr
+		}
+		return conn.Close()
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	conn, err := tls.DialWithDialer(dialer, "tcp", address, &tls.Config{
+		InsecureSkipVerify: actor.Config.SkipSSLValidation(),
+		ServerName:         host,
+	})
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// endpointAddress returns the host:port to dial for the endpoint, and whether
+// the endpoint speaks TLS.
+func endpointAddress(endpoint string) (string, bool, error) {
+	if !strings.Contains(endpoint, "://") {
+		return endpoint, false, nil
+	}
+
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return "", false, err
+	}
+
+	var useTLS bool
+	defaultPort := "80"
+	switch endpointURL.Scheme {
+	case "https", "wss":
+		useTLS = true
+		defaultPort = "443"
+	}
+
+	if endpointURL.Port() != "" {
+		return endpointURL.Host, useTLS, nil
+	}
+	return net.JoinHostPort(endpointURL.Hostname(), defaultPort), useTLS, nil
+}
@@ -0,0 +1,112 @@
+package v2action_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Endpoint Actions", func() {
+	var (
+		actor      *Actor
+		fakeConfig *v2actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		fakeConfig.DialTimeoutReturns(time.Second)
+		actor = NewActor(nil, nil, fakeConfig)
+	})
+
+	Describe("CheckEndpoint", func() {
+		Context("when the endpoint has no scheme", func() {
+			var listener net.Listener
+
+			BeforeEach(func() {
+				var err error
+				listener, err = net.Listen("tcp", "127.0.0.1:0")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				listener.Close()
+			})
+
+			It("dials the address", func() {
+				Expect(actor.CheckEndpoint(listener.Addr().String())).To(Succeed())
+			})
+
+			Context("when nothing listens on the address", func() {
+				BeforeEach(func() {
+					listener.Close()
+				})
+
+				It("returns the error", func() {
+					Expect(actor.CheckEndpoint(listener.Addr().String())).To(MatchError(ContainSubstring("connection refused")))
+				})
+			})
+		})
+
+		Context("when the endpoint uses TLS", func() {
+			var server *httptest.Server
+
+			BeforeEach(func() {
+				server = httptest.NewTLSServer(http.NotFoundHandler())
+			})
+
+			AfterEach(func() {
+				server.Close()
+			})
+
+			It("validates the certificate", func() {
+				err := actor.CheckEndpoint(server.URL)
+				Expect(err).To(MatchError(ContainSubstring("certificate")))
+			})
+
+			It("accepts wss endpoints", func() {
+				err := actor.CheckEndpoint(strings.Replace(server.URL, "https://", "wss://", 1))
+				Expect(err).To(MatchError(ContainSubstring("certificate")))
+			})
+
+			Context("when SSL validation is skipped", func() {
+				BeforeEach(func() {
+					fakeConfig.SkipSSLValidationReturns(true)
+				})
+
+				It("completes the handshake", func() {
+					Expect(actor.CheckEndpoint(server.URL)).To(Succeed())
+				})
+			})
+		})
+
+		Context("when the endpoint is plain HTTP", func() {
+			var server *httptest.Server
+
+			BeforeEach(func() {
+				server = httptest.NewServer(http.NotFoundHandler())
+			})
+
+			AfterEach(func() {
+				server.Close()
+			})
+
+			It("dials the address without TLS", func() {
+				Expect(actor.CheckEndpoint(server.URL)).To(Succeed())
+			})
+		})
+
+		Context("when the endpoint is not a valid URL", func() {
+			It("returns the error", func() {
+				Expect(actor.CheckEndpoint("https://%zz")).ToNot(Succeed())
+			})
+		})
+	})
+})
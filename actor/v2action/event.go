@@ -0,0 +1,120 @@
+package v2action
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// Event represents a Cloud Controller audit event.
+type Event ccv2.Event
+
+// EventFilter narrows down the events returned by GetEvents. Empty fields do
+// not filter.
+type EventFilter struct {
+	// ActeeTypes are the resource types the events are about, for example app
+	// or space.
+	ActeeTypes []string
+
+	// Types are the event types, for example audit.app.update.
+	Types []string
+
+	// Actor matches the GUID, name or username of the actor. The Cloud
+	// Controller cannot filter on the actor, so it is matched by the CLI.
+	Actor string
+
+	OrganizationGUID string
+	SpaceGUID        string
+
+	// Since and Until bound the time of the events, inclusively.
+	Since time.Time
+	Until time.Time
+}
+
+// GetEvents returns all the events matching the filter, oldest first.
+func (actor Actor) GetEvents(filter EventFilter) ([]Event, Warnings, error) {
+	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(filter.queries())
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var events []Event
+	for _, ccEvent := range ccEvents {
+		event := Event(ccEvent)
+		if filter.Actor != "" && !event.actedBy(filter.Actor) {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	sort.SliceStable(events, func(i int, j int) bool {
+		return events[i].Timestamp.Before(events[j].Timestamp)
+	})
+
+	return events, Warnings(warnings), nil
+}
+
+func (event Event) actedBy(actor string) bool {
+	return event.ActorGUID == actor ||
+		strings.EqualFold(event.ActorName, actor) ||
+		strings.EqualFold(event.ActorUsername, actor)
+}
+
+func (filter EventFilter) queries() []ccv2.Query {
+	var queries []ccv2.Query
+
+	if len(filter.ActeeTypes) > 0 {
+		queries = append(queries, inQuery(ccv2.ActeeTypeFilter, filter.ActeeTypes))
+	}
+	if len(filter.Types) > 0 {
+		queries = append(queries, inQuery(ccv2.TypeFilter, filter.Types))
+	}
+
+	if filter.SpaceGUID != "" {
+		queries = append(queries, ccv2.Query{
+			Filter:   ccv2.SpaceGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    filter.SpaceGUID,
+		})
+	} else if filter.OrganizationGUID != "" {
+		queries = append(queries, ccv2.Query{
+			Filter:   ccv2.OrganizationGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    filter.OrganizationGUID,
+		})
+	}
+
+	if !filter.Since.IsZero() {
+		queries = append(queries, ccv2.Query{
+			Filter:   ccv2.TimestampFilter,
+			Operator: ccv2.GreaterThanOrEqualOperator,
+			Value:    filter.Since.UTC().Format(time.RFC3339),
+		})
+	}
+	if !filter.Until.IsZero() {
+		queries = append(queries, ccv2.Query{
+			Filter:   ccv2.TimestampFilter,
+			Operator: ccv2.LessThanOrEqualOperator,
+			Value:    filter.Until.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return queries
+}
+
+func inQuery(filter ccv2.QueryFilter, values []string) ccv2.Query {
+	if len(values) == 1 {
+		return ccv2.Query{
+			Filter:   filter,
+			Operator: ccv2.EqualOperator,
+			Value:    values[0],
+		}
+	}
+	return ccv2.Query{
+		Filter:   filter,
+		Operator: ccv2.InOperator,
+		Value:    strings.Join(values, ","),
+	}
+}
@@ -0,0 +1,139 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Event Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetEvents", func() {
+		var (
+			filter     EventFilter
+			events     []Event
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			filter = EventFilter{}
+		})
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = actor.GetEvents(filter)
+		})
+
+		Context("when the cloud controller client returns events", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns([]ccv2.Event{
+					{GUID: "event-3", ActorGUID: "user-guid", ActorName: "Some-User", Timestamp: time.Date(2017, time.August, 16, 12, 0, 0, 0, time.UTC)},
+					{GUID: "event-1", ActorGUID: "other-guid", ActorName: "other-user", Timestamp: time.Date(2017, time.August, 16, 10, 0, 0, 0, time.UTC)},
+					{GUID: "event-2", ActorGUID: "system-guid", ActorName: "system", ActorUsername: "some-user", Timestamp: time.Date(2017, time.August, 16, 11, 0, 0, 0, time.UTC)},
+				}, ccv2.Warnings{"get-events-warning"}, nil)
+			})
+
+			It("returns the events oldest first and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-events-warning"))
+				Expect(events).To(HaveLen(3))
+				Expect(events[0].GUID).To(Equal("event-1"))
+				Expect(events[1].GUID).To(Equal("event-2"))
+				Expect(events[2].GUID).To(Equal("event-3"))
+
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(BeEmpty())
+			})
+
+			Context("when filtering by actor", func() {
+				BeforeEach(func() {
+					filter.Actor = "some-user"
+				})
+
+				It("returns the events whose actor name or username matches", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(events).To(HaveLen(2))
+					Expect(events[0].GUID).To(Equal("event-2"))
+					Expect(events[1].GUID).To(Equal("event-3"))
+				})
+			})
+
+			Context("when filtering by actor GUID", func() {
+				BeforeEach(func() {
+					filter.Actor = "other-guid"
+				})
+
+				It("returns the events of that actor", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(events).To(HaveLen(1))
+					Expect(events[0].GUID).To(Equal("event-1"))
+				})
+			})
+		})
+
+		Context("when filtering on the cloud controller", func() {
+			BeforeEach(func() {
+				filter = EventFilter{
+					ActeeTypes:       []string{"app", "space"},
+					Types:            []string{"audit.app.update"},
+					OrganizationGUID: "some-org-guid",
+					Since:            time.Date(2017, time.August, 16, 10, 0, 0, 0, time.UTC),
+					Until:            time.Date(2017, time.August, 17, 10, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+				}
+			})
+
+			It("passes the filters as queries", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(Equal([]ccv2.Query{
+					{Filter: ccv2.ActeeTypeFilter, Operator: ccv2.InOperator, Value: "app,space"},
+					{Filter: ccv2.TypeFilter, Operator: ccv2.EqualOperator, Value: "audit.app.update"},
+					{Filter: ccv2.OrganizationGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-org-guid"},
+					{Filter: ccv2.TimestampFilter, Operator: ccv2.GreaterThanOrEqualOperator, Value: "2017-08-16T10:00:00Z"},
+					{Filter: ccv2.TimestampFilter, Operator: ccv2.LessThanOrEqualOperator, Value: "2017-08-17T08:00:00Z"},
+				}))
+			})
+
+			Context("when a space is also provided", func() {
+				BeforeEach(func() {
+					filter.SpaceGUID = "some-space-guid"
+				})
+
+				It("filters by the space instead of the organization", func() {
+					queries := fakeCloudControllerClient.GetEventsArgsForCall(0)
+					Expect(queries).To(ContainElement(ccv2.Query{Filter: ccv2.SpaceGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-space-guid"}))
+					Expect(queries).ToNot(ContainElement(ccv2.Query{Filter: ccv2.OrganizationGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-org-guid"}))
+				})
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-events-error")
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv2.Warnings{"get-events-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-events-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,28 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// APIInformation represents the information advertised by the Cloud
+// Controller on /v2/info.
+type APIInformation ccv2.APIInformation
+
+// GetAPIInformation connects to the Cloud Controller with the given settings
+// and returns the information it advertises. Unlike SetTarget, it does not
+// change the target in the config.
+func (actor Actor) GetAPIInformation(settings TargetSettings) (APIInformation, Warnings, error) {
+	var allWarnings Warnings
+
+	warnings, err := actor.CloudControllerClient.TargetCF(ccv2.TargetSettings(settings))
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return APIInformation{}, allWarnings, err
+	}
+
+	info, warnings, err := actor.CloudControllerClient.Info()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return APIInformation{}, allWarnings, err
+	}
+
+	return APIInformation(info), allWarnings, nil
+}
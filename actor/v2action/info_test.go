@@ -0,0 +1,83 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Info Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetAPIInformation", func() {
+		var (
+			settings TargetSettings
+			info     APIInformation
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			settings = TargetSettings{URL: "https://api.foo.com", SkipSSLValidation: true}
+		})
+
+		JustBeforeEach(func() {
+			info, warnings, err = actor.GetAPIInformation(settings)
+		})
+
+		Context("when the Cloud Controller can be reached", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.TargetCFReturns(ccv2.Warnings{"target-warning"}, nil)
+				fakeCloudControllerClient.InfoReturns(
+					ccv2.APIInformation{APIVersion: "2.59.0", DopplerEndpoint: "wss://doppler.foo.com"},
+					ccv2.Warnings{"info-warning"},
+					nil)
+			})
+
+			It("returns the advertised information and all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info).To(Equal(APIInformation{APIVersion: "2.59.0", DopplerEndpoint: "wss://doppler.foo.com"}))
+				Expect(warnings).To(ConsistOf("target-warning", "info-warning"))
+
+				Expect(fakeCloudControllerClient.TargetCFCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.TargetCFArgsForCall(0)).To(Equal(ccv2.TargetSettings(settings)))
+			})
+		})
+
+		Context("when targeting the Cloud Controller fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.TargetCFReturns(ccv2.Warnings{"target-warning"}, errors.New("dial tcp: i/o timeout"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("dial tcp: i/o timeout"))
+				Expect(warnings).To(ConsistOf("target-warning"))
+				Expect(fakeCloudControllerClient.InfoCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the information fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.InfoReturns(ccv2.APIInformation{}, ccv2.Warnings{"info-warning"}, errors.New("info error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("info error"))
+				Expect(warnings).To(ConsistOf("info-warning"))
+			})
+		})
+	})
+})
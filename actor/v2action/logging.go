@@ -1,6 +1,8 @@
 package v2action
 
 import (
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/cloudfoundry/noaa"
@@ -61,6 +63,40 @@ func NewLogMessage(message string, messageType int, timestamp time.Time, sourceT
 	}
 }
 
+// LogMessageFilter selects log messages by source type, source instance,
+// message type and content. Empty fields match every message.
+type LogMessageFilter struct {
+	// SourceType matches the message's source type up to the first '/', so
+	// "APP" matches "APP/PROC/WEB".
+	SourceType     string
+	SourceInstance string
+	// MessageType is either "OUT" or "ERR".
+	MessageType string
+	Pattern     *regexp.Regexp
+}
+
+// Matches returns true if the message satisfies every criterion set on the
+// filter.
+func (filter LogMessageFilter) Matches(message LogMessage) bool {
+	if filter.SourceType != "" && strings.SplitN(message.SourceType(), "/", 2)[0] != filter.SourceType {
+		return false
+	}
+
+	if filter.SourceInstance != "" && message.SourceInstance() != filter.SourceInstance {
+		return false
+	}
+
+	if filter.MessageType != "" && message.Type() != filter.MessageType {
+		return false
+	}
+
+	if filter.Pattern != nil && !filter.Pattern.MatchString(message.Message()) {
+		return false
+	}
+
+	return true
+}
+
 func (Actor) GetStreamingLogs(appGUID string, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error) {
 	// Do not pass in token because client should have a TokenRefresher set
 	eventStream, errStream := client.TailingLogs(appGUID, "")
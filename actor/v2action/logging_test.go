@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"regexp"
 	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
@@ -46,6 +47,56 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("LogMessageFilter", func() {
+		var message *LogMessage
+
+		BeforeEach(func() {
+			message = NewLogMessage("GET /api/users 200", int(events.LogMessage_OUT), time.Now(), "APP/PROC/WEB", "1")
+		})
+
+		Context("when the filter is empty", func() {
+			It("matches every message", func() {
+				Expect(LogMessageFilter{}.Matches(*message)).To(BeTrue())
+			})
+		})
+
+		Context("when filtering by source type", func() {
+			It("matches on the source type prefix", func() {
+				Expect(LogMessageFilter{SourceType: "APP"}.Matches(*message)).To(BeTrue())
+				Expect(LogMessageFilter{SourceType: "RTR"}.Matches(*message)).To(BeFalse())
+				Expect(LogMessageFilter{SourceType: "AP"}.Matches(*message)).To(BeFalse())
+			})
+		})
+
+		Context("when filtering by source instance", func() {
+			It("matches on the exact instance", func() {
+				Expect(LogMessageFilter{SourceInstance: "1"}.Matches(*message)).To(BeTrue())
+				Expect(LogMessageFilter{SourceInstance: "0"}.Matches(*message)).To(BeFalse())
+			})
+		})
+
+		Context("when filtering by message type", func() {
+			It("matches on the message type", func() {
+				Expect(LogMessageFilter{MessageType: "OUT"}.Matches(*message)).To(BeTrue())
+				Expect(LogMessageFilter{MessageType: "ERR"}.Matches(*message)).To(BeFalse())
+			})
+		})
+
+		Context("when filtering by pattern", func() {
+			It("matches on the message content", func() {
+				Expect(LogMessageFilter{Pattern: regexp.MustCompile(`/api/\w+ 2\d\d`)}.Matches(*message)).To(BeTrue())
+				Expect(LogMessageFilter{Pattern: regexp.MustCompile(`5\d\d$`)}.Matches(*message)).To(BeFalse())
+			})
+		})
+
+		Context("when filtering by multiple criteria", func() {
+			It("requires all of them to match", func() {
+				Expect(LogMessageFilter{SourceType: "APP", MessageType: "OUT", SourceInstance: "1"}.Matches(*message)).To(BeTrue())
+				Expect(LogMessageFilter{SourceType: "APP", MessageType: "ERR"}.Matches(*message)).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("GetStreamingLogs", func() {
 		var (
 			expectedAppGUID string
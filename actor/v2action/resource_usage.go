@@ -0,0 +1,214 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/types"
+)
+
+// ResourceUsage is the consumption of a resource limited by a quota.
+type ResourceUsage struct {
+	Used int
+
+	// Limit is not set when the resource is unlimited or not limited by any
+	// quota.
+	Limit types.NullInt
+}
+
+// Percentage returns the used share of the limit, rounded down. It returns
+// false when the resource is unlimited.
+func (usage ResourceUsage) Percentage() (int, bool) {
+	if !usage.Limit.IsSet {
+		return 0, false
+	}
+	if usage.Limit.Value == 0 {
+		if usage.Used == 0 {
+			return 0, true
+		}
+		return 100, true
+	}
+	return usage.Used * 100 / usage.Limit.Value, true
+}
+
+func (usage ResourceUsage) add(other ResourceUsage) ResourceUsage {
+	usage.Used += other.Used
+	return usage
+}
+
+// ResourceUsages is the consumption of every resource limited by
+// organization and space quotas.
+type ResourceUsages struct {
+	// Memory is the memory reserved by started application instances, in
+	// megabytes.
+	Memory ResourceUsage
+
+	// AppInstances is the number of instances of started applications.
+	AppInstances ResourceUsage
+
+	Routes ResourceUsage
+
+	// ServiceInstances is the number of managed service instances.
+	// User-provided service instances do not count towards quotas.
+	ServiceInstances ResourceUsage
+
+	// AppTasks only carries the task limit since running tasks are only
+	// reported by the V3 API.
+	AppTasks ResourceUsage
+}
+
+func (usages ResourceUsages) add(other ResourceUsages) ResourceUsages {
+	return ResourceUsages{
+		Memory:           usages.Memory.add(other.Memory),
+		AppInstances:     usages.AppInstances.add(other.AppInstances),
+		Routes:           usages.Routes.add(other.Routes),
+		ServiceInstances: usages.ServiceInstances.add(other.ServiceInstances),
+		AppTasks:         usages.AppTasks.add(other.AppTasks),
+	}
+}
+
+// SpaceResourceUsage is the resource usage of a space compared to its space
+// quota.
+type SpaceResourceUsage struct {
+	ResourceUsages
+	Space
+
+	// QuotaName is empty when the space has no space quota.
+	QuotaName string
+}
+
+// OrganizationResourceUsage is the resource usage of an organization compared
+// to its quota, along with the usage of each of its spaces.
+type OrganizationResourceUsage struct {
+	ResourceUsages
+	Organization
+
+	QuotaName string
+	Spaces    []SpaceResourceUsage
+}
+
+// GetOrganizationResourceUsageByName returns the resource usage of the
+// organization and of each of its spaces, sorted by space name.
+func (actor Actor) GetOrganizationResourceUsageByName(orgName string) (OrganizationResourceUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationResourceUsage{}, allWarnings, err
+	}
+
+	quota, warnings, err := actor.GetOrganizationQuota(org.QuotaDefinitionGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationResourceUsage{}, allWarnings, err
+	}
+
+	orgUsage := OrganizationResourceUsage{
+		Organization: org,
+		QuotaName:    quota.Name,
+		ResourceUsages: ResourceUsages{
+			Memory:           ResourceUsage{Limit: quota.MemoryLimit},
+			AppInstances:     ResourceUsage{Limit: quota.AppInstanceLimit},
+			Routes:           ResourceUsage{Limit: quota.TotalRoutes},
+			ServiceInstances: ResourceUsage{Limit: quota.TotalServices},
+			AppTasks:         ResourceUsage{Limit: quota.AppTaskLimit},
+		},
+	}
+
+	spaces, warnings, err := actor.GetOrganizationSpaces(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationResourceUsage{}, allWarnings, err
+	}
+
+	for _, space := range spaces {
+		spaceUsage, warnings, err := actor.getSpaceResourceUsage(space)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return OrganizationResourceUsage{}, allWarnings, err
+		}
+
+		orgUsage.ResourceUsages = orgUsage.ResourceUsages.add(spaceUsage.ResourceUsages)
+		orgUsage.Spaces = append(orgUsage.Spaces, spaceUsage)
+	}
+
+	sort.Slice(orgUsage.Spaces, func(i int, j int) bool {
+		return orgUsage.Spaces[i].Name < orgUsage.Spaces[j].Name
+	})
+
+	return orgUsage, allWarnings, nil
+}
+
+// GetSpaceResourceUsageByOrganizationAndName returns the resource usage of
+// the space.
+func (actor Actor) GetSpaceResourceUsageByOrganizationAndName(orgGUID string, spaceName string) (SpaceResourceUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	space, warnings, err := actor.GetSpaceByOrganizationAndName(orgGUID, spaceName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceResourceUsage{}, allWarnings, err
+	}
+
+	spaceUsage, warnings, err := actor.getSpaceResourceUsage(space)
+	allWarnings = append(allWarnings, warnings...)
+	return spaceUsage, allWarnings, err
+}
+
+func (actor Actor) getSpaceResourceUsage(space Space) (SpaceResourceUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	spaceUsage := SpaceResourceUsage{
+		Space: space,
+	}
+
+	if space.SpaceQuotaDefinitionGUID != "" {
+		quota, warnings, err := actor.GetSpaceQuota(space.SpaceQuotaDefinitionGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return SpaceResourceUsage{}, allWarnings, err
+		}
+
+		spaceUsage.QuotaName = quota.Name
+		spaceUsage.Memory.Limit = quota.MemoryLimit
+		spaceUsage.AppInstances.Limit = quota.AppInstanceLimit
+		spaceUsage.Routes.Limit = quota.TotalRoutes
+		spaceUsage.ServiceInstances.Limit = quota.TotalServices
+		spaceUsage.AppTasks.Limit = quota.AppTaskLimit
+	}
+
+	apps, warnings, err := actor.GetApplicationsBySpace(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceResourceUsage{}, allWarnings, err
+	}
+
+	for _, app := range apps {
+		if !app.Started() {
+			continue
+		}
+		spaceUsage.Memory.Used += int(app.Memory) * app.Instances
+		spaceUsage.AppInstances.Used += app.Instances
+	}
+
+	routes, ccWarnings, err := actor.CloudControllerClient.GetSpaceRoutes(space.GUID, nil)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return SpaceResourceUsage{}, allWarnings, err
+	}
+	spaceUsage.Routes.Used = len(routes)
+
+	serviceInstances, warnings, err := actor.GetServiceInstancesBySpace(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceResourceUsage{}, allWarnings, err
+	}
+
+	for _, serviceInstance := range serviceInstances {
+		if serviceInstance.Managed() {
+			spaceUsage.ServiceInstances.Used++
+		}
+	}
+
+	return spaceUsage, allWarnings, nil
+}
@@ -0,0 +1,221 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Resource Usage Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+		fakeCloudControllerClient.GetApplicationsStub = func(queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+			switch queries[0].Value {
+			case "space-1-guid":
+				return []ccv2.Application{
+					{Name: "app-1", Memory: 256, Instances: 2, State: ccv2.ApplicationStarted},
+					{Name: "app-2", Memory: 1024, Instances: 4, State: ccv2.ApplicationStopped},
+				}, ccv2.Warnings{"apps-warning"}, nil
+			case "space-2-guid":
+				return []ccv2.Application{
+					{Name: "app-3", Memory: 512, Instances: 1, State: ccv2.ApplicationStarted},
+				}, ccv2.Warnings{"apps-warning"}, nil
+			}
+			return nil, nil, nil
+		}
+		fakeCloudControllerClient.GetSpaceRoutesStub = func(spaceGUID string, queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+			if spaceGUID == "space-1-guid" {
+				return []ccv2.Route{{GUID: "route-1-guid"}, {GUID: "route-2-guid"}}, ccv2.Warnings{"routes-warning"}, nil
+			}
+			return []ccv2.Route{{GUID: "route-3-guid"}}, ccv2.Warnings{"routes-warning"}, nil
+		}
+		fakeCloudControllerClient.GetSpaceServiceInstancesStub = func(spaceGUID string, includeUserProvided bool, queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
+			if spaceGUID == "space-1-guid" {
+				return []ccv2.ServiceInstance{
+					{Name: "managed-instance", Type: ccv2.ManagedService},
+					{Name: "user-provided-instance", Type: ccv2.UserProvidedService},
+				}, ccv2.Warnings{"service-instances-warning"}, nil
+			}
+			return nil, ccv2.Warnings{"service-instances-warning"}, nil
+		}
+		fakeCloudControllerClient.GetSpaceQuotaReturns(
+			ccv2.SpaceQuota{
+				GUID:          "space-quota-guid",
+				Name:          "small",
+				MemoryLimit:   types.NullInt{Value: 1024, IsSet: true},
+				TotalServices: types.NullInt{Value: 1, IsSet: true},
+			},
+			ccv2.Warnings{"space-quota-warning"},
+			nil)
+	})
+
+	DescribeTable("ResourceUsage.Percentage",
+		func(usage ResourceUsage, expectedPercentage int, expectedLimited bool) {
+			percentage, limited := usage.Percentage()
+			Expect(percentage).To(Equal(expectedPercentage))
+			Expect(limited).To(Equal(expectedLimited))
+		},
+		Entry("unlimited", ResourceUsage{Used: 5}, 0, false),
+		Entry("partially used", ResourceUsage{Used: 5, Limit: types.NullInt{Value: 8, IsSet: true}}, 62, true),
+		Entry("over the limit", ResourceUsage{Used: 12, Limit: types.NullInt{Value: 10, IsSet: true}}, 120, true),
+		Entry("nothing allowed or used", ResourceUsage{Limit: types.NullInt{IsSet: true}}, 0, true),
+		Entry("nothing allowed but used", ResourceUsage{Used: 1, Limit: types.NullInt{IsSet: true}}, 100, true),
+	)
+
+	Describe("GetOrganizationResourceUsageByName", func() {
+		var (
+			orgUsage   OrganizationResourceUsage
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "org-guid", Name: "some-org", QuotaDefinitionGUID: "org-quota-guid"}},
+				ccv2.Warnings{"org-warning"},
+				nil)
+			fakeCloudControllerClient.GetOrganizationQuotaReturns(
+				ccv2.OrganizationQuota{
+					GUID:         "org-quota-guid",
+					Name:         "default",
+					MemoryLimit:  types.NullInt{Value: 10240, IsSet: true},
+					TotalRoutes:  types.NullInt{Value: 100, IsSet: true},
+					AppTaskLimit: types.NullInt{Value: 5, IsSet: true},
+				},
+				ccv2.Warnings{"org-quota-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{
+					{GUID: "space-2-guid", Name: "space-2"},
+					{GUID: "space-1-guid", Name: "space-1", SpaceQuotaDefinitionGUID: "space-quota-guid"},
+				},
+				ccv2.Warnings{"spaces-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			orgUsage, warnings, executeErr = actor.GetOrganizationResourceUsageByName("some-org")
+		})
+
+		It("sums the usage of every space and compares it to the quotas", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"org-warning", "org-quota-warning", "spaces-warning",
+				"space-quota-warning",
+				"apps-warning", "routes-warning", "service-instances-warning",
+				"apps-warning", "routes-warning", "service-instances-warning",
+			))
+
+			Expect(orgUsage.Name).To(Equal("some-org"))
+			Expect(orgUsage.QuotaName).To(Equal("default"))
+			Expect(orgUsage.ResourceUsages).To(Equal(ResourceUsages{
+				Memory:           ResourceUsage{Used: 1024, Limit: types.NullInt{Value: 10240, IsSet: true}},
+				AppInstances:     ResourceUsage{Used: 3},
+				Routes:           ResourceUsage{Used: 3, Limit: types.NullInt{Value: 100, IsSet: true}},
+				ServiceInstances: ResourceUsage{Used: 1},
+				AppTasks:         ResourceUsage{Limit: types.NullInt{Value: 5, IsSet: true}},
+			}))
+
+			Expect(orgUsage.Spaces).To(HaveLen(2))
+			Expect(orgUsage.Spaces[0].Name).To(Equal("space-1"))
+			Expect(orgUsage.Spaces[0].QuotaName).To(Equal("small"))
+			Expect(orgUsage.Spaces[0].ResourceUsages).To(Equal(ResourceUsages{
+				Memory:           ResourceUsage{Used: 512, Limit: types.NullInt{Value: 1024, IsSet: true}},
+				AppInstances:     ResourceUsage{Used: 2},
+				Routes:           ResourceUsage{Used: 2},
+				ServiceInstances: ResourceUsage{Used: 1, Limit: types.NullInt{Value: 1, IsSet: true}},
+			}))
+			Expect(orgUsage.Spaces[1].Name).To(Equal("space-2"))
+			Expect(orgUsage.Spaces[1].QuotaName).To(BeEmpty())
+			Expect(orgUsage.Spaces[1].ResourceUsages).To(Equal(ResourceUsages{
+				Memory:       ResourceUsage{Used: 512},
+				AppInstances: ResourceUsage{Used: 1},
+				Routes:       ResourceUsage{Used: 1},
+			}))
+
+			Expect(fakeCloudControllerClient.GetSpaceQuotaCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetSpaceQuotaArgsForCall(0)).To(Equal("space-quota-guid"))
+		})
+
+		Context("when getting the organization quota fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("org quota error")
+				fakeCloudControllerClient.GetOrganizationQuotaReturns(ccv2.OrganizationQuota{}, ccv2.Warnings{"org-quota-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("org-warning", "org-quota-warning"))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the usage of a space fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("routes error")
+				fakeCloudControllerClient.GetSpaceRoutesStub = nil
+				fakeCloudControllerClient.GetSpaceRoutesReturns(nil, ccv2.Warnings{"routes-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("org-warning", "org-quota-warning", "spaces-warning", "apps-warning", "routes-warning"))
+			})
+		})
+	})
+
+	Describe("GetSpaceResourceUsageByOrganizationAndName", func() {
+		var (
+			spaceUsage SpaceResourceUsage
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{{GUID: "space-1-guid", Name: "space-1", SpaceQuotaDefinitionGUID: "space-quota-guid"}},
+				ccv2.Warnings{"spaces-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			spaceUsage, warnings, executeErr = actor.GetSpaceResourceUsageByOrganizationAndName("org-guid", "space-1")
+		})
+
+		It("returns the usage of the space compared to its space quota", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("spaces-warning", "space-quota-warning", "apps-warning", "routes-warning", "service-instances-warning"))
+			Expect(spaceUsage.GUID).To(Equal("space-1-guid"))
+			Expect(spaceUsage.QuotaName).To(Equal("small"))
+			Expect(spaceUsage.Memory).To(Equal(ResourceUsage{Used: 512, Limit: types.NullInt{Value: 1024, IsSet: true}}))
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"spaces-warning"}, nil)
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(SpaceNotFoundError{Name: "space-1"}))
+				Expect(warnings).To(ConsistOf("spaces-warning"))
+			})
+		})
+	})
+})
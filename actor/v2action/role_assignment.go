@@ -0,0 +1,210 @@
+package v2action
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Role is an organization or space role of a user.
+type Role string
+
+const (
+	OrgManagerRole     Role = "OrgManager"
+	BillingManagerRole Role = "BillingManager"
+	OrgAuditorRole     Role = "OrgAuditor"
+	SpaceManagerRole   Role = "SpaceManager"
+	SpaceDeveloperRole Role = "SpaceDeveloper"
+	SpaceAuditorRole   Role = "SpaceAuditor"
+)
+
+var (
+	orgRoles   = []Role{OrgManagerRole, BillingManagerRole, OrgAuditorRole}
+	spaceRoles = []Role{SpaceManagerRole, SpaceDeveloperRole, SpaceAuditorRole}
+)
+
+// RoleAssignment is a role of a user in an organization, or in a space of the
+// organization when SpaceName is set.
+type RoleAssignment struct {
+	Username         string
+	OrganizationName string
+	SpaceName        string
+	Role             Role
+}
+
+// Target returns the organization, or organization and space, of the
+// assignment.
+func (assignment RoleAssignment) Target() string {
+	if assignment.SpaceName == "" {
+		return assignment.OrganizationName
+	}
+	return assignment.OrganizationName + " / " + assignment.SpaceName
+}
+
+func (assignment RoleAssignment) key() string {
+	return strings.Join([]string{
+		strings.ToLower(assignment.Username),
+		assignment.OrganizationName,
+		assignment.SpaceName,
+		string(assignment.Role),
+	}, "\x00")
+}
+
+// InvalidRolesFileError is returned when a roles file cannot be parsed or has
+// invalid entries.
+type InvalidRolesFileError struct {
+	Path     string
+	Problems []string
+}
+
+func (e InvalidRolesFileError) Error() string {
+	return fmt.Sprintf("invalid roles file %s:\n%s", e.Path, strings.Join(e.Problems, "\n"))
+}
+
+type roleAssignmentEntry struct {
+	User  string `yaml:"user"`
+	Org   string `yaml:"org"`
+	Space string `yaml:"space"`
+	Role  string `yaml:"role"`
+}
+
+// ReadRoleAssignments reads the role assignments from a CSV file with a
+// user,org,space,role header, or otherwise from a YAML file with a list of
+// roles. Duplicate assignments are only returned once.
+func ReadRoleAssignments(path string) ([]RoleAssignment, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []roleAssignmentEntry
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		entries, err = parseRoleAssignmentsCSV(file)
+	} else {
+		entries, err = parseRoleAssignmentsYAML(file)
+	}
+	if err != nil {
+		return nil, InvalidRolesFileError{Path: path, Problems: []string{err.Error()}}
+	}
+
+	var (
+		assignments []RoleAssignment
+		problems    []string
+		seen        = map[string]bool{}
+	)
+	for i, entry := range entries {
+		assignment, problem := entry.toRoleAssignment()
+		if problem != "" {
+			problems = append(problems, fmt.Sprintf("entry %d: %s", i+1, problem))
+			continue
+		}
+		if seen[assignment.key()] {
+			continue
+		}
+		seen[assignment.key()] = true
+		assignments = append(assignments, assignment)
+	}
+
+	if len(problems) > 0 {
+		return nil, InvalidRolesFileError{Path: path, Problems: problems}
+	}
+
+	return assignments, nil
+}
+
+func parseRoleAssignmentsYAML(reader io.Reader) ([]roleAssignmentEntry, error) {
+	raw, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var document struct {
+		Roles []roleAssignmentEntry `yaml:"roles"`
+	}
+	err = yaml.Unmarshal(raw, &document)
+	return document.Roles, err
+}
+
+func parseRoleAssignmentsCSV(reader io.Reader) ([]roleAssignmentEntry, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"user", "org", "role"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing '%s' column in header", name)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var entries []roleAssignmentEntry
+	for _, record := range records[1:] {
+		entries = append(entries, roleAssignmentEntry{
+			User:  field(record, "user"),
+			Org:   field(record, "org"),
+			Space: field(record, "space"),
+			Role:  field(record, "role"),
+		})
+	}
+	return entries, nil
+}
+
+func (entry roleAssignmentEntry) toRoleAssignment() (RoleAssignment, string) {
+	switch {
+	case entry.User == "":
+		return RoleAssignment{}, "user is required"
+	case entry.Org == "":
+		return RoleAssignment{}, "org is required"
+	}
+
+	role := Role(entry.Role)
+	if !containsRole(orgRoles, role) && !containsRole(spaceRoles, role) {
+		return RoleAssignment{}, fmt.Sprintf("unknown role '%s'", entry.Role)
+	}
+	if entry.Space == "" && !containsRole(orgRoles, role) {
+		return RoleAssignment{}, fmt.Sprintf("role '%s' requires a space", entry.Role)
+	}
+	if entry.Space != "" && !containsRole(spaceRoles, role) {
+		return RoleAssignment{}, fmt.Sprintf("role '%s' cannot be assigned in a space", entry.Role)
+	}
+
+	return RoleAssignment{
+		Username:         entry.User,
+		OrganizationName: entry.Org,
+		SpaceName:        entry.Space,
+		Role:             role,
+	}, ""
+}
+
+func containsRole(roles []Role, role Role) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
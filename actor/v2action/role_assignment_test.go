@@ -0,0 +1,142 @@
+package v2action_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Role Assignment Actions", func() {
+	Describe("ReadRoleAssignments", func() {
+		var (
+			dir  string
+			path string
+
+			assignments []RoleAssignment
+			err         error
+		)
+
+		BeforeEach(func() {
+			var tempErr error
+			dir, tempErr = ioutil.TempDir("", "role-assignments")
+			Expect(tempErr).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		writeFile := func(name string, contents string) {
+			path = filepath.Join(dir, name)
+			Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+		}
+
+		JustBeforeEach(func() {
+			assignments, err = ReadRoleAssignments(path)
+		})
+
+		Context("when the file is a YAML file", func() {
+			BeforeEach(func() {
+				writeFile("roles.yml", `---
+roles:
+- user: alice@example.com
+  org: my-org
+  role: OrgManager
+- user: bob@example.com
+  org: my-org
+  space: dev
+  role: SpaceDeveloper
+- user: Alice@example.com
+  org: my-org
+  role: OrgManager
+`)
+			})
+
+			It("returns every assignment once", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(assignments).To(Equal([]RoleAssignment{
+					{Username: "alice@example.com", OrganizationName: "my-org", Role: OrgManagerRole},
+					{Username: "bob@example.com", OrganizationName: "my-org", SpaceName: "dev", Role: SpaceDeveloperRole},
+				}))
+			})
+		})
+
+		Context("when the file is a CSV file", func() {
+			BeforeEach(func() {
+				writeFile("roles.CSV", "user, org, space, role\nalice@example.com, my-org, , BillingManager\nbob@example.com, my-org, dev, SpaceAuditor\n")
+			})
+
+			It("reads the columns of the header", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(assignments).To(Equal([]RoleAssignment{
+					{Username: "alice@example.com", OrganizationName: "my-org", Role: BillingManagerRole},
+					{Username: "bob@example.com", OrganizationName: "my-org", SpaceName: "dev", Role: SpaceAuditorRole},
+				}))
+			})
+		})
+
+		Context("when the CSV file is missing a column", func() {
+			BeforeEach(func() {
+				writeFile("roles.csv", "user,space,role\nalice@example.com,dev,SpaceAuditor\n")
+			})
+
+			It("returns an InvalidRolesFileError", func() {
+				Expect(err).To(MatchError(InvalidRolesFileError{
+					Path:     path,
+					Problems: []string{"missing 'org' column in header"},
+				}))
+			})
+		})
+
+		Context("when the file has invalid entries", func() {
+			BeforeEach(func() {
+				writeFile("roles.yml", `---
+roles:
+- org: my-org
+  role: OrgManager
+- user: alice@example.com
+  role: OrgManager
+- user: alice@example.com
+  org: my-org
+  role: Admin
+- user: alice@example.com
+  org: my-org
+  role: SpaceDeveloper
+- user: alice@example.com
+  org: my-org
+  space: dev
+  role: OrgAuditor
+`)
+			})
+
+			It("returns every problem", func() {
+				Expect(assignments).To(BeEmpty())
+				Expect(err).To(MatchError(InvalidRolesFileError{
+					Path: path,
+					Problems: []string{
+						"entry 1: user is required",
+						"entry 2: org is required",
+						"entry 3: unknown role 'Admin'",
+						"entry 4: role 'SpaceDeveloper' requires a space",
+						"entry 5: role 'OrgAuditor' cannot be assigned in a space",
+					},
+				}))
+			})
+		})
+
+		Context("when the file does not exist", func() {
+			BeforeEach(func() {
+				path = filepath.Join(dir, "missing.yml")
+			})
+
+			It("returns the error", func() {
+				_, isPathError := err.(*os.PathError)
+				Expect(isPathError).To(BeTrue())
+			})
+		})
+	})
+})
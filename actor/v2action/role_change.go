@@ -0,0 +1,292 @@
+package v2action
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+var (
+	orgRolePaths = map[Role]ccv2.OrganizationRole{
+		OrgManagerRole:     ccv2.OrganizationManagerRole,
+		BillingManagerRole: ccv2.OrganizationBillingManagerRole,
+		OrgAuditorRole:     ccv2.OrganizationAuditorRole,
+	}
+	spaceRolePaths = map[Role]ccv2.SpaceRole{
+		SpaceManagerRole:   ccv2.SpaceManagerRole,
+		SpaceDeveloperRole: ccv2.SpaceDeveloperRole,
+		SpaceAuditorRole:   ccv2.SpaceAuditorRole,
+	}
+)
+
+// RoleChange is an assignment or, when Remove is set, a removal of a role.
+type RoleChange struct {
+	RoleAssignment
+	Remove bool
+
+	// UserGUID is only known for the removal of existing assignments.
+	UserGUID         string
+	OrganizationGUID string
+	SpaceGUID        string
+}
+
+// RoleChangeError is returned when a role change of a user fails.
+type RoleChangeError struct {
+	Change RoleChange
+	Err    error
+}
+
+func (e RoleChangeError) Error() string {
+	verb := "assignment"
+	if e.Change.Remove {
+		verb = "removal"
+	}
+	return fmt.Sprintf("%s %s %s: %s", e.Change.Role, e.Change.Target(), verb, e.Err)
+}
+
+// UserRoleChangesResult is the outcome of applying the role changes of a
+// user. Err is nil when every change was applied.
+type UserRoleChangesResult struct {
+	Username string
+	Err      error
+}
+
+// GetRoleChanges compares the role assignments with the current ones in their
+// organizations and spaces, and returns the assignments that are missing.
+// With prune it also returns the removal of the current assignments that are
+// not listed, except for the ones of currentUsername. Changes are sorted by
+// user, with the removals of a user first.
+func (actor Actor) GetRoleChanges(assignments []RoleAssignment, prune bool, currentUsername string) ([]RoleChange, Warnings, error) {
+	var allWarnings Warnings
+	orgs := map[string]Organization{}
+	spaces := map[string]roleSpace{}
+
+	for _, assignment := range assignments {
+		org, ok := orgs[assignment.OrganizationName]
+		if !ok {
+			var (
+				warnings Warnings
+				err      error
+			)
+			org, warnings, err = actor.GetOrganizationByName(assignment.OrganizationName)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			orgs[assignment.OrganizationName] = org
+		}
+
+		if assignment.SpaceName == "" {
+			continue
+		}
+		if _, ok := spaces[assignment.Target()]; !ok {
+			space, warnings, err := actor.GetSpaceByOrganizationAndName(org.GUID, assignment.SpaceName)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			spaces[assignment.Target()] = roleSpace{Space: space, OrganizationName: assignment.OrganizationName}
+		}
+	}
+
+	current, warnings, err := actor.getRoleAssignments(orgs, spaces)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	newChange := func(assignment RoleAssignment, userGUID string, remove bool) RoleChange {
+		return RoleChange{
+			RoleAssignment:   assignment,
+			Remove:           remove,
+			UserGUID:         userGUID,
+			OrganizationGUID: orgs[assignment.OrganizationName].GUID,
+			SpaceGUID:        spaces[assignment.Target()].GUID,
+		}
+	}
+
+	assigned := map[string]bool{}
+	for _, existing := range current {
+		assigned[existing.key()] = true
+	}
+
+	var changes []RoleChange
+	for _, assignment := range assignments {
+		if !assigned[assignment.key()] {
+			changes = append(changes, newChange(assignment, "", false))
+		}
+	}
+
+	if prune {
+		wanted := map[string]bool{}
+		for _, assignment := range assignments {
+			wanted[assignment.key()] = true
+		}
+		for _, existing := range current {
+			// Users without a username, such as UAA clients, cannot be listed
+			// in the file and are never pruned.
+			if existing.Username == "" || wanted[existing.key()] || strings.EqualFold(existing.Username, currentUsername) {
+				continue
+			}
+			changes = append(changes, newChange(existing.RoleAssignment, existing.UserGUID, true))
+		}
+	}
+
+	sort.Slice(changes, func(i int, j int) bool {
+		a, b := changes[i], changes[j]
+		switch {
+		case !strings.EqualFold(a.Username, b.Username):
+			return strings.ToLower(a.Username) < strings.ToLower(b.Username)
+		case a.Remove != b.Remove:
+			return a.Remove
+		case a.OrganizationName != b.OrganizationName:
+			return a.OrganizationName < b.OrganizationName
+		case a.SpaceName != b.SpaceName:
+			return a.SpaceName < b.SpaceName
+		default:
+			return a.Role < b.Role
+		}
+	})
+
+	return changes, allWarnings, nil
+}
+
+// ApplyRoleChanges applies the changes of up to concurrency users at the same
+// time, and returns a result for every user in the order of the changes. The
+// changes of a user are applied in order and stop at the first failure.
+func (actor Actor) ApplyRoleChanges(changes []RoleChange, concurrency int) ([]UserRoleChangesResult, Warnings) {
+	var usernames []string
+	changesByUser := map[string][]RoleChange{}
+	for _, change := range changes {
+		username := strings.ToLower(change.Username)
+		if _, ok := changesByUser[username]; !ok {
+			usernames = append(usernames, username)
+		}
+		changesByUser[username] = append(changesByUser[username], change)
+	}
+
+	var (
+		allWarnings Warnings
+		warningsMu  sync.Mutex
+		wg          sync.WaitGroup
+	)
+	results := make([]UserRoleChangesResult, len(usernames))
+	queue := make(chan int)
+	for worker := 0; worker < concurrency; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				userChanges := changesByUser[usernames[i]]
+				warnings, err := actor.applyUserRoleChanges(userChanges)
+
+				warningsMu.Lock()
+				allWarnings = append(allWarnings, warnings...)
+				warningsMu.Unlock()
+
+				results[i] = UserRoleChangesResult{Username: userChanges[0].Username, Err: err}
+			}
+		}()
+	}
+	for i := range usernames {
+		queue <- i
+	}
+	close(queue)
+	wg.Wait()
+
+	return results, allWarnings
+}
+
+func (actor Actor) applyUserRoleChanges(changes []RoleChange) (Warnings, error) {
+	var allWarnings Warnings
+	for _, change := range changes {
+		warnings, err := actor.applyRoleChange(change)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, RoleChangeError{Change: change, Err: err}
+		}
+	}
+	return allWarnings, nil
+}
+
+func (actor Actor) applyRoleChange(change RoleChange) (Warnings, error) {
+	switch {
+	case change.Remove && change.SpaceName == "":
+		warnings, err := actor.CloudControllerClient.DeleteOrganizationUserByRole(orgRolePaths[change.Role], change.OrganizationGUID, change.UserGUID)
+		return Warnings(warnings), err
+	case change.Remove:
+		warnings, err := actor.CloudControllerClient.DeleteSpaceUserByRole(spaceRolePaths[change.Role], change.SpaceGUID, change.UserGUID)
+		return Warnings(warnings), err
+	}
+
+	// Roles are only assigned to users of the organization. The current user
+	// may manage the space without being allowed to add users to the
+	// organization, in which case the user might already be one.
+	allWarnings, err := actor.CloudControllerClient.UpdateOrganizationUserByRoleAndUsername(ccv2.OrganizationUserRole, change.OrganizationGUID, change.Username)
+	if _, forbidden := err.(ccerror.ForbiddenError); err != nil && !(forbidden && change.SpaceName != "") {
+		return Warnings(allWarnings), err
+	}
+
+	var warnings ccv2.Warnings
+	if change.SpaceName == "" {
+		warnings, err = actor.CloudControllerClient.UpdateOrganizationUserByRoleAndUsername(orgRolePaths[change.Role], change.OrganizationGUID, change.Username)
+	} else {
+		warnings, err = actor.CloudControllerClient.UpdateSpaceUserByRoleAndUsername(spaceRolePaths[change.Role], change.SpaceGUID, change.Username)
+	}
+	allWarnings = append(allWarnings, warnings...)
+	return Warnings(allWarnings), err
+}
+
+type roleSpace struct {
+	Space
+	OrganizationName string
+}
+
+type existingRoleAssignment struct {
+	RoleAssignment
+	UserGUID string
+}
+
+// getRoleAssignments returns the current role assignments in the
+// organizations and spaces. Users are told apart by GUID, since not every
+// user has a username.
+func (actor Actor) getRoleAssignments(orgs map[string]Organization, spaces map[string]roleSpace) ([]existingRoleAssignment, Warnings, error) {
+	var (
+		allWarnings Warnings
+		current     []existingRoleAssignment
+	)
+	add := func(users []ccv2.User, assignment RoleAssignment) {
+		for _, user := range users {
+			assignment.Username = user.Username
+			current = append(current, existingRoleAssignment{RoleAssignment: assignment, UserGUID: user.GUID})
+		}
+	}
+
+	for orgName, org := range orgs {
+		for _, role := range orgRoles {
+			users, warnings, err := actor.CloudControllerClient.GetOrganizationUsersByRole(orgRolePaths[role], org.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			add(users, RoleAssignment{OrganizationName: orgName, Role: role})
+		}
+	}
+
+	for _, space := range spaces {
+		for _, role := range spaceRoles {
+			users, warnings, err := actor.CloudControllerClient.GetSpaceUsersByRole(spaceRolePaths[role], space.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			add(users, RoleAssignment{OrganizationName: space.OrganizationName, SpaceName: space.Name, Role: role})
+		}
+	}
+
+	return current, allWarnings, nil
+}
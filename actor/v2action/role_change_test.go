@@ -0,0 +1,278 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Role Change Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetRoleChanges", func() {
+		var (
+			assignments []RoleAssignment
+			prune       bool
+
+			changes  []RoleChange
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			prune = false
+			assignments = []RoleAssignment{
+				{Username: "Alice@example.com", OrganizationName: "my-org", Role: OrgManagerRole},
+				{Username: "bob@example.com", OrganizationName: "my-org", SpaceName: "dev", Role: SpaceDeveloperRole},
+				{Username: "bob@example.com", OrganizationName: "my-org", Role: OrgAuditorRole},
+			}
+
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "my-org-guid", Name: "my-org"}},
+				ccv2.Warnings{"org-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{{GUID: "dev-guid", Name: "dev", OrganizationGUID: "my-org-guid"}},
+				ccv2.Warnings{"space-warning"},
+				nil)
+			fakeCloudControllerClient.GetOrganizationUsersByRoleStub = func(role ccv2.OrganizationRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+				if role == ccv2.OrganizationManagerRole {
+					return []ccv2.User{
+						{GUID: "alice-guid", Username: "alice@example.com"},
+						{GUID: "my-user-guid", Username: "my-user"},
+					}, ccv2.Warnings{"org-users-warning"}, nil
+				}
+				return nil, nil, nil
+			}
+			fakeCloudControllerClient.GetSpaceUsersByRoleStub = func(role ccv2.SpaceRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+				if role == ccv2.SpaceDeveloperRole {
+					return []ccv2.User{{GUID: "carol-guid", Username: "carol@example.com"}}, ccv2.Warnings{"space-users-warning"}, nil
+				}
+				return nil, nil, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, err = actor.GetRoleChanges(assignments, prune, "my-user")
+		})
+
+		It("returns the missing assignments and all warnings", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("org-warning", "space-warning", "org-users-warning", "space-users-warning"))
+			Expect(changes).To(Equal([]RoleChange{
+				{
+					RoleAssignment:   RoleAssignment{Username: "bob@example.com", OrganizationName: "my-org", Role: OrgAuditorRole},
+					OrganizationGUID: "my-org-guid",
+				},
+				{
+					RoleAssignment:   RoleAssignment{Username: "bob@example.com", OrganizationName: "my-org", SpaceName: "dev", Role: SpaceDeveloperRole},
+					OrganizationGUID: "my-org-guid",
+					SpaceGUID:        "dev-guid",
+				},
+			}))
+
+			Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(ccv2.Query{
+				Filter:   ccv2.NameFilter,
+				Operator: ccv2.EqualOperator,
+				Value:    "my-org",
+			}))
+			Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+
+			Expect(fakeCloudControllerClient.GetOrganizationUsersByRoleCallCount()).To(Equal(3))
+			_, orgGUID := fakeCloudControllerClient.GetOrganizationUsersByRoleArgsForCall(0)
+			Expect(orgGUID).To(Equal("my-org-guid"))
+			Expect(fakeCloudControllerClient.GetSpaceUsersByRoleCallCount()).To(Equal(3))
+			_, spaceGUID := fakeCloudControllerClient.GetSpaceUsersByRoleArgsForCall(0)
+			Expect(spaceGUID).To(Equal("dev-guid"))
+		})
+
+		Context("when prune is true", func() {
+			BeforeEach(func() {
+				prune = true
+			})
+
+			It("also removes the unlisted assignments except the ones of the current user", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(changes).To(HaveLen(3))
+				Expect(changes[2]).To(Equal(RoleChange{
+					RoleAssignment:   RoleAssignment{Username: "carol@example.com", OrganizationName: "my-org", SpaceName: "dev", Role: SpaceDeveloperRole},
+					Remove:           true,
+					UserGUID:         "carol-guid",
+					OrganizationGUID: "my-org-guid",
+					SpaceGUID:        "dev-guid",
+				}))
+			})
+		})
+
+		Context("when users without a username have roles", func() {
+			BeforeEach(func() {
+				prune = true
+				fakeCloudControllerClient.GetOrganizationUsersByRoleStub = func(role ccv2.OrganizationRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+					if role == ccv2.OrganizationAuditorRole {
+						return []ccv2.User{
+							{GUID: "client-guid-1"},
+							{GUID: "client-guid-2"},
+						}, nil, nil
+					}
+					return nil, nil, nil
+				}
+				fakeCloudControllerClient.GetSpaceUsersByRoleStub = nil
+			})
+
+			It("does not prune them", func() {
+				Expect(err).ToNot(HaveOccurred())
+				for _, change := range changes {
+					Expect(change.Remove).To(BeFalse())
+				}
+				Expect(changes).To(HaveLen(3))
+			})
+		})
+
+		Context("when the organization cannot be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError and all warnings", func() {
+				Expect(err).To(MatchError(OrganizationNotFoundError{Name: "my-org"}))
+				Expect(warnings).To(ConsistOf("org-warning"))
+			})
+		})
+
+		Context("when listing the users with a role fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("list users error")
+				fakeCloudControllerClient.GetSpaceUsersByRoleStub = nil
+				fakeCloudControllerClient.GetSpaceUsersByRoleReturns(nil, ccv2.Warnings{"space-users-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("org-warning", "space-warning", "org-users-warning", "space-users-warning"))
+			})
+		})
+	})
+
+	Describe("ApplyRoleChanges", func() {
+		var (
+			changes     []RoleChange
+			concurrency int
+
+			results  []UserRoleChangesResult
+			warnings Warnings
+		)
+
+		BeforeEach(func() {
+			concurrency = 2
+			changes = []RoleChange{
+				{
+					RoleAssignment:   RoleAssignment{Username: "bob@example.com", OrganizationName: "my-org", Role: OrgAuditorRole},
+					OrganizationGUID: "my-org-guid",
+				},
+				{
+					RoleAssignment:   RoleAssignment{Username: "bob@example.com", OrganizationName: "my-org", SpaceName: "dev", Role: SpaceDeveloperRole},
+					OrganizationGUID: "my-org-guid",
+					SpaceGUID:        "dev-guid",
+				},
+				{
+					RoleAssignment:   RoleAssignment{Username: "carol@example.com", OrganizationName: "my-org", SpaceName: "dev", Role: SpaceDeveloperRole},
+					Remove:           true,
+					UserGUID:         "carol-guid",
+					OrganizationGUID: "my-org-guid",
+					SpaceGUID:        "dev-guid",
+				},
+			}
+
+			fakeCloudControllerClient.UpdateOrganizationUserByRoleAndUsernameReturns(ccv2.Warnings{"update-org-user-warning"}, nil)
+			fakeCloudControllerClient.UpdateSpaceUserByRoleAndUsernameReturns(ccv2.Warnings{"update-space-user-warning"}, nil)
+			fakeCloudControllerClient.DeleteSpaceUserByRoleReturns(ccv2.Warnings{"delete-space-user-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			results, warnings = actor.ApplyRoleChanges(changes, concurrency)
+		})
+
+		It("applies the changes and returns a result for every user", func() {
+			Expect(results).To(Equal([]UserRoleChangesResult{
+				{Username: "bob@example.com"},
+				{Username: "carol@example.com"},
+			}))
+			Expect(warnings).To(ConsistOf(
+				"update-org-user-warning", "update-org-user-warning",
+				"update-org-user-warning", "update-space-user-warning",
+				"delete-space-user-warning",
+			))
+
+			Expect(fakeCloudControllerClient.UpdateOrganizationUserByRoleAndUsernameCallCount()).To(Equal(3))
+			role, orgGUID, username := fakeCloudControllerClient.UpdateOrganizationUserByRoleAndUsernameArgsForCall(0)
+			Expect(role).To(Equal(ccv2.OrganizationUserRole))
+			Expect(orgGUID).To(Equal("my-org-guid"))
+			Expect(username).To(Equal("bob@example.com"))
+			role, _, _ = fakeCloudControllerClient.UpdateOrganizationUserByRoleAndUsernameArgsForCall(1)
+			Expect(role).To(Equal(ccv2.OrganizationAuditorRole))
+
+			Expect(fakeCloudControllerClient.UpdateSpaceUserByRoleAndUsernameCallCount()).To(Equal(1))
+			spaceRole, spaceGUID, username := fakeCloudControllerClient.UpdateSpaceUserByRoleAndUsernameArgsForCall(0)
+			Expect(spaceRole).To(Equal(ccv2.SpaceDeveloperRole))
+			Expect(spaceGUID).To(Equal("dev-guid"))
+			Expect(username).To(Equal("bob@example.com"))
+
+			Expect(fakeCloudControllerClient.DeleteSpaceUserByRoleCallCount()).To(Equal(1))
+			spaceRole, spaceGUID, userGUID := fakeCloudControllerClient.DeleteSpaceUserByRoleArgsForCall(0)
+			Expect(spaceRole).To(Equal(ccv2.SpaceDeveloperRole))
+			Expect(spaceGUID).To(Equal("dev-guid"))
+			Expect(userGUID).To(Equal("carol-guid"))
+		})
+
+		Context("when the current user cannot add users to the organization", func() {
+			BeforeEach(func() {
+				changes = changes[1:2]
+				fakeCloudControllerClient.UpdateOrganizationUserByRoleAndUsernameReturns(nil, ccerror.ForbiddenError{Message: "forbidden"})
+			})
+
+			It("still assigns space roles", func() {
+				Expect(results).To(Equal([]UserRoleChangesResult{{Username: "bob@example.com"}}))
+				Expect(fakeCloudControllerClient.UpdateSpaceUserByRoleAndUsernameCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when a change fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("assign error")
+				fakeCloudControllerClient.UpdateOrganizationUserByRoleAndUsernameStub = func(role ccv2.OrganizationRole, orgGUID string, username string) (ccv2.Warnings, error) {
+					if role == ccv2.OrganizationAuditorRole {
+						return nil, expectedErr
+					}
+					return nil, nil
+				}
+			})
+
+			It("stops the changes of the user and still applies the changes of other users", func() {
+				Expect(results).To(Equal([]UserRoleChangesResult{
+					{Username: "bob@example.com", Err: RoleChangeError{Change: changes[0], Err: expectedErr}},
+					{Username: "carol@example.com"},
+				}))
+				Expect(fakeCloudControllerClient.UpdateSpaceUserByRoleAndUsernameCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.DeleteSpaceUserByRoleCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
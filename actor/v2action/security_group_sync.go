@@ -0,0 +1,270 @@
+package v2action
+
+import (
+	"reflect"
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// SecurityGroupChangeType is the kind of change needed to make a security
+// group match its declaration.
+type SecurityGroupChangeType string
+
+const (
+	// SecurityGroupCreate creates a missing security group.
+	SecurityGroupCreate SecurityGroupChangeType = "create"
+	// SecurityGroupUpdate replaces the rules of a security group.
+	SecurityGroupUpdate SecurityGroupChangeType = "update"
+	// SecurityGroupBind binds a security group to a space, or globally.
+	SecurityGroupBind SecurityGroupChangeType = "bind"
+	// SecurityGroupUnbind unbinds a security group from a space, or globally.
+	SecurityGroupUnbind SecurityGroupChangeType = "unbind"
+)
+
+// SecurityGroupChange is a single change needed to make the security groups
+// match a SecurityGroupsDocument.
+type SecurityGroupChange struct {
+	Type              SecurityGroupChangeType
+	SecurityGroupName string
+
+	// Rules are the rules of created and updated security groups.
+	Rules []ccv2.SecurityGroupRule
+
+	// Lifecycle, OrganizationName and SpaceName describe the binding of bind
+	// and unbind changes. OrganizationName and SpaceName are empty for global
+	// bindings.
+	Lifecycle        ccv2.SecurityGroupLifecycle
+	OrganizationName string
+	SpaceName        string
+}
+
+// Global returns true if the change binds or unbinds the security group for
+// every space.
+func (change SecurityGroupChange) Global() bool {
+	return change.SpaceName == ""
+}
+
+type securityGroupBinding struct {
+	Lifecycle        ccv2.SecurityGroupLifecycle
+	OrganizationName string
+	SpaceName        string
+}
+
+type securityGroupState struct {
+	Rules    []SecurityGroupRuleDocument
+	Bindings map[securityGroupBinding]bool
+}
+
+// GetSecurityGroupChanges compares the security groups declared in the
+// document with the existing ones and returns the changes needed to make
+// them match. Security groups missing from the document are left untouched.
+// Staging space bindings are only compared when includeStaging is true.
+func (actor Actor) GetSecurityGroupChanges(document SecurityGroupsDocument, includeStaging bool) ([]SecurityGroupChange, Warnings, error) {
+	secGroupOrgSpaces, warnings, err := actor.GetSecurityGroupsWithOrganizationSpaceAndLifecycle(includeStaging)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	existing := map[string]securityGroupState{}
+	for _, secGroupOrgSpace := range secGroupOrgSpaces {
+		state, ok := existing[secGroupOrgSpace.SecurityGroup.Name]
+		if !ok {
+			state = securityGroupState{
+				Rules:    ruleDocuments(secGroupOrgSpace.SecurityGroup.Rules),
+				Bindings: map[securityGroupBinding]bool{},
+			}
+			existing[secGroupOrgSpace.SecurityGroup.Name] = state
+		}
+
+		if secGroupOrgSpace.Lifecycle != "" {
+			state.Bindings[securityGroupBinding{
+				Lifecycle:        secGroupOrgSpace.Lifecycle,
+				OrganizationName: secGroupOrgSpace.Organization.Name,
+				SpaceName:        secGroupOrgSpace.Space.Name,
+			}] = true
+		}
+	}
+
+	var changes []SecurityGroupChange
+	for _, securityGroup := range document.SecurityGroups {
+		declared := declaredBindings(securityGroup, includeStaging)
+
+		state, ok := existing[securityGroup.Name]
+		switch {
+		case !ok:
+			changes = append(changes, SecurityGroupChange{
+				Type:              SecurityGroupCreate,
+				SecurityGroupName: securityGroup.Name,
+				Rules:             securityGroupRules(securityGroup.Rules),
+			})
+			state.Bindings = map[securityGroupBinding]bool{}
+		case !sameRules(state.Rules, securityGroup.Rules):
+			changes = append(changes, SecurityGroupChange{
+				Type:              SecurityGroupUpdate,
+				SecurityGroupName: securityGroup.Name,
+				Rules:             securityGroupRules(securityGroup.Rules),
+			})
+		}
+
+		changes = append(changes, bindingChanges(SecurityGroupBind, securityGroup.Name, declared, state.Bindings)...)
+		changes = append(changes, bindingChanges(SecurityGroupUnbind, securityGroup.Name, state.Bindings, declared)...)
+	}
+
+	return changes, warnings, nil
+}
+
+// ApplySecurityGroupChange makes a change returned by
+// GetSecurityGroupChanges.
+func (actor Actor) ApplySecurityGroupChange(change SecurityGroupChange) (Warnings, error) {
+	if change.Type == SecurityGroupCreate {
+		_, warnings, err := actor.CloudControllerClient.CreateSecurityGroup(change.SecurityGroupName, change.Rules)
+		return Warnings(warnings), err
+	}
+
+	if change.Type == SecurityGroupUnbind && !change.Global() {
+		return actor.UnbindSecurityGroupByNameOrganizationNameAndSpaceName(change.SecurityGroupName, change.OrganizationName, change.SpaceName, change.Lifecycle)
+	}
+
+	var allWarnings Warnings
+
+	securityGroup, warnings, err := actor.GetSecurityGroupByName(change.SecurityGroupName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	switch change.Type {
+	case SecurityGroupUpdate:
+		_, ccWarnings, updateErr := actor.CloudControllerClient.UpdateSecurityGroup(securityGroup.GUID, change.Rules)
+		allWarnings = append(allWarnings, ccWarnings...)
+		return allWarnings, updateErr
+	case SecurityGroupUnbind:
+		ccWarnings, unbindErr := actor.removeSecurityGroupDefault(securityGroup.GUID, change.Lifecycle)
+		allWarnings = append(allWarnings, ccWarnings...)
+		return allWarnings, unbindErr
+	}
+
+	if change.Global() {
+		ccWarnings, bindErr := actor.associateSecurityGroupDefault(securityGroup.GUID, change.Lifecycle)
+		allWarnings = append(allWarnings, ccWarnings...)
+		return allWarnings, bindErr
+	}
+
+	org, warnings, err := actor.GetOrganizationByName(change.OrganizationName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	space, warnings, err := actor.GetSpaceByOrganizationAndName(org.GUID, change.SpaceName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.BindSecurityGroupToSpace(securityGroup.GUID, space.GUID, change.Lifecycle)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+func (actor Actor) associateSecurityGroupDefault(securityGroupGUID string, lifecycle ccv2.SecurityGroupLifecycle) (ccv2.Warnings, error) {
+	switch lifecycle {
+	case ccv2.SecurityGroupLifecycleRunning:
+		return actor.CloudControllerClient.AssociateRunningSecurityGroupDefault(securityGroupGUID)
+	case ccv2.SecurityGroupLifecycleStaging:
+		return actor.CloudControllerClient.AssociateStagingSecurityGroupDefault(securityGroupGUID)
+	default:
+		return nil, InvalidLifecycleError{lifecycle: lifecycle}
+	}
+}
+
+func (actor Actor) removeSecurityGroupDefault(securityGroupGUID string, lifecycle ccv2.SecurityGroupLifecycle) (ccv2.Warnings, error) {
+	switch lifecycle {
+	case ccv2.SecurityGroupLifecycleRunning:
+		return actor.CloudControllerClient.RemoveRunningSecurityGroupDefault(securityGroupGUID)
+	case ccv2.SecurityGroupLifecycleStaging:
+		return actor.CloudControllerClient.RemoveStagingSecurityGroupDefault(securityGroupGUID)
+	default:
+		return nil, InvalidLifecycleError{lifecycle: lifecycle}
+	}
+}
+
+func declaredBindings(securityGroup SecurityGroupDocument, includeStaging bool) map[securityGroupBinding]bool {
+	bindings := map[securityGroupBinding]bool{}
+
+	if securityGroup.RunningDefault {
+		bindings[securityGroupBinding{Lifecycle: ccv2.SecurityGroupLifecycleRunning}] = true
+	}
+	if securityGroup.StagingDefault {
+		bindings[securityGroupBinding{Lifecycle: ccv2.SecurityGroupLifecycleStaging}] = true
+	}
+
+	for _, space := range securityGroup.RunningSpaces {
+		bindings[securityGroupBinding{
+			Lifecycle:        ccv2.SecurityGroupLifecycleRunning,
+			OrganizationName: space.Organization,
+			SpaceName:        space.Space,
+		}] = true
+	}
+
+	if includeStaging {
+		for _, space := range securityGroup.StagingSpaces {
+			bindings[securityGroupBinding{
+				Lifecycle:        ccv2.SecurityGroupLifecycleStaging,
+				OrganizationName: space.Organization,
+				SpaceName:        space.Space,
+			}] = true
+		}
+	}
+
+	return bindings
+}
+
+// bindingChanges returns a change of the given type for every binding in
+// wanted that is missing from have, sorted by lifecycle, org and space.
+func bindingChanges(changeType SecurityGroupChangeType, securityGroupName string, wanted map[securityGroupBinding]bool, have map[securityGroupBinding]bool) []SecurityGroupChange {
+	var bindings []securityGroupBinding
+	for binding := range wanted {
+		if !have[binding] {
+			bindings = append(bindings, binding)
+		}
+	}
+
+	sort.Slice(bindings, func(i int, j int) bool {
+		switch {
+		case bindings[i].Lifecycle != bindings[j].Lifecycle:
+			return bindings[i].Lifecycle < bindings[j].Lifecycle
+		case bindings[i].OrganizationName != bindings[j].OrganizationName:
+			return bindings[i].OrganizationName < bindings[j].OrganizationName
+		}
+		return bindings[i].SpaceName < bindings[j].SpaceName
+	})
+
+	changes := make([]SecurityGroupChange, len(bindings))
+	for i, binding := range bindings {
+		changes[i] = SecurityGroupChange{
+			Type:              changeType,
+			SecurityGroupName: securityGroupName,
+			Lifecycle:         binding.Lifecycle,
+			OrganizationName:  binding.OrganizationName,
+			SpaceName:         binding.SpaceName,
+		}
+	}
+	return changes
+}
+
+func sameRules(rules []SecurityGroupRuleDocument, otherRules []SecurityGroupRuleDocument) bool {
+	if len(rules) == 0 && len(otherRules) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(rules, otherRules)
+}
+
+func securityGroupRules(documents []SecurityGroupRuleDocument) []ccv2.SecurityGroupRule {
+	rules := make([]ccv2.SecurityGroupRule, len(documents))
+	for i, document := range documents {
+		rules[i] = document.toSecurityGroupRule()
+	}
+	return rules
+}
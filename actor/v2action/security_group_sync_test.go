@@ -0,0 +1,268 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Sync Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetSecurityGroupChanges", func() {
+		var (
+			document       SecurityGroupsDocument
+			includeStaging bool
+			changes        []SecurityGroupChange
+			warnings       Warnings
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			includeStaging = true
+
+			fakeCloudControllerClient.GetSecurityGroupsReturns(
+				[]ccv2.SecurityGroup{
+					{
+						GUID:           "public-guid",
+						Name:           "public",
+						RunningDefault: true,
+						StagingDefault: true,
+						Rules:          []ccv2.SecurityGroupRule{{Protocol: "all", Destination: "0.0.0.0/0"}},
+					},
+					{
+						GUID:  "database-guid",
+						Name:  "database",
+						Rules: []ccv2.SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.0.5", Ports: "5432"}},
+					},
+					{
+						GUID: "undeclared-guid",
+						Name: "undeclared",
+					},
+				},
+				ccv2.Warnings{"security-groups-warning"},
+				nil)
+
+			fakeCloudControllerClient.GetRunningSpacesBySecurityGroupStub = func(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error) {
+				switch securityGroupGUID {
+				case "database-guid":
+					return []ccv2.Space{
+						{GUID: "dev-guid", Name: "dev", OrganizationGUID: "org-guid"},
+						{GUID: "old-guid", Name: "old", OrganizationGUID: "org-guid"},
+					}, nil, nil
+				case "undeclared-guid":
+					return []ccv2.Space{{GUID: "dev-guid", Name: "dev", OrganizationGUID: "org-guid"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+			fakeCloudControllerClient.GetOrganizationReturns(ccv2.Organization{GUID: "org-guid", Name: "some-org"}, nil, nil)
+
+			document = SecurityGroupsDocument{
+				SecurityGroups: []SecurityGroupDocument{
+					{
+						Name:           "public",
+						Rules:          []SecurityGroupRuleDocument{{Protocol: "all", Destination: "0.0.0.0/0"}},
+						RunningDefault: true,
+					},
+					{
+						Name:  "database",
+						Rules: []SecurityGroupRuleDocument{{Protocol: "tcp", Destination: "10.0.0.5", Ports: "5432,5433"}},
+						RunningSpaces: []SecurityGroupSpaceDocument{
+							{Organization: "some-org", Space: "dev"},
+							{Organization: "some-org", Space: "prod"},
+						},
+						StagingSpaces: []SecurityGroupSpaceDocument{{Organization: "some-org", Space: "dev"}},
+					},
+					{
+						Name:           "dns",
+						Rules:          []SecurityGroupRuleDocument{{Protocol: "udp", Destination: "10.0.0.1", Ports: "53"}},
+						StagingDefault: true,
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.GetSecurityGroupChanges(document, includeStaging)
+		})
+
+		It("returns the changes needed to match the document, in document order", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("security-groups-warning"))
+
+			Expect(changes).To(Equal([]SecurityGroupChange{
+				{Type: SecurityGroupUnbind, SecurityGroupName: "public", Lifecycle: ccv2.SecurityGroupLifecycleStaging},
+				{Type: SecurityGroupUpdate, SecurityGroupName: "database", Rules: []ccv2.SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.0.5", Ports: "5432,5433"}}},
+				{Type: SecurityGroupBind, SecurityGroupName: "database", Lifecycle: ccv2.SecurityGroupLifecycleRunning, OrganizationName: "some-org", SpaceName: "prod"},
+				{Type: SecurityGroupBind, SecurityGroupName: "database", Lifecycle: ccv2.SecurityGroupLifecycleStaging, OrganizationName: "some-org", SpaceName: "dev"},
+				{Type: SecurityGroupUnbind, SecurityGroupName: "database", Lifecycle: ccv2.SecurityGroupLifecycleRunning, OrganizationName: "some-org", SpaceName: "old"},
+				{Type: SecurityGroupCreate, SecurityGroupName: "dns", Rules: []ccv2.SecurityGroupRule{{Protocol: "udp", Destination: "10.0.0.1", Ports: "53"}}},
+				{Type: SecurityGroupBind, SecurityGroupName: "dns", Lifecycle: ccv2.SecurityGroupLifecycleStaging},
+			}))
+		})
+
+		Context("when staging space bindings are not supported", func() {
+			BeforeEach(func() {
+				includeStaging = false
+			})
+
+			It("ignores the declared staging spaces", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				for _, change := range changes {
+					Expect(change.SpaceName == "dev" && change.Lifecycle == ccv2.SecurityGroupLifecycleStaging).To(BeFalse())
+				}
+				Expect(fakeCloudControllerClient.GetStagingSpacesBySecurityGroupCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the document matches the existing security groups", func() {
+			BeforeEach(func() {
+				document = SecurityGroupsDocument{
+					SecurityGroups: []SecurityGroupDocument{
+						{
+							Name:           "public",
+							Rules:          []SecurityGroupRuleDocument{{Protocol: "all", Destination: "0.0.0.0/0"}},
+							RunningDefault: true,
+							StagingDefault: true,
+						},
+					},
+				}
+			})
+
+			It("returns no changes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(BeEmpty())
+			})
+		})
+
+		Context("when getting the security groups fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("security groups error")
+				fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv2.Warnings{"security-groups-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("security-groups-warning"))
+			})
+		})
+	})
+
+	Describe("ApplySecurityGroupChange", func() {
+		var (
+			change     SecurityGroupChange
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSecurityGroupsReturns([]ccv2.SecurityGroup{{GUID: "security-group-guid", Name: "some-group"}}, ccv2.Warnings{"get-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplySecurityGroupChange(change)
+		})
+
+		Context("when creating a security group", func() {
+			BeforeEach(func() {
+				change = SecurityGroupChange{Type: SecurityGroupCreate, SecurityGroupName: "some-group", Rules: []ccv2.SecurityGroupRule{{Protocol: "all", Destination: "10.0.0.1"}}}
+				fakeCloudControllerClient.CreateSecurityGroupReturns(ccv2.SecurityGroup{}, ccv2.Warnings{"create-warning"}, nil)
+			})
+
+			It("creates the security group with its rules", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(fakeCloudControllerClient.CreateSecurityGroupCallCount()).To(Equal(1))
+				name, rules := fakeCloudControllerClient.CreateSecurityGroupArgsForCall(0)
+				Expect(name).To(Equal("some-group"))
+				Expect(rules).To(Equal(change.Rules))
+			})
+		})
+
+		Context("when updating a security group", func() {
+			BeforeEach(func() {
+				change = SecurityGroupChange{Type: SecurityGroupUpdate, SecurityGroupName: "some-group", Rules: []ccv2.SecurityGroupRule{{Protocol: "all", Destination: "10.0.0.1"}}}
+				fakeCloudControllerClient.UpdateSecurityGroupReturns(ccv2.SecurityGroup{}, ccv2.Warnings{"update-warning"}, nil)
+			})
+
+			It("replaces the rules of the security group", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+				guid, rules := fakeCloudControllerClient.UpdateSecurityGroupArgsForCall(0)
+				Expect(guid).To(Equal("security-group-guid"))
+				Expect(rules).To(Equal(change.Rules))
+			})
+		})
+
+		Context("when binding a security group globally", func() {
+			BeforeEach(func() {
+				change = SecurityGroupChange{Type: SecurityGroupBind, SecurityGroupName: "some-group", Lifecycle: ccv2.SecurityGroupLifecycleStaging}
+				fakeCloudControllerClient.AssociateStagingSecurityGroupDefaultReturns(ccv2.Warnings{"bind-warning"}, nil)
+			})
+
+			It("enables the security group for every space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "bind-warning"))
+				Expect(fakeCloudControllerClient.AssociateStagingSecurityGroupDefaultArgsForCall(0)).To(Equal("security-group-guid"))
+				Expect(fakeCloudControllerClient.AssociateRunningSecurityGroupDefaultCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when unbinding a security group globally", func() {
+			BeforeEach(func() {
+				change = SecurityGroupChange{Type: SecurityGroupUnbind, SecurityGroupName: "some-group", Lifecycle: ccv2.SecurityGroupLifecycleRunning}
+				fakeCloudControllerClient.RemoveRunningSecurityGroupDefaultReturns(ccv2.Warnings{"unbind-warning"}, nil)
+			})
+
+			It("stops enabling the security group for every space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "unbind-warning"))
+				Expect(fakeCloudControllerClient.RemoveRunningSecurityGroupDefaultArgsForCall(0)).To(Equal("security-group-guid"))
+			})
+		})
+
+		Context("when binding a security group to a space", func() {
+			BeforeEach(func() {
+				change = SecurityGroupChange{Type: SecurityGroupBind, SecurityGroupName: "some-group", Lifecycle: ccv2.SecurityGroupLifecycleRunning, OrganizationName: "some-org", SpaceName: "some-space"}
+				fakeCloudControllerClient.GetOrganizationsReturns([]ccv2.Organization{{GUID: "org-guid", Name: "some-org"}}, ccv2.Warnings{"org-warning"}, nil)
+				fakeCloudControllerClient.GetSpacesReturns([]ccv2.Space{{GUID: "space-guid", Name: "some-space"}}, ccv2.Warnings{"space-warning"}, nil)
+				fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupReturns(ccv2.Warnings{"bind-warning"}, nil)
+			})
+
+			It("binds the security group to the space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "org-warning", "space-warning", "bind-warning"))
+				securityGroupGUID, spaceGUID := fakeCloudControllerClient.AssociateSpaceWithRunningSecurityGroupArgsForCall(0)
+				Expect(securityGroupGUID).To(Equal("security-group-guid"))
+				Expect(spaceGUID).To(Equal("space-guid"))
+			})
+		})
+
+		Context("when the security group does not exist", func() {
+			BeforeEach(func() {
+				change = SecurityGroupChange{Type: SecurityGroupUpdate, SecurityGroupName: "some-group"}
+				fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv2.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns a SecurityGroupNotFoundError", func() {
+				Expect(executeErr).To(MatchError(SecurityGroupNotFoundError{Name: "some-group"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.UpdateSecurityGroupCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,244 @@
+package v2action
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/securityrules"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// SecurityGroupsDocument declares security groups along with their rules and
+// bindings. It is written by export-security-groups and applied by
+// sync-security-groups.
+type SecurityGroupsDocument struct {
+	SecurityGroups []SecurityGroupDocument `yaml:"security_groups"`
+}
+
+// SecurityGroupDocument declares a security group in a
+// SecurityGroupsDocument.
+type SecurityGroupDocument struct {
+	Name  string                      `yaml:"name"`
+	Rules []SecurityGroupRuleDocument `yaml:"rules,omitempty"`
+	// RunningDefault and StagingDefault enable the security group for every
+	// space in the respective lifecycle phase.
+	RunningDefault bool                         `yaml:"running_default,omitempty"`
+	StagingDefault bool                         `yaml:"staging_default,omitempty"`
+	RunningSpaces  []SecurityGroupSpaceDocument `yaml:"running_spaces,omitempty"`
+	StagingSpaces  []SecurityGroupSpaceDocument `yaml:"staging_spaces,omitempty"`
+}
+
+// SecurityGroupRuleDocument declares a rule of a security group in a
+// SecurityGroupsDocument.
+type SecurityGroupRuleDocument struct {
+	Protocol    string `yaml:"protocol"`
+	Destination string `yaml:"destination"`
+	Ports       string `yaml:"ports,omitempty"`
+	Type        *int   `yaml:"type,omitempty"`
+	Code        *int   `yaml:"code,omitempty"`
+	Log         bool   `yaml:"log,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// SecurityGroupSpaceDocument identifies a space a security group is bound to
+// in a SecurityGroupsDocument.
+type SecurityGroupSpaceDocument struct {
+	Organization string `yaml:"org"`
+	Space        string `yaml:"space"`
+}
+
+// InvalidSecurityGroupsDocumentError is returned when a security groups
+// document declares invalid security groups.
+type InvalidSecurityGroupsDocumentError struct {
+	Path     string
+	Problems []string
+}
+
+func (e InvalidSecurityGroupsDocumentError) Error() string {
+	return fmt.Sprintf("invalid security groups document %s:\n%s", e.Path, strings.Join(e.Problems, "\n"))
+}
+
+// GetSecurityGroupsDocument returns every security group with its rules and
+// bindings, optionally including staging space bindings.
+func (actor Actor) GetSecurityGroupsDocument(includeStaging bool) (SecurityGroupsDocument, Warnings, error) {
+	secGroupOrgSpaces, warnings, err := actor.GetSecurityGroupsWithOrganizationSpaceAndLifecycle(includeStaging)
+	if err != nil {
+		return SecurityGroupsDocument{}, warnings, err
+	}
+
+	var document SecurityGroupsDocument
+	indexes := map[string]int{}
+
+	for _, secGroupOrgSpace := range secGroupOrgSpaces {
+		securityGroup := secGroupOrgSpace.SecurityGroup
+
+		index, ok := indexes[securityGroup.Name]
+		if !ok {
+			index = len(document.SecurityGroups)
+			indexes[securityGroup.Name] = index
+			document.SecurityGroups = append(document.SecurityGroups, SecurityGroupDocument{
+				Name:           securityGroup.Name,
+				Rules:          ruleDocuments(securityGroup.Rules),
+				RunningDefault: securityGroup.RunningDefault,
+				StagingDefault: securityGroup.StagingDefault,
+			})
+		}
+
+		if secGroupOrgSpace.Space.Name == "" {
+			continue
+		}
+
+		space := SecurityGroupSpaceDocument{
+			Organization: secGroupOrgSpace.Organization.Name,
+			Space:        secGroupOrgSpace.Space.Name,
+		}
+		switch secGroupOrgSpace.Lifecycle {
+		case ccv2.SecurityGroupLifecycleRunning:
+			document.SecurityGroups[index].RunningSpaces = append(document.SecurityGroups[index].RunningSpaces, space)
+		case ccv2.SecurityGroupLifecycleStaging:
+			document.SecurityGroups[index].StagingSpaces = append(document.SecurityGroups[index].StagingSpaces, space)
+		}
+	}
+
+	return document, warnings, nil
+}
+
+// ReadSecurityGroupsDocument reads and validates the security groups
+// document at the provided path.
+func ReadSecurityGroupsDocument(path string) (SecurityGroupsDocument, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return SecurityGroupsDocument{}, err
+	}
+
+	var document SecurityGroupsDocument
+	err = yaml.Unmarshal(raw, &document)
+	if err != nil {
+		return SecurityGroupsDocument{}, err
+	}
+
+	problems := validateSecurityGroupsDocument(document)
+	if len(problems) > 0 {
+		return SecurityGroupsDocument{}, InvalidSecurityGroupsDocumentError{Path: path, Problems: problems}
+	}
+
+	return document, nil
+}
+
+// WriteSecurityGroupsDocument writes the security groups document as YAML.
+func WriteSecurityGroupsDocument(writer io.Writer, document SecurityGroupsDocument) error {
+	raw, err := yaml.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(raw)
+	return err
+}
+
+func validateSecurityGroupsDocument(document SecurityGroupsDocument) []string {
+	var problems []string
+	names := map[string]bool{}
+
+	for i, securityGroup := range document.SecurityGroups {
+		switch {
+		case securityGroup.Name == "":
+			problems = append(problems, fmt.Sprintf("security group %d: name is required", i+1))
+			continue
+		case names[securityGroup.Name]:
+			problems = append(problems, fmt.Sprintf("security group %s: declared more than once", securityGroup.Name))
+		}
+		names[securityGroup.Name] = true
+
+		rules := make([]map[string]interface{}, len(securityGroup.Rules))
+		for j, rule := range securityGroup.Rules {
+			rules[j] = rule.toMap()
+		}
+		for _, ruleError := range securityrules.ValidateRules(rules) {
+			problems = append(problems, fmt.Sprintf("security group %s: %s", securityGroup.Name, ruleError))
+		}
+
+		if !hasCompleteSpaces(securityGroup.RunningSpaces) || !hasCompleteSpaces(securityGroup.StagingSpaces) {
+			problems = append(problems, fmt.Sprintf("security group %s: spaces require both org and space", securityGroup.Name))
+		}
+	}
+
+	return problems
+}
+
+func hasCompleteSpaces(spaces []SecurityGroupSpaceDocument) bool {
+	for _, space := range spaces {
+		if space.Organization == "" || space.Space == "" {
+			return false
+		}
+	}
+	return true
+}
+
+func (rule SecurityGroupRuleDocument) toMap() map[string]interface{} {
+	ruleMap := map[string]interface{}{
+		"protocol":    rule.Protocol,
+		"destination": rule.Destination,
+	}
+	if rule.Ports != "" {
+		ruleMap["ports"] = rule.Ports
+	}
+	if rule.Type != nil {
+		ruleMap["type"] = *rule.Type
+	}
+	if rule.Code != nil {
+		ruleMap["code"] = *rule.Code
+	}
+	if rule.Log {
+		ruleMap["log"] = rule.Log
+	}
+	if rule.Description != "" {
+		ruleMap["description"] = rule.Description
+	}
+	return ruleMap
+}
+
+func (rule SecurityGroupRuleDocument) toSecurityGroupRule() ccv2.SecurityGroupRule {
+	ccRule := ccv2.SecurityGroupRule{
+		Description: rule.Description,
+		Destination: rule.Destination,
+		Log:         rule.Log,
+		Ports:       rule.Ports,
+		Protocol:    rule.Protocol,
+	}
+	if rule.Type != nil {
+		ccRule.Type = types.NullInt{Value: *rule.Type, IsSet: true}
+	}
+	if rule.Code != nil {
+		ccRule.Code = types.NullInt{Value: *rule.Code, IsSet: true}
+	}
+	return ccRule
+}
+
+func ruleDocuments(rules []ccv2.SecurityGroupRule) []SecurityGroupRuleDocument {
+	var documents []SecurityGroupRuleDocument
+	for _, rule := range rules {
+		document := SecurityGroupRuleDocument{
+			Protocol:    rule.Protocol,
+			Destination: rule.Destination,
+			Ports:       rule.Ports,
+			Log:         rule.Log,
+			Description: rule.Description,
+		}
+		if rule.Type.IsSet {
+			ruleType := rule.Type.Value
+			document.Type = &ruleType
+		}
+		if rule.Code.IsSet {
+			code := rule.Code.Value
+			document.Code = &code
+		}
+		documents = append(documents, document)
+	}
+	return documents
+}
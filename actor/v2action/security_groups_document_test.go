@@ -0,0 +1,214 @@
+package v2action_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Groups Document Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetSecurityGroupsDocument", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSecurityGroupsReturns(
+				[]ccv2.SecurityGroup{
+					{
+						GUID:           "public-guid",
+						Name:           "public",
+						RunningDefault: true,
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "all", Destination: "0.0.0.0/0"},
+						},
+					},
+					{
+						GUID: "database-guid",
+						Name: "database",
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "tcp", Destination: "10.0.0.5", Ports: "5432", Log: true, Description: "postgres"},
+							{Protocol: "icmp", Destination: "10.0.0.5", Type: types.NullInt{Value: 0, IsSet: true}, Code: types.NullInt{Value: -1, IsSet: true}},
+						},
+					},
+					{
+						GUID: "unused-guid",
+						Name: "unused",
+					},
+				},
+				ccv2.Warnings{"security-groups-warning"},
+				nil)
+
+			fakeCloudControllerClient.GetRunningSpacesBySecurityGroupStub = func(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error) {
+				if securityGroupGUID == "database-guid" {
+					return []ccv2.Space{{GUID: "space-guid", Name: "some-space", OrganizationGUID: "org-guid"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+			fakeCloudControllerClient.GetStagingSpacesBySecurityGroupStub = func(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error) {
+				if securityGroupGUID == "database-guid" {
+					return []ccv2.Space{{GUID: "space-guid", Name: "some-space", OrganizationGUID: "org-guid"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+			fakeCloudControllerClient.GetOrganizationReturns(ccv2.Organization{GUID: "org-guid", Name: "some-org"}, nil, nil)
+		})
+
+		It("returns every security group with its rules and bindings", func() {
+			document, warnings, err := actor.GetSecurityGroupsDocument(true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("security-groups-warning"))
+
+			zero, minusOne := 0, -1
+			Expect(document).To(Equal(SecurityGroupsDocument{
+				SecurityGroups: []SecurityGroupDocument{
+					{
+						Name: "database",
+						Rules: []SecurityGroupRuleDocument{
+							{Protocol: "tcp", Destination: "10.0.0.5", Ports: "5432", Log: true, Description: "postgres"},
+							{Protocol: "icmp", Destination: "10.0.0.5", Type: &zero, Code: &minusOne},
+						},
+						RunningSpaces: []SecurityGroupSpaceDocument{{Organization: "some-org", Space: "some-space"}},
+						StagingSpaces: []SecurityGroupSpaceDocument{{Organization: "some-org", Space: "some-space"}},
+					},
+					{
+						Name:           "public",
+						Rules:          []SecurityGroupRuleDocument{{Protocol: "all", Destination: "0.0.0.0/0"}},
+						RunningDefault: true,
+					},
+					{
+						Name: "unused",
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("WriteSecurityGroupsDocument and ReadSecurityGroupsDocument", func() {
+		var tmpDir string
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "security-groups-document")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		It("round trips a document", func() {
+			eight := 8
+			document := SecurityGroupsDocument{
+				SecurityGroups: []SecurityGroupDocument{
+					{
+						Name: "database",
+						Rules: []SecurityGroupRuleDocument{
+							{Protocol: "tcp", Destination: "10.0.0.5", Ports: "5432", Description: "postgres"},
+							{Protocol: "icmp", Destination: "10.0.0.5", Type: &eight, Code: &eight},
+						},
+						StagingDefault: true,
+						RunningSpaces:  []SecurityGroupSpaceDocument{{Organization: "some-org", Space: "some-space"}},
+					},
+				},
+			}
+
+			buffer := new(bytes.Buffer)
+			Expect(WriteSecurityGroupsDocument(buffer, document)).To(Succeed())
+			Expect(buffer.String()).To(ContainSubstring("running_spaces:\n  - org: some-org\n    space: some-space"))
+
+			path := filepath.Join(tmpDir, "security-groups.yml")
+			Expect(ioutil.WriteFile(path, buffer.Bytes(), 0600)).To(Succeed())
+
+			readDocument, err := ReadSecurityGroupsDocument(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readDocument).To(Equal(document))
+		})
+
+		It("lists every problem of an invalid document", func() {
+			path := filepath.Join(tmpDir, "security-groups.yml")
+			Expect(ioutil.WriteFile(path, []byte(`---
+security_groups:
+- name: database
+  rules:
+  - protocol: tcp
+    destination: 10.0.0.5
+  running_spaces:
+  - org: some-org
+- name: database
+- rules: []
+`), 0600)).To(Succeed())
+
+			_, err := ReadSecurityGroupsDocument(path)
+			Expect(err).To(MatchError(InvalidSecurityGroupsDocumentError{
+				Path: path,
+				Problems: []string{
+					"security group database: rule 1, field 'ports': is required for tcp rules",
+					"security group database: spaces require both org and space",
+					"security group database: declared more than once",
+					"security group 3: name is required",
+				},
+			}))
+		})
+	})
+
+	Describe("exporting and syncing security groups", func() {
+		var tmpDir string
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "security-groups-document")
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeCloudControllerClient.GetSecurityGroupsReturns(
+				[]ccv2.SecurityGroup{
+					{
+						GUID: "dns-guid",
+						Name: "dns",
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "udp", Destination: "10.0.0.1", Ports: "53", Log: true},
+							{Protocol: "all", Destination: "10.0.0.2", Log: true},
+						},
+					},
+				},
+				nil,
+				nil)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		It("reads back an exported document with logged udp and all rules without changes", func() {
+			document, _, err := actor.GetSecurityGroupsDocument(false)
+			Expect(err).ToNot(HaveOccurred())
+
+			buffer := new(bytes.Buffer)
+			Expect(WriteSecurityGroupsDocument(buffer, document)).To(Succeed())
+			path := filepath.Join(tmpDir, "security-groups.yml")
+			Expect(ioutil.WriteFile(path, buffer.Bytes(), 0600)).To(Succeed())
+
+			readDocument, err := ReadSecurityGroupsDocument(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readDocument).To(Equal(document))
+
+			changes, _, err := actor.GetSecurityGroupChanges(readDocument, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,12 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// Service represents a service offering in the marketplace.
+type Service ccv2.Service
+
+// GetService returns the service offering with the given GUID.
+func (actor Actor) GetService(serviceGUID string) (Service, Warnings, error) {
+	service, warnings, err := actor.CloudControllerClient.GetService(serviceGUID)
+	return Service(service), Warnings(warnings), err
+}
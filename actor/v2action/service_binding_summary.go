@@ -0,0 +1,67 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+
+// ServiceBindingSummary is a service binding with the service instance it
+// belongs to and the parameters it was created with.
+type ServiceBindingSummary struct {
+	ServiceBinding
+
+	ServiceInstance ServiceInstance
+
+	// Parameters are the configuration parameters reported by the service
+	// broker. They are only looked up when requested.
+	Parameters map[string]interface{}
+
+	// ParametersNotSupported is true when the service broker does not allow
+	// its binding parameters to be retrieved.
+	ParametersNotSupported bool
+}
+
+// GetServiceBindingSummaryBySpace returns the binding between the named
+// application and service instance in the given space. The binding parameters
+// are only looked up when includeParameters is true, since older Cloud
+// Controllers do not support retrieving them.
+func (actor Actor) GetServiceBindingSummaryBySpace(appName string, serviceInstanceName string, spaceGUID string, includeParameters bool) (ServiceBindingSummary, Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceBindingSummary{}, allWarnings, err
+	}
+
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceBindingSummary{}, allWarnings, err
+	}
+
+	serviceBinding, warnings, err := actor.GetServiceBindingByApplicationAndServiceInstance(app.GUID, serviceInstance.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceBindingSummary{}, allWarnings, err
+	}
+
+	summary := ServiceBindingSummary{
+		ServiceBinding:  serviceBinding,
+		ServiceInstance: serviceInstance,
+	}
+
+	if !includeParameters {
+		return summary, allWarnings, nil
+	}
+
+	parameters, ccWarnings, err := actor.CloudControllerClient.GetServiceBindingParameters(serviceBinding.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	switch err.(type) {
+	case nil:
+		summary.Parameters = parameters
+	case ccerror.ServiceFetchBindingParametersNotSupportedError:
+		summary.ParametersNotSupported = true
+	default:
+		return ServiceBindingSummary{}, allWarnings, err
+	}
+
+	return summary, allWarnings, nil
+}
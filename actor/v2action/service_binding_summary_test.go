@@ -0,0 +1,150 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Binding Summary Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetServiceBindingSummaryBySpace", func() {
+		var (
+			includeParameters bool
+
+			summary    ServiceBindingSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			includeParameters = true
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app"}},
+				ccv2.Warnings{"app-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", Name: "some-service-instance"}},
+				ccv2.Warnings{"service-instance-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceBindingsReturns(
+				[]ccv2.ServiceBinding{{
+					GUID:                "some-binding-guid",
+					AppGUID:             "some-app-guid",
+					ServiceInstanceGUID: "some-service-instance-guid",
+					Credentials:         map[string]interface{}{"password": "secret"},
+				}},
+				ccv2.Warnings{"binding-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceBindingParametersReturns(
+				map[string]interface{}{"permissions": "read-only"},
+				ccv2.Warnings{"parameters-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			summary, warnings, executeErr = actor.GetServiceBindingSummaryBySpace("some-app", "some-service-instance", "some-space-guid", includeParameters)
+		})
+
+		It("returns the binding, its service instance and parameters", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("app-warning", "service-instance-warning", "binding-warning", "parameters-warning"))
+			Expect(summary.GUID).To(Equal("some-binding-guid"))
+			Expect(summary.Credentials).To(Equal(map[string]interface{}{"password": "secret"}))
+			Expect(summary.ServiceInstance.Name).To(Equal("some-service-instance"))
+			Expect(summary.Parameters).To(Equal(map[string]interface{}{"permissions": "read-only"}))
+			Expect(summary.ParametersNotSupported).To(BeFalse())
+
+			Expect(fakeCloudControllerClient.GetServiceBindingsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceBindingsArgsForCall(0)).To(ConsistOf(
+				ccv2.Query{Filter: ccv2.AppGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-app-guid"},
+				ccv2.Query{Filter: ccv2.ServiceInstanceGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-service-instance-guid"},
+			))
+
+			Expect(fakeCloudControllerClient.GetServiceBindingParametersCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceBindingParametersArgsForCall(0)).To(Equal("some-binding-guid"))
+		})
+
+		Context("when parameters are not requested", func() {
+			BeforeEach(func() {
+				includeParameters = false
+			})
+
+			It("does not look up the parameters", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summary.Parameters).To(BeNil())
+				Expect(fakeCloudControllerClient.GetServiceBindingParametersCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the service broker does not support fetching parameters", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingParametersReturns(
+					nil,
+					ccv2.Warnings{"parameters-warning"},
+					ccerror.ServiceFetchBindingParametersNotSupportedError{Message: "not supported"})
+			})
+
+			It("marks the parameters as not supported", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("parameters-warning"))
+				Expect(summary.ParametersNotSupported).To(BeTrue())
+			})
+		})
+
+		Context("when fetching the parameters fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("parameters error")
+				fakeCloudControllerClient.GetServiceBindingParametersReturns(nil, ccv2.Warnings{"parameters-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("app-warning", "service-instance-warning", "binding-warning", "parameters-warning"))
+			})
+		})
+
+		Context("when the app is not bound to the service instance", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingsReturns(nil, ccv2.Warnings{"binding-warning"}, nil)
+			})
+
+			It("returns a ServiceBindingNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ServiceBindingNotFoundError{
+					AppGUID:             "some-app-guid",
+					ServiceInstanceGUID: "some-service-instance-guid",
+				}))
+				Expect(warnings).To(ConsistOf("app-warning", "service-instance-warning", "binding-warning"))
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(nil, ccv2.Warnings{"service-instance-warning"}, nil)
+			})
+
+			It("returns a ServiceInstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(fakeCloudControllerClient.GetServiceBindingsCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -22,6 +23,194 @@ func (e ServiceInstanceNotFoundError) Error() string {
 	return fmt.Sprintf("Service instance '%s' not found.", e.Name)
 }
 
+// ServiceInstanceAlreadyExistsError is returned when creating a service
+// instance that already exists with the requested plan.
+type ServiceInstanceAlreadyExistsError struct {
+	Name string
+}
+
+func (e ServiceInstanceAlreadyExistsError) Error() string {
+	return fmt.Sprintf("Service instance '%s' already exists.", e.Name)
+}
+
+// ServiceInstanceOperationFailedError is returned when the service broker
+// reports that the last operation on a service instance failed.
+type ServiceInstanceOperationFailedError struct {
+	Name        string
+	Operation   string
+	Description string
+}
+
+func (e ServiceInstanceOperationFailedError) Error() string {
+	return fmt.Sprintf("%s of service instance '%s' failed: %s", e.Operation, e.Name, e.Description)
+}
+
+// ServiceInstanceOperationTimeoutError is returned when the last operation on
+// a service instance is still in progress after the timeout.
+type ServiceInstanceOperationTimeoutError struct {
+	Name    string
+	Timeout time.Duration
+}
+
+func (e ServiceInstanceOperationTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for service instance '%s'", e.Name)
+}
+
+// ServiceInstanceHasAssociationsError is returned when deleting a service
+// instance that still has service bindings or service keys.
+type ServiceInstanceHasAssociationsError struct {
+	Name string
+}
+
+func (e ServiceInstanceHasAssociationsError) Error() string {
+	return fmt.Sprintf("Service instance '%s' has service bindings or service keys.", e.Name)
+}
+
+// OperationInProgress returns true if the service broker has not finished the
+// last operation on the service instance.
+func (serviceInstance ServiceInstance) OperationInProgress() bool {
+	return serviceInstance.LastOperation.State == ccv2.LastOperationInProgress
+}
+
+// Managed returns true if the service instance is managed by a service
+// broker.
+func (serviceInstance ServiceInstance) Managed() bool {
+	return ccv2.ServiceInstance(serviceInstance).Managed()
+}
+
+// UserProvided returns true if the service instance is a user provided
+// service.
+func (serviceInstance ServiceInstance) UserProvided() bool {
+	return ccv2.ServiceInstance(serviceInstance).UserProvided()
+}
+
+// CreateServiceInstance provisions a service instance of the given plan in
+// the given space. If a service instance with the same name and plan already
+// exists, a ServiceInstanceAlreadyExistsError is returned.
+func (actor Actor) CreateServiceInstance(spaceGUID string, servicePlanGUID string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (ServiceInstance, Warnings, error) {
+	instance, warnings, err := actor.CloudControllerClient.CreateServiceInstance(spaceGUID, servicePlanGUID, serviceInstanceName, parameters, tags)
+	allWarnings := Warnings(warnings)
+	if _, ok := err.(ccerror.ServiceInstanceNameTakenError); ok {
+		existing, existingWarnings, existingErr := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+		allWarnings = append(allWarnings, existingWarnings...)
+		if existingErr == nil && existing.ServicePlanGUID == servicePlanGUID {
+			return existing, allWarnings, ServiceInstanceAlreadyExistsError{Name: serviceInstanceName}
+		}
+	}
+	return ServiceInstance(instance), allWarnings, err
+}
+
+// UpdateServiceInstance changes the plan, parameters or tags of the given
+// service instance. An empty plan GUID, nil parameters or nil tags leave the
+// corresponding setting unchanged.
+func (actor Actor) UpdateServiceInstance(serviceInstance ServiceInstance, servicePlanGUID string, parameters map[string]interface{}, tags *[]string) (ServiceInstance, Warnings, error) {
+	instance, warnings, err := actor.CloudControllerClient.UpdateServiceInstance(serviceInstance.GUID, servicePlanGUID, parameters, tags)
+	if err != nil {
+		return ServiceInstance{}, Warnings(warnings), err
+	}
+
+	instance.Name = serviceInstance.Name
+	return ServiceInstance(instance), Warnings(warnings), nil
+}
+
+// DeleteServiceInstance deprovisions the given service instance. A service
+// instance with service bindings or service keys is not deleted; a
+// ServiceInstanceHasAssociationsError is returned instead. If the service
+// broker deletes it asynchronously, the returned service instance has an
+// operation in progress.
+func (actor Actor) DeleteServiceInstance(serviceInstance ServiceInstance) (ServiceInstance, Warnings, error) {
+	var allWarnings Warnings
+	serviceInstanceQuery := []ccv2.Query{{
+		Filter:   ccv2.ServiceInstanceGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    serviceInstance.GUID,
+	}}
+
+	bindings, warnings, err := actor.CloudControllerClient.GetServiceBindings(serviceInstanceQuery)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstance{}, allWarnings, err
+	}
+
+	keys, warnings, err := actor.CloudControllerClient.GetServiceKeys(serviceInstanceQuery)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstance{}, allWarnings, err
+	}
+
+	if len(bindings) > 0 || len(keys) > 0 {
+		return ServiceInstance{}, allWarnings, ServiceInstanceHasAssociationsError{Name: serviceInstance.Name}
+	}
+
+	instance, warnings, err := actor.CloudControllerClient.DeleteServiceInstance(serviceInstance.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstance{}, allWarnings, err
+	}
+
+	instance.Name = serviceInstance.Name
+	return ServiceInstance(instance), allWarnings, nil
+}
+
+// PollServiceInstanceOperation polls the last operation of the given service
+// instance until the service broker finishes it or the timeout is reached.
+// Every change of the operation's state or description is sent on the
+// returned service instance channel. A failed operation is sent as a
+// ServiceInstanceOperationFailedError; a service instance that disappears
+// while being deleted counts as a successful delete.
+func (actor Actor) PollServiceInstanceOperation(serviceInstance ServiceInstance, timeout time.Duration) (<-chan ServiceInstance, <-chan string, <-chan error) {
+	instances := make(chan ServiceInstance)
+	allWarnings := make(chan string)
+	errs := make(chan error)
+
+	go func() {
+		defer close(instances)
+		defer close(allWarnings)
+		defer close(errs)
+
+		last := serviceInstance.LastOperation
+		deadline := time.Now().Add(timeout)
+		for time.Now().Before(deadline) {
+			instance, warnings, err := actor.CloudControllerClient.GetServiceInstance(serviceInstance.GUID)
+			for _, warning := range warnings {
+				allWarnings <- warning
+			}
+
+			if _, ok := err.(ccerror.ResourceNotFoundError); ok && last.Type == "delete" {
+				return
+			}
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			current := instance.LastOperation
+			if current.State != last.State || current.Description != last.Description {
+				instances <- ServiceInstance(instance)
+			}
+			last = current
+
+			switch current.State {
+			case ccv2.LastOperationSucceeded:
+				return
+			case ccv2.LastOperationFailed:
+				errs <- ServiceInstanceOperationFailedError{
+					Name:        instance.Name,
+					Operation:   current.Type,
+					Description: current.Description,
+				}
+				return
+			}
+
+			time.Sleep(actor.Config.PollingInterval())
+		}
+
+		errs <- ServiceInstanceOperationTimeoutError{Name: serviceInstance.Name, Timeout: timeout}
+	}()
+
+	return instances, allWarnings, errs
+}
+
 func (actor Actor) GetServiceInstance(guid string) (ServiceInstance, Warnings, error) {
 	instance, warnings, err := actor.CloudControllerClient.GetServiceInstance(guid)
 	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
@@ -0,0 +1,34 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// ServiceInstanceSharedFrom represents the space a shared service instance
+// was created in.
+type ServiceInstanceSharedFrom ccv2.ServiceInstanceSharedFrom
+
+// ServiceInstanceSharedTo represents a space a service instance has been
+// shared with.
+type ServiceInstanceSharedTo ccv2.ServiceInstanceSharedTo
+
+// GetServiceInstanceSharedFromByServiceInstance returns the space the service
+// instance was shared from, or an empty ServiceInstanceSharedFrom if it was
+// created in the space it is viewed from.
+func (actor Actor) GetServiceInstanceSharedFromByServiceInstance(serviceInstanceGUID string) (ServiceInstanceSharedFrom, Warnings, error) {
+	sharedFrom, warnings, err := actor.CloudControllerClient.GetServiceInstanceSharedFrom(serviceInstanceGUID)
+	return ServiceInstanceSharedFrom(sharedFrom), Warnings(warnings), err
+}
+
+// GetServiceInstanceSharedTosByServiceInstance returns the spaces the service
+// instance has been shared with.
+func (actor Actor) GetServiceInstanceSharedTosByServiceInstance(serviceInstanceGUID string) ([]ServiceInstanceSharedTo, Warnings, error) {
+	ccSharedTos, warnings, err := actor.CloudControllerClient.GetServiceInstanceSharedTos(serviceInstanceGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	sharedTos := make([]ServiceInstanceSharedTo, len(ccSharedTos))
+	for i, sharedTo := range ccSharedTos {
+		sharedTos[i] = ServiceInstanceSharedTo(sharedTo)
+	}
+	return sharedTos, Warnings(warnings), nil
+}
@@ -0,0 +1,85 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Shared Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetServiceInstanceSharedFromByServiceInstance", func() {
+		Context("when the cloud controller request is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceSharedFromReturns(
+					ccv2.ServiceInstanceSharedFrom{SpaceGUID: "some-space-guid", SpaceName: "some-space", OrganizationName: "some-org"},
+					ccv2.Warnings{"shared-from-warning"},
+					nil)
+			})
+
+			It("returns the space it was shared from and warnings", func() {
+				sharedFrom, warnings, err := actor.GetServiceInstanceSharedFromByServiceInstance("some-service-instance-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sharedFrom).To(Equal(ServiceInstanceSharedFrom{SpaceGUID: "some-space-guid", SpaceName: "some-space", OrganizationName: "some-org"}))
+				Expect(warnings).To(ConsistOf("shared-from-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceInstanceSharedFromArgsForCall(0)).To(Equal("some-service-instance-guid"))
+			})
+		})
+	})
+
+	Describe("GetServiceInstanceSharedTosByServiceInstance", func() {
+		Context("when the cloud controller request is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceSharedTosReturns(
+					[]ccv2.ServiceInstanceSharedTo{
+						{SpaceGUID: "space-guid-1", SpaceName: "space-1", OrganizationName: "org-1", BoundAppCount: 1},
+						{SpaceGUID: "space-guid-2", SpaceName: "space-2", OrganizationName: "org-2"},
+					},
+					ccv2.Warnings{"shared-to-warning"},
+					nil)
+			})
+
+			It("returns the spaces it is shared with and warnings", func() {
+				sharedTos, warnings, err := actor.GetServiceInstanceSharedTosByServiceInstance("some-service-instance-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sharedTos).To(Equal([]ServiceInstanceSharedTo{
+					{SpaceGUID: "space-guid-1", SpaceName: "space-1", OrganizationName: "org-1", BoundAppCount: 1},
+					{SpaceGUID: "space-guid-2", SpaceName: "space-2", OrganizationName: "org-2"},
+				}))
+				Expect(warnings).To(ConsistOf("shared-to-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceInstanceSharedTosArgsForCall(0)).To(Equal("some-service-instance-guid"))
+			})
+		})
+
+		Context("when the cloud controller request fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("shared to error")
+				fakeCloudControllerClient.GetServiceInstanceSharedTosReturns(nil, ccv2.Warnings{"shared-to-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetServiceInstanceSharedTosByServiceInstance("some-service-instance-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("shared-to-warning"))
+			})
+		})
+	})
+})
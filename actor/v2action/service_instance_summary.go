@@ -0,0 +1,122 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// ServiceInstanceSummary is a service instance with the plan, service
+// offering, bound apps and sharing information shown by the service command.
+type ServiceInstanceSummary struct {
+	ServiceInstance
+
+	ServicePlan       ServicePlan
+	Service           Service
+	BoundApplications []string
+
+	// ServiceInstanceSharedFrom is empty unless the service instance was
+	// shared into the space from another space.
+	ServiceInstanceSharedFrom ServiceInstanceSharedFrom
+	ServiceInstanceSharedTos  []ServiceInstanceSharedTo
+}
+
+// IsSharedFrom returns true if the service instance was shared into the space
+// from another space.
+func (summary ServiceInstanceSummary) IsSharedFrom() bool {
+	return summary.ServiceInstanceSharedFrom.SpaceGUID != ""
+}
+
+// IsSharedTo returns true if the service instance has been shared with other
+// spaces.
+func (summary ServiceInstanceSummary) IsSharedTo() bool {
+	return len(summary.ServiceInstanceSharedTos) > 0
+}
+
+// GetServiceInstanceSummaryByNameAndSpace returns the summary of the named
+// service instance in the given space. Sharing information is only looked up
+// when includeSharing is true, since older Cloud Controllers do not support
+// it.
+func (actor Actor) GetServiceInstanceSummaryByNameAndSpace(name string, spaceGUID string, includeSharing bool) (ServiceInstanceSummary, Warnings, error) {
+	var allWarnings Warnings
+
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(name, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstanceSummary{}, allWarnings, err
+	}
+
+	summary := ServiceInstanceSummary{ServiceInstance: serviceInstance}
+
+	if serviceInstance.Managed() {
+		summary.ServicePlan, warnings, err = actor.getServicePlan(serviceInstance.ServicePlanGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ServiceInstanceSummary{}, allWarnings, err
+		}
+
+		summary.Service, warnings, err = actor.GetService(summary.ServicePlan.ServiceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ServiceInstanceSummary{}, allWarnings, err
+		}
+
+		if includeSharing {
+			summary.ServiceInstanceSharedFrom, warnings, err = actor.GetServiceInstanceSharedFromByServiceInstance(serviceInstance.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return ServiceInstanceSummary{}, allWarnings, err
+			}
+
+			// Only the space that owns the service instance can see where it
+			// has been shared to.
+			if !summary.IsSharedFrom() {
+				summary.ServiceInstanceSharedTos, warnings, err = actor.GetServiceInstanceSharedTosByServiceInstance(serviceInstance.GUID)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return ServiceInstanceSummary{}, allWarnings, err
+				}
+			}
+		}
+	}
+
+	summary.BoundApplications, warnings, err = actor.getBoundApplicationNames(serviceInstance.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstanceSummary{}, allWarnings, err
+	}
+
+	return summary, allWarnings, nil
+}
+
+func (actor Actor) getServicePlan(servicePlanGUID string) (ServicePlan, Warnings, error) {
+	servicePlan, warnings, err := actor.CloudControllerClient.GetServicePlan(servicePlanGUID)
+	return ServicePlan(servicePlan), Warnings(warnings), err
+}
+
+func (actor Actor) getBoundApplicationNames(serviceInstanceGUID string) ([]string, Warnings, error) {
+	var allWarnings Warnings
+
+	bindings, apiWarnings, err := actor.CloudControllerClient.GetServiceBindings([]ccv2.Query{{
+		Filter:   ccv2.ServiceInstanceGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    serviceInstanceGUID,
+	}})
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var appNames []string
+	for _, binding := range bindings {
+		app, warnings, appErr := actor.GetApplication(binding.AppGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if appErr != nil {
+			return nil, allWarnings, appErr
+		}
+		appNames = append(appNames, app.Name)
+	}
+	sort.Strings(appNames)
+
+	return appNames, allWarnings, nil
+}
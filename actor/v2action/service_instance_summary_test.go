@@ -0,0 +1,264 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Summary Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("ServiceInstanceSummary", func() {
+		var summary ServiceInstanceSummary
+
+		Describe("IsSharedFrom", func() {
+			Context("when the service instance was shared from another space", func() {
+				BeforeEach(func() {
+					summary.ServiceInstanceSharedFrom = ServiceInstanceSharedFrom{SpaceGUID: "some-space-guid"}
+				})
+
+				It("returns true", func() {
+					Expect(summary.IsSharedFrom()).To(BeTrue())
+				})
+			})
+
+			Context("when the service instance was not shared from another space", func() {
+				BeforeEach(func() {
+					summary.ServiceInstanceSharedFrom = ServiceInstanceSharedFrom{}
+				})
+
+				It("returns false", func() {
+					Expect(summary.IsSharedFrom()).To(BeFalse())
+				})
+			})
+		})
+
+		Describe("IsSharedTo", func() {
+			Context("when the service instance is shared with other spaces", func() {
+				BeforeEach(func() {
+					summary.ServiceInstanceSharedTos = []ServiceInstanceSharedTo{{SpaceGUID: "some-space-guid"}}
+				})
+
+				It("returns true", func() {
+					Expect(summary.IsSharedTo()).To(BeTrue())
+				})
+			})
+
+			Context("when the service instance is not shared with other spaces", func() {
+				BeforeEach(func() {
+					summary.ServiceInstanceSharedTos = nil
+				})
+
+				It("returns false", func() {
+					Expect(summary.IsSharedTo()).To(BeFalse())
+				})
+			})
+		})
+	})
+
+	Describe("GetServiceInstanceSummaryByNameAndSpace", func() {
+		var (
+			includeSharing bool
+
+			summary    ServiceInstanceSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			includeSharing = true
+
+			fakeCloudControllerClient.GetServiceBindingsReturns(
+				[]ccv2.ServiceBinding{{AppGUID: "app-guid-2"}, {AppGUID: "app-guid-1"}},
+				ccv2.Warnings{"bindings-warning"},
+				nil)
+			fakeCloudControllerClient.GetApplicationStub = func(appGUID string) (ccv2.Application, ccv2.Warnings, error) {
+				switch appGUID {
+				case "app-guid-1":
+					return ccv2.Application{GUID: appGUID, Name: "app-1"}, ccv2.Warnings{"app-warning-1"}, nil
+				default:
+					return ccv2.Application{GUID: appGUID, Name: "app-2"}, ccv2.Warnings{"app-warning-2"}, nil
+				}
+			}
+		})
+
+		JustBeforeEach(func() {
+			summary, warnings, executeErr = actor.GetServiceInstanceSummaryByNameAndSpace("some-service-instance", "some-space-guid", includeSharing)
+		})
+
+		Context("when the service instance is managed", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{
+						GUID:            "some-service-instance-guid",
+						Name:            "some-service-instance",
+						ServicePlanGUID: "some-plan-guid",
+						Type:            ccv2.ManagedService,
+					}},
+					ccv2.Warnings{"instance-warning"},
+					nil)
+				fakeCloudControllerClient.GetServicePlanReturns(
+					ccv2.ServicePlan{GUID: "some-plan-guid", Name: "some-plan", ServiceGUID: "some-service-guid"},
+					ccv2.Warnings{"plan-warning"},
+					nil)
+				fakeCloudControllerClient.GetServiceReturns(
+					ccv2.Service{GUID: "some-service-guid", Label: "some-service"},
+					ccv2.Warnings{"service-warning"},
+					nil)
+			})
+
+			Context("when the service instance was shared from another space", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceSharedFromReturns(
+						ccv2.ServiceInstanceSharedFrom{SpaceGUID: "other-space-guid", SpaceName: "other-space", OrganizationName: "other-org"},
+						ccv2.Warnings{"shared-from-warning"},
+						nil)
+				})
+
+				It("returns the summary without looking up where it is shared to", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(summary).To(Equal(ServiceInstanceSummary{
+						ServiceInstance: ServiceInstance{
+							GUID:            "some-service-instance-guid",
+							Name:            "some-service-instance",
+							ServicePlanGUID: "some-plan-guid",
+							Type:            ccv2.ManagedService,
+						},
+						ServicePlan:               ServicePlan{GUID: "some-plan-guid", Name: "some-plan", ServiceGUID: "some-service-guid"},
+						Service:                   Service{GUID: "some-service-guid", Label: "some-service"},
+						BoundApplications:         []string{"app-1", "app-2"},
+						ServiceInstanceSharedFrom: ServiceInstanceSharedFrom{SpaceGUID: "other-space-guid", SpaceName: "other-space", OrganizationName: "other-org"},
+					}))
+					Expect(warnings).To(ConsistOf("instance-warning", "plan-warning", "service-warning", "shared-from-warning", "bindings-warning", "app-warning-1", "app-warning-2"))
+
+					Expect(fakeCloudControllerClient.GetServicePlanArgsForCall(0)).To(Equal("some-plan-guid"))
+					Expect(fakeCloudControllerClient.GetServiceArgsForCall(0)).To(Equal("some-service-guid"))
+					Expect(fakeCloudControllerClient.GetServiceInstanceSharedFromArgsForCall(0)).To(Equal("some-service-instance-guid"))
+					Expect(fakeCloudControllerClient.GetServiceInstanceSharedTosCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.GetServiceBindingsArgsForCall(0)).To(ConsistOf(ccv2.Query{
+						Filter:   ccv2.ServiceInstanceGUIDFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "some-service-instance-guid",
+					}))
+				})
+			})
+
+			Context("when the service instance was created in the space", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceSharedFromReturns(ccv2.ServiceInstanceSharedFrom{}, ccv2.Warnings{"shared-from-warning"}, nil)
+				})
+
+				Context("when it is shared with other spaces", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetServiceInstanceSharedTosReturns(
+							[]ccv2.ServiceInstanceSharedTo{{SpaceGUID: "other-space-guid", SpaceName: "other-space", OrganizationName: "other-org", BoundAppCount: 3}},
+							ccv2.Warnings{"shared-to-warning"},
+							nil)
+					})
+
+					It("returns the spaces it is shared with and all warnings", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(summary.ServiceInstanceSharedTos).To(Equal([]ServiceInstanceSharedTo{
+							{SpaceGUID: "other-space-guid", SpaceName: "other-space", OrganizationName: "other-org", BoundAppCount: 3},
+						}))
+						Expect(warnings).To(ConsistOf("instance-warning", "plan-warning", "service-warning", "shared-from-warning", "shared-to-warning", "bindings-warning", "app-warning-1", "app-warning-2"))
+
+						Expect(fakeCloudControllerClient.GetServiceInstanceSharedTosArgsForCall(0)).To(Equal("some-service-instance-guid"))
+					})
+				})
+
+				Context("when getting the spaces it is shared with fails", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("shared to error")
+						fakeCloudControllerClient.GetServiceInstanceSharedTosReturns(nil, ccv2.Warnings{"shared-to-warning"}, expectedErr)
+					})
+
+					It("returns the error and all warnings", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(warnings).To(ConsistOf("instance-warning", "plan-warning", "service-warning", "shared-from-warning", "shared-to-warning"))
+					})
+				})
+			})
+
+			Context("when sharing is not included", func() {
+				BeforeEach(func() {
+					includeSharing = false
+				})
+
+				It("does not look up sharing information", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(summary.IsSharedFrom()).To(BeFalse())
+					Expect(summary.IsSharedTo()).To(BeFalse())
+
+					Expect(fakeCloudControllerClient.GetServiceInstanceSharedFromCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.GetServiceInstanceSharedTosCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when getting the service plan fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("plan error")
+					fakeCloudControllerClient.GetServicePlanReturns(ccv2.ServicePlan{}, ccv2.Warnings{"plan-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("instance-warning", "plan-warning"))
+				})
+			})
+		})
+
+		Context("when the service instance is user provided", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{
+						GUID: "some-service-instance-guid",
+						Name: "some-service-instance",
+						Type: ccv2.UserProvidedService,
+					}},
+					ccv2.Warnings{"instance-warning"},
+					nil)
+			})
+
+			It("returns the bound apps without plan, service or sharing information", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summary.BoundApplications).To(Equal([]string{"app-1", "app-2"}))
+				Expect(summary.ServicePlan).To(Equal(ServicePlan{}))
+				Expect(warnings).To(ConsistOf("instance-warning", "bindings-warning", "app-warning-1", "app-warning-2"))
+
+				Expect(fakeCloudControllerClient.GetServicePlanCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetServiceCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetServiceInstanceSharedFromCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(nil, ccv2.Warnings{"instance-warning"}, nil)
+			})
+
+			It("returns a ServiceInstanceNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("instance-warning"))
+			})
+		})
+	})
+})
@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
@@ -16,11 +17,13 @@ var _ = Describe("Service Instance Actions", func() {
 	var (
 		actor                     *Actor
 		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
 	)
 
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
-		actor = NewActor(fakeCloudControllerClient, nil, nil)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
 	})
 
 	Describe("GetServiceInstance", func() {
@@ -305,4 +308,304 @@ var _ = Describe("Service Instance Actions", func() {
 			})
 		})
 	})
+
+	Describe("CreateServiceInstance", func() {
+		var (
+			serviceInstance ServiceInstance
+			warnings        Warnings
+			executeErr      error
+		)
+
+		JustBeforeEach(func() {
+			serviceInstance, warnings, executeErr = actor.CreateServiceInstance("some-space-guid", "some-plan-guid", "some-service-instance", map[string]interface{}{"some-key": "some-value"}, []string{"some-tag"})
+		})
+
+		Context("when the service instance is created", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceInstanceReturns(
+					ccv2.ServiceInstance{GUID: "some-service-instance-guid", LastOperation: ccv2.LastOperation{State: ccv2.LastOperationInProgress}},
+					ccv2.Warnings{"create-warning"},
+					nil)
+			})
+
+			It("returns the service instance and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(serviceInstance.GUID).To(Equal("some-service-instance-guid"))
+				Expect(serviceInstance.OperationInProgress()).To(BeTrue())
+				Expect(warnings).To(ConsistOf("create-warning"))
+
+				Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(1))
+				spaceGUID, planGUID, name, parameters, tags := fakeCloudControllerClient.CreateServiceInstanceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(planGUID).To(Equal("some-plan-guid"))
+				Expect(name).To(Equal("some-service-instance"))
+				Expect(parameters).To(Equal(map[string]interface{}{"some-key": "some-value"}))
+				Expect(tags).To(Equal([]string{"some-tag"}))
+			})
+		})
+
+		Context("when the name is already taken", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceInstanceReturns(ccv2.ServiceInstance{}, ccv2.Warnings{"create-warning"}, ccerror.ServiceInstanceNameTakenError{Message: "taken"})
+			})
+
+			Context("by a service instance with the same plan", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+						[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", ServicePlanGUID: "some-plan-guid"}},
+						ccv2.Warnings{"get-warning"},
+						nil)
+				})
+
+				It("returns a ServiceInstanceAlreadyExistsError and all warnings", func() {
+					Expect(executeErr).To(MatchError(ServiceInstanceAlreadyExistsError{Name: "some-service-instance"}))
+					Expect(serviceInstance.GUID).To(Equal("some-service-instance-guid"))
+					Expect(warnings).To(ConsistOf("create-warning", "get-warning"))
+				})
+			})
+
+			Context("by a service instance with a different plan", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+						[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", ServicePlanGUID: "other-plan-guid"}},
+						ccv2.Warnings{"get-warning"},
+						nil)
+				})
+
+				It("returns the name taken error and all warnings", func() {
+					Expect(executeErr).To(MatchError(ccerror.ServiceInstanceNameTakenError{Message: "taken"}))
+					Expect(warnings).To(ConsistOf("create-warning", "get-warning"))
+				})
+			})
+		})
+	})
+
+	Describe("UpdateServiceInstance", func() {
+		Context("when the update succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateServiceInstanceReturns(ccv2.ServiceInstance{GUID: "some-service-instance-guid"}, ccv2.Warnings{"update-warning"}, nil)
+			})
+
+			It("passes the changes through and keeps the instance name", func() {
+				tags := []string{"some-tag"}
+				serviceInstance, warnings, err := actor.UpdateServiceInstance(ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"}, "some-plan-guid", nil, &tags)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(serviceInstance.Name).To(Equal("some-service-instance"))
+				Expect(warnings).To(ConsistOf("update-warning"))
+
+				guid, planGUID, parameters, passedTags := fakeCloudControllerClient.UpdateServiceInstanceArgsForCall(0)
+				Expect(guid).To(Equal("some-service-instance-guid"))
+				Expect(planGUID).To(Equal("some-plan-guid"))
+				Expect(parameters).To(BeNil())
+				Expect(passedTags).To(Equal(&tags))
+			})
+		})
+
+		Context("when the update fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateServiceInstanceReturns(ccv2.ServiceInstance{}, ccv2.Warnings{"update-warning"}, errors.New("update failed"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.UpdateServiceInstance(ServiceInstance{GUID: "some-service-instance-guid"}, "", nil, nil)
+				Expect(err).To(MatchError("update failed"))
+				Expect(warnings).To(ConsistOf("update-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteServiceInstance", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteServiceInstanceReturns(
+				ccv2.ServiceInstance{GUID: "some-service-instance-guid", LastOperation: ccv2.LastOperation{Type: "delete", State: ccv2.LastOperationInProgress}},
+				ccv2.Warnings{"delete-warning"},
+				nil)
+		})
+
+		Context("when the service instance has no bindings or keys", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingsReturns(nil, ccv2.Warnings{"bindings-warning"}, nil)
+				fakeCloudControllerClient.GetServiceKeysReturns(nil, ccv2.Warnings{"keys-warning"}, nil)
+			})
+
+			It("deletes the service instance and returns its last operation", func() {
+				serviceInstance, warnings, err := actor.DeleteServiceInstance(ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(serviceInstance.Name).To(Equal("some-service-instance"))
+				Expect(serviceInstance.OperationInProgress()).To(BeTrue())
+				Expect(warnings).To(ConsistOf("bindings-warning", "keys-warning", "delete-warning"))
+
+				expectedQuery := []ccv2.Query{{
+					Filter:   ccv2.ServiceInstanceGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-service-instance-guid",
+				}}
+				Expect(fakeCloudControllerClient.GetServiceBindingsArgsForCall(0)).To(Equal(expectedQuery))
+				Expect(fakeCloudControllerClient.GetServiceKeysArgsForCall(0)).To(Equal(expectedQuery))
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceArgsForCall(0)).To(Equal("some-service-instance-guid"))
+			})
+		})
+
+		Context("when the service instance has service bindings", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingsReturns([]ccv2.ServiceBinding{{GUID: "some-binding-guid"}}, ccv2.Warnings{"bindings-warning"}, nil)
+			})
+
+			It("does not delete the service instance", func() {
+				_, warnings, err := actor.DeleteServiceInstance(ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"})
+				Expect(err).To(MatchError(ServiceInstanceHasAssociationsError{Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("bindings-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the service instance has service keys", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeysReturns([]ccv2.ServiceKey{{GUID: "some-key-guid"}}, ccv2.Warnings{"keys-warning"}, nil)
+			})
+
+			It("does not delete the service instance", func() {
+				_, warnings, err := actor.DeleteServiceInstance(ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"})
+				Expect(err).To(MatchError(ServiceInstanceHasAssociationsError{Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("keys-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the service bindings fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingsReturns(nil, ccv2.Warnings{"bindings-warning"}, errors.New("bindings-error"))
+			})
+
+			It("returns the error and does not delete the service instance", func() {
+				_, warnings, err := actor.DeleteServiceInstance(ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"})
+				Expect(err).To(MatchError("bindings-error"))
+				Expect(warnings).To(ConsistOf("bindings-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("PollServiceInstanceOperation", func() {
+		var (
+			serviceInstance ServiceInstance
+			timeout         time.Duration
+
+			updates  []ServiceInstance
+			warnings []string
+			pollErr  error
+		)
+
+		BeforeEach(func() {
+			serviceInstance = ServiceInstance{
+				GUID:          "some-service-instance-guid",
+				Name:          "some-service-instance",
+				LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationInProgress},
+			}
+			timeout = time.Minute
+			updates = nil
+			warnings = nil
+			pollErr = nil
+		})
+
+		JustBeforeEach(func() {
+			instances, allWarnings, errs := actor.PollServiceInstanceOperation(serviceInstance, timeout)
+			for instances != nil || allWarnings != nil || errs != nil {
+				select {
+				case instance, ok := <-instances:
+					if !ok {
+						instances = nil
+						continue
+					}
+					updates = append(updates, instance)
+				case warning, ok := <-allWarnings:
+					if !ok {
+						allWarnings = nil
+						continue
+					}
+					warnings = append(warnings, warning)
+				case err, ok := <-errs:
+					if !ok {
+						errs = nil
+						continue
+					}
+					pollErr = err
+				}
+			}
+		})
+
+		Context("when the operation succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(0, ccv2.ServiceInstance{Name: "some-service-instance", LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationInProgress}}, ccv2.Warnings{"warning-1"}, nil)
+				fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(1, ccv2.ServiceInstance{Name: "some-service-instance", LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationInProgress, Description: "50%"}}, ccv2.Warnings{"warning-2"}, nil)
+				fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(2, ccv2.ServiceInstance{Name: "some-service-instance", LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationSucceeded}}, ccv2.Warnings{"warning-3"}, nil)
+			})
+
+			It("sends only the changes to the operation and stops", func() {
+				Expect(pollErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2", "warning-3"))
+				Expect(updates).To(HaveLen(2))
+				Expect(updates[0].LastOperation.Description).To(Equal("50%"))
+				Expect(updates[1].LastOperation.State).To(Equal(ccv2.LastOperationSucceeded))
+
+				Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(3))
+				Expect(fakeCloudControllerClient.GetServiceInstanceArgsForCall(0)).To(Equal("some-service-instance-guid"))
+				Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the operation fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceReturns(ccv2.ServiceInstance{Name: "some-service-instance", LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationFailed, Description: "out of capacity"}}, nil, nil)
+			})
+
+			It("returns a ServiceInstanceOperationFailedError", func() {
+				Expect(pollErr).To(MatchError(ServiceInstanceOperationFailedError{
+					Name:        "some-service-instance",
+					Operation:   "create",
+					Description: "out of capacity",
+				}))
+				Expect(updates).To(HaveLen(1))
+			})
+		})
+
+		Context("when a deleted service instance disappears", func() {
+			BeforeEach(func() {
+				serviceInstance.LastOperation.Type = "delete"
+				fakeCloudControllerClient.GetServiceInstanceReturns(ccv2.ServiceInstance{}, ccv2.Warnings{"warning-1"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("stops without an error", func() {
+				Expect(pollErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(updates).To(BeEmpty())
+			})
+		})
+
+		Context("when getting the service instance fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceReturns(ccv2.ServiceInstance{}, ccv2.Warnings{"warning-1"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(pollErr).To(MatchError(ccerror.ResourceNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the timeout is reached", func() {
+			BeforeEach(func() {
+				timeout = 10 * time.Millisecond
+				fakeConfig.PollingIntervalReturns(time.Millisecond)
+				fakeCloudControllerClient.GetServiceInstanceReturns(ccv2.ServiceInstance{Name: "some-service-instance", LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationInProgress}}, nil, nil)
+			})
+
+			It("returns a ServiceInstanceOperationTimeoutError", func() {
+				Expect(pollErr).To(MatchError(ServiceInstanceOperationTimeoutError{Name: "some-service-instance", Timeout: 10 * time.Millisecond}))
+			})
+		})
+	})
 })
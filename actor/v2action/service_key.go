@@ -0,0 +1,83 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// ServiceKey represents a set of credentials for a service instance that is
+// not tied to an application.
+type ServiceKey ccv2.ServiceKey
+
+// ServiceKeyNotFoundError is returned when a service key cannot be found.
+type ServiceKeyNotFoundError struct {
+	Name                string
+	ServiceInstanceName string
+}
+
+func (e ServiceKeyNotFoundError) Error() string {
+	return fmt.Sprintf("Service key '%s' for service instance '%s' not found.", e.Name, e.ServiceInstanceName)
+}
+
+// ServiceKeyParametersNotSupportedError is returned when the service broker
+// does not allow the parameters of a service key to be retrieved.
+type ServiceKeyParametersNotSupportedError struct {
+	Name string
+}
+
+func (e ServiceKeyParametersNotSupportedError) Error() string {
+	return fmt.Sprintf("The parameters of service key '%s' cannot be retrieved.", e.Name)
+}
+
+// GetServiceKeyByNameAndServiceInstance returns the named service key of the
+// service instance.
+func (actor Actor) GetServiceKeyByNameAndServiceInstance(keyName string, serviceInstance ServiceInstance) (ServiceKey, Warnings, error) {
+	serviceKeys, warnings, err := actor.CloudControllerClient.GetServiceKeys([]ccv2.Query{
+		{
+			Filter:   ccv2.NameFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    keyName,
+		},
+		{
+			Filter:   ccv2.ServiceInstanceGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    serviceInstance.GUID,
+		},
+	})
+	if err != nil {
+		return ServiceKey{}, Warnings(warnings), err
+	}
+
+	if len(serviceKeys) == 0 {
+		return ServiceKey{}, Warnings(warnings), ServiceKeyNotFoundError{
+			Name:                keyName,
+			ServiceInstanceName: serviceInstance.Name,
+		}
+	}
+
+	return ServiceKey(serviceKeys[0]), Warnings(warnings), nil
+}
+
+// GetServiceKeyParameters returns the configuration parameters the service
+// key was created with.
+func (actor Actor) GetServiceKeyParameters(serviceKey ServiceKey) (map[string]interface{}, Warnings, error) {
+	parameters, warnings, err := actor.CloudControllerClient.GetServiceKeyParameters(serviceKey.GUID)
+	if _, ok := err.(ccerror.ServiceFetchBindingParametersNotSupportedError); ok {
+		return nil, Warnings(warnings), ServiceKeyParametersNotSupportedError{Name: serviceKey.Name}
+	}
+	return parameters, Warnings(warnings), err
+}
+
+// CreateServiceKey creates a service key for the service instance.
+func (actor Actor) CreateServiceKey(serviceInstanceGUID string, keyName string, parameters map[string]interface{}) (ServiceKey, Warnings, error) {
+	serviceKey, warnings, err := actor.CloudControllerClient.CreateServiceKey(serviceInstanceGUID, keyName, parameters)
+	return ServiceKey(serviceKey), Warnings(warnings), err
+}
+
+// DeleteServiceKey deletes the service key.
+func (actor Actor) DeleteServiceKey(serviceKeyGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteServiceKey(serviceKeyGUID)
+	return Warnings(warnings), err
+}
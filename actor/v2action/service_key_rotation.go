@@ -0,0 +1,70 @@
+package v2action
+
+import (
+	"regexp"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// rotationSuffixFormat is the layout of the timestamp appended to rotated
+// service key names.
+const rotationSuffixFormat = "20060102150405"
+
+var rotationSuffix = regexp.MustCompile(`-\d{14}$`)
+
+// ServiceKeyRotation is the result of creating a replacement for a service
+// key.
+type ServiceKeyRotation struct {
+	ServiceInstance ServiceInstance
+	OldServiceKey   ServiceKey
+	NewServiceKey   ServiceKey
+}
+
+// RotatedServiceKeyName returns the name of the key replacing keyName. The
+// rotation time is appended as a suffix, replacing the suffix of a previous
+// rotation so names do not grow with every rotation.
+func RotatedServiceKeyName(keyName string, rotatedAt time.Time) string {
+	baseName := rotationSuffix.ReplaceAllString(keyName, "")
+	return baseName + "-" + rotatedAt.UTC().Format(rotationSuffixFormat)
+}
+
+// CreateRotatedServiceKey creates newKeyName alongside the named service key
+// of the service instance. When parameters is nil, the parameters of the old
+// key are used. The old key is left in place.
+func (actor Actor) CreateRotatedServiceKey(serviceInstanceName string, keyName string, newKeyName string, spaceGUID string, parameters map[string]interface{}) (ServiceKeyRotation, Warnings, error) {
+	var allWarnings Warnings
+
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceKeyRotation{}, allWarnings, err
+	}
+
+	oldKey, warnings, err := actor.GetServiceKeyByNameAndServiceInstance(keyName, serviceInstance)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceKeyRotation{}, allWarnings, err
+	}
+
+	if parameters == nil {
+		log.Debugln("copying parameters of service key", oldKey.GUID)
+		parameters, warnings, err = actor.GetServiceKeyParameters(oldKey)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ServiceKeyRotation{}, allWarnings, err
+		}
+	}
+
+	newKey, warnings, err := actor.CreateServiceKey(serviceInstance.GUID, newKeyName, parameters)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceKeyRotation{}, allWarnings, err
+	}
+
+	return ServiceKeyRotation{
+		ServiceInstance: serviceInstance,
+		OldServiceKey:   oldKey,
+		NewServiceKey:   newKey,
+	}, allWarnings, nil
+}
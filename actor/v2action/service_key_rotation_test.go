@@ -0,0 +1,117 @@
+package v2action_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Key Rotation Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("RotatedServiceKeyName", func() {
+		var rotatedAt time.Time
+
+		BeforeEach(func() {
+			rotatedAt = time.Date(2026, time.October, 19, 8, 15, 30, 0, time.UTC)
+		})
+
+		It("appends the rotation time", func() {
+			Expect(RotatedServiceKeyName("some-key", rotatedAt)).To(Equal("some-key-20261019081530"))
+		})
+
+		It("replaces the suffix of a previous rotation", func() {
+			Expect(RotatedServiceKeyName("some-key-20250101000000", rotatedAt)).To(Equal("some-key-20261019081530"))
+		})
+	})
+
+	Describe("CreateRotatedServiceKey", func() {
+		var (
+			parameters map[string]interface{}
+
+			rotation   ServiceKeyRotation
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			parameters = nil
+
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", Name: "some-service-instance"}},
+				ccv2.Warnings{"service-instance-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceKeysReturns(
+				[]ccv2.ServiceKey{{GUID: "old-key-guid", Name: "some-key"}},
+				ccv2.Warnings{"key-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceKeyParametersReturns(
+				map[string]interface{}{"permissions": "read-only"},
+				ccv2.Warnings{"parameters-warning"},
+				nil)
+			fakeCloudControllerClient.CreateServiceKeyReturns(
+				ccv2.ServiceKey{GUID: "new-key-guid", Name: "new-key", Credentials: map[string]interface{}{"password": "new"}},
+				ccv2.Warnings{"create-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			rotation, warnings, executeErr = actor.CreateRotatedServiceKey("some-service-instance", "some-key", "new-key", "some-space-guid", parameters)
+		})
+
+		It("creates the new key with the parameters of the old key", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("service-instance-warning", "key-warning", "parameters-warning", "create-warning"))
+			Expect(rotation.ServiceInstance.GUID).To(Equal("some-service-instance-guid"))
+			Expect(rotation.OldServiceKey.GUID).To(Equal("old-key-guid"))
+			Expect(rotation.NewServiceKey.GUID).To(Equal("new-key-guid"))
+			Expect(rotation.NewServiceKey.Credentials).To(Equal(map[string]interface{}{"password": "new"}))
+
+			Expect(fakeCloudControllerClient.GetServiceKeyParametersArgsForCall(0)).To(Equal("old-key-guid"))
+			serviceInstanceGUID, keyName, createParameters := fakeCloudControllerClient.CreateServiceKeyArgsForCall(0)
+			Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+			Expect(keyName).To(Equal("new-key"))
+			Expect(createParameters).To(Equal(map[string]interface{}{"permissions": "read-only"}))
+
+			Expect(fakeCloudControllerClient.DeleteServiceKeyCallCount()).To(Equal(0))
+		})
+
+		Context("when parameters are provided", func() {
+			BeforeEach(func() {
+				parameters = map[string]interface{}{"permissions": "read-write"}
+			})
+
+			It("uses them instead of the parameters of the old key", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetServiceKeyParametersCallCount()).To(Equal(0))
+				_, _, createParameters := fakeCloudControllerClient.CreateServiceKeyArgsForCall(0)
+				Expect(createParameters).To(Equal(parameters))
+			})
+		})
+
+		Context("when the old key does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeysReturns(nil, ccv2.Warnings{"key-warning"}, nil)
+			})
+
+			It("returns a ServiceKeyNotFoundError without creating a key", func() {
+				Expect(executeErr).To(MatchError(ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("service-instance-warning", "key-warning"))
+				Expect(fakeCloudControllerClient.CreateServiceKeyCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,114 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Key Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetServiceKeyByNameAndServiceInstance", func() {
+		var (
+			serviceKey ServiceKey
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			serviceKey, warnings, executeErr = actor.GetServiceKeyByNameAndServiceInstance("some-key", ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"})
+		})
+
+		Context("when the service key exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeysReturns(
+					[]ccv2.ServiceKey{{GUID: "some-key-guid", Name: "some-key"}},
+					ccv2.Warnings{"key-warning"},
+					nil)
+			})
+
+			It("returns the service key and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(serviceKey).To(Equal(ServiceKey{GUID: "some-key-guid", Name: "some-key"}))
+				Expect(warnings).To(ConsistOf("key-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceKeysCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServiceKeysArgsForCall(0)).To(ConsistOf(
+					ccv2.Query{Filter: ccv2.NameFilter, Operator: ccv2.EqualOperator, Value: "some-key"},
+					ccv2.Query{Filter: ccv2.ServiceInstanceGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-service-instance-guid"},
+				))
+			})
+		})
+
+		Context("when the service key does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeysReturns(nil, ccv2.Warnings{"key-warning"}, nil)
+			})
+
+			It("returns a ServiceKeyNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("key-warning"))
+			})
+		})
+
+		Context("when the lookup fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("lookup error")
+				fakeCloudControllerClient.GetServiceKeysReturns(nil, ccv2.Warnings{"key-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("key-warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceKeyParameters", func() {
+		Context("when the service broker does not support fetching parameters", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeyParametersReturns(
+					nil,
+					ccv2.Warnings{"parameters-warning"},
+					ccerror.ServiceFetchBindingParametersNotSupportedError{Message: "not supported"})
+			})
+
+			It("returns a ServiceKeyParametersNotSupportedError and warnings", func() {
+				_, warnings, err := actor.GetServiceKeyParameters(ServiceKey{GUID: "some-key-guid", Name: "some-key"})
+				Expect(err).To(MatchError(ServiceKeyParametersNotSupportedError{Name: "some-key"}))
+				Expect(warnings).To(ConsistOf("parameters-warning"))
+				Expect(fakeCloudControllerClient.GetServiceKeyParametersArgsForCall(0)).To(Equal("some-key-guid"))
+			})
+		})
+	})
+
+	Describe("DeleteServiceKey", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteServiceKeyReturns(ccv2.Warnings{"delete-warning"}, nil)
+		})
+
+		It("deletes the service key", func() {
+			warnings, err := actor.DeleteServiceKey("some-key-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete-warning"))
+			Expect(fakeCloudControllerClient.DeleteServiceKeyArgsForCall(0)).To(Equal("some-key-guid"))
+		})
+	})
+})
@@ -0,0 +1,100 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// ServicePlan represents a plan of a service offering.
+type ServicePlan ccv2.ServicePlan
+
+// ServiceNotFoundError is returned when a service offering cannot be found in
+// the marketplace of a space.
+type ServiceNotFoundError struct {
+	Name string
+}
+
+func (e ServiceNotFoundError) Error() string {
+	return fmt.Sprintf("Service offering '%s' not found.", e.Name)
+}
+
+// ServicePlanNotFoundError is returned when a service offering does not have
+// a plan with the given name.
+type ServicePlanNotFoundError struct {
+	PlanName    string
+	ServiceName string
+}
+
+func (e ServicePlanNotFoundError) Error() string {
+	return fmt.Sprintf("Plan '%s' not found for service '%s'.", e.PlanName, e.ServiceName)
+}
+
+// GetServicePlanByNameAndServiceAndSpace returns the named plan of the
+// service offering with the given label, as it is available in the given
+// space.
+func (actor Actor) GetServicePlanByNameAndServiceAndSpace(planName string, serviceName string, spaceGUID string) (ServicePlan, Warnings, error) {
+	var allWarnings Warnings
+
+	services, warnings, err := actor.CloudControllerClient.GetSpaceServices(spaceGUID, []ccv2.Query{{
+		Filter:   ccv2.LabelFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    serviceName,
+	}})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServicePlan{}, allWarnings, err
+	}
+	if len(services) == 0 {
+		return ServicePlan{}, allWarnings, ServiceNotFoundError{Name: serviceName}
+	}
+
+	plan, planWarnings, err := actor.getServicePlanByNameAndService(planName, services[0].GUID)
+	allWarnings = append(allWarnings, planWarnings...)
+	if _, ok := err.(ServicePlanNotFoundError); ok {
+		return ServicePlan{}, allWarnings, ServicePlanNotFoundError{PlanName: planName, ServiceName: serviceName}
+	}
+	return plan, allWarnings, err
+}
+
+// GetServicePlanByNameAndServiceInstance returns the named plan of the
+// service offering the given service instance was created from.
+func (actor Actor) GetServicePlanByNameAndServiceInstance(planName string, serviceInstance ServiceInstance) (ServicePlan, Warnings, error) {
+	var allWarnings Warnings
+
+	currentPlan, warnings, err := actor.CloudControllerClient.GetServicePlan(serviceInstance.ServicePlanGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServicePlan{}, allWarnings, err
+	}
+
+	plan, planWarnings, err := actor.getServicePlanByNameAndService(planName, currentPlan.ServiceGUID)
+	allWarnings = append(allWarnings, planWarnings...)
+	if _, ok := err.(ServicePlanNotFoundError); ok {
+		service, warnings, serviceErr := actor.CloudControllerClient.GetService(currentPlan.ServiceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if serviceErr != nil {
+			return ServicePlan{}, allWarnings, serviceErr
+		}
+		return ServicePlan{}, allWarnings, ServicePlanNotFoundError{PlanName: planName, ServiceName: service.Label}
+	}
+	return plan, allWarnings, err
+}
+
+func (actor Actor) getServicePlanByNameAndService(planName string, serviceGUID string) (ServicePlan, Warnings, error) {
+	plans, warnings, err := actor.CloudControllerClient.GetServicePlans([]ccv2.Query{{
+		Filter:   ccv2.ServiceGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    serviceGUID,
+	}})
+	if err != nil {
+		return ServicePlan{}, Warnings(warnings), err
+	}
+
+	for _, plan := range plans {
+		if plan.Name == planName {
+			return ServicePlan(plan), Warnings(warnings), nil
+		}
+	}
+	return ServicePlan{}, Warnings(warnings), ServicePlanNotFoundError{PlanName: planName}
+}
@@ -0,0 +1,138 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Plan Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetServicePlanByNameAndServiceAndSpace", func() {
+		var (
+			plan       ServicePlan
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			plan, warnings, executeErr = actor.GetServicePlanByNameAndServiceAndSpace("some-plan", "some-service", "some-space-guid")
+		})
+
+		Context("when the service is available in the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServicesReturns([]ccv2.Service{{GUID: "some-service-guid", Label: "some-service"}}, ccv2.Warnings{"services-warning"}, nil)
+			})
+
+			Context("when the plan exists", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServicePlansReturns([]ccv2.ServicePlan{
+						{GUID: "other-plan-guid", Name: "other-plan"},
+						{GUID: "some-plan-guid", Name: "some-plan", Free: true},
+					}, ccv2.Warnings{"plans-warning"}, nil)
+				})
+
+				It("returns the plan and all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(plan).To(Equal(ServicePlan{GUID: "some-plan-guid", Name: "some-plan", Free: true}))
+					Expect(warnings).To(ConsistOf("services-warning", "plans-warning"))
+
+					spaceGUID, queries := fakeCloudControllerClient.GetSpaceServicesArgsForCall(0)
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(queries).To(ConsistOf(ccv2.Query{Filter: ccv2.LabelFilter, Operator: ccv2.EqualOperator, Value: "some-service"}))
+					Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(ccv2.Query{Filter: ccv2.ServiceGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-service-guid"}))
+				})
+			})
+
+			Context("when the plan does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServicePlansReturns([]ccv2.ServicePlan{{GUID: "other-plan-guid", Name: "other-plan"}}, ccv2.Warnings{"plans-warning"}, nil)
+				})
+
+				It("returns a ServicePlanNotFoundError and all warnings", func() {
+					Expect(executeErr).To(MatchError(ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"}))
+					Expect(warnings).To(ConsistOf("services-warning", "plans-warning"))
+				})
+			})
+		})
+
+		Context("when the service is not available in the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServicesReturns(nil, ccv2.Warnings{"services-warning"}, nil)
+			})
+
+			It("returns a ServiceNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(ServiceNotFoundError{Name: "some-service"}))
+				Expect(warnings).To(ConsistOf("services-warning"))
+				Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the services fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServicesReturns(nil, ccv2.Warnings{"services-warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("services-warning"))
+			})
+		})
+	})
+
+	Describe("GetServicePlanByNameAndServiceInstance", func() {
+		var (
+			plan       ServicePlan
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServicePlanReturns(ccv2.ServicePlan{GUID: "current-plan-guid", ServiceGUID: "some-service-guid"}, ccv2.Warnings{"plan-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			plan, warnings, executeErr = actor.GetServicePlanByNameAndServiceInstance("some-plan", ServiceInstance{ServicePlanGUID: "current-plan-guid"})
+		})
+
+		Context("when the service offers the plan", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns([]ccv2.ServicePlan{{GUID: "some-plan-guid", Name: "some-plan"}}, ccv2.Warnings{"plans-warning"}, nil)
+			})
+
+			It("returns the plan and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plan.GUID).To(Equal("some-plan-guid"))
+				Expect(warnings).To(ConsistOf("plan-warning", "plans-warning"))
+				Expect(fakeCloudControllerClient.GetServicePlanArgsForCall(0)).To(Equal("current-plan-guid"))
+			})
+		})
+
+		Context("when the service does not offer the plan", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv2.Warnings{"plans-warning"}, nil)
+				fakeCloudControllerClient.GetServiceReturns(ccv2.Service{Label: "some-service"}, ccv2.Warnings{"service-warning"}, nil)
+			})
+
+			It("returns a ServicePlanNotFoundError naming the service", func() {
+				Expect(executeErr).To(MatchError(ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"}))
+				Expect(warnings).To(ConsistOf("plan-warning", "plans-warning", "service-warning"))
+				Expect(fakeCloudControllerClient.GetServiceArgsForCall(0)).To(Equal("some-service-guid"))
+			})
+		})
+	})
+})
@@ -0,0 +1,110 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/securityrules"
+)
+
+// EffectiveSecurityGroupRule is a security group rule that applies to a
+// space, along with what was found comparing it to the other rules of the
+// same lifecycle phase.
+type EffectiveSecurityGroupRule struct {
+	SecurityGroupRule
+
+	// Global is true when the rule comes from a security group enabled for
+	// every space in the lifecycle phase of the rule.
+	Global bool
+
+	// AllowsAllDestinations is true when the destination of the rule is
+	// 0.0.0.0/0 or an equivalent range.
+	AllowsAllDestinations bool
+
+	// OverlappingSecurityGroups lists the security groups with at least one
+	// other rule allowing some of the same traffic.
+	OverlappingSecurityGroups []string
+}
+
+// GetEffectiveSecurityGroupRulesBySpace returns the rules of every security
+// group applying to the space in the running lifecycle phase and, if
+// includeStaging is true, the staging lifecycle phase. This includes globally
+// enabled security groups as well as those bound to the space.
+func (actor Actor) GetEffectiveSecurityGroupRulesBySpace(spaceGUID string, includeStaging bool) ([]EffectiveSecurityGroupRule, Warnings, error) {
+	var allWarnings Warnings
+
+	runningSecurityGroups, warnings, err := actor.GetSpaceRunningSecurityGroupsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	rules := effectiveSecurityGroupRules(runningSecurityGroups, ccv2.SecurityGroupLifecycleRunning)
+
+	if includeStaging {
+		stagingSecurityGroups, warnings, err := actor.GetSpaceStagingSecurityGroupsBySpace(spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		rules = append(rules, effectiveSecurityGroupRules(stagingSecurityGroups, ccv2.SecurityGroupLifecycleStaging)...)
+	}
+
+	return rules, allWarnings, nil
+}
+
+func effectiveSecurityGroupRules(securityGroups []SecurityGroup, lifecycle ccv2.SecurityGroupLifecycle) []EffectiveSecurityGroupRule {
+	sort.SliceStable(securityGroups, func(i int, j int) bool {
+		return securityGroups[i].Name < securityGroups[j].Name
+	})
+
+	var rules []EffectiveSecurityGroupRule
+	var parsedRules []*securityrules.Rule
+
+	for _, securityGroup := range securityGroups {
+		global := securityGroup.RunningDefault
+		if lifecycle == ccv2.SecurityGroupLifecycleStaging {
+			global = securityGroup.StagingDefault
+		}
+
+		for _, rule := range extractSecurityGroupRules(securityGroup, lifecycle) {
+			effectiveRule := EffectiveSecurityGroupRule{
+				SecurityGroupRule: rule,
+				Global:            global,
+			}
+
+			// Rules the Cloud Controller accepted but that cannot be parsed
+			// here are displayed without being compared to the others.
+			var parsedRule *securityrules.Rule
+			if parsed, err := securityrules.ParseRule(rule.Protocol, rule.Destination, rule.Ports); err == nil {
+				parsedRule = &parsed
+				effectiveRule.AllowsAllDestinations = parsed.AllowsAllDestinations()
+			}
+
+			rules = append(rules, effectiveRule)
+			parsedRules = append(parsedRules, parsedRule)
+		}
+	}
+
+	for i := range rules {
+		for j := i + 1; j < len(rules); j++ {
+			if parsedRules[i] == nil || parsedRules[j] == nil || !parsedRules[i].Overlaps(*parsedRules[j]) {
+				continue
+			}
+			rules[i].OverlappingSecurityGroups = appendUnique(rules[i].OverlappingSecurityGroups, rules[j].Name)
+			rules[j].OverlappingSecurityGroups = appendUnique(rules[j].OverlappingSecurityGroups, rules[i].Name)
+		}
+	}
+
+	return rules
+}
+
+func appendUnique(names []string, name string) []string {
+	for _, existing := range names {
+		if existing == name {
+			return names
+		}
+	}
+	return append(names, name)
+}
@@ -0,0 +1,184 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Security Rules Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetEffectiveSecurityGroupRulesBySpace", func() {
+		var (
+			includeStaging bool
+			rules          []EffectiveSecurityGroupRule
+			warnings       Warnings
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			includeStaging = true
+
+			fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceReturns(
+				[]ccv2.SecurityGroup{
+					{
+						Name: "space-group",
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "tcp", Destination: "10.0.0.5", Ports: "443", Description: "database"},
+							{Protocol: "udp", Destination: "10.0.0.1", Ports: "53"},
+						},
+					},
+					{
+						Name:           "public-networks",
+						RunningDefault: true,
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "all", Destination: "0.0.0.0/0"},
+						},
+					},
+				},
+				ccv2.Warnings{"running-warning"},
+				nil)
+
+			fakeCloudControllerClient.GetSpaceStagingSecurityGroupsBySpaceReturns(
+				[]ccv2.SecurityGroup{
+					{
+						Name:           "dns",
+						StagingDefault: true,
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "udp", Destination: "10.0.0.1", Ports: "53"},
+							{Protocol: "tcp", Destination: "not-an-address", Ports: "53"},
+						},
+					},
+					{
+						Name: "space-group",
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80"},
+						},
+					},
+				},
+				ccv2.Warnings{"staging-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			rules, warnings, executeErr = actor.GetEffectiveSecurityGroupRulesBySpace("some-space-guid", includeStaging)
+		})
+
+		It("returns the rules of each lifecycle phase sorted by security group with their findings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("running-warning", "staging-warning"))
+
+			Expect(rules).To(Equal([]EffectiveSecurityGroupRule{
+				{
+					SecurityGroupRule:         SecurityGroupRule{Name: "public-networks", Destination: "0.0.0.0/0", Lifecycle: ccv2.SecurityGroupLifecycleRunning, Protocol: "all"},
+					Global:                    true,
+					AllowsAllDestinations:     true,
+					OverlappingSecurityGroups: []string{"space-group"},
+				},
+				{
+					SecurityGroupRule:         SecurityGroupRule{Name: "space-group", Description: "database", Destination: "10.0.0.5", Lifecycle: ccv2.SecurityGroupLifecycleRunning, Ports: "443", Protocol: "tcp"},
+					OverlappingSecurityGroups: []string{"public-networks"},
+				},
+				{
+					SecurityGroupRule:         SecurityGroupRule{Name: "space-group", Destination: "10.0.0.1", Lifecycle: ccv2.SecurityGroupLifecycleRunning, Ports: "53", Protocol: "udp"},
+					OverlappingSecurityGroups: []string{"public-networks"},
+				},
+				{
+					SecurityGroupRule: SecurityGroupRule{Name: "dns", Destination: "10.0.0.1", Lifecycle: ccv2.SecurityGroupLifecycleStaging, Ports: "53", Protocol: "udp"},
+					Global:            true,
+				},
+				{
+					SecurityGroupRule: SecurityGroupRule{Name: "dns", Destination: "not-an-address", Lifecycle: ccv2.SecurityGroupLifecycleStaging, Ports: "53", Protocol: "tcp"},
+					Global:            true,
+				},
+				{
+					SecurityGroupRule: SecurityGroupRule{Name: "space-group", Destination: "10.0.0.0/24", Lifecycle: ccv2.SecurityGroupLifecycleStaging, Ports: "80", Protocol: "tcp"},
+				},
+			}))
+
+			Expect(fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceCallCount()).To(Equal(1))
+			spaceGUID, _ := fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(fakeCloudControllerClient.GetSpaceStagingSecurityGroupsBySpaceCallCount()).To(Equal(1))
+			spaceGUID, _ = fakeCloudControllerClient.GetSpaceStagingSecurityGroupsBySpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+
+		Context("when two rules of the same security group overlap", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceReturns(
+					[]ccv2.SecurityGroup{
+						{
+							Name: "space-group",
+							Rules: []ccv2.SecurityGroupRule{
+								{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80-90"},
+								{Protocol: "tcp", Destination: "10.0.0.5", Ports: "85"},
+							},
+						},
+					},
+					nil,
+					nil)
+				includeStaging = false
+			})
+
+			It("flags both rules as overlapping their own security group", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(rules).To(HaveLen(2))
+				Expect(rules[0].OverlappingSecurityGroups).To(Equal([]string{"space-group"}))
+				Expect(rules[1].OverlappingSecurityGroups).To(Equal([]string{"space-group"}))
+			})
+		})
+
+		Context("when staging rules are not requested", func() {
+			BeforeEach(func() {
+				includeStaging = false
+			})
+
+			It("only returns running rules", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("running-warning"))
+				Expect(rules).To(HaveLen(3))
+				Expect(fakeCloudControllerClient.GetSpaceStagingSecurityGroupsBySpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceReturns(nil, ccv2.Warnings{"running-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a SpaceNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(SpaceNotFoundError{GUID: "some-space-guid"}))
+				Expect(warnings).To(ConsistOf("running-warning"))
+			})
+		})
+
+		Context("when getting the staging security groups fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("staging error")
+				fakeCloudControllerClient.GetSpaceStagingSecurityGroupsBySpaceReturns(nil, ccv2.Warnings{"staging-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("running-warning", "staging-warning"))
+			})
+		})
+	})
+})
@@ -1,5 +1,28 @@
 package v2action
 
+// SSHAuthentication contains everything needed to open an SSH connection to
+// an application instance.
+type SSHAuthentication struct {
+	Endpoint           string
+	HostKeyFingerprint string
+	Passcode           string
+}
+
 func (actor Actor) GetSSHPasscode() (string, error) {
 	return actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
 }
+
+// GetSSHAuthentication returns the SSH endpoint and host key fingerprint
+// advertised by the Cloud Controller, along with a one time passcode.
+func (actor Actor) GetSSHAuthentication() (SSHAuthentication, error) {
+	passcode, err := actor.GetSSHPasscode()
+	if err != nil {
+		return SSHAuthentication{}, err
+	}
+
+	return SSHAuthentication{
+		Endpoint:           actor.CloudControllerClient.AppSSHEndpoint(),
+		HostKeyFingerprint: actor.CloudControllerClient.AppSSHHostKeyFingerprint(),
+		Passcode:           passcode,
+	}, nil
+}
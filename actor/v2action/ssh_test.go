@@ -11,15 +11,17 @@ import (
 
 var _ = Describe("SSH Actions", func() {
 	var (
-		actor         *Actor
-		fakeConfig    *v2actionfakes.FakeConfig
-		fakeUAAClient *v2actionfakes.FakeUAAClient
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
+		fakeUAAClient             *v2actionfakes.FakeUAAClient
 	)
 
 	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
 		fakeConfig = new(v2actionfakes.FakeConfig)
 		fakeUAAClient = new(v2actionfakes.FakeUAAClient)
-		actor = NewActor(nil, fakeUAAClient, fakeConfig)
+		actor = NewActor(fakeCloudControllerClient, fakeUAAClient, fakeConfig)
 	})
 
 	Describe("GetSSHPasscode", func() {
@@ -68,4 +70,41 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSSHAuthentication", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.AppSSHEndpointReturns("ssh.example.com:2222")
+			fakeCloudControllerClient.AppSSHHostKeyFingerprintReturns("some-fingerprint")
+		})
+
+		Context("when getting the ssh passcode succeeds", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetSSHPasscodeReturns("s3curep4ss", nil)
+			})
+
+			It("returns the endpoint, fingerprint and passcode", func() {
+				auth, err := actor.GetSSHAuthentication()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(auth).To(Equal(SSHAuthentication{
+					Endpoint:           "ssh.example.com:2222",
+					HostKeyFingerprint: "some-fingerprint",
+					Passcode:           "s3curep4ss",
+				}))
+			})
+		})
+
+		Context("when getting the ssh passcode fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("failed fetching code")
+				fakeUAAClient.GetSSHPasscodeReturns("", expectedErr)
+			})
+
+			It("returns the error", func() {
+				_, err := actor.GetSSHAuthentication()
+				Expect(err).To(MatchError(expectedErr))
+			})
+		})
+	})
 })
@@ -1,5 +1,29 @@
 package v2action
 
+import (
+	"strings"
+	"time"
+
+	"github.com/SermoDigital/jose/jws"
+)
+
+// GetAccessTokenExpiration returns the time at which the access token in the
+// config expires. The time is zero when the token does not expire.
+func (actor Actor) GetAccessTokenExpiration() (time.Time, error) {
+	accessToken := actor.Config.AccessToken()
+	if i := strings.Index(accessToken, " "); i >= 0 {
+		accessToken = accessToken[i+1:]
+	}
+
+	token, err := jws.ParseJWT([]byte(accessToken))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	expiration, _ := token.Claims().Expiration()
+	return expiration, nil
+}
+
 func (actor Actor) RefreshAccessToken(refreshToken string) (string, error) {
 	tokens, err := actor.UAAClient.RefreshAccessToken(refreshToken)
 	if err != nil {
@@ -9,6 +9,19 @@ import (
 )
 
 type FakeCloudControllerClient struct {
+	AssociateRunningSecurityGroupDefaultStub        func(securityGroupGUID string) (ccv2.Warnings, error)
+	associateRunningSecurityGroupDefaultMutex       sync.RWMutex
+	associateRunningSecurityGroupDefaultArgsForCall []struct {
+		securityGroupGUID string
+	}
+	associateRunningSecurityGroupDefaultReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	associateRunningSecurityGroupDefaultReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	AssociateSpaceWithRunningSecurityGroupStub        func(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	associateSpaceWithRunningSecurityGroupMutex       sync.RWMutex
 	associateSpaceWithRunningSecurityGroupArgsForCall []struct {
@@ -37,6 +50,19 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	AssociateStagingSecurityGroupDefaultStub        func(securityGroupGUID string) (ccv2.Warnings, error)
+	associateStagingSecurityGroupDefaultMutex       sync.RWMutex
+	associateStagingSecurityGroupDefaultArgsForCall []struct {
+		securityGroupGUID string
+	}
+	associateStagingSecurityGroupDefaultReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	associateStagingSecurityGroupDefaultReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	BindRouteToApplicationStub        func(routeGUID string, appGUID string) (ccv2.Route, ccv2.Warnings, error)
 	bindRouteToApplicationMutex       sync.RWMutex
 	bindRouteToApplicationArgsForCall []struct {
@@ -99,6 +125,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateSecurityGroupStub        func(name string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error)
+	createSecurityGroupMutex       sync.RWMutex
+	createSecurityGroupArgsForCall []struct {
+		name  string
+		rules []ccv2.SecurityGroupRule
+	}
+	createSecurityGroupReturns struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSecurityGroupReturnsOnCall map[int]struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateServiceBindingStub        func(appGUID string, serviceBindingGUID string, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error)
 	createServiceBindingMutex       sync.RWMutex
 	createServiceBindingArgsForCall []struct {
@@ -116,6 +158,42 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateServiceInstanceStub        func(spaceGUID string, servicePlanGUID string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	createServiceInstanceMutex       sync.RWMutex
+	createServiceInstanceArgsForCall []struct {
+		spaceGUID           string
+		servicePlanGUID     string
+		serviceInstanceName string
+		parameters          map[string]interface{}
+		tags                []string
+	}
+	createServiceInstanceReturns struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateServiceKeyStub        func(serviceInstanceGUID string, keyName string, parameters map[string]interface{}) (ccv2.ServiceKey, ccv2.Warnings, error)
+	createServiceKeyMutex       sync.RWMutex
+	createServiceKeyArgsForCall []struct {
+		serviceInstanceGUID string
+		keyName             string
+		parameters          map[string]interface{}
+	}
+	createServiceKeyReturns struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createServiceKeyReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateUserStub        func(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -146,6 +224,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteOrganizationUserByRoleStub        func(role ccv2.OrganizationRole, orgGUID string, userGUID string) (ccv2.Warnings, error)
+	deleteOrganizationUserByRoleMutex       sync.RWMutex
+	deleteOrganizationUserByRoleArgsForCall []struct {
+		role     ccv2.OrganizationRole
+		orgGUID  string
+		userGUID string
+	}
+	deleteOrganizationUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteOrganizationUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteRouteStub        func(routeGUID string) (ccv2.Warnings, error)
 	deleteRouteMutex       sync.RWMutex
 	deleteRouteArgsForCall []struct {
@@ -172,6 +265,34 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	DeleteServiceInstanceStub        func(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	deleteServiceInstanceMutex       sync.RWMutex
+	deleteServiceInstanceArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	deleteServiceInstanceReturns struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	deleteServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	DeleteServiceKeyStub        func(serviceKeyGUID string) (ccv2.Warnings, error)
+	deleteServiceKeyMutex       sync.RWMutex
+	deleteServiceKeyArgsForCall []struct {
+		serviceKeyGUID string
+	}
+	deleteServiceKeyReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteServiceKeyReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteSpaceStub        func(spaceGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteSpaceMutex       sync.RWMutex
 	deleteSpaceArgsForCall []struct {
@@ -187,6 +308,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteSpaceUserByRoleStub        func(role ccv2.SpaceRole, spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	deleteSpaceUserByRoleMutex       sync.RWMutex
+	deleteSpaceUserByRoleArgsForCall []struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+		userGUID  string
+	}
+	deleteSpaceUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteSpaceUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	GetApplicationStub        func(guid string) (ccv2.Application, ccv2.Warnings, error)
 	getApplicationMutex       sync.RWMutex
 	getApplicationArgsForCall []struct {
@@ -263,6 +399,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetEventsStub        func(queries []ccv2.Query) ([]ccv2.Event, ccv2.Warnings, error)
+	getEventsMutex       sync.RWMutex
+	getEventsArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getEventsReturns struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getEventsReturnsOnCall map[int]struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetJobStub        func(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	getJobMutex       sync.RWMutex
 	getJobArgsForCall []struct {
@@ -324,6 +475,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetOrganizationUsersByRoleStub        func(role ccv2.OrganizationRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getOrganizationUsersByRoleMutex       sync.RWMutex
+	getOrganizationUsersByRoleArgsForCall []struct {
+		role    ccv2.OrganizationRole
+		orgGUID string
+	}
+	getOrganizationUsersByRoleReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationUsersByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetOrganizationsStub        func(queries []ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error)
 	getOrganizationsMutex       sync.RWMutex
 	getOrganizationsArgsForCall []struct {
@@ -415,6 +582,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceStub        func(serviceGUID string) (ccv2.Service, ccv2.Warnings, error)
+	getServiceMutex       sync.RWMutex
+	getServiceArgsForCall []struct {
+		serviceGUID string
+	}
+	getServiceReturns struct {
+		result1 ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceReturnsOnCall map[int]struct {
+		result1 ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServiceBindingParametersStub        func(serviceBindingGUID string) (map[string]interface{}, ccv2.Warnings, error)
+	getServiceBindingParametersMutex       sync.RWMutex
+	getServiceBindingParametersArgsForCall []struct {
+		serviceBindingGUID string
+	}
+	getServiceBindingParametersReturns struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceBindingParametersReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServiceBindingsStub        func(queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
 	getServiceBindingsMutex       sync.RWMutex
 	getServiceBindingsArgsForCall []struct {
@@ -445,6 +642,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceInstanceSharedFromStub        func(serviceInstanceGUID string) (ccv2.ServiceInstanceSharedFrom, ccv2.Warnings, error)
+	getServiceInstanceSharedFromMutex       sync.RWMutex
+	getServiceInstanceSharedFromArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	getServiceInstanceSharedFromReturns struct {
+		result1 ccv2.ServiceInstanceSharedFrom
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceInstanceSharedFromReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceInstanceSharedFrom
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServiceInstanceSharedTosStub        func(serviceInstanceGUID string) ([]ccv2.ServiceInstanceSharedTo, ccv2.Warnings, error)
+	getServiceInstanceSharedTosMutex       sync.RWMutex
+	getServiceInstanceSharedTosArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	getServiceInstanceSharedTosReturns struct {
+		result1 []ccv2.ServiceInstanceSharedTo
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceInstanceSharedTosReturnsOnCall map[int]struct {
+		result1 []ccv2.ServiceInstanceSharedTo
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServiceInstancesStub        func(queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
 	getServiceInstancesMutex       sync.RWMutex
 	getServiceInstancesArgsForCall []struct {
@@ -460,6 +687,66 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceKeyParametersStub        func(serviceKeyGUID string) (map[string]interface{}, ccv2.Warnings, error)
+	getServiceKeyParametersMutex       sync.RWMutex
+	getServiceKeyParametersArgsForCall []struct {
+		serviceKeyGUID string
+	}
+	getServiceKeyParametersReturns struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceKeyParametersReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServiceKeysStub        func(queries []ccv2.Query) ([]ccv2.ServiceKey, ccv2.Warnings, error)
+	getServiceKeysMutex       sync.RWMutex
+	getServiceKeysArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getServiceKeysReturns struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceKeysReturnsOnCall map[int]struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServicePlanStub        func(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error)
+	getServicePlanMutex       sync.RWMutex
+	getServicePlanArgsForCall []struct {
+		servicePlanGUID string
+	}
+	getServicePlanReturns struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicePlanReturnsOnCall map[int]struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServicePlansStub        func(queries []ccv2.Query) ([]ccv2.ServicePlan, ccv2.Warnings, error)
+	getServicePlansMutex       sync.RWMutex
+	getServicePlansArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getServicePlansReturns struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicePlansReturnsOnCall map[int]struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSharedDomainStub        func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	getSharedDomainMutex       sync.RWMutex
 	getSharedDomainArgsForCall []struct {
@@ -535,6 +822,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceUsersByRoleStub        func(role ccv2.SpaceRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getSpaceUsersByRoleMutex       sync.RWMutex
+	getSpaceUsersByRoleArgsForCall []struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+	}
+	getSpaceUsersByRoleReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceUsersByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSpacesStub        func(queries []ccv2.Query) ([]ccv2.Space, ccv2.Warnings, error)
 	getSpacesMutex       sync.RWMutex
 	getSpacesArgsForCall []struct {
@@ -567,6 +870,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceServicesStub        func(spaceGUID string, queries []ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error)
+	getSpaceServicesMutex       sync.RWMutex
+	getSpaceServicesArgsForCall []struct {
+		spaceGUID string
+		queries   []ccv2.Query
+	}
+	getSpaceServicesReturns struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceServicesReturnsOnCall map[int]struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSpaceStagingSecurityGroupsBySpaceStub        func(spaceGUID string, queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	getSpaceStagingSecurityGroupsBySpaceMutex       sync.RWMutex
 	getSpaceStagingSecurityGroupsBySpaceArgsForCall []struct {
@@ -628,6 +947,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	InfoStub        func() (ccv2.APIInformation, ccv2.Warnings, error)
+	infoMutex       sync.RWMutex
+	infoArgsForCall []struct{}
+	infoReturns     struct {
+		result1 ccv2.APIInformation
+		result2 ccv2.Warnings
+		result3 error
+	}
+	infoReturnsOnCall map[int]struct {
+		result1 ccv2.APIInformation
+		result2 ccv2.Warnings
+		result3 error
+	}
 	PollJobStub        func(job ccv2.Job) (ccv2.Warnings, error)
 	pollJobMutex       sync.RWMutex
 	pollJobArgsForCall []struct {
@@ -641,6 +973,19 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	RemoveRunningSecurityGroupDefaultStub        func(securityGroupGUID string) (ccv2.Warnings, error)
+	removeRunningSecurityGroupDefaultMutex       sync.RWMutex
+	removeRunningSecurityGroupDefaultArgsForCall []struct {
+		securityGroupGUID string
+	}
+	removeRunningSecurityGroupDefaultReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	removeRunningSecurityGroupDefaultReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	RemoveSpaceFromRunningSecurityGroupStub        func(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	removeSpaceFromRunningSecurityGroupMutex       sync.RWMutex
 	removeSpaceFromRunningSecurityGroupArgsForCall []struct {
@@ -669,6 +1014,19 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	RemoveStagingSecurityGroupDefaultStub        func(securityGroupGUID string) (ccv2.Warnings, error)
+	removeStagingSecurityGroupDefaultMutex       sync.RWMutex
+	removeStagingSecurityGroupDefaultArgsForCall []struct {
+		securityGroupGUID string
+	}
+	removeStagingSecurityGroupDefaultReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	removeStagingSecurityGroupDefaultReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	ResourceMatchStub        func(resourcesToMatch []ccv2.Resource) ([]ccv2.Resource, ccv2.Warnings, error)
 	resourceMatchMutex       sync.RWMutex
 	resourceMatchArgsForCall []struct {
@@ -727,6 +1085,70 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	UpdateOrganizationUserByRoleAndUsernameStub        func(role ccv2.OrganizationRole, orgGUID string, username string) (ccv2.Warnings, error)
+	updateOrganizationUserByRoleAndUsernameMutex       sync.RWMutex
+	updateOrganizationUserByRoleAndUsernameArgsForCall []struct {
+		role     ccv2.OrganizationRole
+		orgGUID  string
+		username string
+	}
+	updateOrganizationUserByRoleAndUsernameReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationUserByRoleAndUsernameReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateSecurityGroupStub        func(securityGroupGUID string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error)
+	updateSecurityGroupMutex       sync.RWMutex
+	updateSecurityGroupArgsForCall []struct {
+		securityGroupGUID string
+		rules             []ccv2.SecurityGroupRule
+	}
+	updateSecurityGroupReturns struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateSecurityGroupReturnsOnCall map[int]struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateServiceInstanceStub        func(serviceInstanceGUID string, servicePlanGUID string, parameters map[string]interface{}, tags *[]string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	updateServiceInstanceMutex       sync.RWMutex
+	updateServiceInstanceArgsForCall []struct {
+		serviceInstanceGUID string
+		servicePlanGUID     string
+		parameters          map[string]interface{}
+		tags                *[]string
+	}
+	updateServiceInstanceReturns struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateSpaceUserByRoleAndUsernameStub        func(role ccv2.SpaceRole, spaceGUID string, username string) (ccv2.Warnings, error)
+	updateSpaceUserByRoleAndUsernameMutex       sync.RWMutex
+	updateSpaceUserByRoleAndUsernameArgsForCall []struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+		username  string
+	}
+	updateSpaceUserByRoleAndUsernameReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceUserByRoleAndUsernameReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	UploadApplicationPackageStub        func(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
 	uploadApplicationPackageMutex       sync.RWMutex
 	uploadApplicationPackageArgsForCall []struct {
@@ -763,6 +1185,24 @@ type FakeCloudControllerClient struct {
 	aPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	AppSSHEndpointStub        func() string
+	appSSHEndpointMutex       sync.RWMutex
+	appSSHEndpointArgsForCall []struct{}
+	appSSHEndpointReturns     struct {
+		result1 string
+	}
+	appSSHEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
+	AppSSHHostKeyFingerprintStub        func() string
+	appSSHHostKeyFingerprintMutex       sync.RWMutex
+	appSSHHostKeyFingerprintArgsForCall []struct{}
+	appSSHHostKeyFingerprintReturns     struct {
+		result1 string
+	}
+	appSSHHostKeyFingerprintReturnsOnCall map[int]struct {
+		result1 string
+	}
 	AuthorizationEndpointStub        func() string
 	authorizationEndpointMutex       sync.RWMutex
 	authorizationEndpointArgsForCall []struct{}
@@ -812,6 +1252,57 @@ type FakeCloudControllerClient struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeCloudControllerClient) AssociateRunningSecurityGroupDefault(securityGroupGUID string) (ccv2.Warnings, error) {
+	fake.associateRunningSecurityGroupDefaultMutex.Lock()
+	ret, specificReturn := fake.associateRunningSecurityGroupDefaultReturnsOnCall[len(fake.associateRunningSecurityGroupDefaultArgsForCall)]
+	fake.associateRunningSecurityGroupDefaultArgsForCall = append(fake.associateRunningSecurityGroupDefaultArgsForCall, struct {
+		securityGroupGUID string
+	}{securityGroupGUID})
+	fake.recordInvocation("AssociateRunningSecurityGroupDefault", []interface{}{securityGroupGUID})
+	fake.associateRunningSecurityGroupDefaultMutex.Unlock()
+	if fake.AssociateRunningSecurityGroupDefaultStub != nil {
+		return fake.AssociateRunningSecurityGroupDefaultStub(securityGroupGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.associateRunningSecurityGroupDefaultReturns.result1, fake.associateRunningSecurityGroupDefaultReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) AssociateRunningSecurityGroupDefaultCallCount() int {
+	fake.associateRunningSecurityGroupDefaultMutex.RLock()
+	defer fake.associateRunningSecurityGroupDefaultMutex.RUnlock()
+	return len(fake.associateRunningSecurityGroupDefaultArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) AssociateRunningSecurityGroupDefaultArgsForCall(i int) string {
+	fake.associateRunningSecurityGroupDefaultMutex.RLock()
+	defer fake.associateRunningSecurityGroupDefaultMutex.RUnlock()
+	return fake.associateRunningSecurityGroupDefaultArgsForCall[i].securityGroupGUID
+}
+
+func (fake *FakeCloudControllerClient) AssociateRunningSecurityGroupDefaultReturns(result1 ccv2.Warnings, result2 error) {
+	fake.AssociateRunningSecurityGroupDefaultStub = nil
+	fake.associateRunningSecurityGroupDefaultReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) AssociateRunningSecurityGroupDefaultReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.AssociateRunningSecurityGroupDefaultStub = nil
+	if fake.associateRunningSecurityGroupDefaultReturnsOnCall == nil {
+		fake.associateRunningSecurityGroupDefaultReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.associateRunningSecurityGroupDefaultReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) AssociateSpaceWithRunningSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error) {
 	fake.associateSpaceWithRunningSecurityGroupMutex.Lock()
 	ret, specificReturn := fake.associateSpaceWithRunningSecurityGroupReturnsOnCall[len(fake.associateSpaceWithRunningSecurityGroupArgsForCall)]
@@ -916,7 +1407,58 @@ func (fake *FakeCloudControllerClient) AssociateSpaceWithStagingSecurityGroupRet
 	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) BindRouteToApplication(routeGUID string, appGUID string) (ccv2.Route, ccv2.Warnings, error) {
+func (fake *FakeCloudControllerClient) AssociateStagingSecurityGroupDefault(securityGroupGUID string) (ccv2.Warnings, error) {
+	fake.associateStagingSecurityGroupDefaultMutex.Lock()
+	ret, specificReturn := fake.associateStagingSecurityGroupDefaultReturnsOnCall[len(fake.associateStagingSecurityGroupDefaultArgsForCall)]
+	fake.associateStagingSecurityGroupDefaultArgsForCall = append(fake.associateStagingSecurityGroupDefaultArgsForCall, struct {
+		securityGroupGUID string
+	}{securityGroupGUID})
+	fake.recordInvocation("AssociateStagingSecurityGroupDefault", []interface{}{securityGroupGUID})
+	fake.associateStagingSecurityGroupDefaultMutex.Unlock()
+	if fake.AssociateStagingSecurityGroupDefaultStub != nil {
+		return fake.AssociateStagingSecurityGroupDefaultStub(securityGroupGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.associateStagingSecurityGroupDefaultReturns.result1, fake.associateStagingSecurityGroupDefaultReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) AssociateStagingSecurityGroupDefaultCallCount() int {
+	fake.associateStagingSecurityGroupDefaultMutex.RLock()
+	defer fake.associateStagingSecurityGroupDefaultMutex.RUnlock()
+	return len(fake.associateStagingSecurityGroupDefaultArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) AssociateStagingSecurityGroupDefaultArgsForCall(i int) string {
+	fake.associateStagingSecurityGroupDefaultMutex.RLock()
+	defer fake.associateStagingSecurityGroupDefaultMutex.RUnlock()
+	return fake.associateStagingSecurityGroupDefaultArgsForCall[i].securityGroupGUID
+}
+
+func (fake *FakeCloudControllerClient) AssociateStagingSecurityGroupDefaultReturns(result1 ccv2.Warnings, result2 error) {
+	fake.AssociateStagingSecurityGroupDefaultStub = nil
+	fake.associateStagingSecurityGroupDefaultReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) AssociateStagingSecurityGroupDefaultReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.AssociateStagingSecurityGroupDefaultStub = nil
+	if fake.associateStagingSecurityGroupDefaultReturnsOnCall == nil {
+		fake.associateStagingSecurityGroupDefaultReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.associateStagingSecurityGroupDefaultReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) BindRouteToApplication(routeGUID string, appGUID string) (ccv2.Route, ccv2.Warnings, error) {
 	fake.bindRouteToApplicationMutex.Lock()
 	ret, specificReturn := fake.bindRouteToApplicationReturnsOnCall[len(fake.bindRouteToApplicationArgsForCall)]
 	fake.bindRouteToApplicationArgsForCall = append(fake.bindRouteToApplicationArgsForCall, struct {
@@ -1134,6 +1676,66 @@ func (fake *FakeCloudControllerClient) CreateRouteReturnsOnCall(i int, result1 c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateSecurityGroup(name string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error) {
+	var rulesCopy []ccv2.SecurityGroupRule
+	if rules != nil {
+		rulesCopy = make([]ccv2.SecurityGroupRule, len(rules))
+		copy(rulesCopy, rules)
+	}
+	fake.createSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.createSecurityGroupReturnsOnCall[len(fake.createSecurityGroupArgsForCall)]
+	fake.createSecurityGroupArgsForCall = append(fake.createSecurityGroupArgsForCall, struct {
+		name  string
+		rules []ccv2.SecurityGroupRule
+	}{name, rulesCopy})
+	fake.recordInvocation("CreateSecurityGroup", []interface{}{name, rulesCopy})
+	fake.createSecurityGroupMutex.Unlock()
+	if fake.CreateSecurityGroupStub != nil {
+		return fake.CreateSecurityGroupStub(name, rules)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSecurityGroupReturns.result1, fake.createSecurityGroupReturns.result2, fake.createSecurityGroupReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupCallCount() int {
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	return len(fake.createSecurityGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupArgsForCall(i int) (string, []ccv2.SecurityGroupRule) {
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	return fake.createSecurityGroupArgsForCall[i].name, fake.createSecurityGroupArgsForCall[i].rules
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupReturns(result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSecurityGroupStub = nil
+	fake.createSecurityGroupReturns = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupReturnsOnCall(i int, result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSecurityGroupStub = nil
+	if fake.createSecurityGroupReturnsOnCall == nil {
+		fake.createSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createSecurityGroupReturnsOnCall[i] = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateServiceBinding(appGUID string, serviceBindingGUID string, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error) {
 	fake.createServiceBindingMutex.Lock()
 	ret, specificReturn := fake.createServiceBindingReturnsOnCall[len(fake.createServiceBindingArgsForCall)]
@@ -1190,6 +1792,125 @@ func (fake *FakeCloudControllerClient) CreateServiceBindingReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateServiceInstance(spaceGUID string, servicePlanGUID string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
+	var tagsCopy []string
+	if tags != nil {
+		tagsCopy = make([]string, len(tags))
+		copy(tagsCopy, tags)
+	}
+	fake.createServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createServiceInstanceReturnsOnCall[len(fake.createServiceInstanceArgsForCall)]
+	fake.createServiceInstanceArgsForCall = append(fake.createServiceInstanceArgsForCall, struct {
+		spaceGUID           string
+		servicePlanGUID     string
+		serviceInstanceName string
+		parameters          map[string]interface{}
+		tags                []string
+	}{spaceGUID, servicePlanGUID, serviceInstanceName, parameters, tagsCopy})
+	fake.recordInvocation("CreateServiceInstance", []interface{}{spaceGUID, servicePlanGUID, serviceInstanceName, parameters, tagsCopy})
+	fake.createServiceInstanceMutex.Unlock()
+	if fake.CreateServiceInstanceStub != nil {
+		return fake.CreateServiceInstanceStub(spaceGUID, servicePlanGUID, serviceInstanceName, parameters, tags)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createServiceInstanceReturns.result1, fake.createServiceInstanceReturns.result2, fake.createServiceInstanceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceInstanceCallCount() int {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return len(fake.createServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceInstanceArgsForCall(i int) (string, string, string, map[string]interface{}, []string) {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return fake.createServiceInstanceArgsForCall[i].spaceGUID, fake.createServiceInstanceArgsForCall[i].servicePlanGUID, fake.createServiceInstanceArgsForCall[i].serviceInstanceName, fake.createServiceInstanceArgsForCall[i].parameters, fake.createServiceInstanceArgsForCall[i].tags
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceInstanceReturns(result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceInstanceStub = nil
+	fake.createServiceInstanceReturns = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceInstanceReturnsOnCall(i int, result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceInstanceStub = nil
+	if fake.createServiceInstanceReturnsOnCall == nil {
+		fake.createServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceKey(serviceInstanceGUID string, keyName string, parameters map[string]interface{}) (ccv2.ServiceKey, ccv2.Warnings, error) {
+	fake.createServiceKeyMutex.Lock()
+	ret, specificReturn := fake.createServiceKeyReturnsOnCall[len(fake.createServiceKeyArgsForCall)]
+	fake.createServiceKeyArgsForCall = append(fake.createServiceKeyArgsForCall, struct {
+		serviceInstanceGUID string
+		keyName             string
+		parameters          map[string]interface{}
+	}{serviceInstanceGUID, keyName, parameters})
+	fake.recordInvocation("CreateServiceKey", []interface{}{serviceInstanceGUID, keyName, parameters})
+	fake.createServiceKeyMutex.Unlock()
+	if fake.CreateServiceKeyStub != nil {
+		return fake.CreateServiceKeyStub(serviceInstanceGUID, keyName, parameters)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createServiceKeyReturns.result1, fake.createServiceKeyReturns.result2, fake.createServiceKeyReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceKeyCallCount() int {
+	fake.createServiceKeyMutex.RLock()
+	defer fake.createServiceKeyMutex.RUnlock()
+	return len(fake.createServiceKeyArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceKeyArgsForCall(i int) (string, string, map[string]interface{}) {
+	fake.createServiceKeyMutex.RLock()
+	defer fake.createServiceKeyMutex.RUnlock()
+	return fake.createServiceKeyArgsForCall[i].serviceInstanceGUID, fake.createServiceKeyArgsForCall[i].keyName, fake.createServiceKeyArgsForCall[i].parameters
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceKeyReturns(result1 ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceKeyStub = nil
+	fake.createServiceKeyReturns = struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceKeyReturnsOnCall(i int, result1 ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceKeyStub = nil
+	if fake.createServiceKeyReturnsOnCall == nil {
+		fake.createServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceKey
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createServiceKeyReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -1298,6 +2019,59 @@ func (fake *FakeCloudControllerClient) DeleteOrganizationReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserByRole(role ccv2.OrganizationRole, orgGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.deleteOrganizationUserByRoleMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationUserByRoleReturnsOnCall[len(fake.deleteOrganizationUserByRoleArgsForCall)]
+	fake.deleteOrganizationUserByRoleArgsForCall = append(fake.deleteOrganizationUserByRoleArgsForCall, struct {
+		role     ccv2.OrganizationRole
+		orgGUID  string
+		userGUID string
+	}{role, orgGUID, userGUID})
+	fake.recordInvocation("DeleteOrganizationUserByRole", []interface{}{role, orgGUID, userGUID})
+	fake.deleteOrganizationUserByRoleMutex.Unlock()
+	if fake.DeleteOrganizationUserByRoleStub != nil {
+		return fake.DeleteOrganizationUserByRoleStub(role, orgGUID, userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteOrganizationUserByRoleReturns.result1, fake.deleteOrganizationUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserByRoleCallCount() int {
+	fake.deleteOrganizationUserByRoleMutex.RLock()
+	defer fake.deleteOrganizationUserByRoleMutex.RUnlock()
+	return len(fake.deleteOrganizationUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserByRoleArgsForCall(i int) (ccv2.OrganizationRole, string, string) {
+	fake.deleteOrganizationUserByRoleMutex.RLock()
+	defer fake.deleteOrganizationUserByRoleMutex.RUnlock()
+	return fake.deleteOrganizationUserByRoleArgsForCall[i].role, fake.deleteOrganizationUserByRoleArgsForCall[i].orgGUID, fake.deleteOrganizationUserByRoleArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationUserByRoleStub = nil
+	fake.deleteOrganizationUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationUserByRoleStub = nil
+	if fake.deleteOrganizationUserByRoleReturnsOnCall == nil {
+		fake.deleteOrganizationUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteOrganizationUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteRoute(routeGUID string) (ccv2.Warnings, error) {
 	fake.deleteRouteMutex.Lock()
 	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
@@ -1400,6 +2174,111 @@ func (fake *FakeCloudControllerClient) DeleteServiceBindingReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteServiceInstance(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
+	fake.deleteServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteServiceInstanceReturnsOnCall[len(fake.deleteServiceInstanceArgsForCall)]
+	fake.deleteServiceInstanceArgsForCall = append(fake.deleteServiceInstanceArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("DeleteServiceInstance", []interface{}{serviceInstanceGUID})
+	fake.deleteServiceInstanceMutex.Unlock()
+	if fake.DeleteServiceInstanceStub != nil {
+		return fake.DeleteServiceInstanceStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteServiceInstanceReturns.result1, fake.deleteServiceInstanceReturns.result2, fake.deleteServiceInstanceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceCallCount() int {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return len(fake.deleteServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceArgsForCall(i int) string {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return fake.deleteServiceInstanceArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturns(result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteServiceInstanceStub = nil
+	fake.deleteServiceInstanceReturns = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturnsOnCall(i int, result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteServiceInstanceStub = nil
+	if fake.deleteServiceInstanceReturnsOnCall == nil {
+		fake.deleteServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.deleteServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKey(serviceKeyGUID string) (ccv2.Warnings, error) {
+	fake.deleteServiceKeyMutex.Lock()
+	ret, specificReturn := fake.deleteServiceKeyReturnsOnCall[len(fake.deleteServiceKeyArgsForCall)]
+	fake.deleteServiceKeyArgsForCall = append(fake.deleteServiceKeyArgsForCall, struct {
+		serviceKeyGUID string
+	}{serviceKeyGUID})
+	fake.recordInvocation("DeleteServiceKey", []interface{}{serviceKeyGUID})
+	fake.deleteServiceKeyMutex.Unlock()
+	if fake.DeleteServiceKeyStub != nil {
+		return fake.DeleteServiceKeyStub(serviceKeyGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteServiceKeyReturns.result1, fake.deleteServiceKeyReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyCallCount() int {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return len(fake.deleteServiceKeyArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyArgsForCall(i int) string {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return fake.deleteServiceKeyArgsForCall[i].serviceKeyGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceKeyStub = nil
+	fake.deleteServiceKeyReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceKeyStub = nil
+	if fake.deleteServiceKeyReturnsOnCall == nil {
+		fake.deleteServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceKeyReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteSpace(spaceGUID string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.deleteSpaceMutex.Lock()
 	ret, specificReturn := fake.deleteSpaceReturnsOnCall[len(fake.deleteSpaceArgsForCall)]
@@ -1454,6 +2333,59 @@ func (fake *FakeCloudControllerClient) DeleteSpaceReturnsOnCall(i int, result1 c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteSpaceUserByRole(role ccv2.SpaceRole, spaceGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.deleteSpaceUserByRoleMutex.Lock()
+	ret, specificReturn := fake.deleteSpaceUserByRoleReturnsOnCall[len(fake.deleteSpaceUserByRoleArgsForCall)]
+	fake.deleteSpaceUserByRoleArgsForCall = append(fake.deleteSpaceUserByRoleArgsForCall, struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+		userGUID  string
+	}{role, spaceGUID, userGUID})
+	fake.recordInvocation("DeleteSpaceUserByRole", []interface{}{role, spaceGUID, userGUID})
+	fake.deleteSpaceUserByRoleMutex.Unlock()
+	if fake.DeleteSpaceUserByRoleStub != nil {
+		return fake.DeleteSpaceUserByRoleStub(role, spaceGUID, userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteSpaceUserByRoleReturns.result1, fake.deleteSpaceUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserByRoleCallCount() int {
+	fake.deleteSpaceUserByRoleMutex.RLock()
+	defer fake.deleteSpaceUserByRoleMutex.RUnlock()
+	return len(fake.deleteSpaceUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserByRoleArgsForCall(i int) (ccv2.SpaceRole, string, string) {
+	fake.deleteSpaceUserByRoleMutex.RLock()
+	defer fake.deleteSpaceUserByRoleMutex.RUnlock()
+	return fake.deleteSpaceUserByRoleArgsForCall[i].role, fake.deleteSpaceUserByRoleArgsForCall[i].spaceGUID, fake.deleteSpaceUserByRoleArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSpaceUserByRoleStub = nil
+	fake.deleteSpaceUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSpaceUserByRoleStub = nil
+	if fake.deleteSpaceUserByRoleReturnsOnCall == nil {
+		fake.deleteSpaceUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteSpaceUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) GetApplication(guid string) (ccv2.Application, ccv2.Warnings, error) {
 	fake.getApplicationMutex.Lock()
 	ret, specificReturn := fake.getApplicationReturnsOnCall[len(fake.getApplicationArgsForCall)]
@@ -1735,6 +2667,65 @@ func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetEvents(queries []ccv2.Query) ([]ccv2.Event, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getEventsMutex.Lock()
+	ret, specificReturn := fake.getEventsReturnsOnCall[len(fake.getEventsArgsForCall)]
+	fake.getEventsArgsForCall = append(fake.getEventsArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetEvents", []interface{}{queriesCopy})
+	fake.getEventsMutex.Unlock()
+	if fake.GetEventsStub != nil {
+		return fake.GetEventsStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getEventsReturns.result1, fake.getEventsReturns.result2, fake.getEventsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetEventsCallCount() int {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return len(fake.getEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetEventsArgsForCall(i int) []ccv2.Query {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return fake.getEventsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetEventsReturns(result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.GetEventsStub = nil
+	fake.getEventsReturns = struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetEventsReturnsOnCall(i int, result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.GetEventsStub = nil
+	if fake.getEventsReturnsOnCall == nil {
+		fake.getEventsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Event
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getEventsReturnsOnCall[i] = struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.getJobMutex.Lock()
 	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
@@ -1957,30 +2948,85 @@ func (fake *FakeCloudControllerClient) GetOrganizationQuotaReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizations(queries []ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error) {
-	var queriesCopy []ccv2.Query
-	if queries != nil {
-		queriesCopy = make([]ccv2.Query, len(queries))
-		copy(queriesCopy, queries)
-	}
-	fake.getOrganizationsMutex.Lock()
-	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
-	fake.getOrganizationsArgsForCall = append(fake.getOrganizationsArgsForCall, struct {
-		queries []ccv2.Query
-	}{queriesCopy})
-	fake.recordInvocation("GetOrganizations", []interface{}{queriesCopy})
-	fake.getOrganizationsMutex.Unlock()
-	if fake.GetOrganizationsStub != nil {
-		return fake.GetOrganizationsStub(queries)
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRole(role ccv2.OrganizationRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getOrganizationUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getOrganizationUsersByRoleReturnsOnCall[len(fake.getOrganizationUsersByRoleArgsForCall)]
+	fake.getOrganizationUsersByRoleArgsForCall = append(fake.getOrganizationUsersByRoleArgsForCall, struct {
+		role    ccv2.OrganizationRole
+		orgGUID string
+	}{role, orgGUID})
+	fake.recordInvocation("GetOrganizationUsersByRole", []interface{}{role, orgGUID})
+	fake.getOrganizationUsersByRoleMutex.Unlock()
+	if fake.GetOrganizationUsersByRoleStub != nil {
+		return fake.GetOrganizationUsersByRoleStub(role, orgGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getOrganizationsReturns.result1, fake.getOrganizationsReturns.result2, fake.getOrganizationsReturns.result3
+	return fake.getOrganizationUsersByRoleReturns.result1, fake.getOrganizationUsersByRoleReturns.result2, fake.getOrganizationUsersByRoleReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationsCallCount() int {
-	fake.getOrganizationsMutex.RLock()
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleCallCount() int {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return len(fake.getOrganizationUsersByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleArgsForCall(i int) (ccv2.OrganizationRole, string) {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return fake.getOrganizationUsersByRoleArgsForCall[i].role, fake.getOrganizationUsersByRoleArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	fake.getOrganizationUsersByRoleReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	if fake.getOrganizationUsersByRoleReturnsOnCall == nil {
+		fake.getOrganizationUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationUsersByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizations(queries []ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getOrganizationsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
+	fake.getOrganizationsArgsForCall = append(fake.getOrganizationsArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetOrganizations", []interface{}{queriesCopy})
+	fake.getOrganizationsMutex.Unlock()
+	if fake.GetOrganizationsStub != nil {
+		return fake.GetOrganizationsStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationsReturns.result1, fake.getOrganizationsReturns.result2, fake.getOrganizationsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsCallCount() int {
+	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
 	return len(fake.getOrganizationsArgsForCall)
 }
@@ -2302,6 +3348,114 @@ func (fake *FakeCloudControllerClient) GetSecurityGroupsReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetService(serviceGUID string) (ccv2.Service, ccv2.Warnings, error) {
+	fake.getServiceMutex.Lock()
+	ret, specificReturn := fake.getServiceReturnsOnCall[len(fake.getServiceArgsForCall)]
+	fake.getServiceArgsForCall = append(fake.getServiceArgsForCall, struct {
+		serviceGUID string
+	}{serviceGUID})
+	fake.recordInvocation("GetService", []interface{}{serviceGUID})
+	fake.getServiceMutex.Unlock()
+	if fake.GetServiceStub != nil {
+		return fake.GetServiceStub(serviceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceReturns.result1, fake.getServiceReturns.result2, fake.getServiceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceCallCount() int {
+	fake.getServiceMutex.RLock()
+	defer fake.getServiceMutex.RUnlock()
+	return len(fake.getServiceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceArgsForCall(i int) string {
+	fake.getServiceMutex.RLock()
+	defer fake.getServiceMutex.RUnlock()
+	return fake.getServiceArgsForCall[i].serviceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceReturns(result1 ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceStub = nil
+	fake.getServiceReturns = struct {
+		result1 ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceReturnsOnCall(i int, result1 ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceStub = nil
+	if fake.getServiceReturnsOnCall == nil {
+		fake.getServiceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Service
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceReturnsOnCall[i] = struct {
+		result1 ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParameters(serviceBindingGUID string) (map[string]interface{}, ccv2.Warnings, error) {
+	fake.getServiceBindingParametersMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingParametersReturnsOnCall[len(fake.getServiceBindingParametersArgsForCall)]
+	fake.getServiceBindingParametersArgsForCall = append(fake.getServiceBindingParametersArgsForCall, struct {
+		serviceBindingGUID string
+	}{serviceBindingGUID})
+	fake.recordInvocation("GetServiceBindingParameters", []interface{}{serviceBindingGUID})
+	fake.getServiceBindingParametersMutex.Unlock()
+	if fake.GetServiceBindingParametersStub != nil {
+		return fake.GetServiceBindingParametersStub(serviceBindingGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBindingParametersReturns.result1, fake.getServiceBindingParametersReturns.result2, fake.getServiceBindingParametersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersCallCount() int {
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
+	return len(fake.getServiceBindingParametersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersArgsForCall(i int) string {
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
+	return fake.getServiceBindingParametersArgsForCall[i].serviceBindingGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersReturns(result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBindingParametersStub = nil
+	fake.getServiceBindingParametersReturns = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersReturnsOnCall(i int, result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBindingParametersStub = nil
+	if fake.getServiceBindingParametersReturnsOnCall == nil {
+		fake.getServiceBindingParametersReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingParametersReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServiceBindings(queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
@@ -2415,6 +3569,114 @@ func (fake *FakeCloudControllerClient) GetServiceInstanceReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedFrom(serviceInstanceGUID string) (ccv2.ServiceInstanceSharedFrom, ccv2.Warnings, error) {
+	fake.getServiceInstanceSharedFromMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceSharedFromReturnsOnCall[len(fake.getServiceInstanceSharedFromArgsForCall)]
+	fake.getServiceInstanceSharedFromArgsForCall = append(fake.getServiceInstanceSharedFromArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("GetServiceInstanceSharedFrom", []interface{}{serviceInstanceGUID})
+	fake.getServiceInstanceSharedFromMutex.Unlock()
+	if fake.GetServiceInstanceSharedFromStub != nil {
+		return fake.GetServiceInstanceSharedFromStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceSharedFromReturns.result1, fake.getServiceInstanceSharedFromReturns.result2, fake.getServiceInstanceSharedFromReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedFromCallCount() int {
+	fake.getServiceInstanceSharedFromMutex.RLock()
+	defer fake.getServiceInstanceSharedFromMutex.RUnlock()
+	return len(fake.getServiceInstanceSharedFromArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedFromArgsForCall(i int) string {
+	fake.getServiceInstanceSharedFromMutex.RLock()
+	defer fake.getServiceInstanceSharedFromMutex.RUnlock()
+	return fake.getServiceInstanceSharedFromArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedFromReturns(result1 ccv2.ServiceInstanceSharedFrom, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceSharedFromStub = nil
+	fake.getServiceInstanceSharedFromReturns = struct {
+		result1 ccv2.ServiceInstanceSharedFrom
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedFromReturnsOnCall(i int, result1 ccv2.ServiceInstanceSharedFrom, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceSharedFromStub = nil
+	if fake.getServiceInstanceSharedFromReturnsOnCall == nil {
+		fake.getServiceInstanceSharedFromReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceInstanceSharedFrom
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceSharedFromReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceInstanceSharedFrom
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTos(serviceInstanceGUID string) ([]ccv2.ServiceInstanceSharedTo, ccv2.Warnings, error) {
+	fake.getServiceInstanceSharedTosMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceSharedTosReturnsOnCall[len(fake.getServiceInstanceSharedTosArgsForCall)]
+	fake.getServiceInstanceSharedTosArgsForCall = append(fake.getServiceInstanceSharedTosArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("GetServiceInstanceSharedTos", []interface{}{serviceInstanceGUID})
+	fake.getServiceInstanceSharedTosMutex.Unlock()
+	if fake.GetServiceInstanceSharedTosStub != nil {
+		return fake.GetServiceInstanceSharedTosStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceSharedTosReturns.result1, fake.getServiceInstanceSharedTosReturns.result2, fake.getServiceInstanceSharedTosReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTosCallCount() int {
+	fake.getServiceInstanceSharedTosMutex.RLock()
+	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
+	return len(fake.getServiceInstanceSharedTosArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTosArgsForCall(i int) string {
+	fake.getServiceInstanceSharedTosMutex.RLock()
+	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
+	return fake.getServiceInstanceSharedTosArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTosReturns(result1 []ccv2.ServiceInstanceSharedTo, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceSharedTosStub = nil
+	fake.getServiceInstanceSharedTosReturns = struct {
+		result1 []ccv2.ServiceInstanceSharedTo
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTosReturnsOnCall(i int, result1 []ccv2.ServiceInstanceSharedTo, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceSharedTosStub = nil
+	if fake.getServiceInstanceSharedTosReturnsOnCall == nil {
+		fake.getServiceInstanceSharedTosReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceInstanceSharedTo
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceSharedTosReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceInstanceSharedTo
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServiceInstances(queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
@@ -2474,6 +3736,232 @@ func (fake *FakeCloudControllerClient) GetServiceInstancesReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceKeyParameters(serviceKeyGUID string) (map[string]interface{}, ccv2.Warnings, error) {
+	fake.getServiceKeyParametersMutex.Lock()
+	ret, specificReturn := fake.getServiceKeyParametersReturnsOnCall[len(fake.getServiceKeyParametersArgsForCall)]
+	fake.getServiceKeyParametersArgsForCall = append(fake.getServiceKeyParametersArgsForCall, struct {
+		serviceKeyGUID string
+	}{serviceKeyGUID})
+	fake.recordInvocation("GetServiceKeyParameters", []interface{}{serviceKeyGUID})
+	fake.getServiceKeyParametersMutex.Unlock()
+	if fake.GetServiceKeyParametersStub != nil {
+		return fake.GetServiceKeyParametersStub(serviceKeyGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceKeyParametersReturns.result1, fake.getServiceKeyParametersReturns.result2, fake.getServiceKeyParametersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeyParametersCallCount() int {
+	fake.getServiceKeyParametersMutex.RLock()
+	defer fake.getServiceKeyParametersMutex.RUnlock()
+	return len(fake.getServiceKeyParametersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeyParametersArgsForCall(i int) string {
+	fake.getServiceKeyParametersMutex.RLock()
+	defer fake.getServiceKeyParametersMutex.RUnlock()
+	return fake.getServiceKeyParametersArgsForCall[i].serviceKeyGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeyParametersReturns(result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeyParametersStub = nil
+	fake.getServiceKeyParametersReturns = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeyParametersReturnsOnCall(i int, result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeyParametersStub = nil
+	if fake.getServiceKeyParametersReturnsOnCall == nil {
+		fake.getServiceKeyParametersReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeyParametersReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeys(queries []ccv2.Query) ([]ccv2.ServiceKey, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getServiceKeysMutex.Lock()
+	ret, specificReturn := fake.getServiceKeysReturnsOnCall[len(fake.getServiceKeysArgsForCall)]
+	fake.getServiceKeysArgsForCall = append(fake.getServiceKeysArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetServiceKeys", []interface{}{queriesCopy})
+	fake.getServiceKeysMutex.Unlock()
+	if fake.GetServiceKeysStub != nil {
+		return fake.GetServiceKeysStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceKeysReturns.result1, fake.getServiceKeysReturns.result2, fake.getServiceKeysReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysCallCount() int {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	return len(fake.getServiceKeysArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysArgsForCall(i int) []ccv2.Query {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	return fake.getServiceKeysArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysReturns(result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeysStub = nil
+	fake.getServiceKeysReturns = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysReturnsOnCall(i int, result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeysStub = nil
+	if fake.getServiceKeysReturnsOnCall == nil {
+		fake.getServiceKeysReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceKey
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeysReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlan(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error) {
+	fake.getServicePlanMutex.Lock()
+	ret, specificReturn := fake.getServicePlanReturnsOnCall[len(fake.getServicePlanArgsForCall)]
+	fake.getServicePlanArgsForCall = append(fake.getServicePlanArgsForCall, struct {
+		servicePlanGUID string
+	}{servicePlanGUID})
+	fake.recordInvocation("GetServicePlan", []interface{}{servicePlanGUID})
+	fake.getServicePlanMutex.Unlock()
+	if fake.GetServicePlanStub != nil {
+		return fake.GetServicePlanStub(servicePlanGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicePlanReturns.result1, fake.getServicePlanReturns.result2, fake.getServicePlanReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanCallCount() int {
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	return len(fake.getServicePlanArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanArgsForCall(i int) string {
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	return fake.getServicePlanArgsForCall[i].servicePlanGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanReturns(result1 ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlanStub = nil
+	fake.getServicePlanReturns = struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanReturnsOnCall(i int, result1 ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlanStub = nil
+	if fake.getServicePlanReturnsOnCall == nil {
+		fake.getServicePlanReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServicePlan
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlanReturnsOnCall[i] = struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlans(queries []ccv2.Query) ([]ccv2.ServicePlan, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getServicePlansMutex.Lock()
+	ret, specificReturn := fake.getServicePlansReturnsOnCall[len(fake.getServicePlansArgsForCall)]
+	fake.getServicePlansArgsForCall = append(fake.getServicePlansArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetServicePlans", []interface{}{queriesCopy})
+	fake.getServicePlansMutex.Unlock()
+	if fake.GetServicePlansStub != nil {
+		return fake.GetServicePlansStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicePlansReturns.result1, fake.getServicePlansReturns.result2, fake.getServicePlansReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansCallCount() int {
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	return len(fake.getServicePlansArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansArgsForCall(i int) []ccv2.Query {
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	return fake.getServicePlansArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansReturns(result1 []ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlansStub = nil
+	fake.getServicePlansReturns = struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansReturnsOnCall(i int, result1 []ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlansStub = nil
+	if fake.getServicePlansReturnsOnCall == nil {
+		fake.getServicePlansReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServicePlan
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlansReturnsOnCall[i] = struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSharedDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
 	fake.getSharedDomainMutex.Lock()
 	ret, specificReturn := fake.getSharedDomainReturnsOnCall[len(fake.getSharedDomainArgsForCall)]
@@ -2732,17 +4220,72 @@ func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceRetur
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
-	if fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall == nil {
-		fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.SecurityGroup
+func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
+	if fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall == nil {
+		fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[i] = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRole(role ccv2.SpaceRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getSpaceUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsersByRoleReturnsOnCall[len(fake.getSpaceUsersByRoleArgsForCall)]
+	fake.getSpaceUsersByRoleArgsForCall = append(fake.getSpaceUsersByRoleArgsForCall, struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+	}{role, spaceGUID})
+	fake.recordInvocation("GetSpaceUsersByRole", []interface{}{role, spaceGUID})
+	fake.getSpaceUsersByRoleMutex.Unlock()
+	if fake.GetSpaceUsersByRoleStub != nil {
+		return fake.GetSpaceUsersByRoleStub(role, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceUsersByRoleReturns.result1, fake.getSpaceUsersByRoleReturns.result2, fake.getSpaceUsersByRoleReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleCallCount() int {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return len(fake.getSpaceUsersByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleArgsForCall(i int) (ccv2.SpaceRole, string) {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return fake.getSpaceUsersByRoleArgsForCall[i].role, fake.getSpaceUsersByRoleArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	fake.getSpaceUsersByRoleReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	if fake.getSpaceUsersByRoleReturnsOnCall == nil {
+		fake.getSpaceUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[i] = struct {
-		result1 []ccv2.SecurityGroup
+	fake.getSpaceUsersByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.User
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -2868,6 +4411,66 @@ func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaceServices(spaceGUID string, queries []ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getSpaceServicesMutex.Lock()
+	ret, specificReturn := fake.getSpaceServicesReturnsOnCall[len(fake.getSpaceServicesArgsForCall)]
+	fake.getSpaceServicesArgsForCall = append(fake.getSpaceServicesArgsForCall, struct {
+		spaceGUID string
+		queries   []ccv2.Query
+	}{spaceGUID, queriesCopy})
+	fake.recordInvocation("GetSpaceServices", []interface{}{spaceGUID, queriesCopy})
+	fake.getSpaceServicesMutex.Unlock()
+	if fake.GetSpaceServicesStub != nil {
+		return fake.GetSpaceServicesStub(spaceGUID, queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceServicesReturns.result1, fake.getSpaceServicesReturns.result2, fake.getSpaceServicesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesCallCount() int {
+	fake.getSpaceServicesMutex.RLock()
+	defer fake.getSpaceServicesMutex.RUnlock()
+	return len(fake.getSpaceServicesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getSpaceServicesMutex.RLock()
+	defer fake.getSpaceServicesMutex.RUnlock()
+	return fake.getSpaceServicesArgsForCall[i].spaceGUID, fake.getSpaceServicesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesReturns(result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceServicesStub = nil
+	fake.getSpaceServicesReturns = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesReturnsOnCall(i int, result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceServicesStub = nil
+	if fake.getSpaceServicesReturnsOnCall == nil {
+		fake.getSpaceServicesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Service
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceServicesReturnsOnCall[i] = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpace(spaceGUID string, queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
@@ -3095,6 +4698,52 @@ func (fake *FakeCloudControllerClient) GetStagingSpacesBySecurityGroupReturnsOnC
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) Info() (ccv2.APIInformation, ccv2.Warnings, error) {
+	fake.infoMutex.Lock()
+	ret, specificReturn := fake.infoReturnsOnCall[len(fake.infoArgsForCall)]
+	fake.infoArgsForCall = append(fake.infoArgsForCall, struct{}{})
+	fake.recordInvocation("Info", []interface{}{})
+	fake.infoMutex.Unlock()
+	if fake.InfoStub != nil {
+		return fake.InfoStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.infoReturns.result1, fake.infoReturns.result2, fake.infoReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) InfoCallCount() int {
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	return len(fake.infoArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) InfoReturns(result1 ccv2.APIInformation, result2 ccv2.Warnings, result3 error) {
+	fake.InfoStub = nil
+	fake.infoReturns = struct {
+		result1 ccv2.APIInformation
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) InfoReturnsOnCall(i int, result1 ccv2.APIInformation, result2 ccv2.Warnings, result3 error) {
+	fake.InfoStub = nil
+	if fake.infoReturnsOnCall == nil {
+		fake.infoReturnsOnCall = make(map[int]struct {
+			result1 ccv2.APIInformation
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.infoReturnsOnCall[i] = struct {
+		result1 ccv2.APIInformation
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) PollJob(job ccv2.Job) (ccv2.Warnings, error) {
 	fake.pollJobMutex.Lock()
 	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
@@ -3146,6 +4795,57 @@ func (fake *FakeCloudControllerClient) PollJobReturnsOnCall(i int, result1 ccv2.
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) RemoveRunningSecurityGroupDefault(securityGroupGUID string) (ccv2.Warnings, error) {
+	fake.removeRunningSecurityGroupDefaultMutex.Lock()
+	ret, specificReturn := fake.removeRunningSecurityGroupDefaultReturnsOnCall[len(fake.removeRunningSecurityGroupDefaultArgsForCall)]
+	fake.removeRunningSecurityGroupDefaultArgsForCall = append(fake.removeRunningSecurityGroupDefaultArgsForCall, struct {
+		securityGroupGUID string
+	}{securityGroupGUID})
+	fake.recordInvocation("RemoveRunningSecurityGroupDefault", []interface{}{securityGroupGUID})
+	fake.removeRunningSecurityGroupDefaultMutex.Unlock()
+	if fake.RemoveRunningSecurityGroupDefaultStub != nil {
+		return fake.RemoveRunningSecurityGroupDefaultStub(securityGroupGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.removeRunningSecurityGroupDefaultReturns.result1, fake.removeRunningSecurityGroupDefaultReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) RemoveRunningSecurityGroupDefaultCallCount() int {
+	fake.removeRunningSecurityGroupDefaultMutex.RLock()
+	defer fake.removeRunningSecurityGroupDefaultMutex.RUnlock()
+	return len(fake.removeRunningSecurityGroupDefaultArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) RemoveRunningSecurityGroupDefaultArgsForCall(i int) string {
+	fake.removeRunningSecurityGroupDefaultMutex.RLock()
+	defer fake.removeRunningSecurityGroupDefaultMutex.RUnlock()
+	return fake.removeRunningSecurityGroupDefaultArgsForCall[i].securityGroupGUID
+}
+
+func (fake *FakeCloudControllerClient) RemoveRunningSecurityGroupDefaultReturns(result1 ccv2.Warnings, result2 error) {
+	fake.RemoveRunningSecurityGroupDefaultStub = nil
+	fake.removeRunningSecurityGroupDefaultReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) RemoveRunningSecurityGroupDefaultReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.RemoveRunningSecurityGroupDefaultStub = nil
+	if fake.removeRunningSecurityGroupDefaultReturnsOnCall == nil {
+		fake.removeRunningSecurityGroupDefaultReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.removeRunningSecurityGroupDefaultReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) RemoveSpaceFromRunningSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error) {
 	fake.removeSpaceFromRunningSecurityGroupMutex.Lock()
 	ret, specificReturn := fake.removeSpaceFromRunningSecurityGroupReturnsOnCall[len(fake.removeSpaceFromRunningSecurityGroupArgsForCall)]
@@ -3250,6 +4950,57 @@ func (fake *FakeCloudControllerClient) RemoveSpaceFromStagingSecurityGroupReturn
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) RemoveStagingSecurityGroupDefault(securityGroupGUID string) (ccv2.Warnings, error) {
+	fake.removeStagingSecurityGroupDefaultMutex.Lock()
+	ret, specificReturn := fake.removeStagingSecurityGroupDefaultReturnsOnCall[len(fake.removeStagingSecurityGroupDefaultArgsForCall)]
+	fake.removeStagingSecurityGroupDefaultArgsForCall = append(fake.removeStagingSecurityGroupDefaultArgsForCall, struct {
+		securityGroupGUID string
+	}{securityGroupGUID})
+	fake.recordInvocation("RemoveStagingSecurityGroupDefault", []interface{}{securityGroupGUID})
+	fake.removeStagingSecurityGroupDefaultMutex.Unlock()
+	if fake.RemoveStagingSecurityGroupDefaultStub != nil {
+		return fake.RemoveStagingSecurityGroupDefaultStub(securityGroupGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.removeStagingSecurityGroupDefaultReturns.result1, fake.removeStagingSecurityGroupDefaultReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) RemoveStagingSecurityGroupDefaultCallCount() int {
+	fake.removeStagingSecurityGroupDefaultMutex.RLock()
+	defer fake.removeStagingSecurityGroupDefaultMutex.RUnlock()
+	return len(fake.removeStagingSecurityGroupDefaultArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) RemoveStagingSecurityGroupDefaultArgsForCall(i int) string {
+	fake.removeStagingSecurityGroupDefaultMutex.RLock()
+	defer fake.removeStagingSecurityGroupDefaultMutex.RUnlock()
+	return fake.removeStagingSecurityGroupDefaultArgsForCall[i].securityGroupGUID
+}
+
+func (fake *FakeCloudControllerClient) RemoveStagingSecurityGroupDefaultReturns(result1 ccv2.Warnings, result2 error) {
+	fake.RemoveStagingSecurityGroupDefaultStub = nil
+	fake.removeStagingSecurityGroupDefaultReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) RemoveStagingSecurityGroupDefaultReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.RemoveStagingSecurityGroupDefaultStub = nil
+	if fake.removeStagingSecurityGroupDefaultReturnsOnCall == nil {
+		fake.removeStagingSecurityGroupDefaultReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.removeStagingSecurityGroupDefaultReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) ResourceMatch(resourcesToMatch []ccv2.Resource) ([]ccv2.Resource, ccv2.Warnings, error) {
 	var resourcesToMatchCopy []ccv2.Resource
 	if resourcesToMatch != nil {
@@ -3468,6 +5219,229 @@ func (fake *FakeCloudControllerClient) UpdateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleAndUsername(role ccv2.OrganizationRole, orgGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateOrganizationUserByRoleAndUsernameMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationUserByRoleAndUsernameReturnsOnCall[len(fake.updateOrganizationUserByRoleAndUsernameArgsForCall)]
+	fake.updateOrganizationUserByRoleAndUsernameArgsForCall = append(fake.updateOrganizationUserByRoleAndUsernameArgsForCall, struct {
+		role     ccv2.OrganizationRole
+		orgGUID  string
+		username string
+	}{role, orgGUID, username})
+	fake.recordInvocation("UpdateOrganizationUserByRoleAndUsername", []interface{}{role, orgGUID, username})
+	fake.updateOrganizationUserByRoleAndUsernameMutex.Unlock()
+	if fake.UpdateOrganizationUserByRoleAndUsernameStub != nil {
+		return fake.UpdateOrganizationUserByRoleAndUsernameStub(role, orgGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationUserByRoleAndUsernameReturns.result1, fake.updateOrganizationUserByRoleAndUsernameReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleAndUsernameCallCount() int {
+	fake.updateOrganizationUserByRoleAndUsernameMutex.RLock()
+	defer fake.updateOrganizationUserByRoleAndUsernameMutex.RUnlock()
+	return len(fake.updateOrganizationUserByRoleAndUsernameArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleAndUsernameArgsForCall(i int) (ccv2.OrganizationRole, string, string) {
+	fake.updateOrganizationUserByRoleAndUsernameMutex.RLock()
+	defer fake.updateOrganizationUserByRoleAndUsernameMutex.RUnlock()
+	return fake.updateOrganizationUserByRoleAndUsernameArgsForCall[i].role, fake.updateOrganizationUserByRoleAndUsernameArgsForCall[i].orgGUID, fake.updateOrganizationUserByRoleAndUsernameArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleAndUsernameReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserByRoleAndUsernameStub = nil
+	fake.updateOrganizationUserByRoleAndUsernameReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleAndUsernameReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserByRoleAndUsernameStub = nil
+	if fake.updateOrganizationUserByRoleAndUsernameReturnsOnCall == nil {
+		fake.updateOrganizationUserByRoleAndUsernameReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationUserByRoleAndUsernameReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroup(securityGroupGUID string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error) {
+	var rulesCopy []ccv2.SecurityGroupRule
+	if rules != nil {
+		rulesCopy = make([]ccv2.SecurityGroupRule, len(rules))
+		copy(rulesCopy, rules)
+	}
+	fake.updateSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.updateSecurityGroupReturnsOnCall[len(fake.updateSecurityGroupArgsForCall)]
+	fake.updateSecurityGroupArgsForCall = append(fake.updateSecurityGroupArgsForCall, struct {
+		securityGroupGUID string
+		rules             []ccv2.SecurityGroupRule
+	}{securityGroupGUID, rulesCopy})
+	fake.recordInvocation("UpdateSecurityGroup", []interface{}{securityGroupGUID, rulesCopy})
+	fake.updateSecurityGroupMutex.Unlock()
+	if fake.UpdateSecurityGroupStub != nil {
+		return fake.UpdateSecurityGroupStub(securityGroupGUID, rules)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateSecurityGroupReturns.result1, fake.updateSecurityGroupReturns.result2, fake.updateSecurityGroupReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupCallCount() int {
+	fake.updateSecurityGroupMutex.RLock()
+	defer fake.updateSecurityGroupMutex.RUnlock()
+	return len(fake.updateSecurityGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupArgsForCall(i int) (string, []ccv2.SecurityGroupRule) {
+	fake.updateSecurityGroupMutex.RLock()
+	defer fake.updateSecurityGroupMutex.RUnlock()
+	return fake.updateSecurityGroupArgsForCall[i].securityGroupGUID, fake.updateSecurityGroupArgsForCall[i].rules
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupReturns(result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSecurityGroupStub = nil
+	fake.updateSecurityGroupReturns = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupReturnsOnCall(i int, result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSecurityGroupStub = nil
+	if fake.updateSecurityGroupReturnsOnCall == nil {
+		fake.updateSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateSecurityGroupReturnsOnCall[i] = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateServiceInstance(serviceInstanceGUID string, servicePlanGUID string, parameters map[string]interface{}, tags *[]string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
+	fake.updateServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.updateServiceInstanceReturnsOnCall[len(fake.updateServiceInstanceArgsForCall)]
+	fake.updateServiceInstanceArgsForCall = append(fake.updateServiceInstanceArgsForCall, struct {
+		serviceInstanceGUID string
+		servicePlanGUID     string
+		parameters          map[string]interface{}
+		tags                *[]string
+	}{serviceInstanceGUID, servicePlanGUID, parameters, tags})
+	fake.recordInvocation("UpdateServiceInstance", []interface{}{serviceInstanceGUID, servicePlanGUID, parameters, tags})
+	fake.updateServiceInstanceMutex.Unlock()
+	if fake.UpdateServiceInstanceStub != nil {
+		return fake.UpdateServiceInstanceStub(serviceInstanceGUID, servicePlanGUID, parameters, tags)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateServiceInstanceReturns.result1, fake.updateServiceInstanceReturns.result2, fake.updateServiceInstanceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceCallCount() int {
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	return len(fake.updateServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceArgsForCall(i int) (string, string, map[string]interface{}, *[]string) {
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	return fake.updateServiceInstanceArgsForCall[i].serviceInstanceGUID, fake.updateServiceInstanceArgsForCall[i].servicePlanGUID, fake.updateServiceInstanceArgsForCall[i].parameters, fake.updateServiceInstanceArgsForCall[i].tags
+}
+
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceReturns(result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateServiceInstanceStub = nil
+	fake.updateServiceInstanceReturns = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceReturnsOnCall(i int, result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateServiceInstanceStub = nil
+	if fake.updateServiceInstanceReturnsOnCall == nil {
+		fake.updateServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleAndUsername(role ccv2.SpaceRole, spaceGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateSpaceUserByRoleAndUsernameMutex.Lock()
+	ret, specificReturn := fake.updateSpaceUserByRoleAndUsernameReturnsOnCall[len(fake.updateSpaceUserByRoleAndUsernameArgsForCall)]
+	fake.updateSpaceUserByRoleAndUsernameArgsForCall = append(fake.updateSpaceUserByRoleAndUsernameArgsForCall, struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+		username  string
+	}{role, spaceGUID, username})
+	fake.recordInvocation("UpdateSpaceUserByRoleAndUsername", []interface{}{role, spaceGUID, username})
+	fake.updateSpaceUserByRoleAndUsernameMutex.Unlock()
+	if fake.UpdateSpaceUserByRoleAndUsernameStub != nil {
+		return fake.UpdateSpaceUserByRoleAndUsernameStub(role, spaceGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateSpaceUserByRoleAndUsernameReturns.result1, fake.updateSpaceUserByRoleAndUsernameReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleAndUsernameCallCount() int {
+	fake.updateSpaceUserByRoleAndUsernameMutex.RLock()
+	defer fake.updateSpaceUserByRoleAndUsernameMutex.RUnlock()
+	return len(fake.updateSpaceUserByRoleAndUsernameArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleAndUsernameArgsForCall(i int) (ccv2.SpaceRole, string, string) {
+	fake.updateSpaceUserByRoleAndUsernameMutex.RLock()
+	defer fake.updateSpaceUserByRoleAndUsernameMutex.RUnlock()
+	return fake.updateSpaceUserByRoleAndUsernameArgsForCall[i].role, fake.updateSpaceUserByRoleAndUsernameArgsForCall[i].spaceGUID, fake.updateSpaceUserByRoleAndUsernameArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleAndUsernameReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserByRoleAndUsernameStub = nil
+	fake.updateSpaceUserByRoleAndUsernameReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleAndUsernameReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserByRoleAndUsernameStub = nil
+	if fake.updateSpaceUserByRoleAndUsernameReturnsOnCall == nil {
+		fake.updateSpaceUserByRoleAndUsernameReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceUserByRoleAndUsernameReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UploadApplicationPackage(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error) {
 	var existingResourcesCopy []ccv2.Resource
 	if existingResources != nil {
@@ -3610,6 +5584,86 @@ func (fake *FakeCloudControllerClient) APIVersionReturnsOnCall(i int, result1 st
 	}{result1}
 }
 
+func (fake *FakeCloudControllerClient) AppSSHEndpoint() string {
+	fake.appSSHEndpointMutex.Lock()
+	ret, specificReturn := fake.appSSHEndpointReturnsOnCall[len(fake.appSSHEndpointArgsForCall)]
+	fake.appSSHEndpointArgsForCall = append(fake.appSSHEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("AppSSHEndpoint", []interface{}{})
+	fake.appSSHEndpointMutex.Unlock()
+	if fake.AppSSHEndpointStub != nil {
+		return fake.AppSSHEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.appSSHEndpointReturns.result1
+}
+
+func (fake *FakeCloudControllerClient) AppSSHEndpointCallCount() int {
+	fake.appSSHEndpointMutex.RLock()
+	defer fake.appSSHEndpointMutex.RUnlock()
+	return len(fake.appSSHEndpointArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) AppSSHEndpointReturns(result1 string) {
+	fake.AppSSHEndpointStub = nil
+	fake.appSSHEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHEndpointReturnsOnCall(i int, result1 string) {
+	fake.AppSSHEndpointStub = nil
+	if fake.appSSHEndpointReturnsOnCall == nil {
+		fake.appSSHEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.appSSHEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprint() string {
+	fake.appSSHHostKeyFingerprintMutex.Lock()
+	ret, specificReturn := fake.appSSHHostKeyFingerprintReturnsOnCall[len(fake.appSSHHostKeyFingerprintArgsForCall)]
+	fake.appSSHHostKeyFingerprintArgsForCall = append(fake.appSSHHostKeyFingerprintArgsForCall, struct{}{})
+	fake.recordInvocation("AppSSHHostKeyFingerprint", []interface{}{})
+	fake.appSSHHostKeyFingerprintMutex.Unlock()
+	if fake.AppSSHHostKeyFingerprintStub != nil {
+		return fake.AppSSHHostKeyFingerprintStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.appSSHHostKeyFingerprintReturns.result1
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprintCallCount() int {
+	fake.appSSHHostKeyFingerprintMutex.RLock()
+	defer fake.appSSHHostKeyFingerprintMutex.RUnlock()
+	return len(fake.appSSHHostKeyFingerprintArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprintReturns(result1 string) {
+	fake.AppSSHHostKeyFingerprintStub = nil
+	fake.appSSHHostKeyFingerprintReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprintReturnsOnCall(i int, result1 string) {
+	fake.AppSSHHostKeyFingerprintStub = nil
+	if fake.appSSHHostKeyFingerprintReturnsOnCall == nil {
+		fake.appSSHHostKeyFingerprintReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.appSSHHostKeyFingerprintReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeCloudControllerClient) AuthorizationEndpoint() string {
 	fake.authorizationEndpointMutex.Lock()
 	ret, specificReturn := fake.authorizationEndpointReturnsOnCall[len(fake.authorizationEndpointArgsForCall)]
@@ -3813,10 +5867,14 @@ func (fake *FakeCloudControllerClient) TokenEndpointReturnsOnCall(i int, result1
 func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.associateRunningSecurityGroupDefaultMutex.RLock()
+	defer fake.associateRunningSecurityGroupDefaultMutex.RUnlock()
 	fake.associateSpaceWithRunningSecurityGroupMutex.RLock()
 	defer fake.associateSpaceWithRunningSecurityGroupMutex.RUnlock()
 	fake.associateSpaceWithStagingSecurityGroupMutex.RLock()
 	defer fake.associateSpaceWithStagingSecurityGroupMutex.RUnlock()
+	fake.associateStagingSecurityGroupDefaultMutex.RLock()
+	defer fake.associateStagingSecurityGroupDefaultMutex.RUnlock()
 	fake.bindRouteToApplicationMutex.RLock()
 	defer fake.bindRouteToApplicationMutex.RUnlock()
 	fake.checkRouteMutex.RLock()
@@ -3825,18 +5883,32 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.createApplicationMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
 	fake.createServiceBindingMutex.RLock()
 	defer fake.createServiceBindingMutex.RUnlock()
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	fake.createServiceKeyMutex.RLock()
+	defer fake.createServiceKeyMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
 	fake.deleteOrganizationMutex.RLock()
 	defer fake.deleteOrganizationMutex.RUnlock()
+	fake.deleteOrganizationUserByRoleMutex.RLock()
+	defer fake.deleteOrganizationUserByRoleMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
 	defer fake.deleteRouteMutex.RUnlock()
 	fake.deleteServiceBindingMutex.RLock()
 	defer fake.deleteServiceBindingMutex.RUnlock()
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
 	fake.deleteSpaceMutex.RLock()
 	defer fake.deleteSpaceMutex.RUnlock()
+	fake.deleteSpaceUserByRoleMutex.RLock()
+	defer fake.deleteSpaceUserByRoleMutex.RUnlock()
 	fake.getApplicationMutex.RLock()
 	defer fake.getApplicationMutex.RUnlock()
 	fake.getApplicationInstancesByApplicationMutex.RLock()
@@ -3847,6 +5919,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationRoutesMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
 	fake.getJobMutex.RLock()
 	defer fake.getJobMutex.RUnlock()
 	fake.getOrganizationMutex.RLock()
@@ -3855,6 +5929,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getOrganizationPrivateDomainsMutex.RUnlock()
 	fake.getOrganizationQuotaMutex.RLock()
 	defer fake.getOrganizationQuotaMutex.RUnlock()
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getPrivateDomainMutex.RLock()
@@ -3867,12 +5943,28 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getRunningSpacesBySecurityGroupMutex.RUnlock()
 	fake.getSecurityGroupsMutex.RLock()
 	defer fake.getSecurityGroupsMutex.RUnlock()
+	fake.getServiceMutex.RLock()
+	defer fake.getServiceMutex.RUnlock()
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
 	fake.getServiceBindingsMutex.RLock()
 	defer fake.getServiceBindingsMutex.RUnlock()
 	fake.getServiceInstanceMutex.RLock()
 	defer fake.getServiceInstanceMutex.RUnlock()
+	fake.getServiceInstanceSharedFromMutex.RLock()
+	defer fake.getServiceInstanceSharedFromMutex.RUnlock()
+	fake.getServiceInstanceSharedTosMutex.RLock()
+	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
 	fake.getServiceInstancesMutex.RLock()
 	defer fake.getServiceInstancesMutex.RUnlock()
+	fake.getServiceKeyParametersMutex.RLock()
+	defer fake.getServiceKeyParametersMutex.RUnlock()
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
 	fake.getSharedDomainMutex.RLock()
 	defer fake.getSharedDomainMutex.RUnlock()
 	fake.getSharedDomainsMutex.RLock()
@@ -3883,10 +5975,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSpaceRoutesMutex.RUnlock()
 	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
 	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
 	defer fake.getSpacesMutex.RUnlock()
 	fake.getSpaceServiceInstancesMutex.RLock()
 	defer fake.getSpaceServiceInstancesMutex.RUnlock()
+	fake.getSpaceServicesMutex.RLock()
+	defer fake.getSpaceServicesMutex.RUnlock()
 	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
 	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
 	fake.getStackMutex.RLock()
@@ -3895,12 +5991,18 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getStacksMutex.RUnlock()
 	fake.getStagingSpacesBySecurityGroupMutex.RLock()
 	defer fake.getStagingSpacesBySecurityGroupMutex.RUnlock()
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
 	fake.pollJobMutex.RLock()
 	defer fake.pollJobMutex.RUnlock()
+	fake.removeRunningSecurityGroupDefaultMutex.RLock()
+	defer fake.removeRunningSecurityGroupDefaultMutex.RUnlock()
 	fake.removeSpaceFromRunningSecurityGroupMutex.RLock()
 	defer fake.removeSpaceFromRunningSecurityGroupMutex.RUnlock()
 	fake.removeSpaceFromStagingSecurityGroupMutex.RLock()
 	defer fake.removeSpaceFromStagingSecurityGroupMutex.RUnlock()
+	fake.removeStagingSecurityGroupDefaultMutex.RLock()
+	defer fake.removeStagingSecurityGroupDefaultMutex.RUnlock()
 	fake.resourceMatchMutex.RLock()
 	defer fake.resourceMatchMutex.RUnlock()
 	fake.restageApplicationMutex.RLock()
@@ -3909,12 +6011,24 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.targetCFMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateOrganizationUserByRoleAndUsernameMutex.RLock()
+	defer fake.updateOrganizationUserByRoleAndUsernameMutex.RUnlock()
+	fake.updateSecurityGroupMutex.RLock()
+	defer fake.updateSecurityGroupMutex.RUnlock()
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	fake.updateSpaceUserByRoleAndUsernameMutex.RLock()
+	defer fake.updateSpaceUserByRoleAndUsernameMutex.RUnlock()
 	fake.uploadApplicationPackageMutex.RLock()
 	defer fake.uploadApplicationPackageMutex.RUnlock()
 	fake.aPIMutex.RLock()
 	defer fake.aPIMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
 	defer fake.aPIVersionMutex.RUnlock()
+	fake.appSSHEndpointMutex.RLock()
+	defer fake.appSSHEndpointMutex.RUnlock()
+	fake.appSSHHostKeyFingerprintMutex.RLock()
+	defer fake.appSSHHostKeyFingerprintMutex.RUnlock()
 	fake.authorizationEndpointMutex.RLock()
 	defer fake.authorizationEndpointMutex.RUnlock()
 	fake.dopplerEndpointMutex.RLock()
@@ -71,6 +71,13 @@ func (actor Actor) getProcessesForApp(appGUID string) (Processes, Warnings, erro
 			Type:       ccv3Process.Type,
 			Instances:  []Instance{},
 			MemoryInMB: ccv3Process.MemoryInMB,
+
+			Command:             ccv3Process.Command,
+			DesiredInstances:    ccv3Process.Instances,
+			DiskInMB:            ccv3Process.DiskInMB,
+			HealthCheckType:     ccv3Process.HealthCheck.Type,
+			HealthCheckEndpoint: ccv3Process.HealthCheck.Data.Endpoint,
+			HealthCheckTimeout:  ccv3Process.HealthCheck.Data.Timeout,
 		}
 		for _, instance := range instances {
 			process.Instances = append(process.Instances, Instance(instance))
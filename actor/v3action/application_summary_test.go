@@ -43,7 +43,14 @@ var _ = Describe("Application Summary Actions", func() {
 						{
 							GUID:       "some-process-guid",
 							Type:       "some-type",
+							Command:    "some-command",
+							Instances:  1,
 							MemoryInMB: 32,
+							DiskInMB:   64,
+							HealthCheck: ccv3.ProcessHealthCheck{
+								Type: "http",
+								Data: ccv3.ProcessHealthCheckData{Endpoint: "/health", Timeout: 30},
+							},
 						},
 					},
 					ccv3.Warnings{"some-process-warning"},
@@ -106,6 +113,13 @@ var _ = Describe("Application Summary Actions", func() {
 							Process{
 								MemoryInMB: 32,
 								Type:       "some-type",
+
+								Command:             "some-command",
+								DesiredInstances:    1,
+								DiskInMB:            64,
+								HealthCheckType:     "http",
+								HealthCheckEndpoint: "/health",
+								HealthCheckTimeout:  30,
 								Instances: []Instance{
 									{
 										State:       "RUNNING",
@@ -186,6 +200,13 @@ var _ = Describe("Application Summary Actions", func() {
 							Process{
 								MemoryInMB: 32,
 								Type:       "some-type",
+
+								Command:             "some-command",
+								DesiredInstances:    1,
+								DiskInMB:            64,
+								HealthCheckType:     "http",
+								HealthCheckEndpoint: "/health",
+								HealthCheckTimeout:  30,
 								Instances: []Instance{
 									{
 										State:       "RUNNING",
@@ -0,0 +1,14 @@
+package v3action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+// EnvironmentVariables represents the user provided environment variables of
+// an application.
+type EnvironmentVariables ccv3.EnvironmentVariables
+
+// GetApplicationEnvironmentVariables returns the user provided environment
+// variables of the given application.
+func (actor Actor) GetApplicationEnvironmentVariables(appGUID string) (EnvironmentVariables, Warnings, error) {
+	envVars, warnings, err := actor.CloudControllerClient.GetApplicationEnvironmentVariables(appGUID)
+	return EnvironmentVariables(envVars), Warnings(warnings), err
+}
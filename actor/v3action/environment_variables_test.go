@@ -0,0 +1,69 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Environment Variables Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetApplicationEnvironmentVariables", func() {
+		var (
+			envVars    EnvironmentVariables
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			envVars, warnings, executeErr = actor.GetApplicationEnvironmentVariables("some-app-guid")
+		})
+
+		Context("when getting the environment variables succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationEnvironmentVariablesReturns(
+					ccv3.EnvironmentVariables{"SOME_VAR": "some-value"},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the environment variables and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(envVars).To(Equal(EnvironmentVariables{"SOME_VAR": "some-value"}))
+
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentVariablesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentVariablesArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when getting the environment variables fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationEnvironmentVariablesReturns(
+					nil,
+					ccv3.Warnings{"some-warning"},
+					errors.New("some-error"),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+})
@@ -2,6 +2,7 @@ package v3action
 
 import (
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 )
@@ -45,3 +46,90 @@ func (actor Actor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(appNa
 
 	return allWarnings, nil
 }
+
+// ProcessInstanceCrashedError is returned when a process instance crashes
+// while waiting for it to start.
+type ProcessInstanceCrashedError struct {
+	ProcessType   string
+	InstanceIndex int
+}
+
+func (e ProcessInstanceCrashedError) Error() string {
+	return fmt.Sprintf("Instance %d for process %s crashed", e.InstanceIndex, e.ProcessType)
+}
+
+// GetProcessInstancesByApplicationAndProcessType returns the instances of the
+// given process type for the provided application.
+func (actor Actor) GetProcessInstancesByApplicationAndProcessType(appGUID string, processType string) ([]Instance, Warnings, error) {
+	var allWarnings Warnings
+	process, warnings, err := actor.CloudControllerClient.GetApplicationProcessByType(appGUID, processType)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.ProcessNotFoundError); ok {
+			return nil, allWarnings, ProcessNotFoundError{ProcessType: processType}
+		}
+		return nil, allWarnings, err
+	}
+
+	ccv3Instances, warnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var instances []Instance
+	for _, instance := range ccv3Instances {
+		instances = append(instances, Instance(instance))
+	}
+
+	return instances, allWarnings, nil
+}
+
+// PollProcessInstances waits for the instances with the provided indexes to
+// be replaced by instances that started after restartedAt and are RUNNING. It
+// returns a ProcessInstanceCrashedError if any of them crash, and a
+// StartupTimeoutError if they are not all running before the startup timeout.
+func (actor Actor) PollProcessInstances(appGUID string, processType string, instanceIndexes []int, restartedAt time.Time) (Warnings, error) {
+	var allWarnings Warnings
+
+	timeout := time.Now().Add(actor.Config.StartupTimeout())
+	for time.Now().Before(timeout) {
+		instances, warnings, err := actor.GetProcessInstancesByApplicationAndProcessType(appGUID, processType)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		runningInstances := 0
+		for _, index := range instanceIndexes {
+			for _, instance := range instances {
+				if instance.Index != index {
+					continue
+				}
+
+				// Instances that started before the restart have not been
+				// restarted yet.
+				if instance.StartTime().Before(restartedAt) {
+					continue
+				}
+
+				switch instance.State {
+				case "CRASHED":
+					return allWarnings, ProcessInstanceCrashedError{
+						ProcessType:   processType,
+						InstanceIndex: index,
+					}
+				case "RUNNING":
+					runningInstances++
+				}
+			}
+		}
+
+		if runningInstances == len(instanceIndexes) {
+			return allWarnings, nil
+		}
+		time.Sleep(actor.Config.PollingInterval())
+	}
+
+	return allWarnings, StartupTimeoutError{}
+}
@@ -3,6 +3,7 @@ package v3action_test
 import (
 	"errors"
 	"net/url"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -16,11 +17,13 @@ var _ = Describe("instance actions", func() {
 	var (
 		actor                     *Actor
 		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
 	)
 
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
-		actor = NewActor(fakeCloudControllerClient, nil)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig)
 	})
 
 	Describe("DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex", func() {
@@ -108,4 +111,163 @@ var _ = Describe("instance actions", func() {
 			})
 		})
 	})
+
+	Describe("GetProcessInstancesByApplicationAndProcessType", func() {
+		var (
+			instances  []Instance
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			instances, warnings, executeErr = actor.GetProcessInstancesByApplicationAndProcessType("some-app-guid", "some-process-type")
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(ccv3.Process{}, ccv3.Warnings{"some-process-warning"}, ccerror.ProcessNotFoundError{})
+			})
+
+			It("returns all warnings and a ProcessNotFoundError", func() {
+				Expect(executeErr).To(Equal(ProcessNotFoundError{ProcessType: "some-process-type"}))
+				Expect(warnings).To(ConsistOf("some-process-warning"))
+			})
+		})
+
+		Context("when getting the instances returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(ccv3.Process{GUID: "some-process-guid"}, ccv3.Warnings{"some-process-warning"}, nil)
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"some-instances-warning"}, errors.New("some-instances-error"))
+			})
+
+			It("returns all warnings and the error", func() {
+				Expect(executeErr).To(MatchError("some-instances-error"))
+				Expect(warnings).To(ConsistOf("some-process-warning", "some-instances-warning"))
+			})
+		})
+
+		Context("when getting the instances succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(ccv3.Process{GUID: "some-process-guid"}, ccv3.Warnings{"some-process-warning"}, nil)
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.Instance{{Index: 0, State: "RUNNING"}, {Index: 1, State: "STARTING"}}, ccv3.Warnings{"some-instances-warning"}, nil)
+			})
+
+			It("returns the instances and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-process-warning", "some-instances-warning"))
+				Expect(instances).To(ConsistOf(
+					Instance{Index: 0, State: "RUNNING"},
+					Instance{Index: 1, State: "STARTING"},
+				))
+
+				Expect(fakeCloudControllerClient.GetApplicationProcessByTypeCallCount()).To(Equal(1))
+				appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(processType).To(Equal("some-process-type"))
+
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("some-process-guid"))
+			})
+		})
+	})
+
+	Describe("PollProcessInstances", func() {
+		var (
+			restartedAt time.Time
+			warnings    Warnings
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			restartedAt = time.Now().Add(-10 * time.Second)
+			fakeConfig.StartupTimeoutReturns(time.Second)
+			fakeConfig.PollingIntervalReturns(0)
+			fakeCloudControllerClient.GetApplicationProcessByTypeReturns(ccv3.Process{GUID: "some-process-guid"}, ccv3.Warnings{"some-process-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.PollProcessInstances("some-app-guid", "web", []int{1, 2}, restartedAt)
+		})
+
+		Context("when the instances are replaced and running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0, []ccv3.Instance{
+					{Index: 0, State: "RUNNING", Uptime: 60},
+					{Index: 1, State: "RUNNING", Uptime: 60},
+					{Index: 2, State: "STARTING", Uptime: 0},
+				}, ccv3.Warnings{"some-instances-warning-1"}, nil)
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1, []ccv3.Instance{
+					{Index: 0, State: "RUNNING", Uptime: 60},
+					{Index: 1, State: "RUNNING", Uptime: 2},
+					{Index: 2, State: "RUNNING", Uptime: 1},
+				}, ccv3.Warnings{"some-instances-warning-2"}, nil)
+			})
+
+			It("polls until every restarted instance is running and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-process-warning", "some-instances-warning-1", "some-process-warning", "some-instances-warning-2"))
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when a restarted instance crashes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.Instance{
+					{Index: 1, State: "RUNNING", Uptime: 2},
+					{Index: 2, State: "CRASHED"},
+				}, ccv3.Warnings{"some-instances-warning"}, nil)
+			})
+
+			It("returns a ProcessInstanceCrashedError", func() {
+				Expect(executeErr).To(MatchError(ProcessInstanceCrashedError{ProcessType: "web", InstanceIndex: 2}))
+				Expect(warnings).To(ConsistOf("some-process-warning", "some-instances-warning"))
+			})
+		})
+
+		Context("when an instance crashed before the restart", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0, []ccv3.Instance{
+					{Index: 1, State: "RUNNING", Uptime: 2},
+					{Index: 2, State: "CRASHED", Uptime: 60},
+				}, ccv3.Warnings{"some-instances-warning-1"}, nil)
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1, []ccv3.Instance{
+					{Index: 1, State: "RUNNING", Uptime: 2},
+					{Index: 2, State: "RUNNING", Uptime: 1},
+				}, ccv3.Warnings{"some-instances-warning-2"}, nil)
+			})
+
+			It("keeps polling until the instance is restarted", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-process-warning", "some-instances-warning-1", "some-process-warning", "some-instances-warning-2"))
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the instances do not start before the startup timeout", func() {
+			BeforeEach(func() {
+				fakeConfig.StartupTimeoutReturns(time.Millisecond)
+				fakeConfig.PollingIntervalReturns(time.Millisecond * 2)
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.Instance{
+					{Index: 1, State: "STARTING"},
+					{Index: 2, State: "STARTING"},
+				}, ccv3.Warnings{"some-instances-warning"}, nil)
+			})
+
+			It("returns a StartupTimeoutError", func() {
+				Expect(executeErr).To(MatchError(StartupTimeoutError{}))
+				Expect(warnings).To(ConsistOf("some-process-warning", "some-instances-warning"))
+			})
+		})
+
+		Context("when getting the instances returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"some-instances-warning"}, errors.New("some-instances-error"))
+			})
+
+			It("returns all warnings and the error", func() {
+				Expect(executeErr).To(MatchError("some-instances-error"))
+				Expect(warnings).To(ConsistOf("some-process-warning", "some-instances-warning"))
+			})
+		})
+	})
 })
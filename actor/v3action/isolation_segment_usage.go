@@ -0,0 +1,222 @@
+package v3action
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// IsolationSegmentUsage describes which organizations and spaces use an
+// isolation segment, and how many apps and instances run in those spaces.
+type IsolationSegmentUsage struct {
+	Name           string
+	GUID           string
+	EntitledOrgs   []string
+	DefaultForOrgs []string
+	Spaces         []IsolationSegmentSpaceUsage
+}
+
+// IsolationSegmentSpaceUsage describes the apps running in a space that is
+// placed on an isolation segment.
+type IsolationSegmentSpaceUsage struct {
+	Name             string
+	GUID             string
+	OrganizationName string
+
+	// InheritedFromOrg is true when the space has no isolation segment of its
+	// own and runs on the segment because it is its organization's default.
+	InheritedFromOrg bool
+
+	Apps             int
+	StartedApps      int
+	DesiredInstances int
+}
+
+// Apps returns the total number of apps across all spaces.
+func (usage IsolationSegmentUsage) Apps() int {
+	var total int
+	for _, space := range usage.Spaces {
+		total += space.Apps
+	}
+	return total
+}
+
+// DesiredInstances returns the total number of instances of started apps
+// across all spaces.
+func (usage IsolationSegmentUsage) DesiredInstances() int {
+	var total int
+	for _, space := range usage.Spaces {
+		total += space.DesiredInstances
+	}
+	return total
+}
+
+// GetIsolationSegmentUsageByName returns the organizations entitled to the
+// isolation segment, the organizations using it as their default, and the
+// spaces (with app and instance counts) that run on it.
+//
+// A space runs on the segment if it is assigned to it directly, or if it has
+// no segment of its own and its organization's default is the segment.
+func (actor Actor) GetIsolationSegmentUsageByName(name string) (IsolationSegmentUsage, Warnings, error) {
+	isolationSegment, allWarnings, err := actor.GetIsolationSegmentByName(name)
+	if err != nil {
+		return IsolationSegmentUsage{}, allWarnings, err
+	}
+
+	usage := IsolationSegmentUsage{
+		Name:           isolationSegment.Name,
+		GUID:           isolationSegment.GUID,
+		EntitledOrgs:   []string{},
+		DefaultForOrgs: []string{},
+		Spaces:         []IsolationSegmentSpaceUsage{},
+	}
+
+	orgs, warnings, err := actor.CloudControllerClient.GetIsolationSegmentOrganizationsByIsolationSegment(isolationSegment.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return IsolationSegmentUsage{}, allWarnings, err
+	}
+
+	orgNames := map[string]string{}
+	var defaultOrgGUIDs []string
+	for _, org := range orgs {
+		orgNames[org.GUID] = org.Name
+		usage.EntitledOrgs = append(usage.EntitledOrgs, org.Name)
+
+		relationship, warnings, err := actor.CloudControllerClient.GetOrganizationDefaultIsolationSegment(org.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return IsolationSegmentUsage{}, allWarnings, err
+		}
+		if relationship.GUID == isolationSegment.GUID {
+			usage.DefaultForOrgs = append(usage.DefaultForOrgs, org.Name)
+			defaultOrgGUIDs = append(defaultOrgGUIDs, org.GUID)
+		}
+	}
+
+	spaces, spacesWarnings, err := actor.getIsolationSegmentSpaces(isolationSegment.GUID, defaultOrgGUIDs)
+	allWarnings = append(allWarnings, spacesWarnings...)
+	if err != nil {
+		return IsolationSegmentUsage{}, allWarnings, err
+	}
+
+	for _, space := range spaces {
+		spaceUsage, usageWarnings, err := actor.getSpaceUsage(space, orgNames[space.OrganizationGUID])
+		allWarnings = append(allWarnings, usageWarnings...)
+		if err != nil {
+			return IsolationSegmentUsage{}, allWarnings, err
+		}
+		usage.Spaces = append(usage.Spaces, spaceUsage)
+	}
+
+	sort.Strings(usage.EntitledOrgs)
+	sort.Strings(usage.DefaultForOrgs)
+	sort.Slice(usage.Spaces, func(i int, j int) bool {
+		if usage.Spaces[i].OrganizationName != usage.Spaces[j].OrganizationName {
+			return usage.Spaces[i].OrganizationName < usage.Spaces[j].OrganizationName
+		}
+		return usage.Spaces[i].Name < usage.Spaces[j].Name
+	})
+
+	return usage, allWarnings, nil
+}
+
+type isolationSegmentSpace struct {
+	ccv3.Space
+	InheritedFromOrg bool
+}
+
+func (actor Actor) getIsolationSegmentSpaces(isolationSegmentGUID string, defaultOrgGUIDs []string) ([]isolationSegmentSpace, Warnings, error) {
+	var (
+		allWarnings Warnings
+		spaces      []isolationSegmentSpace
+	)
+
+	relationships, warnings, err := actor.CloudControllerClient.GetIsolationSegmentSpaces(isolationSegmentGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	assigned := map[string]bool{}
+	if len(relationships.GUIDs) > 0 {
+		assignedSpaces, warnings, err := actor.CloudControllerClient.GetSpaces(url.Values{
+			ccv3.GUIDFilter: {strings.Join(relationships.GUIDs, ",")},
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, space := range assignedSpaces {
+			assigned[space.GUID] = true
+			spaces = append(spaces, isolationSegmentSpace{Space: space})
+		}
+	}
+
+	if len(defaultOrgGUIDs) == 0 {
+		return spaces, allWarnings, nil
+	}
+
+	orgSpaces, warnings, err := actor.CloudControllerClient.GetSpaces(url.Values{
+		ccv3.OrganizationGUIDFilter: {strings.Join(defaultOrgGUIDs, ",")},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	for _, space := range orgSpaces {
+		if assigned[space.GUID] {
+			continue
+		}
+
+		relationship, warnings, err := actor.CloudControllerClient.GetSpaceIsolationSegment(space.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		if relationship.GUID == "" {
+			spaces = append(spaces, isolationSegmentSpace{Space: space, InheritedFromOrg: true})
+		}
+	}
+
+	return spaces, allWarnings, nil
+}
+
+func (actor Actor) getSpaceUsage(space isolationSegmentSpace, orgName string) (IsolationSegmentSpaceUsage, Warnings, error) {
+	usage := IsolationSegmentSpaceUsage{
+		Name:             space.Name,
+		GUID:             space.GUID,
+		OrganizationName: orgName,
+		InheritedFromOrg: space.InheritedFromOrg,
+	}
+
+	apps, allWarnings, err := actor.CloudControllerClient.GetApplications(url.Values{
+		ccv3.SpaceGUIDFilter: []string{space.GUID},
+	})
+	if err != nil {
+		return IsolationSegmentSpaceUsage{}, Warnings(allWarnings), err
+	}
+
+	usage.Apps = len(apps)
+	for _, app := range apps {
+		if !Application(app).Started() {
+			continue
+		}
+		usage.StartedApps++
+
+		processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return IsolationSegmentSpaceUsage{}, Warnings(allWarnings), err
+		}
+		for _, process := range processes {
+			usage.DesiredInstances += process.Instances
+		}
+	}
+
+	return usage, Warnings(allWarnings), nil
+}
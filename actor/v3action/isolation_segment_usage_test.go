@@ -0,0 +1,277 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Isolation Segment Usage Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetIsolationSegmentUsageByName", func() {
+		var (
+			usage    IsolationSegmentUsage
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			usage, warnings, err = actor.GetIsolationSegmentUsageByName("some-iso-seg")
+		})
+
+		Context("when the isolation segment does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetIsolationSegmentsReturns(nil, ccv3.Warnings{"get-iso-warning"}, nil)
+			})
+
+			It("returns an IsolationSegmentNotFoundError and all warnings", func() {
+				Expect(err).To(MatchError(IsolationSegmentNotFoundError{Name: "some-iso-seg"}))
+				Expect(warnings).To(ConsistOf("get-iso-warning"))
+			})
+		})
+
+		Context("when the isolation segment exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetIsolationSegmentsReturns(
+					[]ccv3.IsolationSegment{{Name: "some-iso-seg", GUID: "some-iso-guid"}},
+					ccv3.Warnings{"get-iso-warning"},
+					nil,
+				)
+			})
+
+			Context("when all the requests succeed", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetIsolationSegmentOrganizationsByIsolationSegmentReturns(
+						[]ccv3.Organization{
+							{Name: "org-2", GUID: "org-guid-2"},
+							{Name: "org-1", GUID: "org-guid-1"},
+						},
+						ccv3.Warnings{"get-entitled-orgs-warning"},
+						nil,
+					)
+					fakeCloudControllerClient.GetOrganizationDefaultIsolationSegmentStub = func(orgGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
+						if orgGUID == "org-guid-1" {
+							return ccv3.Relationship{GUID: "some-iso-guid"}, ccv3.Warnings{"get-org-default-warning"}, nil
+						}
+						return ccv3.Relationship{GUID: "other-iso-guid"}, ccv3.Warnings{"get-org-default-warning"}, nil
+					}
+					fakeCloudControllerClient.GetIsolationSegmentSpacesReturns(
+						ccv3.RelationshipList{GUIDs: []string{"space-guid-assigned"}},
+						ccv3.Warnings{"get-iso-spaces-warning"},
+						nil,
+					)
+					fakeCloudControllerClient.GetSpacesStub = func(query url.Values) ([]ccv3.Space, ccv3.Warnings, error) {
+						if query.Get(ccv3.GUIDFilter) != "" {
+							return []ccv3.Space{
+								{Name: "assigned-space", GUID: "space-guid-assigned", OrganizationGUID: "org-guid-2"},
+							}, ccv3.Warnings{"get-assigned-spaces-warning"}, nil
+						}
+						return []ccv3.Space{
+							{Name: "inheriting-space", GUID: "space-guid-inheriting", OrganizationGUID: "org-guid-1"},
+							{Name: "other-space", GUID: "space-guid-other", OrganizationGUID: "org-guid-1"},
+						}, ccv3.Warnings{"get-org-spaces-warning"}, nil
+					}
+					fakeCloudControllerClient.GetSpaceIsolationSegmentStub = func(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
+						if spaceGUID == "space-guid-other" {
+							return ccv3.Relationship{GUID: "other-iso-guid"}, ccv3.Warnings{"get-space-iso-warning"}, nil
+						}
+						return ccv3.Relationship{}, ccv3.Warnings{"get-space-iso-warning"}, nil
+					}
+					fakeCloudControllerClient.GetApplicationsStub = func(query url.Values) ([]ccv3.Application, ccv3.Warnings, error) {
+						if query.Get(ccv3.SpaceGUIDFilter) == "space-guid-assigned" {
+							return []ccv3.Application{
+								{Name: "app-1", GUID: "app-guid-1", State: "STARTED"},
+								{Name: "app-2", GUID: "app-guid-2", State: "STOPPED"},
+							}, ccv3.Warnings{"get-apps-warning"}, nil
+						}
+						return []ccv3.Application{
+							{Name: "app-3", GUID: "app-guid-3", State: "STARTED"},
+						}, ccv3.Warnings{"get-apps-warning"}, nil
+					}
+					fakeCloudControllerClient.GetApplicationProcessesStub = func(appGUID string) ([]ccv3.Process, ccv3.Warnings, error) {
+						if appGUID == "app-guid-1" {
+							return []ccv3.Process{
+								{Type: "web", Instances: 3},
+								{Type: "worker", Instances: 2},
+							}, ccv3.Warnings{"get-processes-warning"}, nil
+						}
+						return []ccv3.Process{{Type: "web", Instances: 1}}, ccv3.Warnings{"get-processes-warning"}, nil
+					}
+				})
+
+				It("returns the usage of the isolation segment and all warnings", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(usage).To(Equal(IsolationSegmentUsage{
+						Name:           "some-iso-seg",
+						GUID:           "some-iso-guid",
+						EntitledOrgs:   []string{"org-1", "org-2"},
+						DefaultForOrgs: []string{"org-1"},
+						Spaces: []IsolationSegmentSpaceUsage{
+							{
+								Name:             "inheriting-space",
+								GUID:             "space-guid-inheriting",
+								OrganizationName: "org-1",
+								InheritedFromOrg: true,
+								Apps:             1,
+								StartedApps:      1,
+								DesiredInstances: 1,
+							},
+							{
+								Name:             "assigned-space",
+								GUID:             "space-guid-assigned",
+								OrganizationName: "org-2",
+								Apps:             2,
+								StartedApps:      1,
+								DesiredInstances: 5,
+							},
+						},
+					}))
+					Expect(usage.Apps()).To(Equal(3))
+					Expect(usage.DesiredInstances()).To(Equal(6))
+
+					Expect(warnings).To(ConsistOf(
+						"get-iso-warning",
+						"get-entitled-orgs-warning",
+						"get-org-default-warning",
+						"get-org-default-warning",
+						"get-iso-spaces-warning",
+						"get-assigned-spaces-warning",
+						"get-org-spaces-warning",
+						"get-space-iso-warning",
+						"get-space-iso-warning",
+						"get-apps-warning",
+						"get-apps-warning",
+						"get-processes-warning",
+						"get-processes-warning",
+					))
+
+					Expect(fakeCloudControllerClient.GetIsolationSegmentOrganizationsByIsolationSegmentArgsForCall(0)).To(Equal("some-iso-guid"))
+					Expect(fakeCloudControllerClient.GetIsolationSegmentSpacesArgsForCall(0)).To(Equal("some-iso-guid"))
+
+					Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal(url.Values{
+						ccv3.GUIDFilter: []string{"space-guid-assigned"},
+					}))
+					Expect(fakeCloudControllerClient.GetSpacesArgsForCall(1)).To(Equal(url.Values{
+						ccv3.OrganizationGUIDFilter: []string{"org-guid-1"},
+					}))
+
+					Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(2))
+				})
+			})
+
+			Context("when several spaces are assigned and several orgs use it as default", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetIsolationSegmentOrganizationsByIsolationSegmentReturns(
+						[]ccv3.Organization{
+							{Name: "org-1", GUID: "org-guid-1"},
+							{Name: "org-2", GUID: "org-guid-2"},
+						},
+						nil,
+						nil,
+					)
+					fakeCloudControllerClient.GetOrganizationDefaultIsolationSegmentReturns(ccv3.Relationship{GUID: "some-iso-guid"}, nil, nil)
+					fakeCloudControllerClient.GetIsolationSegmentSpacesReturns(
+						ccv3.RelationshipList{GUIDs: []string{"space-guid-1", "space-guid-2"}},
+						nil,
+						nil,
+					)
+				})
+
+				It("queries the spaces with comma-separated GUIDs", func() {
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal(url.Values{
+						ccv3.GUIDFilter: []string{"space-guid-1,space-guid-2"},
+					}))
+					Expect(fakeCloudControllerClient.GetSpacesArgsForCall(1)).To(Equal(url.Values{
+						ccv3.OrganizationGUIDFilter: []string{"org-guid-1,org-guid-2"},
+					}))
+				})
+			})
+
+			Context("when no spaces are assigned and no org uses it as default", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetIsolationSegmentOrganizationsByIsolationSegmentReturns(
+						[]ccv3.Organization{{Name: "org-1", GUID: "org-guid-1"}},
+						nil,
+						nil,
+					)
+					fakeCloudControllerClient.GetIsolationSegmentSpacesReturns(ccv3.RelationshipList{}, nil, nil)
+				})
+
+				It("returns the entitled orgs without looking up spaces", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(usage).To(Equal(IsolationSegmentUsage{
+						Name:           "some-iso-seg",
+						GUID:           "some-iso-guid",
+						EntitledOrgs:   []string{"org-1"},
+						DefaultForOrgs: []string{},
+						Spaces:         []IsolationSegmentSpaceUsage{},
+					}))
+					Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when getting the entitled organizations fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get-orgs-error")
+					fakeCloudControllerClient.GetIsolationSegmentOrganizationsByIsolationSegmentReturns(nil, ccv3.Warnings{"get-entitled-orgs-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-iso-warning", "get-entitled-orgs-warning"))
+				})
+			})
+
+			Context("when getting the assigned spaces fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get-spaces-error")
+					fakeCloudControllerClient.GetIsolationSegmentSpacesReturns(ccv3.RelationshipList{}, ccv3.Warnings{"get-iso-spaces-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-iso-warning", "get-iso-spaces-warning"))
+				})
+			})
+
+			Context("when getting the applications fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get-apps-error")
+					fakeCloudControllerClient.GetIsolationSegmentSpacesReturns(ccv3.RelationshipList{GUIDs: []string{"space-guid"}}, nil, nil)
+					fakeCloudControllerClient.GetSpacesReturns([]ccv3.Space{{Name: "space", GUID: "space-guid"}}, nil, nil)
+					fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-iso-warning", "get-apps-warning"))
+				})
+			})
+		})
+	})
+})
@@ -14,6 +14,13 @@ type Process struct {
 	Type       string
 	Instances  []Instance
 	MemoryInMB int
+
+	Command             string
+	DesiredInstances    int
+	DiskInMB            int
+	HealthCheckType     string
+	HealthCheckEndpoint string
+	HealthCheckTimeout  int
 }
 
 // Instance represents a V3 actor instance.
@@ -0,0 +1,45 @@
+package v3action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+
+// ServiceInstanceSharingDisabledError is returned when the
+// service_instance_sharing feature flag is disabled.
+type ServiceInstanceSharingDisabledError struct{}
+
+func (ServiceInstanceSharingDisabledError) Error() string {
+	return "Service instance sharing is disabled."
+}
+
+// ServiceInstanceNotShareableError is returned when the service broker of a
+// service instance does not allow it to be shared.
+type ServiceInstanceNotShareableError struct{}
+
+func (ServiceInstanceNotShareableError) Error() string {
+	return "Service instance sharing is not supported by the service broker."
+}
+
+// ShareServiceInstanceToSpace shares the service instance with the given
+// space.
+func (actor Actor) ShareServiceInstanceToSpace(serviceInstanceGUID string, spaceGUID string) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.ShareServiceInstanceToSpaces(serviceInstanceGUID, []string{spaceGUID})
+	return Warnings(warnings), convertServiceInstanceSharingError(err)
+}
+
+// UnshareServiceInstanceFromSpace stops sharing the service instance with the
+// given space. Any bindings to apps in that space are removed by the Cloud
+// Controller.
+func (actor Actor) UnshareServiceInstanceFromSpace(serviceInstanceGUID string, spaceGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UnshareServiceInstanceFromSpace(serviceInstanceGUID, spaceGUID)
+	return Warnings(warnings), convertServiceInstanceSharingError(err)
+}
+
+func convertServiceInstanceSharingError(err error) error {
+	switch err.(type) {
+	case ccerror.FeatureDisabledError:
+		return ServiceInstanceSharingDisabledError{}
+	case ccerror.ServiceInstanceNotShareableError:
+		return ServiceInstanceNotShareableError{}
+	default:
+		return err
+	}
+}
@@ -0,0 +1,136 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("ShareServiceInstanceToSpace", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ShareServiceInstanceToSpace("some-service-instance-guid", "some-space-guid")
+		})
+
+		Context("when the share is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.ShareServiceInstanceToSpacesReturns(
+					ccv3.RelationshipList{GUIDs: []string{"some-space-guid"}},
+					ccv3.Warnings{"share-warning"},
+					nil)
+			})
+
+			It("shares the service instance with the space and returns warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("share-warning"))
+
+				Expect(fakeCloudControllerClient.ShareServiceInstanceToSpacesCallCount()).To(Equal(1))
+				serviceInstanceGUID, spaceGUIDs := fakeCloudControllerClient.ShareServiceInstanceToSpacesArgsForCall(0)
+				Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+				Expect(spaceGUIDs).To(Equal([]string{"some-space-guid"}))
+			})
+		})
+
+		Context("when the service_instance_sharing feature flag is disabled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.ShareServiceInstanceToSpacesReturns(
+					ccv3.RelationshipList{},
+					ccv3.Warnings{"share-warning"},
+					ccerror.FeatureDisabledError{Message: "Feature Disabled: service_instance_sharing"})
+			})
+
+			It("returns a ServiceInstanceSharingDisabledError and warnings", func() {
+				Expect(executeErr).To(MatchError(ServiceInstanceSharingDisabledError{}))
+				Expect(warnings).To(ConsistOf("share-warning"))
+			})
+		})
+
+		Context("when the service broker does not allow sharing", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.ShareServiceInstanceToSpacesReturns(
+					ccv3.RelationshipList{},
+					ccv3.Warnings{"share-warning"},
+					ccerror.ServiceInstanceNotShareableError{Message: "The service broker does not support service instance sharing."})
+			})
+
+			It("returns a ServiceInstanceNotShareableError and warnings", func() {
+				Expect(executeErr).To(MatchError(ServiceInstanceNotShareableError{}))
+				Expect(warnings).To(ConsistOf("share-warning"))
+			})
+		})
+
+		Context("when the share fails for another reason", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("share error")
+				fakeCloudControllerClient.ShareServiceInstanceToSpacesReturns(ccv3.RelationshipList{}, ccv3.Warnings{"share-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("share-warning"))
+			})
+		})
+	})
+
+	Describe("UnshareServiceInstanceFromSpace", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UnshareServiceInstanceFromSpace("some-service-instance-guid", "some-space-guid")
+		})
+
+		Context("when the unshare is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UnshareServiceInstanceFromSpaceReturns(ccv3.Warnings{"unshare-warning"}, nil)
+			})
+
+			It("unshares the service instance from the space and returns warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("unshare-warning"))
+
+				Expect(fakeCloudControllerClient.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(1))
+				serviceInstanceGUID, spaceGUID := fakeCloudControllerClient.UnshareServiceInstanceFromSpaceArgsForCall(0)
+				Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when the service_instance_sharing feature flag is disabled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UnshareServiceInstanceFromSpaceReturns(
+					ccv3.Warnings{"unshare-warning"},
+					ccerror.FeatureDisabledError{Message: "Feature Disabled: service_instance_sharing"})
+			})
+
+			It("returns a ServiceInstanceSharingDisabledError and warnings", func() {
+				Expect(executeErr).To(MatchError(ServiceInstanceSharingDisabledError{}))
+				Expect(warnings).To(ConsistOf("unshare-warning"))
+			})
+		})
+	})
+})
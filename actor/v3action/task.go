@@ -3,9 +3,10 @@ package v3action
 import (
 	"fmt"
 	"net/url"
-	"strconv"
-
 	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -46,12 +47,49 @@ func (actor Actor) RunTask(appGUID string, task Task) (Task, Warnings, error) {
 	return Task(createdTask), Warnings(warnings), err
 }
 
+// TaskFilter narrows down the tasks returned by GetApplicationTasks. Zero
+// values do not filter.
+type TaskFilter struct {
+	// States only includes tasks in one of the given states.
+	States []string
+	// Names only includes tasks with one of the given names.
+	Names []string
+	// CreatedAfter only includes tasks created after the given time.
+	CreatedAfter time.Time
+	// Limit is the maximum number of tasks returned.
+	Limit int
+}
+
 // GetApplicationTasks returns a list of tasks associated with the provided
-// appplication GUID.
-func (actor Actor) GetApplicationTasks(appGUID string, sortOrder SortOrder) ([]Task, Warnings, error) {
+// appplication GUID. Filtering and ordering happens on the Cloud Controller,
+// so when a limit is provided only the first limit tasks in the requested
+// order are retrieved.
+func (actor Actor) GetApplicationTasks(appGUID string, sortOrder SortOrder, filter TaskFilter) ([]Task, Warnings, error) {
 	query := url.Values{}
 
-	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(appGUID, query)
+	if sortOrder == Descending {
+		query.Set(ccv3.OrderBy, "-created_at")
+	} else {
+		query.Set(ccv3.OrderBy, "created_at")
+	}
+	if len(filter.States) > 0 {
+		query.Set(ccv3.StateFilter, strings.Join(filter.States, ","))
+	}
+	if len(filter.Names) > 0 {
+		query.Set(ccv3.NameFilter, strings.Join(filter.Names, ","))
+	}
+	if !filter.CreatedAfter.IsZero() {
+		query.Set(ccv3.CreatedAfterFilter, filter.CreatedAfter.UTC().Format(time.RFC3339))
+	}
+	if filter.Limit > 0 {
+		perPage := filter.Limit
+		if perPage > ccv3.MaxPerPage {
+			perPage = ccv3.MaxPerPage
+		}
+		query.Set(ccv3.PerPage, strconv.Itoa(perPage))
+	}
+
+	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(appGUID, query, filter.Limit)
 	actorWarnings := Warnings(warnings)
 	if err != nil {
 		return nil, actorWarnings, err
@@ -71,12 +109,26 @@ func (actor Actor) GetApplicationTasks(appGUID string, sortOrder SortOrder) ([]T
 	return allTasks, actorWarnings, nil
 }
 
+// GetRunningTaskCountBySpace returns the number of tasks currently running in
+// the space.
+func (actor Actor) GetRunningTaskCountBySpace(spaceGUID string) (int, Warnings, error) {
+	tasks, warnings, err := actor.CloudControllerClient.GetTasks(url.Values{
+		ccv3.SpaceGUIDFilter: []string{spaceGUID},
+		ccv3.StateFilter:     []string{"RUNNING"},
+	})
+	if err != nil {
+		return 0, Warnings(warnings), err
+	}
+
+	return len(tasks), Warnings(warnings), nil
+}
+
 func (actor Actor) GetTaskBySequenceIDAndApplication(sequenceID int, appGUID string) (Task, Warnings, error) {
 	query := url.Values{
-		"sequence_ids": []string{strconv.Itoa(sequenceID)},
+		ccv3.SequenceIDFilter: []string{strconv.Itoa(sequenceID)},
 	}
 
-	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(appGUID, query)
+	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(appGUID, query, 0)
 	if err != nil {
 		return Task{}, Warnings(warnings), err
 	}
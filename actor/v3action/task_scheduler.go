@@ -0,0 +1,227 @@
+package v3action
+
+import (
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/util/cron"
+	"code.cloudfoundry.org/clock"
+)
+
+// ScheduledTaskRunStatus describes the outcome of a scheduled task firing.
+type ScheduledTaskRunStatus string
+
+const (
+	// ScheduledTaskSubmitted means the task was created on the Cloud Controller.
+	ScheduledTaskSubmitted ScheduledTaskRunStatus = "submitted"
+	// ScheduledTaskSkipped means the task was not created because the task
+	// started by the previous firing is still pending or running.
+	ScheduledTaskSkipped ScheduledTaskRunStatus = "skipped"
+	// ScheduledTaskFailed means an error occurred creating the task.
+	ScheduledTaskFailed ScheduledTaskRunStatus = "failed"
+	// ScheduleReloadFailed means the scheduled tasks could not be reloaded and
+	// the previously loaded schedule is still in use.
+	ScheduleReloadFailed ScheduledTaskRunStatus = "reload failed"
+)
+
+// ScheduleReloadInterval is the longest the task scheduler waits before
+// reloading the scheduled tasks.
+const ScheduleReloadInterval = time.Minute
+
+// ScheduledTask is a task that is run on an application whenever its cron
+// schedule comes due.
+type ScheduledTask struct {
+	Name      string
+	AppName   string
+	SpaceGUID string
+	Task      Task
+	Schedule  cron.Schedule
+}
+
+// ScheduledTaskRun is the outcome of a single firing of a scheduled task.
+type ScheduledTaskRun struct {
+	ScheduledTask ScheduledTask
+	Time          time.Time
+	Status        ScheduledTaskRunStatus
+	Task          Task
+	Warnings      Warnings
+	Err           error
+}
+
+type submittedTask struct {
+	appGUID string
+	task    Task
+}
+
+type scheduledTaskState struct {
+	scheduledTask ScheduledTask
+	nextRun       time.Time
+	previous      *submittedTask
+}
+
+// RunTaskScheduler runs each of the provided scheduled tasks whenever its
+// schedule comes due until stop is closed. The outcome of every firing is sent
+// on the returned channel, which is closed when the scheduler stops. A firing
+// is skipped when the task started by the previous firing of the same
+// scheduled task is still PENDING or RUNNING, so runs never overlap.
+//
+// Each time the scheduler wakes up, and at least every
+// ScheduleReloadInterval, the scheduled tasks are replaced with the result of
+// reload so that changes made while the scheduler runs take effect. When
+// reload fails, the previous schedule is kept and a ScheduleReloadFailed run
+// is sent.
+func (actor Actor) RunTaskScheduler(scheduledTasks []ScheduledTask, reload func() ([]ScheduledTask, error), clk clock.Clock, stop <-chan struct{}) <-chan ScheduledTaskRun {
+	runs := make(chan ScheduledTaskRun)
+
+	go func() {
+		defer close(runs)
+
+		states := map[string]*scheduledTaskState{}
+		updateScheduledTaskStates(states, scheduledTasks, clk.Now())
+		reloadFailed := false
+
+		for {
+			next := clk.Now().Add(ScheduleReloadInterval)
+			for _, state := range states {
+				if !state.nextRun.IsZero() && state.nextRun.Before(next) {
+					next = state.nextRun
+				}
+			}
+
+			timer := clk.NewTimer(next.Sub(clk.Now()))
+			select {
+			case <-stop:
+				timer.Stop()
+				return
+			case <-timer.C():
+			}
+
+			now := clk.Now()
+			reloaded, err := reload()
+			if err != nil {
+				if !reloadFailed {
+					reloadFailed = true
+					select {
+					case runs <- ScheduledTaskRun{Time: now, Status: ScheduleReloadFailed, Err: err}:
+					case <-stop:
+						return
+					}
+				}
+			} else {
+				reloadFailed = false
+				updateScheduledTaskStates(states, reloaded, now)
+			}
+
+			for _, state := range sortedScheduledTaskStates(states) {
+				if state.nextRun.IsZero() || state.nextRun.After(now) {
+					continue
+				}
+
+				run := actor.runScheduledTask(state.scheduledTask, state.previous)
+				run.Time = state.nextRun
+				state.nextRun = state.scheduledTask.Schedule.Next(now)
+
+				select {
+				case runs <- run.ScheduledTaskRun:
+				case <-stop:
+					return
+				}
+
+				if run.submitted != nil {
+					state.previous = run.submitted
+				}
+			}
+		}
+	}()
+
+	return runs
+}
+
+// updateScheduledTaskStates replaces the scheduled tasks in states with
+// scheduledTasks. Scheduled tasks whose schedule changed are rescheduled from
+// now; the last submitted task is kept so that runs still never overlap.
+func updateScheduledTaskStates(states map[string]*scheduledTaskState, scheduledTasks []ScheduledTask, now time.Time) {
+	current := map[string]bool{}
+	for _, scheduledTask := range scheduledTasks {
+		current[scheduledTask.Name] = true
+
+		state, exists := states[scheduledTask.Name]
+		if !exists {
+			states[scheduledTask.Name] = &scheduledTaskState{
+				scheduledTask: scheduledTask,
+				nextRun:       scheduledTask.Schedule.Next(now),
+			}
+			continue
+		}
+
+		if state.scheduledTask.Schedule.String() != scheduledTask.Schedule.String() {
+			state.nextRun = scheduledTask.Schedule.Next(now)
+		}
+		state.scheduledTask = scheduledTask
+	}
+
+	for name := range states {
+		if !current[name] {
+			delete(states, name)
+		}
+	}
+}
+
+func sortedScheduledTaskStates(states map[string]*scheduledTaskState) []*scheduledTaskState {
+	sorted := make([]*scheduledTaskState, 0, len(states))
+	for _, state := range states {
+		sorted = append(sorted, state)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].scheduledTask.Name < sorted[j].scheduledTask.Name
+	})
+	return sorted
+}
+
+type scheduledTaskRunResult struct {
+	ScheduledTaskRun
+	submitted *submittedTask
+}
+
+func (actor Actor) runScheduledTask(scheduledTask ScheduledTask, previous *submittedTask) scheduledTaskRunResult {
+	result := scheduledTaskRunResult{
+		ScheduledTaskRun: ScheduledTaskRun{ScheduledTask: scheduledTask},
+	}
+
+	if previous != nil {
+		task, warnings, err := actor.GetTaskBySequenceIDAndApplication(previous.task.SequenceID, previous.appGUID)
+		result.Warnings = append(result.Warnings, warnings...)
+		if _, ok := err.(TaskNotFoundError); err != nil && !ok {
+			result.Status = ScheduledTaskFailed
+			result.Err = err
+			return result
+		}
+
+		if task.State == "PENDING" || task.State == "RUNNING" {
+			result.Status = ScheduledTaskSkipped
+			result.Task = task
+			return result
+		}
+	}
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(scheduledTask.AppName, scheduledTask.SpaceGUID)
+	result.Warnings = append(result.Warnings, warnings...)
+	if err != nil {
+		result.Status = ScheduledTaskFailed
+		result.Err = err
+		return result
+	}
+
+	task, warnings, err := actor.RunTask(app.GUID, scheduledTask.Task)
+	result.Warnings = append(result.Warnings, warnings...)
+	if err != nil {
+		result.Status = ScheduledTaskFailed
+		result.Err = err
+		return result
+	}
+
+	result.Status = ScheduledTaskSubmitted
+	result.Task = task
+	result.submitted = &submittedTask{appGUID: app.GUID, task: task}
+	return result
+}
@@ -0,0 +1,301 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/util/cron"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Task Scheduler Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("RunTaskScheduler", func() {
+		var (
+			fakeClock      *fakeclock.FakeClock
+			scheduledTasks []ScheduledTask
+			reloadedTasks  []ScheduledTask
+			reloadErr      error
+			reloadCount    int32
+			stop           chan struct{}
+			runs           <-chan ScheduledTaskRun
+		)
+
+		BeforeEach(func() {
+			fakeClock = fakeclock.NewFakeClock(time.Date(2017, time.August, 16, 10, 30, 30, 0, time.UTC))
+
+			schedule, err := cron.Parse("* * * * *")
+			Expect(err).ToNot(HaveOccurred())
+			scheduledTasks = []ScheduledTask{
+				{
+					Name:      "some-schedule",
+					AppName:   "some-app",
+					SpaceGUID: "some-space-guid",
+					Task:      Task{Command: "some-command", MemoryInMB: 256},
+					Schedule:  schedule,
+				},
+			}
+
+			reloadedTasks = scheduledTasks
+			reloadErr = nil
+			atomic.StoreInt32(&reloadCount, 0)
+
+			stop = make(chan struct{})
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{GUID: "some-app-guid"}},
+				ccv3.Warnings{"get-app-warning"},
+				nil)
+			fakeCloudControllerClient.CreateApplicationTaskReturns(
+				ccv3.Task{SequenceID: 3, Name: "some-task"},
+				ccv3.Warnings{"create-task-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			reload := func() ([]ScheduledTask, error) {
+				atomic.AddInt32(&reloadCount, 1)
+				return reloadedTasks, reloadErr
+			}
+			runs = actor.RunTaskScheduler(scheduledTasks, reload, fakeClock, stop)
+		})
+
+		AfterEach(func() {
+			close(stop)
+			Eventually(runs).Should(BeClosed())
+		})
+
+		It("does not run anything before the schedule comes due", func() {
+			fakeClock.WaitForWatcherAndIncrement(29 * time.Second)
+			Consistently(runs).ShouldNot(Receive())
+			Expect(fakeCloudControllerClient.CreateApplicationTaskCallCount()).To(Equal(0))
+		})
+
+		It("runs the task when the schedule comes due", func() {
+			fakeClock.WaitForWatcherAndIncrement(30 * time.Second)
+
+			var run ScheduledTaskRun
+			Eventually(runs).Should(Receive(&run))
+			Expect(run).To(Equal(ScheduledTaskRun{
+				ScheduledTask: scheduledTasks[0],
+				Time:          time.Date(2017, time.August, 16, 10, 31, 0, 0, time.UTC),
+				Status:        ScheduledTaskSubmitted,
+				Task:          Task{SequenceID: 3, Name: "some-task"},
+				Warnings:      Warnings{"get-app-warning", "create-task-warning"},
+			}))
+
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{
+				"space_guids": []string{"some-space-guid"},
+				"names":       []string{"some-app"},
+			}))
+
+			Expect(fakeCloudControllerClient.CreateApplicationTaskCallCount()).To(Equal(1))
+			appGUID, task := fakeCloudControllerClient.CreateApplicationTaskArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(task).To(Equal(ccv3.Task{Command: "some-command", MemoryInMB: 256}))
+		})
+
+		It("reloads the scheduled tasks every time it wakes up", func() {
+			fakeClock.WaitForWatcherAndIncrement(30 * time.Second)
+			Eventually(runs).Should(Receive())
+			Expect(atomic.LoadInt32(&reloadCount)).To(BeEquivalentTo(1))
+		})
+
+		Context("when no scheduled task comes due for a while", func() {
+			BeforeEach(func() {
+				schedule, err := cron.Parse("0 2 * * *")
+				Expect(err).ToNot(HaveOccurred())
+				scheduledTasks[0].Schedule = schedule
+			})
+
+			It("still reloads the scheduled tasks every ScheduleReloadInterval", func() {
+				fakeClock.WaitForWatcherAndIncrement(ScheduleReloadInterval)
+				Eventually(func() int32 { return atomic.LoadInt32(&reloadCount) }).Should(BeEquivalentTo(1))
+
+				fakeClock.WaitForWatcherAndIncrement(ScheduleReloadInterval)
+				Eventually(func() int32 { return atomic.LoadInt32(&reloadCount) }).Should(BeEquivalentTo(2))
+
+				Consistently(runs).ShouldNot(Receive())
+			})
+		})
+
+		Context("when a scheduled task is added while the scheduler runs", func() {
+			BeforeEach(func() {
+				schedule, err := cron.Parse("* * * * *")
+				Expect(err).ToNot(HaveOccurred())
+				reloadedTasks = append([]ScheduledTask{{
+					Name:      "another-schedule",
+					AppName:   "some-app",
+					SpaceGUID: "some-space-guid",
+					Task:      Task{Command: "another-command"},
+					Schedule:  schedule,
+				}}, scheduledTasks...)
+			})
+
+			It("runs the added scheduled task from its next due time on", func() {
+				fakeClock.WaitForWatcherAndIncrement(30 * time.Second)
+
+				var run ScheduledTaskRun
+				Eventually(runs).Should(Receive(&run))
+				Expect(run.ScheduledTask.Name).To(Equal("some-schedule"))
+				Consistently(runs).ShouldNot(Receive())
+
+				fakeClock.WaitForWatcherAndIncrement(time.Minute)
+				Eventually(runs).Should(Receive(&run))
+				Expect(run.ScheduledTask.Name).To(Equal("another-schedule"))
+				Expect(run.Time).To(Equal(time.Date(2017, time.August, 16, 10, 32, 0, 0, time.UTC)))
+				Expect(run.Status).To(Equal(ScheduledTaskSubmitted))
+				Eventually(runs).Should(Receive(&run))
+				Expect(run.ScheduledTask.Name).To(Equal("some-schedule"))
+
+				Expect(fakeCloudControllerClient.CreateApplicationTaskCallCount()).To(Equal(3))
+			})
+		})
+
+		Context("when a scheduled task is removed while the scheduler runs", func() {
+			BeforeEach(func() {
+				reloadedTasks = nil
+			})
+
+			It("stops running the removed scheduled task", func() {
+				fakeClock.WaitForWatcherAndIncrement(30 * time.Second)
+				Eventually(func() int32 { return atomic.LoadInt32(&reloadCount) }).Should(BeEquivalentTo(1))
+				Consistently(runs).ShouldNot(Receive())
+				Expect(fakeCloudControllerClient.CreateApplicationTaskCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when reloading the scheduled tasks fails", func() {
+			BeforeEach(func() {
+				reloadErr = errors.New("reload error")
+			})
+
+			It("reports the failure once and keeps running the previous schedule", func() {
+				fakeClock.WaitForWatcherAndIncrement(30 * time.Second)
+
+				var run ScheduledTaskRun
+				Eventually(runs).Should(Receive(&run))
+				Expect(run).To(Equal(ScheduledTaskRun{
+					Time:   time.Date(2017, time.August, 16, 10, 31, 0, 0, time.UTC),
+					Status: ScheduleReloadFailed,
+					Err:    reloadErr,
+				}))
+				Eventually(runs).Should(Receive(&run))
+				Expect(run.ScheduledTask.Name).To(Equal("some-schedule"))
+				Expect(run.Status).To(Equal(ScheduledTaskSubmitted))
+
+				fakeClock.WaitForWatcherAndIncrement(time.Minute)
+				Eventually(runs).Should(Receive(&run))
+				Expect(run.Status).To(Equal(ScheduledTaskSubmitted))
+				Expect(atomic.LoadInt32(&reloadCount)).To(BeEquivalentTo(2))
+			})
+		})
+
+		Context("when the previously submitted task is still running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					[]ccv3.Task{{SequenceID: 3, State: "RUNNING"}},
+					ccv3.Warnings{"get-task-warning"},
+					nil)
+			})
+
+			It("skips the next run", func() {
+				fakeClock.WaitForWatcherAndIncrement(30 * time.Second)
+				Eventually(runs).Should(Receive())
+
+				fakeClock.WaitForWatcherAndIncrement(time.Minute)
+				var run ScheduledTaskRun
+				Eventually(runs).Should(Receive(&run))
+				Expect(run.Status).To(Equal(ScheduledTaskSkipped))
+				Expect(run.Time).To(Equal(time.Date(2017, time.August, 16, 10, 32, 0, 0, time.UTC)))
+				Expect(run.Task.State).To(Equal("RUNNING"))
+				Expect(run.Warnings).To(ConsistOf("get-task-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(1))
+				appGUID, query, limit := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(Equal(url.Values{"sequence_ids": []string{"3"}}))
+				Expect(limit).To(Equal(0))
+				Expect(fakeCloudControllerClient.CreateApplicationTaskCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the previously submitted task has finished", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					[]ccv3.Task{{SequenceID: 3, State: "SUCCEEDED"}},
+					nil,
+					nil)
+			})
+
+			It("runs the task again", func() {
+				fakeClock.WaitForWatcherAndIncrement(30 * time.Second)
+				Eventually(runs).Should(Receive())
+
+				fakeClock.WaitForWatcherAndIncrement(time.Minute)
+				var run ScheduledTaskRun
+				Eventually(runs).Should(Receive(&run))
+				Expect(run.Status).To(Equal(ScheduledTaskSubmitted))
+				Expect(fakeCloudControllerClient.CreateApplicationTaskCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when running the task fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create task error")
+				fakeCloudControllerClient.CreateApplicationTaskReturns(
+					ccv3.Task{},
+					ccv3.Warnings{"create-task-warning"},
+					expectedErr)
+			})
+
+			It("reports the failure and keeps the schedule", func() {
+				fakeClock.WaitForWatcherAndIncrement(30 * time.Second)
+				var run ScheduledTaskRun
+				Eventually(runs).Should(Receive(&run))
+				Expect(run.Status).To(Equal(ScheduledTaskFailed))
+				Expect(run.Err).To(MatchError(expectedErr))
+				Expect(run.Warnings).To(ConsistOf("get-app-warning", "create-task-warning"))
+
+				fakeClock.WaitForWatcherAndIncrement(time.Minute)
+				Eventually(runs).Should(Receive(&run))
+				Expect(run.Status).To(Equal(ScheduledTaskFailed))
+				Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, nil, nil)
+			})
+
+			It("reports the failure", func() {
+				fakeClock.WaitForWatcherAndIncrement(30 * time.Second)
+				var run ScheduledTaskRun
+				Eventually(runs).Should(Receive(&run))
+				Expect(run.Status).To(Equal(ScheduledTaskFailed))
+				Expect(run.Err).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+				Expect(fakeCloudControllerClient.CreateApplicationTaskCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
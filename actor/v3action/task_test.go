@@ -3,6 +3,7 @@ package v3action_test
 import (
 	"errors"
 	"net/url"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -144,24 +145,64 @@ var _ = Describe("Task Actions", func() {
 				})
 
 				It("returns all tasks associated with the application and all warnings", func() {
-					tasks, warnings, err := actor.GetApplicationTasks("some-app-guid", Descending)
+					tasks, warnings, err := actor.GetApplicationTasks("some-app-guid", Descending, TaskFilter{})
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(tasks).To(Equal([]Task{Task(task3), Task(task2), Task(task1)}))
 					Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
 
-					tasks, warnings, err = actor.GetApplicationTasks("some-app-guid", Ascending)
+					tasks, warnings, err = actor.GetApplicationTasks("some-app-guid", Ascending, TaskFilter{})
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(tasks).To(Equal([]Task{Task(task1), Task(task2), Task(task3)}))
 					Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
 
 					Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(2))
-					appGUID, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+					appGUID, query, limit := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
 					Expect(appGUID).To(Equal("some-app-guid"))
-					Expect(query).To(Equal(
-						url.Values{},
-					))
+					Expect(query).To(Equal(url.Values{
+						"order_by": []string{"-created_at"},
+					}))
+					Expect(limit).To(Equal(0))
+
+					_, query, _ = fakeCloudControllerClient.GetApplicationTasksArgsForCall(1)
+					Expect(query).To(Equal(url.Values{
+						"order_by": []string{"created_at"},
+					}))
+				})
+
+				Context("when filters are provided", func() {
+					It("passes them to the cloud controller as query parameters", func() {
+						_, _, err := actor.GetApplicationTasks("some-app-guid", Descending, TaskFilter{
+							States:       []string{"RUNNING", "FAILED"},
+							Names:        []string{"some-task"},
+							CreatedAfter: time.Date(2017, time.August, 16, 10, 30, 0, 0, time.FixedZone("UTC-7", -7*60*60)),
+							Limit:        10,
+						})
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(1))
+						_, query, limit := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+						Expect(query).To(Equal(url.Values{
+							"order_by":        []string{"-created_at"},
+							"states":          []string{"RUNNING,FAILED"},
+							"names":           []string{"some-task"},
+							"created_ats[gt]": []string{"2017-08-16T17:30:00Z"},
+							"per_page":        []string{"10"},
+						}))
+						Expect(limit).To(Equal(10))
+					})
+
+					Context("when the limit is larger than the maximum page size", func() {
+						It("requests the maximum page size", func() {
+							_, _, err := actor.GetApplicationTasks("some-app-guid", Descending, TaskFilter{Limit: 12000})
+							Expect(err).ToNot(HaveOccurred())
+
+							_, query, limit := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+							Expect(query.Get("per_page")).To(Equal("5000"))
+							Expect(limit).To(Equal(12000))
+						})
+					})
 				})
 			})
 
@@ -175,7 +216,7 @@ var _ = Describe("Task Actions", func() {
 				})
 
 				It("returns an empty list of tasks", func() {
-					tasks, _, err := actor.GetApplicationTasks("some-app-guid", Descending)
+					tasks, _, err := actor.GetApplicationTasks("some-app-guid", Descending, TaskFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(tasks).To(BeEmpty())
 				})
@@ -195,13 +236,53 @@ var _ = Describe("Task Actions", func() {
 			})
 
 			It("returns the same error and all warnings", func() {
-				_, warnings, err := actor.GetApplicationTasks("some-app-guid", Descending)
+				_, warnings, err := actor.GetApplicationTasks("some-app-guid", Descending, TaskFilter{})
 				Expect(err).To(MatchError(expectedErr))
 				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
 			})
 		})
 	})
 
+	Describe("GetRunningTaskCountBySpace", func() {
+		Context("when the cloud controller client does not return an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTasksReturns(
+					[]ccv3.Task{{GUID: "task-1-guid"}, {GUID: "task-2-guid"}},
+					ccv3.Warnings{"get-tasks-warning"},
+					nil,
+				)
+			})
+
+			It("returns the number of running tasks in the space and all warnings", func() {
+				count, warnings, err := actor.GetRunningTaskCountBySpace("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(Equal(2))
+				Expect(warnings).To(ConsistOf("get-tasks-warning"))
+
+				Expect(fakeCloudControllerClient.GetTasksCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetTasksArgsForCall(0)).To(Equal(url.Values{
+					ccv3.SpaceGUIDFilter: []string{"some-space-guid"},
+					ccv3.StateFilter:     []string{"RUNNING"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get tasks error")
+				fakeCloudControllerClient.GetTasksReturns(nil, ccv3.Warnings{"get-tasks-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetRunningTaskCountBySpace("some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-tasks-warning"))
+			})
+		})
+	})
+
 	Describe("GetTaskBySequenceIDAndApplication", func() {
 		Context("when the cloud controller client does not return an error", func() {
 			Context("when the task is found", func() {
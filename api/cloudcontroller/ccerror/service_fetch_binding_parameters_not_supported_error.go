@@ -0,0 +1,11 @@
+package ccerror
+
+// ServiceFetchBindingParametersNotSupportedError is returned when the service
+// broker does not support retrieving the parameters of a service binding.
+type ServiceFetchBindingParametersNotSupportedError struct {
+	Message string
+}
+
+func (e ServiceFetchBindingParametersNotSupportedError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,11 @@
+package ccerror
+
+// ServiceInstanceNameTakenError is returned when creating a service instance
+// with a name that is already used in the space.
+type ServiceInstanceNameTakenError struct {
+	Message string
+}
+
+func (e ServiceInstanceNameTakenError) Error() string {
+	return e.Message
+}
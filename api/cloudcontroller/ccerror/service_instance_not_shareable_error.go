@@ -0,0 +1,11 @@
+package ccerror
+
+// ServiceInstanceNotShareableError is returned when the service broker of a
+// service instance does not allow sharing it with other spaces.
+type ServiceInstanceNotShareableError struct {
+	Message string
+}
+
+func (e ServiceInstanceNotShareableError) Error() string {
+	return e.Message
+}
@@ -43,6 +43,9 @@ type Application struct {
 	// DetectedStartCommand is the command used to start the application.
 	DetectedStartCommand string `json:"-"`
 
+	// Diego is true when the application runs on Diego rather than on DEAs.
+	Diego bool `json:"-"`
+
 	// DiskQuota is the disk given to each instance, in megabytes.
 	DiskQuota uint64 `json:"disk_quota,omitempty"`
 
@@ -106,6 +109,7 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 			Command              string `json:"command"`
 			DetectedBuildpack    string `json:"detected_buildpack"`
 			DetectedStartCommand string `json:"detected_start_command"`
+			Diego                bool   `json:"diego"`
 			DiskQuota            uint64 `json:"disk_quota"`
 			DockerImage          string `json:"docker_image"`
 			// EnvironmentVariables' values can be any type, so we must accept
@@ -137,6 +141,7 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	application.Command = ccApp.Entity.Command
 	application.DetectedBuildpack = ccApp.Entity.DetectedBuildpack
 	application.DetectedStartCommand = ccApp.Entity.DetectedStartCommand
+	application.Diego = ccApp.Entity.Diego
 	application.DiskQuota = ccApp.Entity.DiskQuota
 	application.DockerImage = ccApp.Entity.DockerImage
 	application.GUID = ccApp.Metadata.GUID
@@ -97,6 +97,7 @@ var _ = Describe("Application", func() {
 							"buildpack": "ruby 1.6.29",
 							"command": "some-command",
 							"detected_start_command": "echo 'I am a banana'",
+							"diego": true,
 							"disk_quota": 586,
 							"detected_buildpack": null,
 							"docker_image": "some-docker-path",
@@ -141,6 +142,7 @@ var _ = Describe("Application", func() {
 					Command:              "some-command",
 					DetectedBuildpack:    "",
 					DetectedStartCommand: "echo 'I am a banana'",
+					Diego:                true,
 					DiskQuota:            586,
 					DockerImage:          "some-docker-path",
 					EnvironmentVariables: map[string]string{
@@ -79,6 +79,10 @@ func handleBadRequest(errorResponse ccerror.V2ErrorResponse) error {
 		return ccerror.NotStagedError{Message: errorResponse.Description}
 	case "CF-ServiceBindingAppServiceTaken":
 		return ccerror.ServiceBindingTakenError{Message: errorResponse.Description}
+	case "CF-ServiceFetchBindingParametersNotSupported":
+		return ccerror.ServiceFetchBindingParametersNotSupportedError{Message: errorResponse.Description}
+	case "CF-ServiceInstanceNameTaken":
+		return ccerror.ServiceInstanceNameTakenError{Message: errorResponse.Description}
 	default:
 		return ccerror.BadRequestError{Message: errorResponse.Description}
 	}
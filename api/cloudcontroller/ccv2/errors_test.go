@@ -135,6 +135,40 @@ var _ = Describe("Error Wrapper", func() {
 					})
 				})
 
+				Context("when the service instance name is taken", func() {
+					BeforeEach(func() {
+						response = `{
+							"code": 60002,
+							"description": "The service instance name is taken: some-service",
+							"error_code": "CF-ServiceInstanceNameTaken"
+						}`
+					})
+
+					It("returns a ServiceInstanceNameTakenError", func() {
+						_, _, err := client.GetApplications(nil)
+						Expect(err).To(MatchError(ccerror.ServiceInstanceNameTakenError{
+							Message: "The service instance name is taken: some-service",
+						}))
+					})
+				})
+
+				Context("when the service broker does not support fetching binding parameters", func() {
+					BeforeEach(func() {
+						response = `{
+							"code": 90010,
+							"description": "This service does not support fetching service binding parameters.",
+							"error_code": "CF-ServiceFetchBindingParametersNotSupported"
+						}`
+					})
+
+					It("returns a ServiceFetchBindingParametersNotSupportedError", func() {
+						_, _, err := client.GetApplications(nil)
+						Expect(err).To(MatchError(ccerror.ServiceFetchBindingParametersNotSupportedError{
+							Message: "This service does not support fetching service binding parameters.",
+						}))
+					})
+				})
+
 				Context("getting stats for a stopped app", func() {
 					BeforeEach(func() {
 						response = `{
@@ -0,0 +1,119 @@
+package ccv2
+
+import (
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Event represents a Cloud Controller audit event.
+type Event struct {
+	// GUID is the unique event identifier.
+	GUID string
+
+	// Type is the type of event, for example audit.app.update.
+	Type string
+
+	// ActorGUID is the GUID of the user or process that caused the event.
+	ActorGUID string
+
+	// ActorType is the type of the actor, for example user.
+	ActorType string
+
+	// ActorName is the name of the actor.
+	ActorName string
+
+	// ActorUsername is the username of the actor, when the actor is a user.
+	ActorUsername string
+
+	// ActeeGUID is the GUID of the resource the event is about.
+	ActeeGUID string
+
+	// ActeeType is the type of the resource, for example app.
+	ActeeType string
+
+	// ActeeName is the name of the resource.
+	ActeeName string
+
+	// Timestamp is the time the event occurred.
+	Timestamp time.Time
+
+	// Metadata contains the event specific details, such as the requested
+	// changes.
+	Metadata map[string]interface{}
+
+	// OrganizationGUID is the GUID of the organization the event occurred in.
+	OrganizationGUID string
+
+	// SpaceGUID is the GUID of the space the event occurred in.
+	SpaceGUID string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Event response.
+func (event *Event) UnmarshalJSON(data []byte) error {
+	var ccEvent struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Type             string                 `json:"type"`
+			Actor            string                 `json:"actor"`
+			ActorType        string                 `json:"actor_type"`
+			ActorName        string                 `json:"actor_name"`
+			ActorUsername    string                 `json:"actor_username"`
+			Actee            string                 `json:"actee"`
+			ActeeType        string                 `json:"actee_type"`
+			ActeeName        string                 `json:"actee_name"`
+			Timestamp        time.Time              `json:"timestamp"`
+			Metadata         map[string]interface{} `json:"metadata"`
+			OrganizationGUID string                 `json:"organization_guid"`
+			SpaceGUID        string                 `json:"space_guid"`
+		} `json:"entity"`
+	}
+	err := json.Unmarshal(data, &ccEvent)
+	if err != nil {
+		return err
+	}
+
+	event.GUID = ccEvent.Metadata.GUID
+	event.Type = ccEvent.Entity.Type
+	event.ActorGUID = ccEvent.Entity.Actor
+	event.ActorType = ccEvent.Entity.ActorType
+	event.ActorName = ccEvent.Entity.ActorName
+	event.ActorUsername = ccEvent.Entity.ActorUsername
+	event.ActeeGUID = ccEvent.Entity.Actee
+	event.ActeeType = ccEvent.Entity.ActeeType
+	event.ActeeName = ccEvent.Entity.ActeeName
+	event.Timestamp = ccEvent.Entity.Timestamp
+	event.Metadata = ccEvent.Entity.Metadata
+	event.OrganizationGUID = ccEvent.Entity.OrganizationGUID
+	event.SpaceGUID = ccEvent.Entity.SpaceGUID
+	return nil
+}
+
+// GetEvents returns back a list of Events based off of the provided queries,
+// following every page of the results.
+func (client *Client) GetEvents(queries []Query) ([]Event, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetEventsRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullEventsList []Event
+	warnings, err := client.paginate(request, Event{}, func(item interface{}) error {
+		if event, ok := item.(Event); ok {
+			fullEventsList = append(fullEventsList, event)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Event{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullEventsList, warnings, err
+}
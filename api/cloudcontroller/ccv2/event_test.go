@@ -0,0 +1,139 @@
+package ccv2_test
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Event", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetEvents", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/events?q=actee_type+IN+app,space&q=timestamp>=2017-08-16T10:30:00Z&page=2",
+				"resources": [
+					{
+						"metadata": {
+							"guid": "event-guid-1"
+						},
+						"entity": {
+							"type": "audit.app.update",
+							"actor": "user-guid",
+							"actor_type": "user",
+							"actor_name": "some-user",
+							"actor_username": "some-user",
+							"actee": "app-guid",
+							"actee_type": "app",
+							"actee_name": "some-app",
+							"timestamp": "2017-08-16T11:00:00Z",
+							"metadata": {
+								"request": {
+									"instances": 2
+								}
+							},
+							"space_guid": "space-guid",
+							"organization_guid": "org-guid"
+						}
+					}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "event-guid-2"
+						},
+						"entity": {
+							"type": "audit.space.create",
+							"actor": "user-guid",
+							"actor_type": "user",
+							"actor_name": "some-user",
+							"actee": "space-guid",
+							"actee_type": "space",
+							"actee_name": "some-space",
+							"timestamp": "2017-08-16T12:00:00Z",
+							"metadata": {},
+							"space_guid": "space-guid",
+							"organization_guid": "org-guid"
+						}
+					}
+				]
+			}`
+			query := url.Values{"q": {"actee_type IN app,space", "timestamp>=2017-08-16T10:30:00Z"}}.Encode()
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/events", query),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/events", query+"&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the queried events", func() {
+			events, warnings, err := client.GetEvents([]Query{
+				{
+					Filter:   ActeeTypeFilter,
+					Operator: InOperator,
+					Value:    "app,space",
+				},
+				{
+					Filter:   TimestampFilter,
+					Operator: GreaterThanOrEqualOperator,
+					Value:    "2017-08-16T10:30:00Z",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(ConsistOf(
+				Event{
+					GUID:          "event-guid-1",
+					Type:          "audit.app.update",
+					ActorGUID:     "user-guid",
+					ActorType:     "user",
+					ActorName:     "some-user",
+					ActorUsername: "some-user",
+					ActeeGUID:     "app-guid",
+					ActeeType:     "app",
+					ActeeName:     "some-app",
+					Timestamp:     time.Date(2017, time.August, 16, 11, 0, 0, 0, time.UTC),
+					Metadata: map[string]interface{}{
+						"request": map[string]interface{}{"instances": float64(2)},
+					},
+					SpaceGUID:        "space-guid",
+					OrganizationGUID: "org-guid",
+				},
+				Event{
+					GUID:             "event-guid-2",
+					Type:             "audit.space.create",
+					ActorGUID:        "user-guid",
+					ActorType:        "user",
+					ActorName:        "some-user",
+					ActeeGUID:        "space-guid",
+					ActeeType:        "space",
+					ActeeName:        "some-space",
+					Timestamp:        time.Date(2017, time.August, 16, 12, 0, 0, 0, time.UTC),
+					Metadata:         map[string]interface{}{},
+					SpaceGUID:        "space-guid",
+					OrganizationGUID: "org-guid",
+				},
+			))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+		})
+	})
+})
@@ -1,6 +1,9 @@
 package ccv2
 
 import (
+	"net/http"
+	"time"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
@@ -9,6 +12,8 @@ import (
 // APIInformation represents the information returned back from /v2/info
 type APIInformation struct {
 	APIVersion                   string `json:"api_version"`
+	AppSSHEndpoint               string `json:"app_ssh_endpoint"`
+	AppSSHHostKeyFingerprint     string `json:"app_ssh_host_key_fingerprint"`
 	AuthorizationEndpoint        string `json:"authorization_endpoint"`
 	DopplerEndpoint              string `json:"doppler_logging_endpoint"`
 	MinCLIVersion                string `json:"min_cli_version"`
@@ -16,6 +21,10 @@ type APIInformation struct {
 	Name                         string `json:"name"`
 	RoutingEndpoint              string `json:"routing_endpoint"`
 	TokenEndpoint                string `json:"token_endpoint"`
+
+	// ServerTime is the time reported by the Date header of the response. It
+	// is zero when the header is missing or malformed.
+	ServerTime time.Time `json:"-"`
 }
 
 // API returns the Cloud Controller API URL for the targeted Cloud Controller.
@@ -29,6 +38,18 @@ func (client *Client) APIVersion() string {
 	return client.cloudControllerAPIVersion
 }
 
+// AppSSHEndpoint returns the address of the SSH proxy for the targeted Cloud
+// Controller.
+func (client *Client) AppSSHEndpoint() string {
+	return client.appSSHEndpoint
+}
+
+// AppSSHHostKeyFingerprint returns the fingerprint of the host key of the SSH
+// proxy for the targeted Cloud Controller.
+func (client *Client) AppSSHHostKeyFingerprint() string {
+	return client.appSSHHostKeyFingerprint
+}
+
 // AuthorizationEndpoint returns the authorization endpoint for the targeted
 // Cloud Controller.
 func (client *Client) AuthorizationEndpoint() string {
@@ -76,5 +97,9 @@ func (client *Client) Info() (APIInformation, Warnings, error) {
 	if _, ok := err.(ccerror.NotFoundError); ok {
 		return APIInformation{}, nil, ccerror.APINotFoundError{URL: client.cloudControllerURL}
 	}
+
+	if response.HTTPResponse != nil {
+		info.ServerTime, _ = http.ParseTime(response.HTTPResponse.Header.Get("Date"))
+	}
 	return info, response.Warnings, err
 }
@@ -1,8 +1,10 @@
 package ccv2_test
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -48,7 +50,10 @@ var _ = Describe("Info", func() {
 			server.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(http.MethodGet, "/v2/info"),
-					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					RespondWith(http.StatusOK, response, http.Header{
+						"X-Cf-Warnings": {"this is a warning"},
+						"Date":          {"Mon, 02 Jan 2006 15:04:05 GMT"},
+					}),
 				),
 			)
 		})
@@ -58,6 +63,8 @@ var _ = Describe("Info", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(info.APIVersion).To(Equal("2.59.0"))
+			Expect(info.AppSSHEndpoint).To(Equal(fmt.Sprintf("ssh.%s", serverAPIURL)))
+			Expect(info.AppSSHHostKeyFingerprint).To(Equal("a6:d1:08:0b:b0:cb:9b:5f:c4:ba:44:2a:97:26:19:8a"))
 			Expect(info.AuthorizationEndpoint).To(MatchRegexp("https://login.%s", serverAPIURL))
 			Expect(info.DopplerEndpoint).To(MatchRegexp("wss://doppler.%s", serverAPIURL))
 			Expect(info.MinCLIVersion).To(Equal("6.22.1"))
@@ -65,6 +72,7 @@ var _ = Describe("Info", func() {
 			Expect(info.Name).To(Equal("faceman test server"))
 			Expect(info.RoutingEndpoint).To(MatchRegexp("https://%s/routing", serverAPIURL))
 			Expect(info.TokenEndpoint).To(MatchRegexp("https://uaa.%s", serverAPIURL))
+			Expect(info.ServerTime).To(BeTemporally("==", time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)))
 		})
 
 		It("sets the http endpoint and warns user", func() {
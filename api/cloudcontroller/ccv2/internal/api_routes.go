@@ -15,57 +15,84 @@ import (
 //
 // The const name should always be the const value + Request.
 const (
-	DeleteOrganizationRequest              = "DeleteOrganization"
-	DeleteRouteRequest                     = "DeleteRoute"
-	DeleteRunningSecurityGroupSpaceRequest = "DeleteRunningSecurityGroupSpace"
-	DeleteSecurityGroupSpaceRequest        = "DeleteSecurityGroupSpace"
-	DeleteServiceBindingRequest            = "DeleteServiceBinding"
-	DeleteSpaceRequest                     = "DeleteSpaceRequest"
-	DeleteStagingSecurityGroupSpaceRequest = "DeleteStagingSecurityGroupSpace"
-	GetAppInstancesRequest                 = "GetAppInstances"
-	GetAppRequest                          = "GetApp"
-	GetAppRoutesRequest                    = "GetAppRoutes"
-	GetAppsRequest                         = "GetApps"
-	GetAppStatsRequest                     = "GetAppStats"
-	GetInfoRequest                         = "GetInfo"
-	GetJobRequest                          = "GetJob"
-	GetOrganizationPrivateDomainsRequest   = "GetOrganizationPrivateDomains"
-	GetOrganizationQuotaDefinitionRequest  = "GetOrganizationQuotaDefinition"
-	GetOrganizationRequest                 = "GetOrganization"
-	GetOrganizationsRequest                = "GetOrganizations"
-	GetPrivateDomainRequest                = "GetPrivateDomain"
-	GetRouteAppsRequest                    = "GetRouteApps"
-	GetRouteReservedRequest                = "GetRouteReserved"
-	GetRouteRouteMappingsRequest           = "GetRouteRouteMappings"
-	GetRoutesRequest                       = "GetRoutes"
-	GetSecurityGroupRunningSpacesRequest   = "GetSecurityGroupRunningSpaces"
-	GetSecurityGroupsRequest               = "GetSecurityGroups"
-	GetSecurityGroupStagingSpacesRequest   = "GetSecurityGroupStagingSpaces"
-	GetServiceBindingsRequest              = "GetServiceBindings"
-	GetServiceInstanceRequest              = "GetServiceInstance"
-	GetServiceInstancesRequest             = "GetServiceInstances"
-	GetSharedDomainRequest                 = "GetSharedDomain"
-	GetSharedDomainsRequest                = "GetSharedDomains"
-	GetSpaceQuotaDefinitionRequest         = "GetSpaceQuotaDefinition"
-	GetSpaceRoutesRequest                  = "GetSpaceRoutes"
-	GetSpaceRunningSecurityGroupsRequest   = "GetSpaceRunningSecurityGroups"
-	GetSpaceServiceInstancesRequest        = "GetSpaceServiceInstances"
-	GetSpacesRequest                       = "GetSpaces"
-	GetSpaceStagingSecurityGroupsRequest   = "GetSpaceStagingSecurityGroups"
-	GetStackRequest                        = "GetStack"
-	GetStacksRequest                       = "GetStacks"
-	GetUsersRequest                        = "GetUsers"
-	PostAppRequest                         = "PostApp"
-	PostAppRestageRequest                  = "PostAppRestage"
-	PostRouteRequest                       = "PostRoute"
-	PostServiceBindingRequest              = "PostServiceBinding"
-	PostUserRequest                        = "PostUser"
-	PutAppBitsRequest                      = "PutAppBits"
-	PutAppRequest                          = "PutApp"
-	PutBindRouteAppRequest                 = "PutBindRouteApp"
-	PutResourceMatch                       = "PutResourceMatch"
-	PutRunningSecurityGroupSpaceRequest    = "PutRunningSecurityGroupSpace"
-	PutStagingSecurityGroupSpaceRequest    = "PutStagingSecurityGroupSpace"
+	DeleteConfigRunningSecurityGroupRequest = "DeleteConfigRunningSecurityGroup"
+	DeleteConfigStagingSecurityGroupRequest = "DeleteConfigStagingSecurityGroup"
+	DeleteOrganizationRequest               = "DeleteOrganization"
+	DeleteOrganizationUserByRoleRequest     = "DeleteOrganizationUserByRole"
+	DeleteRouteRequest                      = "DeleteRoute"
+	DeleteRunningSecurityGroupSpaceRequest  = "DeleteRunningSecurityGroupSpace"
+	DeleteSecurityGroupSpaceRequest         = "DeleteSecurityGroupSpace"
+	DeleteServiceBindingRequest             = "DeleteServiceBinding"
+	DeleteServiceInstanceRequest            = "DeleteServiceInstance"
+	DeleteServiceKeyRequest                 = "DeleteServiceKey"
+	DeleteSpaceRequest                      = "DeleteSpaceRequest"
+	DeleteSpaceUserByRoleRequest            = "DeleteSpaceUserByRole"
+	DeleteStagingSecurityGroupSpaceRequest  = "DeleteStagingSecurityGroupSpace"
+	GetAppInstancesRequest                  = "GetAppInstances"
+	GetAppRequest                           = "GetApp"
+	GetAppRoutesRequest                     = "GetAppRoutes"
+	GetAppsRequest                          = "GetApps"
+	GetAppStatsRequest                      = "GetAppStats"
+	GetEventsRequest                        = "GetEvents"
+	GetInfoRequest                          = "GetInfo"
+	GetJobRequest                           = "GetJob"
+	GetOrganizationPrivateDomainsRequest    = "GetOrganizationPrivateDomains"
+	GetOrganizationQuotaDefinitionRequest   = "GetOrganizationQuotaDefinition"
+	GetOrganizationRequest                  = "GetOrganization"
+	GetOrganizationUsersByRoleRequest       = "GetOrganizationUsersByRole"
+	GetOrganizationsRequest                 = "GetOrganizations"
+	GetPrivateDomainRequest                 = "GetPrivateDomain"
+	GetRouteAppsRequest                     = "GetRouteApps"
+	GetRouteReservedRequest                 = "GetRouteReserved"
+	GetRouteRouteMappingsRequest            = "GetRouteRouteMappings"
+	GetRoutesRequest                        = "GetRoutes"
+	GetSecurityGroupRunningSpacesRequest    = "GetSecurityGroupRunningSpaces"
+	GetSecurityGroupsRequest                = "GetSecurityGroups"
+	GetSecurityGroupStagingSpacesRequest    = "GetSecurityGroupStagingSpaces"
+	GetServiceBindingParametersRequest      = "GetServiceBindingParameters"
+	GetServiceBindingsRequest               = "GetServiceBindings"
+	GetServiceInstanceRequest               = "GetServiceInstance"
+	GetServiceInstanceSharedFromRequest     = "GetServiceInstanceSharedFrom"
+	GetServiceInstanceSharedToRequest       = "GetServiceInstanceSharedTo"
+	GetServiceInstancesRequest              = "GetServiceInstances"
+	GetServiceKeyParametersRequest          = "GetServiceKeyParameters"
+	GetServiceKeysRequest                   = "GetServiceKeys"
+	GetServicePlanRequest                   = "GetServicePlan"
+	GetServicePlansRequest                  = "GetServicePlans"
+	GetServiceRequest                       = "GetService"
+	GetSharedDomainRequest                  = "GetSharedDomain"
+	GetSharedDomainsRequest                 = "GetSharedDomains"
+	GetSpaceQuotaDefinitionRequest          = "GetSpaceQuotaDefinition"
+	GetSpaceRoutesRequest                   = "GetSpaceRoutes"
+	GetSpaceRunningSecurityGroupsRequest    = "GetSpaceRunningSecurityGroups"
+	GetSpaceServiceInstancesRequest         = "GetSpaceServiceInstances"
+	GetSpaceServicesRequest                 = "GetSpaceServices"
+	GetSpacesRequest                        = "GetSpaces"
+	GetSpaceStagingSecurityGroupsRequest    = "GetSpaceStagingSecurityGroups"
+	GetSpaceUsersByRoleRequest              = "GetSpaceUsersByRole"
+	GetStackRequest                         = "GetStack"
+	GetStacksRequest                        = "GetStacks"
+	GetUsersRequest                         = "GetUsers"
+	PostAppRequest                          = "PostApp"
+	PostAppRestageRequest                   = "PostAppRestage"
+	PostRouteRequest                        = "PostRoute"
+	PostSecurityGroupRequest                = "PostSecurityGroup"
+	PostServiceBindingRequest               = "PostServiceBinding"
+	PostServiceInstancesRequest             = "PostServiceInstances"
+	PostServiceKeyRequest                   = "PostServiceKey"
+	PostUserRequest                         = "PostUser"
+	PutAppBitsRequest                       = "PutAppBits"
+	PutAppRequest                           = "PutApp"
+	PutBindRouteAppRequest                  = "PutBindRouteApp"
+	PutConfigRunningSecurityGroupRequest    = "PutConfigRunningSecurityGroup"
+	PutConfigStagingSecurityGroupRequest    = "PutConfigStagingSecurityGroup"
+	PutOrganizationUserByRoleRequest        = "PutOrganizationUserByRole"
+	PutResourceMatch                        = "PutResourceMatch"
+	PutRunningSecurityGroupSpaceRequest     = "PutRunningSecurityGroupSpace"
+	PutSecurityGroupRequest                 = "PutSecurityGroup"
+	PutServiceInstanceRequest               = "PutServiceInstance"
+	PutSpaceUserByRoleRequest               = "PutSpaceUserByRole"
+	PutStagingSecurityGroupSpaceRequest     = "PutStagingSecurityGroupSpace"
 )
 
 // APIRoutes is a list of routes used by the rata library to construct request
@@ -80,12 +107,20 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/apps/:app_guid/restage", Method: http.MethodPost, Name: PostAppRestageRequest},
 	{Path: "/v2/apps/:app_guid/routes", Method: http.MethodGet, Name: GetAppRoutesRequest},
 	{Path: "/v2/apps/:app_guid/stats", Method: http.MethodGet, Name: GetAppStatsRequest},
+	{Path: "/v2/config/running_security_groups/:security_group_guid", Method: http.MethodDelete, Name: DeleteConfigRunningSecurityGroupRequest},
+	{Path: "/v2/config/running_security_groups/:security_group_guid", Method: http.MethodPut, Name: PutConfigRunningSecurityGroupRequest},
+	{Path: "/v2/config/staging_security_groups/:security_group_guid", Method: http.MethodDelete, Name: DeleteConfigStagingSecurityGroupRequest},
+	{Path: "/v2/config/staging_security_groups/:security_group_guid", Method: http.MethodPut, Name: PutConfigStagingSecurityGroupRequest},
+	{Path: "/v2/events", Method: http.MethodGet, Name: GetEventsRequest},
 	{Path: "/v2/info", Method: http.MethodGet, Name: GetInfoRequest},
 	{Path: "/v2/jobs/:job_guid", Method: http.MethodGet, Name: GetJobRequest},
 	{Path: "/v2/organizations", Method: http.MethodGet, Name: GetOrganizationsRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodGet, Name: GetOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid/private_domains", Method: http.MethodGet, Name: GetOrganizationPrivateDomainsRequest},
+	{Path: "/v2/organizations/:organization_guid/:role", Method: http.MethodGet, Name: GetOrganizationUsersByRoleRequest},
+	{Path: "/v2/organizations/:organization_guid/:role", Method: http.MethodPut, Name: PutOrganizationUserByRoleRequest},
+	{Path: "/v2/organizations/:organization_guid/:role/:user_guid", Method: http.MethodDelete, Name: DeleteOrganizationUserByRoleRequest},
 	{Path: "/v2/private_domains/:private_domain_guid", Method: http.MethodGet, Name: GetPrivateDomainRequest},
 	{Path: "/v2/quota_definitions/:organization_quota_guid", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionRequest},
 	{Path: "/v2/resource_match", Method: http.MethodPut, Name: PutResourceMatch},
@@ -97,6 +132,8 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/routes/:route_guid/route_mappings", Method: http.MethodGet, Name: GetRouteRouteMappingsRequest},
 	{Path: "/v2/routes/reserved/domain/:domain_guid", Method: http.MethodGet, Name: GetRouteReservedRequest},
 	{Path: "/v2/security_groups", Method: http.MethodGet, Name: GetSecurityGroupsRequest},
+	{Path: "/v2/security_groups", Method: http.MethodPost, Name: PostSecurityGroupRequest},
+	{Path: "/v2/security_groups/:security_group_guid", Method: http.MethodPut, Name: PutSecurityGroupRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces", Method: http.MethodGet, Name: GetSecurityGroupRunningSpacesRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteRunningSecurityGroupSpaceRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces/:space_guid", Method: http.MethodPut, Name: PutRunningSecurityGroupSpaceRequest},
@@ -106,8 +143,21 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/service_bindings", Method: http.MethodGet, Name: GetServiceBindingsRequest},
 	{Path: "/v2/service_bindings", Method: http.MethodPost, Name: PostServiceBindingRequest},
 	{Path: "/v2/service_bindings/:service_binding_guid", Method: http.MethodDelete, Name: DeleteServiceBindingRequest},
+	{Path: "/v2/service_bindings/:service_binding_guid/parameters", Method: http.MethodGet, Name: GetServiceBindingParametersRequest},
 	{Path: "/v2/service_instances", Method: http.MethodGet, Name: GetServiceInstancesRequest},
+	{Path: "/v2/service_instances", Method: http.MethodPost, Name: PostServiceInstancesRequest},
+	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodDelete, Name: DeleteServiceInstanceRequest},
 	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodGet, Name: GetServiceInstanceRequest},
+	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodPut, Name: PutServiceInstanceRequest},
+	{Path: "/v2/service_instances/:service_instance_guid/shared_from", Method: http.MethodGet, Name: GetServiceInstanceSharedFromRequest},
+	{Path: "/v2/service_instances/:service_instance_guid/shared_to", Method: http.MethodGet, Name: GetServiceInstanceSharedToRequest},
+	{Path: "/v2/service_keys", Method: http.MethodGet, Name: GetServiceKeysRequest},
+	{Path: "/v2/service_keys", Method: http.MethodPost, Name: PostServiceKeyRequest},
+	{Path: "/v2/service_keys/:service_key_guid", Method: http.MethodDelete, Name: DeleteServiceKeyRequest},
+	{Path: "/v2/service_keys/:service_key_guid/parameters", Method: http.MethodGet, Name: GetServiceKeyParametersRequest},
+	{Path: "/v2/service_plans", Method: http.MethodGet, Name: GetServicePlansRequest},
+	{Path: "/v2/service_plans/:service_plan_guid", Method: http.MethodGet, Name: GetServicePlanRequest},
+	{Path: "/v2/services/:service_guid", Method: http.MethodGet, Name: GetServiceRequest},
 	{Path: "/v2/shared_domains", Method: http.MethodGet, Name: GetSharedDomainsRequest},
 	{Path: "/v2/shared_domains/:shared_domain_guid", Method: http.MethodGet, Name: GetSharedDomainRequest},
 	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodGet, Name: GetSpaceQuotaDefinitionRequest},
@@ -116,7 +166,11 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteSpaceRequest},
 	{Path: "/v2/spaces/:space_guid/routes", Method: http.MethodGet, Name: GetSpaceRoutesRequest},
 	{Path: "/v2/spaces/:space_guid/security_groups", Method: http.MethodGet, Name: GetSpaceRunningSecurityGroupsRequest},
+	{Path: "/v2/spaces/:space_guid/services", Method: http.MethodGet, Name: GetSpaceServicesRequest},
 	{Path: "/v2/spaces/:space_guid/staging_security_groups", Method: http.MethodGet, Name: GetSpaceStagingSecurityGroupsRequest},
+	{Path: "/v2/spaces/:space_guid/:role", Method: http.MethodGet, Name: GetSpaceUsersByRoleRequest},
+	{Path: "/v2/spaces/:space_guid/:role", Method: http.MethodPut, Name: PutSpaceUserByRoleRequest},
+	{Path: "/v2/spaces/:space_guid/:role/:user_guid", Method: http.MethodDelete, Name: DeleteSpaceUserByRoleRequest},
 	{Path: "/v2/stacks", Method: http.MethodGet, Name: GetStacksRequest},
 	{Path: "/v2/stacks/:stack_guid", Method: http.MethodGet, Name: GetStackRequest},
 	{Path: "/v2/users", Method: http.MethodPost, Name: PostUserRequest},
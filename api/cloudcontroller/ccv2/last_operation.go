@@ -0,0 +1,39 @@
+package ccv2
+
+// LastOperationState is the state of the last operation requested on a
+// service instance.
+type LastOperationState string
+
+const (
+	// LastOperationInProgress is the state of an operation the service broker
+	// has not finished yet.
+	LastOperationInProgress LastOperationState = "in progress"
+
+	// LastOperationSucceeded is the state of an operation that completed
+	// successfully.
+	LastOperationSucceeded LastOperationState = "succeeded"
+
+	// LastOperationFailed is the state of an operation that the service broker
+	// could not complete.
+	LastOperationFailed LastOperationState = "failed"
+)
+
+// LastOperation is the status of the last create, update or delete requested
+// on a service instance.
+type LastOperation struct {
+	// Type is the kind of operation: create, update or delete.
+	Type string `json:"type"`
+
+	// State is the state of the operation.
+	State LastOperationState `json:"state"`
+
+	// Description is the message the service broker provided about the
+	// operation.
+	Description string `json:"description"`
+
+	// CreatedAt is the time the operation was started.
+	CreatedAt string `json:"created_at"`
+
+	// UpdatedAt is the time the operation was last updated.
+	UpdatedAt string `json:"updated_at"`
+}
@@ -5,12 +5,22 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // OrganizationQuota is the definition of a quota for an organization.
 type OrganizationQuota struct {
 	GUID string
 	Name string
+
+	// AppInstanceLimit, AppTaskLimit, MemoryLimit, TotalRoutes and
+	// TotalServices are the limits of the quota. They are not set when the
+	// resource is unlimited. MemoryLimit is in megabytes.
+	AppInstanceLimit types.NullInt
+	AppTaskLimit     types.NullInt
+	MemoryLimit      types.NullInt
+	TotalRoutes      types.NullInt
+	TotalServices    types.NullInt
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller organization quota response.
@@ -18,7 +28,12 @@ func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
 	var ccOrgQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name             string `json:"name"`
+			AppInstanceLimit *int   `json:"app_instance_limit"`
+			AppTaskLimit     *int   `json:"app_task_limit"`
+			MemoryLimit      *int   `json:"memory_limit"`
+			TotalRoutes      *int   `json:"total_routes"`
+			TotalServices    *int   `json:"total_services"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccOrgQuota); err != nil {
@@ -27,6 +42,11 @@ func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
 
 	application.GUID = ccOrgQuota.Metadata.GUID
 	application.Name = ccOrgQuota.Entity.Name
+	application.AppInstanceLimit = quotaLimit(ccOrgQuota.Entity.AppInstanceLimit)
+	application.AppTaskLimit = quotaLimit(ccOrgQuota.Entity.AppTaskLimit)
+	application.MemoryLimit = quotaLimit(ccOrgQuota.Entity.MemoryLimit)
+	application.TotalRoutes = quotaLimit(ccOrgQuota.Entity.TotalRoutes)
+	application.TotalServices = quotaLimit(ccOrgQuota.Entity.TotalServices)
 
 	return nil
 }
@@ -49,3 +69,12 @@ func (client *Client) GetOrganizationQuota(guid string) (OrganizationQuota, Warn
 	err = client.connection.Make(request, &response)
 	return orgQuota, response.Warnings, err
 }
+
+// quotaLimit converts a quota limit from the Cloud Controller, which uses -1
+// or null for unlimited resources.
+func quotaLimit(limit *int) types.NullInt {
+	if limit == nil || *limit == -1 {
+		return types.NullInt{}
+	}
+	return types.NullInt{Value: *limit, IsSet: true}
+}
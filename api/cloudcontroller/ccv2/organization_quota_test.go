@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -26,7 +27,12 @@ var _ = Describe("OrganizationQuota", func() {
 					"guid": "some-org-quota-guid"
 				},
 				"entity": {
-					"name": "some-org-quota"
+					"name": "some-org-quota",
+					"memory_limit": 10240,
+					"total_routes": 1000,
+					"total_services": -1,
+					"app_instance_limit": 25,
+					"app_task_limit": -1
 				}
 			}`
 				server.AppendHandlers(
@@ -42,8 +48,11 @@ var _ = Describe("OrganizationQuota", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(Equal(Warnings{"warning-1"}))
 				Expect(orgQuota).To(Equal(OrganizationQuota{
-					GUID: "some-org-quota-guid",
-					Name: "some-org-quota",
+					GUID:             "some-org-quota-guid",
+					Name:             "some-org-quota",
+					AppInstanceLimit: types.NullInt{Value: 25, IsSet: true},
+					MemoryLimit:      types.NullInt{Value: 10240, IsSet: true},
+					TotalRoutes:      types.NullInt{Value: 1000, IsSet: true},
 				}))
 			})
 		})
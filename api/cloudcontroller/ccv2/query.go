@@ -20,20 +20,37 @@ const (
 	OrganizationGUIDFilter QueryFilter = "organization_guid"
 	// RouteGUIDFilter is the name of the 'route_guid' filter.
 	RouteGUIDFilter QueryFilter = "route_guid"
+	// ServiceGUIDFilter is the name of the 'service_guid' filter.
+	ServiceGUIDFilter QueryFilter = "service_guid"
 	// ServiceInstanceGUIDFilter is the name of the 'service_instance_guid' filter.
 	ServiceInstanceGUIDFilter QueryFilter = "service_instance_guid"
 	// SpaceGUIDFilter is the name of the 'space_guid' filter.
 	SpaceGUIDFilter QueryFilter = "space_guid"
 
+	// ActeeTypeFilter is the name of the 'actee_type' filter.
+	ActeeTypeFilter QueryFilter = "actee_type"
+	// TimestampFilter is the name of the 'timestamp' filter.
+	TimestampFilter QueryFilter = "timestamp"
+	// TypeFilter is the name of the 'type' filter.
+	TypeFilter QueryFilter = "type"
+
 	// NameFilter is the name of the 'name' filter.
 	NameFilter QueryFilter = "name"
 	// HostFilter is the name of the 'host' filter.
 	HostFilter QueryFilter = "host"
+	// LabelFilter is the name of the 'label' filter.
+	LabelFilter QueryFilter = "label"
 )
 
 const (
 	// EqualOperator is the query equal operator.
 	EqualOperator QueryOperator = ":"
+	// GreaterThanOrEqualOperator is the query greater than or equal operator.
+	GreaterThanOrEqualOperator QueryOperator = ">="
+	// LessThanOrEqualOperator is the query less than or equal operator.
+	LessThanOrEqualOperator QueryOperator = "<="
+	// InOperator is the query IN operator. Its value is a comma separated list.
+	InOperator QueryOperator = " IN "
 )
 
 // Query is a type of filter that can be passed to specific request to narrow
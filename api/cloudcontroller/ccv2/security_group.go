@@ -1,11 +1,13 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // SecurityGroupLifecycle represents the lifecycle phase of a security group
@@ -21,10 +23,16 @@ const (
 )
 
 type SecurityGroupRule struct {
+	// Code is the ICMP code of icmp rules.
+	Code        types.NullInt
 	Description string
 	Destination string
-	Ports       string
-	Protocol    string
+	// Log enables logging of the connections matching tcp, udp and all rules.
+	Log      bool
+	Ports    string
+	Protocol string
+	// Type is the ICMP type of icmp rules.
+	Type types.NullInt
 }
 
 type SecurityGroup struct {
@@ -43,10 +51,13 @@ func (securityGroup *SecurityGroup) UnmarshalJSON(data []byte) error {
 			GUID  string `json:"guid"`
 			Name  string `json:"name"`
 			Rules []struct {
+				Code        *int   `json:"code"`
 				Description string `json:"description"`
 				Destination string `json:"destination"`
+				Log         bool   `json:"log"`
 				Ports       string `json:"ports"`
 				Protocol    string `json:"protocol"`
+				Type        *int   `json:"type"`
 			} `json:"rules"`
 			RunningDefault bool `json:"running_default"`
 			StagingDefault bool `json:"staging_default"`
@@ -65,6 +76,13 @@ func (securityGroup *SecurityGroup) UnmarshalJSON(data []byte) error {
 		securityGroup.Rules[i].Destination = ccRule.Destination
 		securityGroup.Rules[i].Ports = ccRule.Ports
 		securityGroup.Rules[i].Protocol = ccRule.Protocol
+		securityGroup.Rules[i].Log = ccRule.Log
+		if ccRule.Code != nil {
+			securityGroup.Rules[i].Code = types.NullInt{Value: *ccRule.Code, IsSet: true}
+		}
+		if ccRule.Type != nil {
+			securityGroup.Rules[i].Type = types.NullInt{Value: *ccRule.Type, IsSet: true}
+		}
 	}
 	securityGroup.RunningDefault = ccSecurityGroup.Entity.RunningDefault
 	securityGroup.StagingDefault = ccSecurityGroup.Entity.StagingDefault
@@ -109,6 +127,85 @@ func (client *Client) AssociateSpaceWithStagingSecurityGroup(securityGroupGUID s
 	return response.Warnings, err
 }
 
+// securityGroupRequestBody represents the body of the security group create
+// and update requests.
+type securityGroupRequestBody struct {
+	Name  string                         `json:"name,omitempty"`
+	Rules []securityGroupRuleRequestBody `json:"rules"`
+}
+
+type securityGroupRuleRequestBody struct {
+	Code        *int   `json:"code,omitempty"`
+	Description string `json:"description,omitempty"`
+	Destination string `json:"destination"`
+	Log         bool   `json:"log,omitempty"`
+	Ports       string `json:"ports,omitempty"`
+	Protocol    string `json:"protocol"`
+	Type        *int   `json:"type,omitempty"`
+}
+
+func newSecurityGroupRequestBody(name string, rules []SecurityGroupRule) securityGroupRequestBody {
+	body := securityGroupRequestBody{
+		Name:  name,
+		Rules: make([]securityGroupRuleRequestBody, len(rules)),
+	}
+	for i, rule := range rules {
+		body.Rules[i] = securityGroupRuleRequestBody{
+			Description: rule.Description,
+			Destination: rule.Destination,
+			Log:         rule.Log,
+			Ports:       rule.Ports,
+			Protocol:    rule.Protocol,
+		}
+		if rule.Code.IsSet {
+			code := rule.Code.Value
+			body.Rules[i].Code = &code
+		}
+		if rule.Type.IsSet {
+			ruleType := rule.Type.Value
+			body.Rules[i].Type = &ruleType
+		}
+	}
+	return body
+}
+
+// AssociateRunningSecurityGroupDefault enables the security group, specified
+// by its GUID, in the running lifecycle phase of every space.
+func (client *Client) AssociateRunningSecurityGroupDefault(securityGroupGUID string) (Warnings, error) {
+	return client.makeSecurityGroupDefaultRequest(internal.PutConfigRunningSecurityGroupRequest, securityGroupGUID)
+}
+
+// AssociateStagingSecurityGroupDefault enables the security group, specified
+// by its GUID, in the staging lifecycle phase of every space.
+func (client *Client) AssociateStagingSecurityGroupDefault(securityGroupGUID string) (Warnings, error) {
+	return client.makeSecurityGroupDefaultRequest(internal.PutConfigStagingSecurityGroupRequest, securityGroupGUID)
+}
+
+// CreateSecurityGroup creates a security group with the provided name and
+// rules.
+func (client *Client) CreateSecurityGroup(name string, rules []SecurityGroupRule) (SecurityGroup, Warnings, error) {
+	bodyBytes, err := json.Marshal(newSecurityGroupRequestBody(name, rules))
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSecurityGroupRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	var securityGroup SecurityGroup
+	response := cloudcontroller.Response{
+		Result: &securityGroup,
+	}
+
+	err = client.connection.Make(request, &response)
+	return securityGroup, response.Warnings, err
+}
+
 func (client *Client) GetSecurityGroups(queries []Query) ([]SecurityGroup, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.GetSecurityGroupsRequest,
@@ -173,6 +270,18 @@ func (client *Client) getSpaceSecurityGroupsBySpaceAndLifecycle(spaceGUID string
 	return securityGroupsList, warnings, err
 }
 
+// RemoveRunningSecurityGroupDefault stops enabling the security group,
+// specified by its GUID, in the running lifecycle phase of every space.
+func (client *Client) RemoveRunningSecurityGroupDefault(securityGroupGUID string) (Warnings, error) {
+	return client.makeSecurityGroupDefaultRequest(internal.DeleteConfigRunningSecurityGroupRequest, securityGroupGUID)
+}
+
+// RemoveStagingSecurityGroupDefault stops enabling the security group,
+// specified by its GUID, in the staging lifecycle phase of every space.
+func (client *Client) RemoveStagingSecurityGroupDefault(securityGroupGUID string) (Warnings, error) {
+	return client.makeSecurityGroupDefaultRequest(internal.DeleteConfigStagingSecurityGroupRequest, securityGroupGUID)
+}
+
 // RemoveSpaceRunningFromSecurityGroup disassociates a security group in the
 // running phase fo the lifecycle, specified by its GUID, from a space, which
 // is also specified by its GUID.
@@ -216,3 +325,44 @@ func (client *Client) RemoveSpaceFromStagingSecurityGroup(securityGroupGUID stri
 	err = client.connection.Make(request, &response)
 	return response.Warnings, err
 }
+
+// UpdateSecurityGroup replaces the rules of the security group specified by
+// its GUID.
+func (client *Client) UpdateSecurityGroup(securityGroupGUID string, rules []SecurityGroupRule) (SecurityGroup, Warnings, error) {
+	bodyBytes, err := json.Marshal(newSecurityGroupRequestBody("", rules))
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSecurityGroupRequest,
+		URIParams:   Params{"security_group_guid": securityGroupGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	var securityGroup SecurityGroup
+	response := cloudcontroller.Response{
+		Result: &securityGroup,
+	}
+
+	err = client.connection.Make(request, &response)
+	return securityGroup, response.Warnings, err
+}
+
+func (client *Client) makeSecurityGroupDefaultRequest(requestName string, securityGroupGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   Params{"security_group_guid": securityGroupGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
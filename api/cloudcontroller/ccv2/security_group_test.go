@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -17,6 +18,102 @@ var _ = Describe("Security Groups", func() {
 		client = NewTestClient()
 	})
 
+	Describe("AssociateRunningSecurityGroupDefault", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/running_security_groups/security-group-guid"),
+						RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns all warnings", func() {
+				warnings, err := client.AssociateRunningSecurityGroupDefault("security-group-guid")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 10001,
+  "description": "Some Error",
+  "error_code": "CF-SomeError"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/running_security_groups/security-group-guid"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				warnings, err := client.AssociateRunningSecurityGroupDefault("security-group-guid")
+
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
+	Describe("AssociateStagingSecurityGroupDefault", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/staging_security_groups/security-group-guid"),
+						RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns all warnings", func() {
+				warnings, err := client.AssociateStagingSecurityGroupDefault("security-group-guid")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 10001,
+  "description": "Some Error",
+  "error_code": "CF-SomeError"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/staging_security_groups/security-group-guid"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				warnings, err := client.AssociateStagingSecurityGroupDefault("security-group-guid")
+
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
 	Describe("AssociateSpaceWithRunningSecurityGroup", func() {
 		Context("when no errors are encountered", func() {
 			BeforeEach(func() {
@@ -115,6 +212,87 @@ var _ = Describe("Security Groups", func() {
 		})
 	})
 
+	Describe("CreateSecurityGroup", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"name": "some-security-group",
+					"rules": []map[string]interface{}{
+						{"protocol": "tcp", "destination": "10.0.0.0/24", "ports": "443", "log": true, "description": "https"},
+						{"protocol": "icmp", "destination": "10.0.0.1", "type": 0, "code": -1},
+					},
+				}
+				response := `{
+					"metadata": {
+						"guid": "security-group-guid"
+					},
+					"entity": {
+						"name": "some-security-group",
+						"rules": [
+							{"protocol": "tcp", "destination": "10.0.0.0/24", "ports": "443", "log": true, "description": "https"},
+							{"protocol": "icmp", "destination": "10.0.0.1", "type": 0, "code": -1}
+						],
+						"running_default": false,
+						"staging_default": false
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/security_groups"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the created security group and all warnings", func() {
+				securityGroup, warnings, err := client.CreateSecurityGroup("some-security-group", []SecurityGroupRule{
+					{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443", Log: true, Description: "https"},
+					{Protocol: "icmp", Destination: "10.0.0.1", Type: types.NullInt{Value: 0, IsSet: true}, Code: types.NullInt{Value: -1, IsSet: true}},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(securityGroup).To(Equal(SecurityGroup{
+					GUID: "security-group-guid",
+					Name: "some-security-group",
+					Rules: []SecurityGroupRule{
+						{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443", Log: true, Description: "https"},
+						{Protocol: "icmp", Destination: "10.0.0.1", Type: types.NullInt{Value: 0, IsSet: true}, Code: types.NullInt{Value: -1, IsSet: true}},
+					},
+				}))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 300005,
+  "description": "The security group is invalid",
+  "error_code": "CF-SecurityGroupInvalid"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/security_groups"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				_, warnings, err := client.CreateSecurityGroup("some-security-group", nil)
+
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        300005,
+						Description: "The security group is invalid",
+						ErrorCode:   "CF-SecurityGroupInvalid",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
 	Describe("GetSecurityGroups", func() {
 		Context("when no errors are encountered", func() {
 			Context("when results are paginated", func() {
@@ -606,6 +784,102 @@ var _ = Describe("Security Groups", func() {
 		})
 	})
 
+	Describe("RemoveRunningSecurityGroupDefault", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/config/running_security_groups/security-group-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns all warnings", func() {
+				warnings, err := client.RemoveRunningSecurityGroupDefault("security-group-guid")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 10001,
+  "description": "Some Error",
+  "error_code": "CF-SomeError"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/config/running_security_groups/security-group-guid"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				warnings, err := client.RemoveRunningSecurityGroupDefault("security-group-guid")
+
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
+	Describe("RemoveStagingSecurityGroupDefault", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/config/staging_security_groups/security-group-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns all warnings", func() {
+				warnings, err := client.RemoveStagingSecurityGroupDefault("security-group-guid")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 10001,
+  "description": "Some Error",
+  "error_code": "CF-SomeError"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/config/staging_security_groups/security-group-guid"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				warnings, err := client.RemoveStagingSecurityGroupDefault("security-group-guid")
+
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
 	Describe("RemoveSpaceFromRunningSecurityGroup", func() {
 		var (
 			warnings Warnings
@@ -711,4 +985,79 @@ var _ = Describe("Security Groups", func() {
 			})
 		})
 	})
+
+	Describe("UpdateSecurityGroup", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"rules": []map[string]interface{}{
+						{"protocol": "all", "destination": "0.0.0.0/0"},
+					},
+				}
+				response := `{
+					"metadata": {
+						"guid": "security-group-guid"
+					},
+					"entity": {
+						"name": "some-security-group",
+						"rules": [
+							{"protocol": "all", "destination": "0.0.0.0/0"}
+						],
+						"running_default": true,
+						"staging_default": false
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/security_groups/security-group-guid"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the updated security group and all warnings", func() {
+				securityGroup, warnings, err := client.UpdateSecurityGroup("security-group-guid", []SecurityGroupRule{
+					{Protocol: "all", Destination: "0.0.0.0/0"},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(securityGroup).To(Equal(SecurityGroup{
+					GUID:           "security-group-guid",
+					Name:           "some-security-group",
+					Rules:          []SecurityGroupRule{{Protocol: "all", Destination: "0.0.0.0/0"}},
+					RunningDefault: true,
+				}))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 10001,
+  "description": "Some Error",
+  "error_code": "CF-SomeError"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/security_groups/security-group-guid"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				_, warnings, err := client.UpdateSecurityGroup("security-group-guid", nil)
+
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
 })
@@ -0,0 +1,97 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Service represents a Cloud Controller Service, the offering a service
+// broker advertises in the marketplace.
+type Service struct {
+	GUID             string
+	Label            string
+	Description      string
+	DocumentationURL string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service response.
+func (service *Service) UnmarshalJSON(data []byte) error {
+	var ccService struct {
+		Metadata internal.Metadata
+		Entity   struct {
+			Label       string `json:"label"`
+			Description string `json:"description"`
+			Extra       string `json:"extra"`
+		}
+	}
+	err := json.Unmarshal(data, &ccService)
+	if err != nil {
+		return err
+	}
+
+	service.GUID = ccService.Metadata.GUID
+	service.Label = ccService.Entity.Label
+	service.Description = ccService.Entity.Description
+
+	// The broker's extra metadata is a JSON document encoded as a string; a
+	// broker that sends something else just has no documentation URL.
+	if ccService.Entity.Extra != "" {
+		var extra struct {
+			DocumentationURL string `json:"documentationUrl"`
+		}
+		if json.Unmarshal([]byte(ccService.Entity.Extra), &extra) == nil {
+			service.DocumentationURL = extra.DocumentationURL
+		}
+	}
+	return nil
+}
+
+// GetService returns the service with the given GUID.
+func (client *Client) GetService(serviceGUID string) (Service, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceRequest,
+		URIParams:   Params{"service_guid": serviceGUID},
+	})
+	if err != nil {
+		return Service{}, nil, err
+	}
+
+	var service Service
+	response := cloudcontroller.Response{
+		Result: &service,
+	}
+
+	err = client.connection.Make(request, &response)
+	return service, response.Warnings, err
+}
+
+// GetSpaceServices returns back a list of the services available in the
+// given space, based off of the provided queries.
+func (client *Client) GetSpaceServices(spaceGUID string, queries []Query) ([]Service, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpaceServicesRequest,
+		URIParams:   Params{"space_guid": spaceGUID},
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServicesList []Service
+	warnings, err := client.paginate(request, Service{}, func(item interface{}) error {
+		if service, ok := item.(Service); ok {
+			fullServicesList = append(fullServicesList, service)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Service{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServicesList, warnings, err
+}
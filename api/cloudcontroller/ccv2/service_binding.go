@@ -11,9 +11,15 @@ import (
 
 // ServiceBinding represents a Cloud Controller Service Binding.
 type ServiceBinding struct {
-	AppGUID             string
+	AppGUID string
+
+	// Credentials are the credentials the service broker returned for the
+	// binding. They are injected into the application's VCAP_SERVICES.
+	Credentials map[string]interface{}
+
 	GUID                string
 	ServiceInstanceGUID string
+	SyslogDrainURL      string
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Binding response.
@@ -21,8 +27,10 @@ func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	var ccServiceBinding struct {
 		Metadata internal.Metadata
 		Entity   struct {
-			AppGUID             string `json:"app_guid"`
-			ServiceInstanceGUID string `json:"service_instance_guid"`
+			AppGUID             string                 `json:"app_guid"`
+			Credentials         map[string]interface{} `json:"credentials"`
+			ServiceInstanceGUID string                 `json:"service_instance_guid"`
+			SyslogDrainURL      string                 `json:"syslog_drain_url"`
 		} `json:"entity"`
 	}
 	err := json.Unmarshal(data, &ccServiceBinding)
@@ -31,8 +39,10 @@ func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	}
 
 	serviceBinding.AppGUID = ccServiceBinding.Entity.AppGUID
+	serviceBinding.Credentials = ccServiceBinding.Entity.Credentials
 	serviceBinding.GUID = ccServiceBinding.Metadata.GUID
 	serviceBinding.ServiceInstanceGUID = ccServiceBinding.Entity.ServiceInstanceGUID
+	serviceBinding.SyslogDrainURL = ccServiceBinding.Entity.SyslogDrainURL
 	return nil
 }
 
@@ -105,6 +115,26 @@ func (client *Client) GetServiceBindings(queries []Query) ([]ServiceBinding, War
 	return fullBindingsList, warnings, err
 }
 
+// GetServiceBindingParameters returns the configuration parameters the
+// service binding was created with, as reported by the service broker.
+func (client *Client) GetServiceBindingParameters(serviceBindingGUID string) (map[string]interface{}, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceBindingParametersRequest,
+		URIParams:   Params{"service_binding_guid": serviceBindingGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var parameters map[string]interface{}
+	response := cloudcontroller.Response{
+		Result: &parameters,
+	}
+
+	err = client.connection.Make(request, &response)
+	return parameters, response.Warnings, err
+}
+
 // DeleteServiceBinding will destroy the requested Service Binding.
 func (client *Client) DeleteServiceBinding(serviceBindingGUID string) (Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -1,7 +1,9 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
+	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -21,10 +23,14 @@ const (
 
 // ServiceInstance represents a Cloud Controller Service Instance.
 type ServiceInstance struct {
-	GUID      string
-	Name      string
-	SpaceGUID string
-	Type      ServiceInstanceType
+	GUID            string
+	Name            string
+	SpaceGUID       string
+	ServicePlanGUID string
+	Type            ServiceInstanceType
+	Tags            []string
+	DashboardURL    string
+	LastOperation   LastOperation
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Instance response.
@@ -32,9 +38,13 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 	var ccServiceInstance struct {
 		Metadata internal.Metadata
 		Entity   struct {
-			Name      string `json:"name"`
-			SpaceGUID string `json:"space_guid"`
-			Type      string `json:"type"`
+			Name            string        `json:"name"`
+			SpaceGUID       string        `json:"space_guid"`
+			ServicePlanGUID string        `json:"service_plan_guid"`
+			Type            string        `json:"type"`
+			Tags            []string      `json:"tags"`
+			DashboardURL    string        `json:"dashboard_url"`
+			LastOperation   LastOperation `json:"last_operation"`
 		}
 	}
 	err := json.Unmarshal(data, &ccServiceInstance)
@@ -45,7 +55,11 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 	serviceInstance.GUID = ccServiceInstance.Metadata.GUID
 	serviceInstance.Name = ccServiceInstance.Entity.Name
 	serviceInstance.SpaceGUID = ccServiceInstance.Entity.SpaceGUID
+	serviceInstance.ServicePlanGUID = ccServiceInstance.Entity.ServicePlanGUID
 	serviceInstance.Type = ServiceInstanceType(ccServiceInstance.Entity.Type)
+	serviceInstance.Tags = ccServiceInstance.Entity.Tags
+	serviceInstance.DashboardURL = ccServiceInstance.Entity.DashboardURL
+	serviceInstance.LastOperation = ccServiceInstance.Entity.LastOperation
 	return nil
 }
 
@@ -60,6 +74,118 @@ func (serviceInstance ServiceInstance) Managed() bool {
 	return serviceInstance.Type == ManagedService
 }
 
+// serviceInstanceCreateRequestBody represents the body of the service
+// instance create request.
+type serviceInstanceCreateRequestBody struct {
+	Name            string                 `json:"name"`
+	SpaceGUID       string                 `json:"space_guid"`
+	ServicePlanGUID string                 `json:"service_plan_guid"`
+	Parameters      map[string]interface{} `json:"parameters,omitempty"`
+	Tags            []string               `json:"tags,omitempty"`
+}
+
+// serviceInstanceUpdateRequestBody represents the body of the service
+// instance update request. Tags are only sent when they are being changed.
+type serviceInstanceUpdateRequestBody struct {
+	ServicePlanGUID string                 `json:"service_plan_guid,omitempty"`
+	Parameters      map[string]interface{} `json:"parameters,omitempty"`
+	Tags            *[]string              `json:"tags,omitempty"`
+}
+
+// CreateServiceInstance provisions a managed service instance of the given
+// plan in the given space. The Cloud Controller is told to accept
+// asynchronous provisioning; check the LastOperation of the returned service
+// instance to see whether the service broker is still working on it.
+func (client *Client) CreateServiceInstance(spaceGUID string, servicePlanGUID string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (ServiceInstance, Warnings, error) {
+	bodyBytes, err := json.Marshal(serviceInstanceCreateRequestBody{
+		Name:            serviceInstanceName,
+		SpaceGUID:       spaceGUID,
+		ServicePlanGUID: servicePlanGUID,
+		Parameters:      parameters,
+		Tags:            tags,
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostServiceInstancesRequest,
+		Query:       url.Values{"accepts_incomplete": {"true"}},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	var serviceInstance ServiceInstance
+	response := cloudcontroller.Response{
+		Result: &serviceInstance,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceInstance, response.Warnings, err
+}
+
+// UpdateServiceInstance changes the plan, parameters or tags of a managed
+// service instance. Empty plan GUIDs and nil parameters are left unchanged, as
+// are the tags when tags is nil. Like CreateServiceInstance, the update may
+// complete asynchronously.
+func (client *Client) UpdateServiceInstance(serviceInstanceGUID string, servicePlanGUID string, parameters map[string]interface{}, tags *[]string) (ServiceInstance, Warnings, error) {
+	bodyBytes, err := json.Marshal(serviceInstanceUpdateRequestBody{
+		ServicePlanGUID: servicePlanGUID,
+		Parameters:      parameters,
+		Tags:            tags,
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutServiceInstanceRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+		Query:       url.Values{"accepts_incomplete": {"true"}},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	var serviceInstance ServiceInstance
+	response := cloudcontroller.Response{
+		Result: &serviceInstance,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceInstance, response.Warnings, err
+}
+
+// DeleteServiceInstance deprovisions a managed service instance. When the
+// service broker deletes the instance asynchronously, the returned service
+// instance has a LastOperation in progress; otherwise it is empty.
+func (client *Client) DeleteServiceInstance(serviceInstanceGUID string) (ServiceInstance, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceInstanceRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+		Query:       url.Values{"accepts_incomplete": {"true"}},
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return ServiceInstance{}, response.Warnings, err
+	}
+
+	// Synchronous deletes respond with 204 No Content.
+	var serviceInstance ServiceInstance
+	if len(response.RawResponse) > 0 {
+		err = json.Unmarshal(response.RawResponse, &serviceInstance)
+	}
+	return serviceInstance, response.Warnings, err
+}
+
 // GetServiceInstance returns the service instance with the given GUID. This
 // service can be either a managed or user provided.
 func (client *Client) GetServiceInstance(serviceInstanceGUID string) (ServiceInstance, Warnings, error) {
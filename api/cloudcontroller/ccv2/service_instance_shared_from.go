@@ -0,0 +1,43 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ServiceInstanceSharedFrom represents the space a shared Service Instance
+// was created in.
+type ServiceInstanceSharedFrom struct {
+	SpaceGUID        string `json:"space_guid"`
+	SpaceName        string `json:"space_name"`
+	OrganizationName string `json:"organization_name"`
+}
+
+// GetServiceInstanceSharedFrom returns the space the service instance was
+// shared from. A service instance that has not been shared into another space
+// returns an empty ServiceInstanceSharedFrom.
+func (client *Client) GetServiceInstanceSharedFrom(serviceInstanceGUID string) (ServiceInstanceSharedFrom, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceInstanceSharedFromRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+	})
+	if err != nil {
+		return ServiceInstanceSharedFrom{}, nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return ServiceInstanceSharedFrom{}, response.Warnings, err
+	}
+
+	// The Cloud Controller responds with 204 No Content when the service
+	// instance lives in its own space.
+	var sharedFrom ServiceInstanceSharedFrom
+	if len(response.RawResponse) > 0 {
+		err = json.Unmarshal(response.RawResponse, &sharedFrom)
+	}
+	return sharedFrom, response.Warnings, err
+}
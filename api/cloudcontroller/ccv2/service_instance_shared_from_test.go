@@ -0,0 +1,92 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Instance Shared From", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServiceInstanceSharedFrom", func() {
+		Context("when the service instance was shared from another space", func() {
+			BeforeEach(func() {
+				response := `{
+					"space_guid": "some-space-guid",
+					"space_name": "some-space",
+					"organization_name": "some-org"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/shared_from"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the space it was shared from and warnings", func() {
+				sharedFrom, warnings, err := client.GetServiceInstanceSharedFrom("some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(sharedFrom).To(Equal(ServiceInstanceSharedFrom{
+					SpaceGUID:        "some-space-guid",
+					SpaceName:        "some-space",
+					OrganizationName: "some-org",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service instance was not shared", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/shared_from"),
+						RespondWith(http.StatusNoContent, nil, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an empty ServiceInstanceSharedFrom and warnings", func() {
+				sharedFrom, warnings, err := client.GetServiceInstanceSharedFrom("some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(sharedFrom).To(Equal(ServiceInstanceSharedFrom{}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 60004,
+					"description": "The service instance could not be found: some-service-instance-guid",
+					"error_code": "CF-ServiceInstanceNotFound"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/shared_from"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetServiceInstanceSharedFrom("some-service-instance-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The service instance could not be found: some-service-instance-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
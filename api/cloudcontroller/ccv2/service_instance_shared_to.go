@@ -0,0 +1,45 @@
+package ccv2
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ServiceInstanceSharedTo represents a space a Service Instance has been
+// shared with.
+type ServiceInstanceSharedTo struct {
+	SpaceGUID        string `json:"space_guid"`
+	SpaceName        string `json:"space_name"`
+	OrganizationName string `json:"organization_name"`
+
+	// BoundAppCount is the number of apps in the space bound to the service
+	// instance.
+	BoundAppCount int `json:"bound_app_count"`
+}
+
+// GetServiceInstanceSharedTos returns the spaces the service instance has
+// been shared with.
+func (client *Client) GetServiceInstanceSharedTos(serviceInstanceGUID string) ([]ServiceInstanceSharedTo, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceInstanceSharedToRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullSharedToList []ServiceInstanceSharedTo
+	warnings, err := client.paginate(request, ServiceInstanceSharedTo{}, func(item interface{}) error {
+		if sharedTo, ok := item.(ServiceInstanceSharedTo); ok {
+			fullSharedToList = append(fullSharedToList, sharedTo)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServiceInstanceSharedTo{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullSharedToList, warnings, err
+}
@@ -119,6 +119,206 @@ var _ = Describe("Service Instance", func() {
 		})
 	})
 
+	Describe("CreateServiceInstance", func() {
+		Context("when the create is accepted", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-instance-guid"
+					},
+					"entity": {
+						"name": "some-service-instance",
+						"space_guid": "some-space-guid",
+						"service_plan_guid": "some-plan-guid",
+						"type": "managed_service_instance",
+						"tags": ["tag-1", "tag-2"],
+						"last_operation": {
+							"type": "create",
+							"state": "in progress",
+							"description": "provisioning",
+							"updated_at": "2017-09-01T00:00:00Z"
+						}
+					}
+				}`
+				requestBody := map[string]interface{}{
+					"name":              "some-service-instance",
+					"space_guid":        "some-space-guid",
+					"service_plan_guid": "some-plan-guid",
+					"parameters":        map[string]interface{}{"some-key": "some-value"},
+					"tags":              []string{"tag-1", "tag-2"},
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_instances", "accepts_incomplete=true"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service instance with its last operation and warnings", func() {
+				serviceInstance, warnings, err := client.CreateServiceInstance("some-space-guid", "some-plan-guid", "some-service-instance", map[string]interface{}{"some-key": "some-value"}, []string{"tag-1", "tag-2"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(serviceInstance).To(Equal(ServiceInstance{
+					GUID:            "some-service-instance-guid",
+					Name:            "some-service-instance",
+					SpaceGUID:       "some-space-guid",
+					ServicePlanGUID: "some-plan-guid",
+					Type:            ManagedService,
+					Tags:            []string{"tag-1", "tag-2"},
+					LastOperation: LastOperation{
+						Type:        "create",
+						State:       LastOperationInProgress,
+						Description: "provisioning",
+						UpdatedAt:   "2017-09-01T00:00:00Z",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the name is already taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 60002,
+					"description": "The service instance name is taken: some-service-instance",
+					"error_code": "CF-ServiceInstanceNameTaken"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_instances", "accepts_incomplete=true"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ServiceInstanceNameTakenError and warnings", func() {
+				_, warnings, err := client.CreateServiceInstance("some-space-guid", "some-plan-guid", "some-service-instance", nil, nil)
+				Expect(err).To(MatchError(ccerror.ServiceInstanceNameTakenError{Message: "The service instance name is taken: some-service-instance"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("UpdateServiceInstance", func() {
+		var (
+			requestBody map[string]interface{}
+			tags        *[]string
+		)
+
+		JustBeforeEach(func() {
+			response := `{
+				"metadata": {
+					"guid": "some-service-instance-guid"
+				},
+				"entity": {
+					"name": "some-service-instance",
+					"last_operation": {
+						"type": "update",
+						"state": "succeeded"
+					}
+				}
+			}`
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/service_instances/some-service-instance-guid", "accepts_incomplete=true"),
+					VerifyJSONRepresenting(requestBody),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		Context("when tags are given", func() {
+			BeforeEach(func() {
+				tags = &[]string{}
+				requestBody = map[string]interface{}{
+					"service_plan_guid": "some-plan-guid",
+					"tags":              []string{},
+				}
+			})
+
+			It("sends the tags, even when clearing them", func() {
+				serviceInstance, warnings, err := client.UpdateServiceInstance("some-service-instance-guid", "some-plan-guid", nil, tags)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(serviceInstance.LastOperation.State).To(Equal(LastOperationSucceeded))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when tags are not given", func() {
+			BeforeEach(func() {
+				tags = nil
+				requestBody = map[string]interface{}{
+					"parameters": map[string]interface{}{"some-key": "some-value"},
+				}
+			})
+
+			It("leaves the tags alone", func() {
+				_, _, err := client.UpdateServiceInstance("some-service-instance-guid", "", map[string]interface{}{"some-key": "some-value"}, tags)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("DeleteServiceInstance", func() {
+		Context("when the service broker deletes the instance asynchronously", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-instance-guid"
+					},
+					"entity": {
+						"name": "some-service-instance",
+						"last_operation": {
+							"type": "delete",
+							"state": "in progress"
+						}
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "accepts_incomplete=true"),
+						RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service instance with its last operation and warnings", func() {
+				serviceInstance, warnings, err := client.DeleteServiceInstance("some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(serviceInstance.GUID).To(Equal("some-service-instance-guid"))
+				Expect(serviceInstance.LastOperation).To(Equal(LastOperation{Type: "delete", State: LastOperationInProgress}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service instance is deleted immediately", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "accepts_incomplete=true"),
+						RespondWith(http.StatusNoContent, nil, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an empty service instance and warnings", func() {
+				serviceInstance, warnings, err := client.DeleteServiceInstance("some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(serviceInstance).To(Equal(ServiceInstance{}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetServiceInstance", func() {
 		BeforeEach(func() {
 			response := `{
@@ -128,7 +328,8 @@ var _ = Describe("Service Instance", func() {
 				"entity": {
 					"name": "some-service-name",
 					"space_guid": "some-space-guid",
-					"type": "managed_service_instance"
+					"type": "managed_service_instance",
+					"dashboard_url": "https://dashboard.example.com"
 				}
 			}`
 
@@ -146,10 +347,11 @@ var _ = Describe("Service Instance", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(serviceInstance).To(Equal(ServiceInstance{
-					Name:      "some-service-name",
-					GUID:      "some-service-guid",
-					SpaceGUID: "some-space-guid",
-					Type:      ManagedService,
+					Name:         "some-service-name",
+					GUID:         "some-service-guid",
+					SpaceGUID:    "some-space-guid",
+					Type:         ManagedService,
+					DashboardURL: "https://dashboard.example.com",
 				}))
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 			})
@@ -0,0 +1,181 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Key", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateServiceKey", func() {
+		Context("when the create is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-key-guid"
+					},
+					"entity": {
+						"name": "some-key",
+						"service_instance_guid": "some-service-instance-guid",
+						"credentials": {
+							"username": "some-user"
+						}
+					}
+				}`
+				requestBody := map[string]interface{}{
+					"service_instance_guid": "some-service-instance-guid",
+					"name":                  "some-key",
+					"parameters": map[string]interface{}{
+						"permissions": "read-only",
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_keys"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created key and warnings", func() {
+				serviceKey, warnings, err := client.CreateServiceKey("some-service-instance-guid", "some-key", map[string]interface{}{"permissions": "read-only"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(serviceKey).To(Equal(ServiceKey{
+					GUID:                "some-service-key-guid",
+					Name:                "some-key",
+					ServiceInstanceGUID: "some-service-instance-guid",
+					Credentials:         map[string]interface{}{"username": "some-user"},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the create returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 360001,
+					"description": "The service key name is taken: some-key",
+					"error_code": "CF-ServiceKeyNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_keys"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateServiceKey("some-service-instance-guid", "some-key", nil)
+				Expect(err).To(MatchError(ccerror.BadRequestError{Message: "The service key name is taken: some-key"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetServiceKeys", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/service_keys?q=service_instance_guid:some-service-instance-guid&page=2",
+				"resources": [
+					{
+						"metadata": {
+							"guid": "service-key-guid-1"
+						},
+						"entity": {
+							"name": "key-1",
+							"service_instance_guid": "some-service-instance-guid"
+						}
+					}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "service-key-guid-2"
+						},
+						"entity": {
+							"name": "key-2",
+							"service_instance_guid": "some-service-instance-guid"
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_keys", "q=service_instance_guid:some-service-instance-guid"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_keys", "q=service_instance_guid:some-service-instance-guid&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the queried service keys", func() {
+			serviceKeys, warnings, err := client.GetServiceKeys([]Query{{
+				Filter:   ServiceInstanceGUIDFilter,
+				Operator: EqualOperator,
+				Value:    "some-service-instance-guid",
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceKeys).To(ConsistOf(
+				ServiceKey{GUID: "service-key-guid-1", Name: "key-1", ServiceInstanceGUID: "some-service-instance-guid"},
+				ServiceKey{GUID: "service-key-guid-2", Name: "key-2", ServiceInstanceGUID: "some-service-instance-guid"},
+			))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+		})
+	})
+
+	Describe("GetServiceKeyParameters", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_keys/some-service-key-guid/parameters"),
+					RespondWith(http.StatusOK, `{"permissions": "read-only"}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the parameters and warnings", func() {
+			parameters, warnings, err := client.GetServiceKeyParameters("some-service-key-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parameters).To(Equal(map[string]interface{}{"permissions": "read-only"}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	Describe("DeleteServiceKey", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v2/service_keys/some-service-key-guid"),
+					RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("deletes the service key", func() {
+			warnings, err := client.DeleteServiceKey("some-service-key-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+})
@@ -0,0 +1,115 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Plan", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServicePlan", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {
+					"guid": "some-plan-guid"
+				},
+				"entity": {
+					"name": "some-plan",
+					"service_guid": "some-service-guid",
+					"free": true
+				}
+			}`
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_plans/some-plan-guid"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the service plan and warnings", func() {
+			plan, warnings, err := client.GetServicePlan("some-plan-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(plan).To(Equal(ServicePlan{
+				GUID:        "some-plan-guid",
+				Name:        "some-plan",
+				ServiceGUID: "some-service-guid",
+				Free:        true,
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	Describe("GetServicePlans", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/service_plans?q=service_guid:some-service-guid&page=2",
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-plan-guid-1"
+						},
+						"entity": {
+							"name": "some-plan-1",
+							"service_guid": "some-service-guid",
+							"free": true
+						}
+					}
+				]
+			}`
+
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-plan-guid-2"
+						},
+						"entity": {
+							"name": "some-plan-2",
+							"service_guid": "some-service-guid",
+							"free": false
+						}
+					}
+				]
+			}`
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_plans", "q=service_guid:some-service-guid"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_plans", "q=service_guid:some-service-guid&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the queried service plans and warnings", func() {
+			plans, warnings, err := client.GetServicePlans([]Query{{
+				Filter:   ServiceGUIDFilter,
+				Operator: EqualOperator,
+				Value:    "some-service-guid",
+			}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(plans).To(ConsistOf(
+				ServicePlan{GUID: "some-plan-guid-1", Name: "some-plan-1", ServiceGUID: "some-service-guid", Free: true},
+				ServicePlan{GUID: "some-plan-guid-2", Name: "some-plan-2", ServiceGUID: "some-service-guid", Free: false},
+			))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+		})
+	})
+})
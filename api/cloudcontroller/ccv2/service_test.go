@@ -0,0 +1,137 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetService", func() {
+		Context("when the service exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-guid"
+					},
+					"entity": {
+						"label": "some-service",
+						"description": "some description",
+						"extra": "{\"documentationUrl\":\"https://example.com/docs\"}"
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/services/some-service-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service and warnings", func() {
+				service, warnings, err := client.GetService("some-service-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(service).To(Equal(Service{
+					GUID:             "some-service-guid",
+					Label:            "some-service",
+					Description:      "some description",
+					DocumentationURL: "https://example.com/docs",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 120003,
+					"description": "The service could not be found: some-service-guid",
+					"error_code": "CF-ServiceNotFound"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/services/some-service-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetService("some-service-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The service could not be found: some-service-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetSpaceServices", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/spaces/some-space-guid/services?q=label:some-service&page=2",
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-service-guid-1"
+						},
+						"entity": {
+							"label": "some-service"
+						}
+					}
+				]
+			}`
+
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-service-guid-2"
+						},
+						"entity": {
+							"label": "some-service"
+						}
+					}
+				]
+			}`
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid/services", "q=label:some-service"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid/services", "q=label:some-service&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the queried services and warnings", func() {
+			services, warnings, err := client.GetSpaceServices("some-space-guid", []Query{{
+				Filter:   LabelFilter,
+				Operator: EqualOperator,
+				Value:    "some-service",
+			}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(services).To(ConsistOf(
+				Service{GUID: "some-service-guid-1", Label: "some-service"},
+				Service{GUID: "some-service-guid-2", Label: "some-service"},
+			))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+		})
+	})
+})
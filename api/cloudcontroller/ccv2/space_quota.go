@@ -5,11 +5,21 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 type SpaceQuota struct {
 	GUID string
 	Name string
+
+	// AppInstanceLimit, AppTaskLimit, MemoryLimit, TotalRoutes and
+	// TotalServices are the limits of the quota. They are not set when the
+	// resource is unlimited. MemoryLimit is in megabytes.
+	AppInstanceLimit types.NullInt
+	AppTaskLimit     types.NullInt
+	MemoryLimit      types.NullInt
+	TotalRoutes      types.NullInt
+	TotalServices    types.NullInt
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Space Quota response.
@@ -17,7 +27,12 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 	var ccSpaceQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name             string `json:"name"`
+			AppInstanceLimit *int   `json:"app_instance_limit"`
+			AppTaskLimit     *int   `json:"app_task_limit"`
+			MemoryLimit      *int   `json:"memory_limit"`
+			TotalRoutes      *int   `json:"total_routes"`
+			TotalServices    *int   `json:"total_services"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccSpaceQuota); err != nil {
@@ -26,6 +41,11 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 
 	spaceQuota.GUID = ccSpaceQuota.Metadata.GUID
 	spaceQuota.Name = ccSpaceQuota.Entity.Name
+	spaceQuota.AppInstanceLimit = quotaLimit(ccSpaceQuota.Entity.AppInstanceLimit)
+	spaceQuota.AppTaskLimit = quotaLimit(ccSpaceQuota.Entity.AppTaskLimit)
+	spaceQuota.MemoryLimit = quotaLimit(ccSpaceQuota.Entity.MemoryLimit)
+	spaceQuota.TotalRoutes = quotaLimit(ccSpaceQuota.Entity.TotalRoutes)
+	spaceQuota.TotalServices = quotaLimit(ccSpaceQuota.Entity.TotalServices)
 	return nil
 }
 
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -27,7 +28,12 @@ var _ = Describe("Space Quotas", func() {
 						"updated_at": null
 					},
 					"entity": {
-						"name": "space-quota"
+						"name": "space-quota",
+						"memory_limit": 2048,
+						"total_routes": -1,
+						"total_services": 10,
+						"app_instance_limit": -1,
+						"app_task_limit": 5
 					}
 				}`
 				server.AppendHandlers(
@@ -43,8 +49,11 @@ var _ = Describe("Space Quotas", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 				Expect(spaceQuota).To(Equal(SpaceQuota{
-					Name: "space-quota",
-					GUID: "space-quota-guid",
+					Name:          "space-quota",
+					GUID:          "space-quota-guid",
+					AppTaskLimit:  types.NullInt{Value: 5, IsSet: true},
+					MemoryLimit:   types.NullInt{Value: 2048, IsSet: true},
+					TotalServices: types.NullInt{Value: 10, IsSet: true},
 				}))
 			})
 		})
@@ -1,6 +1,7 @@
 package ccv2_test
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -98,6 +99,8 @@ var _ = Describe("Target", func() {
 
 						Expect(client.API()).To(MatchRegexp("https://%s", serverAPIURL))
 						Expect(client.APIVersion()).To(Equal("2.59.0"))
+						Expect(client.AppSSHEndpoint()).To(Equal(fmt.Sprintf("ssh.%s", serverAPIURL)))
+						Expect(client.AppSSHHostKeyFingerprint()).To(Equal("a6:d1:08:0b:b0:cb:9b:5f:c4:ba:44:2a:97:26:19:8a"))
 						Expect(client.AuthorizationEndpoint()).To(MatchRegexp("https://login.%s", serverAPIURL))
 						Expect(client.DopplerEndpoint()).To(MatchRegexp("wss://doppler.%s", serverAPIURL))
 						Expect(client.RoutingEndpoint()).To(MatchRegexp("https://%s/routing", serverAPIURL))
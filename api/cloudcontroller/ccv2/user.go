@@ -5,12 +5,47 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
+// OrganizationRole is a role of a user in an organization. Its value is the
+// path of the users with the role in the organization.
+type OrganizationRole string
+
+const (
+	// OrganizationUserRole is the role of every user of an organization.
+	OrganizationUserRole OrganizationRole = "users"
+
+	// OrganizationManagerRole is the OrgManager role.
+	OrganizationManagerRole OrganizationRole = "managers"
+
+	// OrganizationBillingManagerRole is the BillingManager role.
+	OrganizationBillingManagerRole OrganizationRole = "billing_managers"
+
+	// OrganizationAuditorRole is the OrgAuditor role.
+	OrganizationAuditorRole OrganizationRole = "auditors"
+)
+
+// SpaceRole is a role of a user in a space. Its value is the path of the
+// users with the role in the space.
+type SpaceRole string
+
+const (
+	// SpaceManagerRole is the SpaceManager role.
+	SpaceManagerRole SpaceRole = "managers"
+
+	// SpaceDeveloperRole is the SpaceDeveloper role.
+	SpaceDeveloperRole SpaceRole = "developers"
+
+	// SpaceAuditorRole is the SpaceAuditor role.
+	SpaceAuditorRole SpaceRole = "auditors"
+)
+
 // User represents a Cloud Controller User.
 type User struct {
-	GUID string
+	GUID     string
+	Username string
 }
 
 // userRequestBody represents the body of the request.
@@ -22,12 +57,16 @@ type userRequestBody struct {
 func (user *User) UnmarshalJSON(data []byte) error {
 	var ccUser struct {
 		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Username string `json:"username"`
+		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccUser); err != nil {
 		return err
 	}
 
 	user.GUID = ccUser.Metadata.GUID
+	user.Username = ccUser.Entity.Username
 	return nil
 }
 
@@ -61,3 +100,146 @@ func (client *Client) CreateUser(uaaUserID string) (User, Warnings, error) {
 
 	return user, response.Warnings, nil
 }
+
+// GetOrganizationUsersByRole returns the users with the role in the
+// organization.
+func (client *Client) GetOrganizationUsersByRole(role OrganizationRole, orgGUID string) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationUsersByRoleRequest,
+		URIParams: Params{
+			"organization_guid": orgGUID,
+			"role":              string(role),
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateUsers(request)
+}
+
+// UpdateOrganizationUserByRoleAndUsername assigns the role in the
+// organization to the user with the username.
+func (client *Client) UpdateOrganizationUserByRoleAndUsername(role OrganizationRole, orgGUID string, username string) (Warnings, error) {
+	bodyBytes, err := json.Marshal(map[string]string{
+		"username": username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutOrganizationUserByRoleRequest,
+		URIParams: Params{
+			"organization_guid": orgGUID,
+			"role":              string(role),
+		},
+		Body: bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// DeleteOrganizationUserByRole removes the role in the organization from the
+// user.
+func (client *Client) DeleteOrganizationUserByRole(role OrganizationRole, orgGUID string, userGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteOrganizationUserByRoleRequest,
+		URIParams: Params{
+			"organization_guid": orgGUID,
+			"role":              string(role),
+			"user_guid":         userGUID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// GetSpaceUsersByRole returns the users with the role in the space.
+func (client *Client) GetSpaceUsersByRole(role SpaceRole, spaceGUID string) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpaceUsersByRoleRequest,
+		URIParams: Params{
+			"space_guid": spaceGUID,
+			"role":       string(role),
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateUsers(request)
+}
+
+// UpdateSpaceUserByRoleAndUsername assigns the role in the space to the user
+// with the username. The user must be a user of the space's organization.
+func (client *Client) UpdateSpaceUserByRoleAndUsername(role SpaceRole, spaceGUID string, username string) (Warnings, error) {
+	bodyBytes, err := json.Marshal(map[string]string{
+		"username": username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSpaceUserByRoleRequest,
+		URIParams: Params{
+			"space_guid": spaceGUID,
+			"role":       string(role),
+		},
+		Body: bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// DeleteSpaceUserByRole removes the role in the space from the user.
+func (client *Client) DeleteSpaceUserByRole(role SpaceRole, spaceGUID string, userGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteSpaceUserByRoleRequest,
+		URIParams: Params{
+			"space_guid": spaceGUID,
+			"role":       string(role),
+			"user_guid":  userGUID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+func (client *Client) paginateUsers(request *cloudcontroller.Request) ([]User, Warnings, error) {
+	var fullUsersList []User
+	warnings, err := client.paginate(request, User{}, func(item interface{}) error {
+		if user, ok := item.(User); ok {
+			fullUsersList = append(fullUsersList, user)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   User{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullUsersList, warnings, err
+}
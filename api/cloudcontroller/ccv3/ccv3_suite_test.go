@@ -117,6 +117,9 @@ func SetupV3Response() {
 			},
 			"processes": {
 				"href": "SERVER_URL/v3/processes"
+			},
+			"service_instances": {
+				"href": "SERVER_URL/v3/service_instances"
 			}
 		}
 	}`, "SERVER_URL", serverURL, -1)
@@ -0,0 +1,55 @@
+package ccv3
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// EnvironmentVariables represents the user provided environment variables of
+// a Cloud Controller V3 Application.
+type EnvironmentVariables map[string]string
+
+// UnmarshalJSON helps unmarshal a Cloud Controller V3 environment variables
+// response. Non-string values are kept in their JSON representation.
+func (variables *EnvironmentVariables) UnmarshalJSON(data []byte) error {
+	var ccEnvVars struct {
+		Var map[string]json.RawMessage `json:"var"`
+	}
+
+	if err := json.Unmarshal(data, &ccEnvVars); err != nil {
+		return err
+	}
+
+	*variables = EnvironmentVariables{}
+	for key, rawValue := range ccEnvVars.Var {
+		var value string
+		if err := json.Unmarshal(rawValue, &value); err != nil {
+			value = string(rawValue)
+		}
+		(*variables)[key] = value
+	}
+
+	return nil
+}
+
+// GetApplicationEnvironmentVariables returns the user provided environment
+// variables of the given application.
+func (client *Client) GetApplicationEnvironmentVariables(appGUID string) (EnvironmentVariables, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetApplicationEnvironmentVariablesRequest,
+		URIParams:   map[string]string{"app_guid": appGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var envVars EnvironmentVariables
+	response := cloudcontroller.Response{
+		Result: &envVars,
+	}
+
+	err = client.connection.Make(request, &response)
+	return envVars, response.Warnings, err
+}
@@ -0,0 +1,87 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Environment Variables", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationEnvironmentVariables", func() {
+		var (
+			envVars  EnvironmentVariables
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			envVars, warnings, err = client.GetApplicationEnvironmentVariables("some-app-guid")
+		})
+
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"var": {
+						"SOME_VAR": "some-value",
+						"SOME_NUMBER": 42
+					},
+					"links": {
+						"self": {
+							"href": "https://api.example.org/v3/apps/some-app-guid/environment_variables"
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/environment_variables"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the environment variables and all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(envVars).To(Equal(EnvironmentVariables{
+					"SOME_VAR":    "some-value",
+					"SOME_NUMBER": "42",
+				}))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/environment_variables"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an ApplicationNotFoundError and all warnings", func() {
+				Expect(err).To(MatchError(ccerror.ApplicationNotFoundError{}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package ccv3
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -68,6 +69,9 @@ func convert(rawHTTPStatusErr ccerror.RawHTTPStatusError) error {
 		}
 		return ccerror.UnauthorizedError{Message: firstErr.Detail}
 	case http.StatusForbidden: // 403
+		if firstErr.Title == "CF-FeatureDisabled" {
+			return ccerror.FeatureDisabledError{Message: firstErr.Detail}
+		}
 		return ccerror.ForbiddenError{Message: firstErr.Detail}
 	case http.StatusNotFound: // 404
 		return handleNotFound(firstErr)
@@ -101,6 +105,11 @@ func handleNotFound(errorResponse ccerror.V3Error) error {
 }
 
 func handleUnprocessableEntity(errorResponse ccerror.V3Error) error {
+	// The detail names the service offering, so only its ending is stable.
+	if strings.HasSuffix(errorResponse.Detail, "does not support service instance sharing.") {
+		return ccerror.ServiceInstanceNotShareableError{Message: errorResponse.Detail}
+	}
+
 	switch errorResponse.Detail {
 	case "name must be unique in space":
 		return ccerror.NameNotUniqueInSpaceError{}
@@ -138,6 +138,25 @@ var _ = Describe("Error Wrapper", func() {
 				It("returns a ForbiddenError", func() {
 					Expect(makeError).To(MatchError(ccerror.ForbiddenError{Message: "SomeCC Error Message"}))
 				})
+
+				Context("when a feature flag is disabled", func() {
+					BeforeEach(func() {
+						serverResponse = `
+{
+  "errors": [
+    {
+      "code": 330002,
+      "detail": "Feature Disabled: service_instance_sharing",
+      "title": "CF-FeatureDisabled"
+    }
+  ]
+}`
+					})
+
+					It("returns a FeatureDisabledError", func() {
+						Expect(makeError).To(MatchError(ccerror.FeatureDisabledError{Message: "Feature Disabled: service_instance_sharing"}))
+					})
+				})
 			})
 
 			Context("(404) Not Found", func() {
@@ -263,6 +282,25 @@ var _ = Describe("Error Wrapper", func() {
 					})
 				})
 
+				Context("when the service does not support sharing", func() {
+					BeforeEach(func() {
+						serverResponse = `
+{
+  "errors": [
+    {
+      "code": 10008,
+      "detail": "The p-mysql service does not support service instance sharing.",
+      "title": "CF-UnprocessableEntity"
+    }
+  ]
+}`
+					})
+
+					It("returns a ServiceInstanceNotShareableError", func() {
+						Expect(makeError).To(MatchError(ccerror.ServiceInstanceNotShareableError{Message: "The p-mysql service does not support service instance sharing."}))
+					})
+				})
+
 				Context("when the detail describes something else", func() {
 					It("returns a UnprocessableEntityError", func() {
 						Expect(makeError).To(MatchError(ccerror.UnprocessableEntityError{Message: "SomeCC Error Message"}))
@@ -7,7 +7,14 @@ import (
 )
 
 func (client Client) paginate(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
+	return client.paginateWithLimit(request, obj, 0, appendToExternalList)
+}
+
+// paginateWithLimit stops requesting pages once limit resources have been
+// appended. A limit of 0 retrieves every page.
+func (client Client) paginateWithLimit(request *cloudcontroller.Request, obj interface{}, limit int, appendToExternalList func(interface{}) error) (Warnings, error) {
 	fullWarningsList := Warnings{}
+	count := 0
 
 	for {
 		wrapper := NewPaginatedResources(obj)
@@ -27,13 +34,18 @@ func (client Client) paginate(request *cloudcontroller.Request, obj interface{},
 		}
 
 		for _, item := range list {
+			if limit > 0 && count == limit {
+				break
+			}
+
 			err = appendToExternalList(item)
 			if err != nil {
 				return fullWarningsList, err
 			}
+			count++
 		}
 
-		if wrapper.NextPage() == "" {
+		if wrapper.NextPage() == "" || (limit > 0 && count == limit) {
 			break
 		}
 
@@ -12,7 +12,10 @@ import (
 type Process struct {
 	GUID        string             `json:"guid"`
 	Type        string             `json:"type"`
+	Command     string             `json:"command"`
+	Instances   int                `json:"instances"`
 	MemoryInMB  int                `json:"memory_in_mb"`
+	DiskInMB    int                `json:"disk_in_mb"`
 	HealthCheck ProcessHealthCheck `json:"health_check"`
 }
 
@@ -23,6 +26,7 @@ type ProcessHealthCheck struct {
 
 type ProcessHealthCheckData struct {
 	Endpoint string `json:"endpoint"`
+	Timeout  int    `json:"timeout"`
 }
 
 func (p Process) MarshalJSON() ([]byte, error) {
@@ -44,7 +44,10 @@ var _ = Describe("Process", func() {
 							{
 								"guid": "process-2-guid",
 								"type": "worker",
+								"command": "some-worker-command",
+								"instances": 2,
 								"memory_in_mb": 64,
+								"disk_in_mb": 512,
 								"health_check": {
                   "type": "http",
                   "data": {
@@ -103,17 +106,23 @@ var _ = Describe("Process", func() {
 					Process{
 						GUID:       "process-2-guid",
 						Type:       "worker",
+						Command:    "some-worker-command",
+						Instances:  2,
 						MemoryInMB: 64,
+						DiskInMB:   512,
 						HealthCheck: ProcessHealthCheck{
 							Type: "http",
-							Data: ProcessHealthCheckData{Endpoint: "/health"},
+							Data: ProcessHealthCheckData{Endpoint: "/health", Timeout: 60},
 						},
 					},
 					Process{
-						GUID:        "process-3-guid",
-						Type:        "console",
-						MemoryInMB:  128,
-						HealthCheck: ProcessHealthCheck{Type: "process"},
+						GUID:       "process-3-guid",
+						Type:       "console",
+						MemoryInMB: 128,
+						HealthCheck: ProcessHealthCheck{
+							Type: "process",
+							Data: ProcessHealthCheckData{Timeout: 90},
+						},
 					},
 				))
 				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
@@ -188,7 +197,7 @@ var _ = Describe("Process", func() {
 					MemoryInMB: 32,
 					HealthCheck: ProcessHealthCheck{
 						Type: "http",
-						Data: ProcessHealthCheckData{Endpoint: "/health"}},
+						Data: ProcessHealthCheckData{Endpoint: "/health", Timeout: 90}},
 				}))
 			})
 		})
@@ -1,12 +1,29 @@
 package ccv3
 
 const (
+	// CreatedAfterFilter is a query parameter for listing objects created after
+	// the given RFC3339 timestamp.
+	CreatedAfterFilter = "created_ats[gt]"
 	// GUIDFilter is a query paramater for listing objects by GUID.
 	GUIDFilter = "guids"
 	// NameFilter is a query paramater for listing objects by name.
 	NameFilter = "names"
 	// OrganizationGUIDFilter is a query paramater for listing objects by Organization GUID.
 	OrganizationGUIDFilter = "organization_guids"
+	// SequenceIDFilter is a query parameter for listing objects by sequence ID.
+	SequenceIDFilter = "sequence_ids"
 	// SpaceGUIDFilter is a query paramater for listing objects by Space GUID.
 	SpaceGUIDFilter = "space_guids"
+	// StateFilter is a query parameter for listing objects by state.
+	StateFilter = "states"
+
+	// OrderBy is a query parameter for sorting listed objects by the given
+	// field. Prefixing the field with '-' sorts in descending order.
+	OrderBy = "order_by"
+	// PerPage is a query parameter for setting the number of objects returned
+	// in each page.
+	PerPage = "per_page"
 )
+
+// MaxPerPage is the largest page size the Cloud Controller accepts.
+const MaxPerPage = 5000
@@ -153,3 +153,19 @@ func (client *Client) PatchOrganizationDefaultIsolationSegment(orgGUID string, i
 	err = client.connection.Make(request, &response)
 	return response.Warnings, err
 }
+
+// UnshareServiceInstanceFromSpace will delete the sharing relationship
+// between the service instance and the shared-to space provided.
+func (client *Client) UnshareServiceInstanceFromSpace(serviceInstanceGUID string, spaceGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceInstanceRelationshipsSharedSpaceRequest,
+		URIParams:   internal.Params{"service_instance_guid": serviceInstanceGUID, "space_guid": spaceGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
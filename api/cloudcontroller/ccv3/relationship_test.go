@@ -167,6 +167,52 @@ var _ = Describe("Relationship", func() {
 		})
 	})
 
+	Describe("UnshareServiceInstanceFromSpace", func() {
+		Context("when the service instance is shared with the space", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/service_instances/some-service-instance-guid/relationships/shared_spaces/some-space-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the sharing relationship", func() {
+				warnings, err := client.UnshareServiceInstanceFromSpace("some-service-instance-guid", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when an error occurs", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Service instance not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/service_instances/some-service-instance-guid/relationships/shared_spaces/some-space-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.UnshareServiceInstanceFromSpace("some-service-instance-guid", "some-space-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Service instance not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("GetOrganizationDefaultIsolationSegment", func() {
 		Context("when getting the isolation segment is successful", func() {
 			BeforeEach(func() {
@@ -0,0 +1,63 @@
+package ccv3
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Space represents a Cloud Controller V3 Space.
+type Space struct {
+	Name             string
+	GUID             string
+	OrganizationGUID string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller V3 Space response.
+func (s *Space) UnmarshalJSON(data []byte) error {
+	var ccSpace struct {
+		Name          string `json:"name"`
+		GUID          string `json:"guid"`
+		Relationships struct {
+			Organization Relationship `json:"organization"`
+		} `json:"relationships"`
+	}
+
+	if err := json.Unmarshal(data, &ccSpace); err != nil {
+		return err
+	}
+
+	s.Name = ccSpace.Name
+	s.GUID = ccSpace.GUID
+	s.OrganizationGUID = ccSpace.Relationships.Organization.GUID
+
+	return nil
+}
+
+// GetSpaces lists spaces with optional filters.
+func (client *Client) GetSpaces(query url.Values) ([]Space, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpacesRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullSpacesList []Space
+	warnings, err := client.paginate(request, Space{}, func(item interface{}) error {
+		if space, ok := item.(Space); ok {
+			fullSpacesList = append(fullSpacesList, space)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Space{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullSpacesList, warnings, err
+}
@@ -0,0 +1,134 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Spaces", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetSpaces", func() {
+		Context("when spaces exist", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/spaces?organization_guids=some-org-guid&page=2&per_page=2"
+		}
+	},
+	"resources": [
+		{
+			"name": "space-name-1",
+			"guid": "space-guid-1",
+			"relationships": {
+				"organization": {
+					"data": { "guid": "some-org-guid" }
+				}
+			}
+		},
+		{
+			"name": "space-name-2",
+			"guid": "space-guid-2",
+			"relationships": {
+				"organization": {
+					"data": { "guid": "some-org-guid" }
+				}
+			}
+		}
+	]
+}`, server.URL())
+				response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"name": "space-name-3",
+			"guid": "space-guid-3",
+			"relationships": {
+				"organization": {
+					"data": { "guid": "some-org-guid" }
+				}
+			}
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/spaces", "organization_guids=some-org-guid"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/spaces", "organization_guids=some-org-guid&page=2&per_page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns the queried spaces and all warnings", func() {
+				spaces, warnings, err := client.GetSpaces(url.Values{
+					OrganizationGUIDFilter: []string{"some-org-guid"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(spaces).To(ConsistOf(
+					Space{Name: "space-name-1", GUID: "space-guid-1", OrganizationGUID: "some-org-guid"},
+					Space{Name: "space-name-2", GUID: "space-guid-2", OrganizationGUID: "some-org-guid"},
+					Space{Name: "space-name-3", GUID: "space-guid-3", OrganizationGUID: "some-org-guid"},
+				))
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10008,
+      "detail": "The request is semantically invalid: command presence",
+      "title": "CF-UnprocessableEntity"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/spaces"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetSpaces(nil)
+				Expect(err).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						[]ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "The request is semantically invalid: command presence",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
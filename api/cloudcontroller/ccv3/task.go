@@ -51,8 +51,10 @@ func (client *Client) CreateApplicationTask(appGUID string, task Task) (Task, Wa
 }
 
 // GetApplicationTasks returns a list of tasks associated with the provided
-// application GUID. Results can be filtered by providing URL queries.
-func (client *Client) GetApplicationTasks(appGUID string, query url.Values) ([]Task, Warnings, error) {
+// application GUID. Results can be filtered by providing URL queries. When
+// limit is greater than 0 no more than limit tasks are returned and no further
+// pages are requested once they have been retrieved.
+func (client *Client) GetApplicationTasks(appGUID string, query url.Values, limit int) ([]Task, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.GetAppTasksRequest,
 		URIParams: internal.Params{
@@ -64,6 +66,33 @@ func (client *Client) GetApplicationTasks(appGUID string, query url.Values) ([]T
 		return nil, nil, err
 	}
 
+	var fullTasksList []Task
+	warnings, err := client.paginateWithLimit(request, Task{}, limit, func(item interface{}) error {
+		if task, ok := item.(Task); ok {
+			fullTasksList = append(fullTasksList, task)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Task{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullTasksList, warnings, err
+}
+
+// GetTasks returns a list of tasks across all applications. Results can be
+// filtered by providing URL queries.
+func (client *Client) GetTasks(query url.Values) ([]Task, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetTasksRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var fullTasksList []Task
 	warnings, err := client.paginate(request, Task{}, func(item interface{}) error {
 		if task, ok := item.(Task); ok {
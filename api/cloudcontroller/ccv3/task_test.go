@@ -225,7 +225,7 @@ var _ = Describe("Task", func() {
 			})
 
 			It("returns a list of tasks associated with the application and all warnings", func() {
-				tasks, warnings, err := client.GetApplicationTasks("some-app-guid", url.Values{"per_page": []string{"2"}})
+				tasks, warnings, err := client.GetApplicationTasks("some-app-guid", url.Values{"per_page": []string{"2"}}, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(tasks).To(ConsistOf(
@@ -256,6 +256,37 @@ var _ = Describe("Task", func() {
 				))
 				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
 			})
+
+			Context("when a limit is provided", func() {
+				It("stops requesting pages once the limit is reached", func() {
+					tasks, warnings, err := client.GetApplicationTasks("some-app-guid", url.Values{"per_page": []string{"2"}}, 2)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(tasks).To(HaveLen(2))
+					Expect(tasks[0].GUID).To(Equal("task-1-guid"))
+					Expect(tasks[1].GUID).To(Equal("task-2-guid"))
+					Expect(warnings).To(ConsistOf("warning-1"))
+					Expect(server.ReceivedRequests()).To(HaveLen(3))
+				})
+
+				It("does not return more tasks than the limit", func() {
+					tasks, _, err := client.GetApplicationTasks("some-app-guid", url.Values{"per_page": []string{"2"}}, 1)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(tasks).To(HaveLen(1))
+					Expect(tasks[0].GUID).To(Equal("task-1-guid"))
+					Expect(server.ReceivedRequests()).To(HaveLen(3))
+				})
+
+				It("keeps paging until the limit is reached", func() {
+					tasks, warnings, err := client.GetApplicationTasks("some-app-guid", url.Values{"per_page": []string{"2"}}, 3)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(tasks).To(HaveLen(3))
+					Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+					Expect(server.ReceivedRequests()).To(HaveLen(4))
+				})
+			})
 		})
 
 		Context("when the application does not exist", func() {
@@ -278,7 +309,7 @@ var _ = Describe("Task", func() {
 			})
 
 			It("returns a ResourceNotFoundError", func() {
-				_, _, err := client.GetApplicationTasks("some-app-guid", nil)
+				_, _, err := client.GetApplicationTasks("some-app-guid", nil, 0)
 				Expect(err).To(MatchError(ccerror.ApplicationNotFoundError{}))
 			})
 		})
@@ -308,7 +339,7 @@ var _ = Describe("Task", func() {
 			})
 
 			It("returns the errors and all warnings", func() {
-				_, warnings, err := client.GetApplicationTasks("some-app-guid", nil)
+				_, warnings, err := client.GetApplicationTasks("some-app-guid", nil, 0)
 				Expect(err).To(MatchError(ccerror.V3UnexpectedResponseError{
 					ResponseCode: http.StatusTeapot,
 					V3ErrorResponse: ccerror.V3ErrorResponse{
@@ -331,6 +362,105 @@ var _ = Describe("Task", func() {
 		})
 	})
 
+	Describe("GetTasks", func() {
+		Context("when the cloud controller returns tasks", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+					"pagination": {
+						"next": {
+							"href": "%s/v3/tasks?space_guids=some-space-guid&states=RUNNING&page=2"
+						}
+					},
+					"resources": [
+						{
+							"guid": "task-1-guid",
+							"sequence_id": 1,
+							"name": "task-1",
+							"command": "some-command",
+							"state": "RUNNING"
+						}
+					]
+				}`, server.URL())
+				response2 := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "task-2-guid",
+							"sequence_id": 2,
+							"name": "task-2",
+							"command": "some-command",
+							"state": "RUNNING"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/tasks", "space_guids=some-space-guid&states=RUNNING"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/tasks", "space_guids=some-space-guid&states=RUNNING&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the tasks from every page and all warnings", func() {
+				tasks, warnings, err := client.GetTasks(url.Values{
+					SpaceGUIDFilter: []string{"some-space-guid"},
+					StateFilter:     []string{"RUNNING"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tasks).To(Equal([]Task{
+					{GUID: "task-1-guid", SequenceID: 1, Name: "task-1", Command: "some-command", State: "RUNNING"},
+					{GUID: "task-2-guid", SequenceID: 2, Name: "task-2", Command: "some-command", State: "RUNNING"},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid: command presence",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/tasks"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the errors and all warnings", func() {
+				_, warnings, err := client.GetTasks(nil)
+				Expect(err).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "The request is semantically invalid: command presence",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
 	Describe("UpdateTask", func() {
 		Context("when the request succeeds", func() {
 			BeforeEach(func() {
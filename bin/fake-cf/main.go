@@ -0,0 +1,40 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"code.cloudfoundry.org/cli/util/testhelpers/fakecf"
+)
+
+func main() {
+	org := flag.String("org", "", "create an organization with this name")
+	space := flag.String("space", "", "create a space with this name in the organization given by -org")
+	flag.Parse()
+
+	if *space != "" && *org == "" {
+		fmt.Fprintln(os.Stderr, "-space requires -org")
+		os.Exit(1)
+	}
+
+	server := fakecf.NewServer()
+	defer server.Close()
+
+	if *org != "" {
+		createdOrg := server.AddOrganization(*org)
+		if *space != "" {
+			server.AddSpace(createdOrg.GUID, *space)
+		}
+	}
+
+	fmt.Println(server.URL())
+	fmt.Fprintf(os.Stderr, "Fake CF listening. Log in with:\n  cf api %s --skip-ssl-validation\n  cf auth %s %s\nPress Ctrl-C to stop.\n",
+		server.URL(), fakecf.DefaultUsername, fakecf.DefaultPassword)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	<-signals
+}
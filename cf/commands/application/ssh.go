@@ -10,6 +10,7 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands"
+	"code.cloudfoundry.org/cli/cf/configuration/confighelpers"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
@@ -43,9 +44,12 @@ func init() {
 func (cmd *SSH) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["L"] = &flags.StringSliceFlag{ShortName: "L", Usage: T("Local port forward specification. This flag can be defined more than once.")}
+	fs["D"] = &flags.StringSliceFlag{ShortName: "D", Usage: T("Dynamic port forward specification for a local SOCKS5 proxy. This flag can be defined more than once.")}
+	fs["R"] = &flags.StringSliceFlag{ShortName: "R", Usage: T("Remote port forward specification. This flag can be defined more than once.")}
 	fs["command"] = &flags.StringSliceFlag{Name: "command", ShortName: "c", Usage: T("Command to run. This flag can be defined more than once.")}
 	fs["app-instance-index"] = &flags.IntFlag{Name: "app-instance-index", ShortName: "i", Usage: T("Application instance index")}
 	fs["skip-host-validation"] = &flags.BoolFlag{Name: "skip-host-validation", ShortName: "k", Usage: T("Skip host key validation")}
+	fs["reset-host-key"] = &flags.BoolFlag{Name: "reset-host-key", Usage: T("Replace the pinned host key of the SSH proxy with the key it presents")}
 	fs["skip-remote-execution"] = &flags.BoolFlag{Name: "skip-remote-execution", ShortName: "N", Usage: T("Do not execute a remote command")}
 	fs["request-pseudo-tty"] = &flags.BoolFlag{Name: "request-pseudo-tty", ShortName: "t", Usage: T("Request pseudo-tty allocation")}
 	fs["force-pseudo-tty"] = &flags.BoolFlag{Name: "force-pseudo-tty", ShortName: "tt", Usage: T("Force pseudo-tty allocation")}
@@ -55,7 +59,7 @@ func (cmd *SSH) MetaData() commandregistry.CommandMetadata {
 		Name:        "ssh",
 		Description: T("SSH to an application container instance"),
 		Usage: []string{
-			T("CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [--skip-host-validation] [--skip-remote-execution] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty]"),
+			T("CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [-D [bind_address:]port] [-R [bind_address:]port:host:hostport] [--skip-host-validation] [--reset-host-key] [--skip-remote-execution] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty]"),
 		},
 		Flags: fs,
 	}
@@ -139,6 +143,11 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 		)
 	}
 
+	cmd.opts.KnownHostsFile, err = confighelpers.KnownHostsFilePath()
+	if err != nil {
+		return errors.New(T("Error opening SSH connection: ") + err.Error())
+	}
+
 	err = cmd.secureShell.Connect(cmd.opts)
 	if err != nil {
 		return errors.New(T("Error opening SSH connection: ") + err.Error())
@@ -150,6 +159,16 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 		return errors.New(T("Error forwarding port: ") + err.Error())
 	}
 
+	err = cmd.secureShell.DynamicPortForward()
+	if err != nil {
+		return errors.New(T("Error forwarding port: ") + err.Error())
+	}
+
+	err = cmd.secureShell.RemotePortForward()
+	if err != nil {
+		return errors.New(T("Error forwarding port: ") + err.Error())
+	}
+
 	if cmd.opts.SkipRemoteExecution {
 		err = cmd.secureShell.Wait()
 	} else {
@@ -2,8 +2,11 @@ package application_test
 
 import (
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"time"
 
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
@@ -292,6 +295,44 @@ var _ = Describe("SSH command", func() {
 				})
 			})
 
+			Context("when connecting", func() {
+				var (
+					cfHome         string
+					originalCFHome string
+				)
+
+				BeforeEach(func() {
+					var err error
+					cfHome, err = ioutil.TempDir("", "cf-home")
+					Expect(err).NotTo(HaveOccurred())
+					originalCFHome = os.Getenv("CF_HOME")
+					Expect(os.Setenv("CF_HOME", cfHome)).To(Succeed())
+				})
+
+				AfterEach(func() {
+					Expect(os.Setenv("CF_HOME", originalCFHome)).To(Succeed())
+					Expect(os.RemoveAll(cfHome)).To(Succeed())
+				})
+
+				It("pins the host key in the known hosts file under CF_HOME", func() {
+					runCommand("my-app")
+
+					Expect(fakeSecureShell.ConnectCallCount()).To(Equal(1))
+					opts := fakeSecureShell.ConnectArgsForCall(0)
+					Expect(opts.KnownHostsFile).To(Equal(filepath.Join(cfHome, ".cf", "known_hosts")))
+					Expect(opts.ResetHostKey).To(BeFalse())
+				})
+
+				Context("when --reset-host-key is provided", func() {
+					It("resets the pinned host key", func() {
+						runCommand("my-app", "--reset-host-key")
+
+						Expect(fakeSecureShell.ConnectCallCount()).To(Equal(1))
+						Expect(fakeSecureShell.ConnectArgsForCall(0).ResetHostKey).To(BeTrue())
+					})
+				})
+			})
+
 			Context("Error port forwarding when -L is provided", func() {
 				It("notifies users", func() {
 					fakeSecureShell.LocalPortForwardReturns(errors.New("listen error"))
@@ -305,6 +346,43 @@ var _ = Describe("SSH command", func() {
 				})
 			})
 
+			Context("Error port forwarding when -D is provided", func() {
+				It("notifies users", func() {
+					fakeSecureShell.DynamicPortForwardReturns(errors.New("listen error"))
+
+					runCommand("my-app", "-D", "1080")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Error forwarding port", "listen error"},
+					))
+					Expect(fakeSecureShell.InteractiveSessionCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("Error port forwarding when -R is provided", func() {
+				It("notifies users", func() {
+					fakeSecureShell.RemotePortForwardReturns(errors.New("tcpip-forward request denied by peer"))
+
+					runCommand("my-app", "-R", "8000:localhost:8000")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Error forwarding port", "tcpip-forward request denied by peer"},
+					))
+					Expect(fakeSecureShell.InteractiveSessionCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when -D and -R are provided", func() {
+				It("starts all forwarders before the session", func() {
+					runCommand("my-app", "-k", "-D", "1080", "-R", "8000:localhost:8000")
+
+					Expect(fakeSecureShell.LocalPortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShell.DynamicPortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShell.RemotePortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShell.InteractiveSessionCallCount()).To(Equal(1))
+				})
+			})
+
 			Context("when -N is provided", func() {
 				It("calls secureShell.Wait()", func() {
 					fakeSecureShell.ConnectReturns(nil)
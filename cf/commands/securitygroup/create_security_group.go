@@ -88,6 +88,11 @@ Valid json file example:
 ]`, map[string]interface{}{"JSONFile": pathToJSONFile}))
 	}
 
+	err = validateRules(pathToJSONFile, rules)
+	if err != nil {
+		return err
+	}
+
 	cmd.ui.Say(T("Creating security group {{.security_group}} as {{.username}}",
 		map[string]interface{}{
 			"security_group": terminal.EntityNameColor(name),
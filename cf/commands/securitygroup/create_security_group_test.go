@@ -145,5 +145,22 @@ var _ = Describe("create-security-group command", func() {
 				))
 			})
 		})
+
+		Context("when the file specified has invalid rules", func() {
+			BeforeEach(func() {
+				tempFile.Write([]byte(`[{"protocol":"icmp","destination":"10.0.0.0/33"}]`))
+			})
+
+			It("lists every problem without creating the security group", func() {
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Invalid security group rules in file:", tempFile.Name()},
+					[]string{"rule 1, field 'destination': '10.0.0.0/33' is not a valid IPv4 CIDR block"},
+					[]string{"rule 1, field 'type': is required for icmp rules"},
+					[]string{"rule 1, field 'code': is required for icmp rules"},
+				))
+				Expect(securityGroupRepo.CreateCallCount()).To(Equal(0))
+			})
+		})
 	})
 })
@@ -71,6 +71,11 @@ func (cmd *UpdateSecurityGroup) Execute(context flags.FlagContext) error {
 		return err
 	}
 
+	err = validateRules(pathToJSONFile, rules)
+	if err != nil {
+		return err
+	}
+
 	cmd.ui.Say(T("Updating security group {{.security_group}} as {{.username}}",
 		map[string]interface{}{
 			"security_group": terminal.EntityNameColor(name),
@@ -0,0 +1,27 @@
+package securitygroup
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/errors"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/util/securityrules"
+)
+
+func validateRules(pathToJSONFile string, rules []map[string]interface{}) error {
+	ruleErrors := securityrules.ValidateRules(rules)
+	if len(ruleErrors) == 0 {
+		return nil
+	}
+
+	reasons := make([]string, len(ruleErrors))
+	for i, ruleError := range ruleErrors {
+		reasons[i] = "  " + ruleError.Error()
+	}
+
+	return errors.New(T("Invalid security group rules in file: {{.JSONFile}}\n{{.Reasons}}",
+		map[string]interface{}{
+			"JSONFile": pathToJSONFile,
+			"Reasons":  strings.Join(reasons, "\n"),
+		}))
+}
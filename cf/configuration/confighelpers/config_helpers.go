@@ -33,6 +33,18 @@ func DefaultFilePath() (string, error) {
 	return filepath.Join(homeDir, ".cf", "config.json"), nil
 }
 
+// KnownHostsFilePath returns the location of the file that pins the host
+// keys of SSH endpoints.
+func KnownHostsFilePath() (string, error) {
+	homeDir, err := homeDir()
+
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".cf", "known_hosts"), nil
+}
+
 // See: http://stackoverflow.com/questions/7922270/obtain-users-home-directory
 // we can't cross compile using cgo and use user.Current()
 var userHomeDir = func() string {
@@ -0,0 +1,226 @@
+package sshCmd
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	socksVersion5 = 0x05
+
+	socksAuthMethodNone         = 0x00
+	socksAuthMethodNoAcceptable = 0xff
+
+	socksCommandConnect = 0x01
+
+	socksAddressIPv4   = 0x01
+	socksAddressDomain = 0x03
+	socksAddressIPv6   = 0x04
+
+	socksReplySucceeded           = 0x00
+	socksReplyGeneralFailure      = 0x01
+	socksReplyHostUnreachable     = 0x04
+	socksReplyCommandNotSupported = 0x07
+	socksReplyAddressNotSupported = 0x08
+)
+
+// DynamicPortForward starts a SOCKS5 proxy on every dynamic forwarding
+// address. Connections made through the proxies are opened from the
+// application instance.
+func (c *secureShell) DynamicPortForward() error {
+	for _, listenAddress := range c.opts.DynamicForwardSpecs {
+		listener, err := c.listenerFactory.Listen("tcp", listenAddress)
+		if err != nil {
+			return err
+		}
+		c.localListeners = append(c.localListeners, listener)
+
+		go acceptLoop(listener, c.handleDynamicForwardConnection)
+	}
+
+	return nil
+}
+
+// RemotePortForward listens on the application instance for every remote
+// forwarding spec and forwards the connections to the connect address from
+// the local machine.
+func (c *secureShell) RemotePortForward() error {
+	for _, forwardSpec := range c.opts.RemoteForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", forwardSpec.ListenAddress)
+		if err != nil {
+			return err
+		}
+		c.remoteListeners = append(c.remoteListeners, listener)
+
+		connectAddress := forwardSpec.ConnectAddress
+		go acceptLoop(listener, func(conn net.Conn) {
+			c.handleRemoteForwardConnection(conn, connectAddress)
+		})
+	}
+
+	return nil
+}
+
+func acceptLoop(listener net.Listener, handle func(net.Conn)) {
+	defer listener.Close()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if netErr, ok := err.(net.Error); ok && netErr.Temporary() {
+				time.Sleep(100 * time.Millisecond)
+				continue
+			}
+			return
+		}
+
+		go handle(conn)
+	}
+}
+
+func (c *secureShell) handleDynamicForwardConnection(conn net.Conn) {
+	defer conn.Close()
+
+	targetAddr, err := socksHandshake(conn)
+	if err != nil {
+		reportForwardError("SOCKS request from %s failed: %s", conn.RemoteAddr(), err)
+		return
+	}
+
+	target, err := c.secureClient.Dial("tcp", targetAddr)
+	if err != nil {
+		_ = socksReply(conn, socksReplyHostUnreachable)
+		reportForwardError("connect to %s failed: %s", targetAddr, err)
+		return
+	}
+	defer target.Close()
+
+	err = socksReply(conn, socksReplySucceeded)
+	if err != nil {
+		return
+	}
+
+	proxyConnection(conn, target)
+}
+
+func (c *secureShell) handleRemoteForwardConnection(conn net.Conn, targetAddr string) {
+	defer conn.Close()
+
+	target, err := net.Dial("tcp", targetAddr)
+	if err != nil {
+		reportForwardError("connect to %s failed: %s", targetAddr, err)
+		return
+	}
+	defer target.Close()
+
+	proxyConnection(conn, target)
+}
+
+// proxyConnection copies data both ways until both connections are closed.
+func proxyConnection(conn net.Conn, target net.Conn) {
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndClose(wg, conn, target)
+	go copyAndClose(wg, target, conn)
+	wg.Wait()
+}
+
+func reportForwardError(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+}
+
+// socksHandshake negotiates a SOCKS5 CONNECT request without authentication
+// and returns the requested address. Failed requests are answered before the
+// error is returned.
+func socksHandshake(conn io.ReadWriter) (string, error) {
+	header := make([]byte, 2)
+	_, err := io.ReadFull(conn, header)
+	if err != nil {
+		return "", err
+	}
+	if header[0] != socksVersion5 {
+		return "", fmt.Errorf("unsupported SOCKS version %d", header[0])
+	}
+
+	methods := make([]byte, header[1])
+	_, err = io.ReadFull(conn, methods)
+	if err != nil {
+		return "", err
+	}
+
+	method := byte(socksAuthMethodNoAcceptable)
+	for _, m := range methods {
+		if m == socksAuthMethodNone {
+			method = socksAuthMethodNone
+		}
+	}
+	_, err = conn.Write([]byte{socksVersion5, method})
+	if err != nil {
+		return "", err
+	}
+	if method == socksAuthMethodNoAcceptable {
+		return "", errors.New("no supported SOCKS authentication method")
+	}
+
+	request := make([]byte, 4)
+	_, err = io.ReadFull(conn, request)
+	if err != nil {
+		return "", err
+	}
+	if request[0] != socksVersion5 {
+		_ = socksReply(conn, socksReplyGeneralFailure)
+		return "", fmt.Errorf("unsupported SOCKS version %d", request[0])
+	}
+
+	var host string
+	switch request[3] {
+	case socksAddressIPv4, socksAddressIPv6:
+		ip := make(net.IP, net.IPv4len)
+		if request[3] == socksAddressIPv6 {
+			ip = make(net.IP, net.IPv6len)
+		}
+		_, err = io.ReadFull(conn, ip)
+		host = ip.String()
+	case socksAddressDomain:
+		length := make([]byte, 1)
+		_, err = io.ReadFull(conn, length)
+		if err == nil {
+			domain := make([]byte, length[0])
+			_, err = io.ReadFull(conn, domain)
+			host = string(domain)
+		}
+	default:
+		_ = socksReply(conn, socksReplyAddressNotSupported)
+		return "", fmt.Errorf("unsupported SOCKS address type %d", request[3])
+	}
+	if err != nil {
+		return "", err
+	}
+
+	port := make([]byte, 2)
+	_, err = io.ReadFull(conn, port)
+	if err != nil {
+		return "", err
+	}
+
+	if request[1] != socksCommandConnect {
+		_ = socksReply(conn, socksReplyCommandNotSupported)
+		return "", fmt.Errorf("unsupported SOCKS command %d", request[1])
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), nil
+}
+
+// socksReply answers a SOCKS5 request. The bound address is not reported.
+func socksReply(conn io.Writer, reply byte) error {
+	_, err := conn.Write([]byte{socksVersion5, reply, 0x00, socksAddressIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
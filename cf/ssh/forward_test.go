@@ -0,0 +1,276 @@
+// +build !windows,!386
+
+package sshCmd_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/cf/ssh/terminal"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Port forwarding", func() {
+	var (
+		fakeSecureClient    *sshfakes.FakeSecureClient
+		fakeSecureDialer    *sshfakes.FakeSecureDialer
+		fakeListenerFactory *sshfakes.FakeListenerFactory
+
+		secureShell sshCmd.SecureShell
+		opts        *options.SSHOptions
+
+		echoListener net.Listener
+		echoAddress  string
+	)
+
+	BeforeEach(func() {
+		fakeSecureClient = new(sshfakes.FakeSecureClient)
+		fakeSecureDialer = new(sshfakes.FakeSecureDialer)
+		fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+		fakeListenerFactory = new(sshfakes.FakeListenerFactory)
+		fakeListenerFactory.ListenStub = net.Listen
+
+		var err error
+		echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		echoAddress = echoListener.Addr().String()
+		go func(listener net.Listener) {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				go func() {
+					_, _ = io.Copy(conn, conn)
+					_ = conn.Close()
+				}()
+			}
+		}(echoListener)
+
+		opts = &options.SSHOptions{AppName: "app-1", SkipHostValidation: true}
+
+		secureShell = sshCmd.NewSecureShell(
+			fakeSecureDialer,
+			terminal.DefaultHelper(),
+			fakeListenerFactory,
+			30*time.Second,
+			models.Application{ApplicationFields: models.ApplicationFields{State: "STARTED", Diego: true}},
+			"",
+			"ssh.example.com:2222",
+			"",
+		)
+	})
+
+	AfterEach(func() {
+		Expect(secureShell.Close()).To(Succeed())
+		Expect(echoListener.Close()).To(Succeed())
+	})
+
+	echo := func(conn net.Conn) {
+		_, err := conn.Write([]byte("hello"))
+		Expect(err).NotTo(HaveOccurred())
+
+		response := make([]byte, 5)
+		_, err = io.ReadFull(conn, response)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(response)).To(Equal("hello"))
+	}
+
+	Describe("DynamicPortForward", func() {
+		var (
+			proxyAddress string
+			forwardErr   error
+		)
+
+		BeforeEach(func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			proxyAddress = listener.Addr().String()
+			Expect(listener.Close()).To(Succeed())
+
+			opts.DynamicForwardSpecs = []string{proxyAddress}
+			fakeSecureClient.DialStub = net.Dial
+		})
+
+		JustBeforeEach(func() {
+			Expect(secureShell.Connect(opts)).To(Succeed())
+			forwardErr = secureShell.DynamicPortForward()
+		})
+
+		connectRequest := func(conn net.Conn, host string, port int) []byte {
+			_, err := conn.Write([]byte{0x05, 0x01, 0x00})
+			Expect(err).NotTo(HaveOccurred())
+
+			method := make([]byte, 2)
+			_, err = io.ReadFull(conn, method)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(method).To(Equal([]byte{0x05, 0x00}))
+
+			request := []byte{0x05, 0x01, 0x00, 0x03, byte(len(host))}
+			request = append(request, host...)
+			portBytes := make([]byte, 2)
+			binary.BigEndian.PutUint16(portBytes, uint16(port))
+			_, err = conn.Write(append(request, portBytes...))
+			Expect(err).NotTo(HaveOccurred())
+
+			reply := make([]byte, 10)
+			_, err = io.ReadFull(conn, reply)
+			Expect(err).NotTo(HaveOccurred())
+			return reply
+		}
+
+		It("listens on the dynamic forwarding address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+			network, address := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(address).To(Equal(proxyAddress))
+		})
+
+		It("connects to the requested address through the application instance", func() {
+			conn, err := net.Dial("tcp", proxyAddress)
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			port := echoListener.Addr().(*net.TCPAddr).Port
+			reply := connectRequest(conn, "localhost", port)
+			Expect(reply[:2]).To(Equal([]byte{0x05, 0x00}))
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+			network, address := fakeSecureClient.DialArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(address).To(Equal(net.JoinHostPort("localhost", strconv.Itoa(port))))
+
+			echo(conn)
+		})
+
+		Context("when the application instance cannot reach the address", func() {
+			BeforeEach(func() {
+				fakeSecureClient.DialStub = nil
+				fakeSecureClient.DialReturns(nil, errors.New("connection refused"))
+			})
+
+			It("replies that the host is unreachable and closes the connection", func() {
+				conn, err := net.Dial("tcp", proxyAddress)
+				Expect(err).NotTo(HaveOccurred())
+				defer conn.Close()
+
+				reply := connectRequest(conn, "db.internal", 5432)
+				Expect(reply[:2]).To(Equal([]byte{0x05, 0x04}))
+
+				_, err = conn.Read(make([]byte, 1))
+				Expect(err).To(Equal(io.EOF))
+			})
+		})
+
+		Context("when the client does not offer unauthenticated access", func() {
+			It("rejects the connection", func() {
+				conn, err := net.Dial("tcp", proxyAddress)
+				Expect(err).NotTo(HaveOccurred())
+				defer conn.Close()
+
+				_, err = conn.Write([]byte{0x05, 0x01, 0x02})
+				Expect(err).NotTo(HaveOccurred())
+
+				method := make([]byte, 2)
+				_, err = io.ReadFull(conn, method)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(method).To(Equal([]byte{0x05, 0xff}))
+				Expect(fakeSecureClient.DialCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when listening fails", func() {
+			BeforeEach(func() {
+				fakeListenerFactory.ListenStub = nil
+				fakeListenerFactory.ListenReturns(nil, errors.New("address in use"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("address in use"))
+			})
+		})
+
+		It("stops listening when the secure shell is closed", func() {
+			Expect(secureShell.Close()).To(Succeed())
+			Eventually(func() error {
+				conn, err := net.Dial("tcp", proxyAddress)
+				if err == nil {
+					conn.Close()
+				}
+				return err
+			}).Should(HaveOccurred())
+		})
+	})
+
+	Describe("RemotePortForward", func() {
+		var (
+			remoteListener net.Listener
+			forwardErr     error
+		)
+
+		BeforeEach(func() {
+			var err error
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			opts.RemoteForwardSpecs = []options.ForwardSpec{{
+				ListenAddress:  "localhost:9999",
+				ConnectAddress: echoAddress,
+			}}
+		})
+
+		JustBeforeEach(func() {
+			Expect(secureShell.Connect(opts)).To(Succeed())
+			forwardErr = secureShell.RemotePortForward()
+		})
+
+		It("listens on the application instance", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, address := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(address).To(Equal("localhost:9999"))
+		})
+
+		It("forwards remote connections to the local connect address", func() {
+			conn, err := net.Dial("tcp", remoteListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			echo(conn)
+		})
+
+		It("stops listening when the secure shell is closed", func() {
+			Expect(secureShell.Close()).To(Succeed())
+			Eventually(func() error {
+				conn, err := net.Dial("tcp", remoteListener.Addr().String())
+				if err == nil {
+					conn.Close()
+				}
+				return err
+			}).Should(HaveOccurred())
+		})
+
+		Context("when listening on the application instance fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("tcpip-forward request denied by peer"))
+			})
+		})
+	})
+})
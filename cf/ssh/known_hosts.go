@@ -0,0 +1,106 @@
+package sshCmd
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// knownHostsLock serializes access to known hosts files between connections
+// opened at the same time.
+var knownHostsLock sync.Mutex
+
+// knownHostsCallback trusts the host key of an SSH endpoint the first time it
+// is seen by recording it in the known hosts file, and rejects any other key
+// for the endpoint afterwards. When reset is true the recorded key is
+// replaced by the received one.
+func knownHostsCallback(path string, reset bool) ssh.HostKeyCallback {
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		knownHostsLock.Lock()
+		defer knownHostsLock.Unlock()
+
+		if reset {
+			err := removeKnownHost(path, hostname)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := os.MkdirAll(filepath.Dir(path), 0700)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.OpenFile(path, os.O_RDONLY|os.O_CREATE, 0600)
+		if err != nil {
+			return err
+		}
+		_ = file.Close()
+
+		check, err := knownhosts.New(path)
+		if err != nil {
+			return err
+		}
+
+		err = check(hostname, remote, key)
+		keyErr, ok := err.(*knownhosts.KeyError)
+		if !ok {
+			return err
+		}
+
+		if len(keyErr.Want) == 0 {
+			return addKnownHost(path, hostname, key)
+		}
+
+		return fmt.Errorf("WARNING: THE HOST KEY OF %s HAS CHANGED!\n\nSomeone could be intercepting the connection, or the host key of the SSH proxy was replaced.\nThe fingerprint of the received key was %q, but %s line %d pins %q.\nIf the host key was replaced on purpose, connect again with --reset-host-key.",
+			hostname, base64Sha256Fingerprint(key), path, keyErr.Want[0].Line, base64Sha256Fingerprint(keyErr.Want[0].Key))
+	}
+}
+
+func addKnownHost(path string, hostname string, key ssh.PublicKey) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintln(file, knownhosts.Line([]string{hostname}, key))
+	return err
+}
+
+// removeKnownHost removes every line of the known hosts file that only
+// lists the endpoint.
+func removeKnownHost(path string, hostname string) error {
+	contents, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	entry := knownhosts.Normalize(hostname)
+	kept := &bytes.Buffer{}
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 0 && fields[0] == entry {
+			continue
+		}
+		fmt.Fprintln(kept, scanner.Text())
+	}
+	if err = scanner.Err(); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, kept.Bytes(), 0600)
+}
@@ -0,0 +1,173 @@
+// +build !windows,!386
+
+package sshCmd_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"golang.org/x/crypto/ssh"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Known hosts", func() {
+	var (
+		tmpdir         string
+		knownHostsFile string
+		opts           *options.SSHOptions
+		remote         net.Addr
+
+		firstKey  ssh.PublicKey
+		secondKey ssh.PublicKey
+	)
+
+	newKey := func() ssh.PublicKey {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		key, err := ssh.NewPublicKey(&privateKey.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+		return key
+	}
+
+	// connect connects to the endpoint with an API that advertises the
+	// fingerprint of key, and returns the resulting host key callback.
+	connect := func(endpoint string, key ssh.PublicKey) (string, ssh.HostKeyCallback) {
+		sum := sha256.Sum256(key.Marshal())
+		fakeSecureDialer := new(sshfakes.FakeSecureDialer)
+		fakeSecureDialer.DialReturns(new(sshfakes.FakeSecureClient), nil)
+
+		secureShell := sshCmd.NewSecureShell(
+			fakeSecureDialer,
+			terminal.DefaultHelper(),
+			new(sshfakes.FakeListenerFactory),
+			30*time.Second,
+			models.Application{ApplicationFields: models.ApplicationFields{State: "STARTED", Diego: true}},
+			base64.RawStdEncoding.EncodeToString(sum[:]),
+			endpoint,
+			"",
+		)
+		Expect(secureShell.Connect(opts)).To(Succeed())
+
+		_, address, config := fakeSecureDialer.DialArgsForCall(0)
+		return address, config.HostKeyCallback
+	}
+
+	verifyEndpoint := func(endpoint string, key ssh.PublicKey) error {
+		address, callback := connect(endpoint, key)
+		return callback(address, remote, key)
+	}
+
+	verify := func(key ssh.PublicKey) error {
+		return verifyEndpoint("ssh.example.com:2222", key)
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpdir, err = ioutil.TempDir("", "known-hosts")
+		Expect(err).NotTo(HaveOccurred())
+
+		knownHostsFile = filepath.Join(tmpdir, ".cf", "known_hosts")
+		opts = &options.SSHOptions{AppName: "app-1", KnownHostsFile: knownHostsFile}
+		remote = &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 2222}
+
+		firstKey = newKey()
+		secondKey = newKey()
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpdir)).To(Succeed())
+	})
+
+	Context("when the endpoint has not been connected to before", func() {
+		It("pins the host key of the endpoint", func() {
+			Expect(verify(firstKey)).To(Succeed())
+
+			contents, err := ioutil.ReadFile(knownHostsFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(HavePrefix("[ssh.example.com]:2222 ecdsa-sha2-nistp256 "))
+
+			info, err := os.Stat(knownHostsFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+	})
+
+	Context("when the endpoint has a pinned host key", func() {
+		BeforeEach(func() {
+			Expect(verify(firstKey)).To(Succeed())
+		})
+
+		It("accepts the same key", func() {
+			Expect(verify(firstKey)).To(Succeed())
+
+			contents, err := ioutil.ReadFile(knownHostsFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(strings.Count(string(contents), "\n")).To(Equal(1))
+		})
+
+		It("rejects a different key, even when the API advertises it", func() {
+			err := verify(secondKey)
+			Expect(err).To(MatchError(ContainSubstring("WARNING: THE HOST KEY OF ssh.example.com:2222 HAS CHANGED!")))
+			Expect(err).To(MatchError(ContainSubstring(knownHostsFile + " line 1 pins")))
+			Expect(err).To(MatchError(ContainSubstring("--reset-host-key")))
+		})
+
+		It("pins the host keys of other endpoints separately", func() {
+			Expect(verifyEndpoint("ssh.other.example.com:2222", secondKey)).To(Succeed())
+			Expect(verify(firstKey)).To(Succeed())
+			Expect(verifyEndpoint("ssh.other.example.com:2222", firstKey)).To(MatchError(ContainSubstring("HAS CHANGED")))
+		})
+
+		Context("when the host key is reset", func() {
+			BeforeEach(func() {
+				opts.ResetHostKey = true
+			})
+
+			It("pins the received key instead", func() {
+				Expect(verify(secondKey)).To(Succeed())
+
+				opts.ResetHostKey = false
+				Expect(verify(secondKey)).To(Succeed())
+				Expect(verify(firstKey)).To(MatchError(ContainSubstring("HAS CHANGED")))
+			})
+		})
+	})
+
+	Context("when host key validation is skipped", func() {
+		BeforeEach(func() {
+			opts.SkipHostValidation = true
+		})
+
+		It("does not create the known hosts file", func() {
+			_, callback := connect("ssh.example.com:2222", firstKey)
+			Expect(callback).To(BeNil())
+			_, err := os.Stat(knownHostsFile)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	Context("when the advertised fingerprint does not match", func() {
+		It("fails without pinning the key", func() {
+			address, callback := connect("ssh.example.com:2222", secondKey)
+			Expect(callback(address, remote, firstKey)).To(MatchError(ContainSubstring("Host key verification failed.")))
+			_, err := os.Stat(knownHostsFile)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
@@ -29,6 +29,17 @@ type SSHOptions struct {
 	SkipRemoteExecution bool
 	TerminalRequest     TTYRequest
 	ForwardSpecs        []ForwardSpec
+	// DynamicForwardSpecs are the local addresses of SOCKS proxies that
+	// tunnel connections through the application instance.
+	DynamicForwardSpecs []string
+	// RemoteForwardSpecs listen on the application instance and connect to
+	// addresses reachable from the local machine.
+	RemoteForwardSpecs []ForwardSpec
+	// KnownHostsFile pins the host key of the SSH endpoint the first time it
+	// is connected to. Pinning is disabled when it is empty.
+	KnownHostsFile string
+	// ResetHostKey replaces the host key pinned for the SSH endpoint.
+	ResetHostKey bool
 }
 
 func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
@@ -37,6 +48,7 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 	sshOptions.AppName = fc.Args()[0]
 	sshOptions.Index = uint(fc.Int("i"))
 	sshOptions.SkipHostValidation = fc.Bool("k")
+	sshOptions.ResetHostKey = fc.Bool("reset-host-key")
 	sshOptions.SkipRemoteExecution = fc.Bool("N")
 	sshOptions.Command = fc.StringSlice("c")
 
@@ -50,6 +62,26 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 		}
 	}
 
+	if fc.IsSet("D") {
+		for _, arg := range fc.StringSlice("D") {
+			listenAddress, err := sshOptions.parseDynamicForwardingSpec(arg)
+			if err != nil {
+				return sshOptions, err
+			}
+			sshOptions.DynamicForwardSpecs = append(sshOptions.DynamicForwardSpecs, listenAddress)
+		}
+	}
+
+	if fc.IsSet("R") {
+		for _, arg := range fc.StringSlice("R") {
+			forwardSpec, err := sshOptions.parseRemoteForwardingSpec(arg)
+			if err != nil {
+				return sshOptions, err
+			}
+			sshOptions.RemoteForwardSpecs = append(sshOptions.RemoteForwardSpecs, *forwardSpec)
+		}
+	}
+
 	if fc.IsSet("t") && fc.Bool("t") {
 		sshOptions.TerminalRequest = RequestTTYYes
 	}
@@ -68,15 +100,60 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error) {
 	arg = strings.TrimSpace(arg)
 
-	parts := []string{}
-	for remainder := arg; remainder != ""; {
-		part, r, err := tokenizeForward(remainder)
-		if err != nil {
-			return nil, err
+	parts, err := tokenizeForwardingSpec(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	forwardSpec := &ForwardSpec{}
+	switch len(parts) {
+	case 4:
+		if parts[0] == "*" {
+			parts[0] = ""
+		}
+		forwardSpec.ListenAddress = fmt.Sprintf("%s:%s", parts[0], parts[1])
+		forwardSpec.ConnectAddress = fmt.Sprintf("%s:%s", parts[2], parts[3])
+	case 3:
+		forwardSpec.ListenAddress = fmt.Sprintf("localhost:%s", parts[0])
+		forwardSpec.ConnectAddress = fmt.Sprintf("%s:%s", parts[1], parts[2])
+	default:
+		return nil, fmt.Errorf("Unable to parse local forwarding argument: %q", arg)
+	}
+
+	return forwardSpec, nil
+}
+
+// parseDynamicForwardingSpec parses a [bind_address:]port argument into the
+// address the SOCKS proxy listens on.
+func (o *SSHOptions) parseDynamicForwardingSpec(arg string) (string, error) {
+	arg = strings.TrimSpace(arg)
+
+	parts, err := tokenizeForwardingSpec(arg)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(parts) {
+	case 2:
+		if parts[0] == "*" {
+			parts[0] = ""
 		}
+		return fmt.Sprintf("%s:%s", parts[0], parts[1]), nil
+	case 1:
+		return fmt.Sprintf("localhost:%s", parts[0]), nil
+	default:
+		return "", fmt.Errorf("Unable to parse dynamic forwarding argument: %q", arg)
+	}
+}
 
-		parts = append(parts, part)
-		remainder = r
+// parseRemoteForwardingSpec parses a [bind_address:]port:host:hostport
+// argument, where bind_address and port are on the application instance.
+func (o *SSHOptions) parseRemoteForwardingSpec(arg string) (*ForwardSpec, error) {
+	arg = strings.TrimSpace(arg)
+
+	parts, err := tokenizeForwardingSpec(arg)
+	if err != nil {
+		return nil, err
 	}
 
 	forwardSpec := &ForwardSpec{}
@@ -91,12 +168,26 @@ func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error)
 		forwardSpec.ListenAddress = fmt.Sprintf("localhost:%s", parts[0])
 		forwardSpec.ConnectAddress = fmt.Sprintf("%s:%s", parts[1], parts[2])
 	default:
-		return nil, fmt.Errorf("Unable to parse local forwarding argument: %q", arg)
+		return nil, fmt.Errorf("Unable to parse remote forwarding argument: %q", arg)
 	}
 
 	return forwardSpec, nil
 }
 
+func tokenizeForwardingSpec(arg string) ([]string, error) {
+	parts := []string{}
+	for remainder := arg; remainder != ""; {
+		part, r, err := tokenizeForward(remainder)
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, part)
+		remainder = r
+	}
+	return parts, nil
+}
+
 func tokenizeForward(arg string) (string, string, error) {
 	switch arg[0] {
 	case ':':
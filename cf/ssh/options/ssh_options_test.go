@@ -20,9 +20,12 @@ var _ = Describe("SSHOptions", func() {
 		BeforeEach(func() {
 			fc = flags.New()
 			fc.NewStringSliceFlag("L", "", "")
+			fc.NewStringSliceFlag("D", "", "")
+			fc.NewStringSliceFlag("R", "", "")
 			fc.NewStringSliceFlag("command", "c", "")
 			fc.NewIntFlag("app-instance-index", "i", "")
 			fc.NewBoolFlag("skip-host-validation", "k", "")
+			fc.NewBoolFlag("reset-host-key", "", "")
 			fc.NewBoolFlag("skip-remote-execution", "N", "")
 			fc.NewBoolFlag("request-pseudo-tty", "t", "")
 			fc.NewBoolFlag("force-pseudo-tty", "tt", "")
@@ -87,6 +90,18 @@ var _ = Describe("SSHOptions", func() {
 			})
 		})
 
+		Context("when --reset-host-key is set", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name", "--reset-host-key")
+			})
+
+			It("resets the pinned host key", func() {
+				Expect(parseError).ToNot(HaveOccurred())
+				Expect(opts.ResetHostKey).To(BeTrue())
+				Expect(opts.SkipHostValidation).To(BeFalse())
+			})
+		})
+
 		Context("when the -t and -T flags are not used", func() {
 			BeforeEach(func() {
 				args = append(args, "app-name")
@@ -281,6 +296,93 @@ var _ = Describe("SSHOptions", func() {
 			})
 		})
 
+		Context("when dynamic port forwarding is requested", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name")
+			})
+
+			Context("without an explicit bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "1080")
+				})
+
+				It("listens on localhost", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.DynamicForwardSpecs).To(ConsistOf("localhost:1080"))
+				})
+			})
+
+			Context("with an explicit ipv6 bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "[::1]:1080")
+				})
+
+				It("listens on the bind address", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.DynamicForwardSpecs).To(ConsistOf("[::1]:1080"))
+				})
+			})
+
+			Context("with * as the bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "*:1080", "-D", "1081")
+				})
+
+				It("listens on all interfaces", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.DynamicForwardSpecs).To(ConsistOf(":1080", "localhost:1081"))
+				})
+			})
+
+			Context("with too many parts", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "localhost:1080:remote")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError(`Unable to parse dynamic forwarding argument: "localhost:1080:remote"`))
+				})
+			})
+		})
+
+		Context("when remote port forwarding is requested", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name")
+			})
+
+			Context("without an explicit bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "9999:localhost:8888")
+				})
+
+				It("sets the forward spec", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.RemoteForwardSpecs).To(ConsistOf(options.ForwardSpec{ListenAddress: "localhost:9999", ConnectAddress: "localhost:8888"}))
+				})
+			})
+
+			Context("with * as the bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "*:9999:[2001:db8::1]:8888")
+				})
+
+				It("sets the forward spec", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.RemoteForwardSpecs).To(ConsistOf(options.ForwardSpec{ListenAddress: ":9999", ConnectAddress: "[2001:db8::1]:8888"}))
+				})
+			})
+
+			Context("with a missing connect port", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "9999:localhost")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError(`Unable to parse remote forwarding argument: "9999:localhost"`))
+				})
+			})
+		})
+
 		Context("when -N is specified", func() {
 			BeforeEach(func() {
 				args = append(args, "app-name", "-N")
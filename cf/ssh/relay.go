@@ -0,0 +1,208 @@
+package sshCmd
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// RelayHostKeyAlias is the name under which the relay host key is pinned in
+// the relay known hosts file. OpenSSH clients look the key up with
+// HostKeyAlias, so the same entry is used for every application.
+const RelayHostKeyAlias = "cf-ssh-relay"
+
+// EnsureRelayHostKey creates the host key presented by Relay at keyPath,
+// unless it already exists, and pins it under RelayHostKeyAlias in the known
+// hosts file at knownHostsPath.
+func EnsureRelayHostKey(keyPath string, knownHostsPath string) error {
+	signer, err := LoadRelayHostKey(keyPath)
+	if os.IsNotExist(err) {
+		signer, err = createRelayHostKey(keyPath)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(knownHostsPath), 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(knownHostsPath, []byte(knownhosts.Line([]string{RelayHostKeyAlias}, signer.PublicKey())+"\n"), 0600)
+}
+
+// LoadRelayHostKey reads the host key created by EnsureRelayHostKey.
+func LoadRelayHostKey(keyPath string) (ssh.Signer, error) {
+	pemBytes, err := ioutil.ReadFile(keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return ssh.ParsePrivateKey(pemBytes)
+}
+
+func createRelayHostKey(keyPath string) (ssh.Signer, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(keyPath), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	err = ioutil.WriteFile(keyPath, pemBytes, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return ssh.NewSignerFromKey(key)
+}
+
+// Relay serves an SSH connection from a local OpenSSH client on stdin and
+// stdout, identifying itself with hostKey, and relays every channel the
+// client opens (sessions, commands, subsystems and local port forwards) to
+// the application instance over the connection authenticated by Connect.
+// The client is not asked to authenticate: only the process that started
+// the relay can reach it. Remote port forwarding is not supported. Relay
+// returns when the client disconnects.
+func (c *secureShell) Relay(hostKey ssh.Signer, stdin io.Reader, stdout io.Writer) error {
+	config := &ssh.ServerConfig{NoClientAuth: true}
+	config.AddHostKey(hostKey)
+
+	conn, newChannels, requests, err := ssh.NewServerConn(&pipeConn{Reader: stdin, Writer: stdout}, config)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	go ssh.DiscardRequests(requests)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	go func() {
+		_ = c.secureClient.Wait()
+		_ = conn.Close()
+	}()
+
+	wg := &sync.WaitGroup{}
+	for newChannel := range newChannels {
+		wg.Add(1)
+		go func(newChannel ssh.NewChannel) {
+			defer wg.Done()
+			c.relayChannel(newChannel)
+		}(newChannel)
+	}
+	wg.Wait()
+
+	return nil
+}
+
+func (c *secureShell) relayChannel(newChannel ssh.NewChannel) {
+	target, targetRequests, err := c.secureClient.Conn().OpenChannel(newChannel.ChannelType(), newChannel.ExtraData())
+	if err != nil {
+		if openErr, ok := err.(*ssh.OpenChannelError); ok {
+			_ = newChannel.Reject(openErr.Reason, openErr.Message)
+		} else {
+			_ = newChannel.Reject(ssh.ConnectionFailed, err.Error())
+		}
+		return
+	}
+	defer target.Close()
+
+	channel, requests, err := newChannel.Accept()
+	if err != nil {
+		return
+	}
+	defer channel.Close()
+
+	go func() {
+		relayRequests(target, requests)
+		_ = target.Close()
+	}()
+	go func() {
+		_, _ = io.Copy(target, channel)
+		_ = target.CloseWrite()
+	}()
+
+	// The channel is closed once the application instance has sent all of
+	// its output and requests, such as the exit status. Extended data cannot
+	// follow EOF, so EOF is only sent once stderr has been copied as well.
+	requestsDone := make(chan struct{})
+	go func() {
+		relayRequests(channel, targetRequests)
+		close(requestsDone)
+	}()
+
+	outputWG := &sync.WaitGroup{}
+	outputWG.Add(2)
+	go func() {
+		defer outputWG.Done()
+		_, _ = io.Copy(channel, target)
+	}()
+	go func() {
+		defer outputWG.Done()
+		_, _ = io.Copy(channel.Stderr(), target.Stderr())
+	}()
+	outputWG.Wait()
+	_ = channel.CloseWrite()
+
+	<-requestsDone
+}
+
+func relayRequests(channel ssh.Channel, requests <-chan *ssh.Request) {
+	for request := range requests {
+		ok, err := channel.SendRequest(request.Type, request.WantReply, request.Payload)
+		if err != nil {
+			ok = false
+		}
+		if request.WantReply {
+			_ = request.Reply(ok, nil)
+		}
+	}
+}
+
+// pipeConn presents the standard input and output of a ProxyCommand as a
+// net.Conn.
+type pipeConn struct {
+	io.Reader
+	io.Writer
+}
+
+// Close closes the reader and the writer when they can be closed, which ends
+// the connection when the application instance disconnects first.
+func (conn *pipeConn) Close() error {
+	if closer, ok := conn.Reader.(io.Closer); ok {
+		_ = closer.Close()
+	}
+	if closer, ok := conn.Writer.(io.Closer); ok {
+		_ = closer.Close()
+	}
+	return nil
+}
+
+func (*pipeConn) LocalAddr() net.Addr                { return pipeAddr{} }
+func (*pipeConn) RemoteAddr() net.Addr               { return pipeAddr{} }
+func (*pipeConn) SetDeadline(t time.Time) error      { return nil }
+func (*pipeConn) SetReadDeadline(t time.Time) error  { return nil }
+func (*pipeConn) SetWriteDeadline(t time.Time) error { return nil }
+
+type pipeAddr struct{}
+
+func (pipeAddr) Network() string { return "pipe" }
+func (pipeAddr) String() string  { return "pipe" }
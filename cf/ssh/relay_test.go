@@ -0,0 +1,290 @@
+// +build !windows,!386
+
+package sshCmd_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/binary"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Relay", func() {
+	var (
+		fakeSecureClient *sshfakes.FakeSecureClient
+		fakeSecureDialer *sshfakes.FakeSecureDialer
+
+		secureShell sshCmd.SecureShell
+
+		instanceListener net.Listener
+		instanceClient   *ssh.Client
+		relayHostKey     ssh.Signer
+
+		clientConn net.Conn
+		relayDone  chan error
+	)
+
+	newSigner := func() ssh.Signer {
+		key, err := rsa.GenerateKey(rand.Reader, 1024)
+		Expect(err).NotTo(HaveOccurred())
+		signer, err := ssh.NewSignerFromKey(key)
+		Expect(err).NotTo(HaveOccurred())
+		return signer
+	}
+
+	// serveInstance answers "exec" requests with the output of a few fake
+	// commands and refuses port forwarding, like a locked down instance.
+	serveInstance := func(conn net.Conn, config *ssh.ServerConfig) {
+		_, newChannels, requests, err := ssh.NewServerConn(conn, config)
+		if err != nil {
+			return
+		}
+		go ssh.DiscardRequests(requests)
+
+		for newChannel := range newChannels {
+			if newChannel.ChannelType() != "session" {
+				_ = newChannel.Reject(ssh.Prohibited, "port forwarding is disabled")
+				continue
+			}
+
+			channel, channelRequests, err := newChannel.Accept()
+			if err != nil {
+				continue
+			}
+
+			go func() {
+				defer channel.Close()
+				for request := range channelRequests {
+					if request.Type != "exec" {
+						_ = request.Reply(false, nil)
+						continue
+					}
+					_ = request.Reply(true, nil)
+
+					exitStatus := uint32(0)
+					switch string(request.Payload[4:]) {
+					case "echo hello":
+						_, _ = channel.Write([]byte("hello\n"))
+						_, _ = channel.Stderr().Write([]byte("some-warning\n"))
+					default:
+						exitStatus = 3
+					}
+
+					status := make([]byte, 4)
+					binary.BigEndian.PutUint32(status, exitStatus)
+					_, _ = channel.SendRequest("exit-status", false, status)
+					return
+				}
+			}()
+		}
+	}
+
+	dialRelay := func(hostKey ssh.PublicKey) (*ssh.Client, error) {
+		conn, newChannels, requests, err := ssh.NewClientConn(clientConn, sshCmd.RelayHostKeyAlias, &ssh.ClientConfig{
+			User:            "vcap",
+			HostKeyCallback: ssh.FixedHostKey(hostKey),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return ssh.NewClient(conn, newChannels, requests), nil
+	}
+
+	BeforeEach(func() {
+		instanceConfig := &ssh.ServerConfig{NoClientAuth: true}
+		instanceConfig.AddHostKey(newSigner())
+
+		var err error
+		instanceListener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		go func(listener net.Listener) {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				go serveInstance(conn, instanceConfig)
+			}
+		}(instanceListener)
+
+		instanceClient, err = ssh.Dial("tcp", instanceListener.Addr().String(), &ssh.ClientConfig{
+			User:            "cf:some-app-guid/0",
+			HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeSecureClient = new(sshfakes.FakeSecureClient)
+		fakeSecureClient.ConnReturns(instanceClient)
+		fakeSecureClient.WaitStub = instanceClient.Wait
+		fakeSecureDialer = new(sshfakes.FakeSecureDialer)
+		fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+
+		secureShell = sshCmd.NewSecureShell(
+			fakeSecureDialer,
+			terminal.DefaultHelper(),
+			new(sshfakes.FakeListenerFactory),
+			30*time.Second,
+			models.Application{ApplicationFields: models.ApplicationFields{State: "STARTED", Diego: true}},
+			"",
+			"ssh.example.com:2222",
+			"",
+		)
+		Expect(secureShell.Connect(&options.SSHOptions{AppName: "app-1", SkipHostValidation: true})).To(Succeed())
+
+		relayHostKey = newSigner()
+
+		// Unlike net.Pipe, a TCP connection buffers writes the way the pipes of
+		// a ProxyCommand do, so both sides can send their version first.
+		relayListener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		defer relayListener.Close()
+
+		relayDone = make(chan error, 1)
+		go func() {
+			relayConn, err := relayListener.Accept()
+			if err != nil {
+				relayDone <- err
+				return
+			}
+			relayDone <- secureShell.Relay(relayHostKey, relayConn, relayConn)
+		}()
+
+		clientConn, err = net.Dial("tcp", relayListener.Addr().String())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		_ = clientConn.Close()
+		Expect(secureShell.Close()).To(Succeed())
+		_ = instanceClient.Close()
+		Expect(instanceListener.Close()).To(Succeed())
+	})
+
+	It("runs commands on the application instance", func() {
+		client, err := dialRelay(relayHostKey.PublicKey())
+		Expect(err).NotTo(HaveOccurred())
+		defer client.Close()
+
+		session, err := client.NewSession()
+		Expect(err).NotTo(HaveOccurred())
+
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		session.Stdout = stdout
+		session.Stderr = stderr
+		Expect(session.Run("echo hello")).To(Succeed())
+		Expect(stdout.String()).To(Equal("hello\n"))
+		Expect(stderr.String()).To(Equal("some-warning\n"))
+	})
+
+	It("relays the exit status of commands", func() {
+		client, err := dialRelay(relayHostKey.PublicKey())
+		Expect(err).NotTo(HaveOccurred())
+		defer client.Close()
+
+		session, err := client.NewSession()
+		Expect(err).NotTo(HaveOccurred())
+
+		err = session.Run("false")
+		Expect(err).To(BeAssignableToTypeOf(&ssh.ExitError{}))
+		Expect(err.(*ssh.ExitError).ExitStatus()).To(Equal(3))
+	})
+
+	It("relays the reason the application instance refused a channel", func() {
+		client, err := dialRelay(relayHostKey.PublicKey())
+		Expect(err).NotTo(HaveOccurred())
+		defer client.Close()
+
+		_, err = client.Dial("tcp", "localhost:8080")
+		Expect(err).To(MatchError(ContainSubstring("port forwarding is disabled")))
+	})
+
+	It("identifies itself with the provided host key", func() {
+		_, err := dialRelay(newSigner().PublicKey())
+		Expect(err).To(MatchError(ContainSubstring("host key mismatch")))
+	})
+
+	It("returns when the client disconnects", func() {
+		client, err := dialRelay(relayHostKey.PublicKey())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.Close()).To(Succeed())
+
+		Eventually(relayDone).Should(Receive(BeNil()))
+	})
+
+	It("disconnects the client when the application instance connection ends", func() {
+		client, err := dialRelay(relayHostKey.PublicKey())
+		Expect(err).NotTo(HaveOccurred())
+		defer client.Close()
+
+		Expect(instanceClient.Close()).To(Succeed())
+
+		Eventually(relayDone).Should(Receive(BeNil()))
+		Expect(client.Wait()).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("EnsureRelayHostKey", func() {
+	var (
+		dir            string
+		keyPath        string
+		knownHostsPath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "relay-host-key")
+		Expect(err).NotTo(HaveOccurred())
+		keyPath = filepath.Join(dir, "cf", "ssh_relay_host_key")
+		knownHostsPath = filepath.Join(dir, "cf", "ssh_relay_known_hosts")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("creates a private host key and pins it under the relay alias", func() {
+		Expect(sshCmd.EnsureRelayHostKey(keyPath, knownHostsPath)).To(Succeed())
+
+		info, err := os.Stat(keyPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+		hostKey, err := sshCmd.LoadRelayHostKey(keyPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		knownHosts, err := ioutil.ReadFile(knownHostsPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(knownHosts)).To(Equal(knownhosts.Line([]string{"cf-ssh-relay"}, hostKey.PublicKey()) + "\n"))
+	})
+
+	It("keeps an existing host key", func() {
+		Expect(sshCmd.EnsureRelayHostKey(keyPath, knownHostsPath)).To(Succeed())
+		firstKey, err := ioutil.ReadFile(keyPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(os.Remove(knownHostsPath)).To(Succeed())
+		Expect(sshCmd.EnsureRelayHostKey(keyPath, knownHostsPath)).To(Succeed())
+
+		secondKey, err := ioutil.ReadFile(keyPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secondKey).To(Equal(firstKey))
+		Expect(knownHostsPath).To(BeAnExistingFile())
+	})
+})
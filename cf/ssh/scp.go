@@ -0,0 +1,469 @@
+package sshCmd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CopyOptions configure a secure copy between the local machine and an
+// application instance.
+type CopyOptions struct {
+	// Recursive copies directories and their contents.
+	Recursive bool
+	// Preserve keeps the permissions and modification times of the copied
+	// files.
+	Preserve bool
+	// Progress, when set, is notified of every file transferred.
+	Progress CopyProgress
+}
+
+//go:generate counterfeiter . CopyProgress
+
+// CopyProgress reports the progress of a secure copy.
+type CopyProgress interface {
+	// Start is called before the contents of a file are transferred. The
+	// returned writer receives the transferred contents.
+	Start(name string, size int64) io.Writer
+	// Finish is called once the contents of the file have been transferred.
+	Finish()
+}
+
+// CopyToRemote copies a local file, or directory in recursive mode, to the
+// given path on the application instance using the scp protocol.
+func (c *secureShell) CopyToRemote(localPath string, remotePath string, copyOpts CopyOptions) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+
+	if info.IsDir() && !copyOpts.Recursive {
+		return fmt.Errorf("%s is a directory, copy it in recursive mode", localPath)
+	}
+
+	return c.runSCP(scpCommand("-t", remotePath, copyOpts), func(conn *scpConnection) error {
+		err := conn.readAck()
+		if err != nil {
+			return err
+		}
+		return conn.send(localPath, info)
+	}, copyOpts)
+}
+
+// CopyFromRemote copies a file, or directory in recursive mode, from the
+// application instance to the given local path using the scp protocol. When
+// the local path is an existing directory, the copy is placed inside it.
+func (c *secureShell) CopyFromRemote(remotePath string, localPath string, copyOpts CopyOptions) error {
+	return c.runSCP(scpCommand("-f", remotePath, copyOpts), func(conn *scpConnection) error {
+		return conn.receive(localPath)
+	}, copyOpts)
+}
+
+func (c *secureShell) runSCP(command string, transfer func(*scpConnection) error, copyOpts CopyOptions) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	inPipe, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.Start(command)
+	if err != nil {
+		return err
+	}
+
+	stderr := &bytes.Buffer{}
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go copyAndDone(wg, stderr, errPipe)
+
+	transferErr := transfer(&scpConnection{
+		in:       inPipe,
+		out:      bufio.NewReader(outPipe),
+		preserve: copyOpts.Preserve,
+		progress: copyOpts.Progress,
+	})
+	_ = inPipe.Close()
+
+	waitErr := session.Wait()
+	wg.Wait()
+
+	switch {
+	case transferErr != nil:
+		return transferErr
+	case waitErr != nil && stderr.Len() > 0:
+		return errors.New(strings.TrimSpace(stderr.String()))
+	default:
+		return waitErr
+	}
+}
+
+// scpCommand returns the remote scp command for the given mode: -t to copy to
+// the remote path and -f to copy from it.
+func scpCommand(mode string, remotePath string, copyOpts CopyOptions) string {
+	args := []string{"scp", mode}
+	if copyOpts.Recursive {
+		args = append(args, "-r")
+	}
+	if copyOpts.Preserve {
+		args = append(args, "-p")
+	}
+
+	// The remote command is run from the home directory of the vcap user, so
+	// paths relative to it can be passed on without the quoted ~.
+	remotePath = strings.TrimPrefix(remotePath, "~/")
+	if remotePath == "" || remotePath == "~" {
+		remotePath = "."
+	}
+
+	return strings.Join(append(args, "--", shellQuote(remotePath)), " ")
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+type scpConnection struct {
+	in       io.Writer
+	out      *bufio.Reader
+	preserve bool
+	progress CopyProgress
+}
+
+type scpTimes struct {
+	modTime    time.Time
+	accessTime time.Time
+}
+
+func (conn *scpConnection) ack() error {
+	_, err := conn.in.Write([]byte{0})
+	return err
+}
+
+func (conn *scpConnection) readAck() error {
+	response, err := conn.out.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	switch response {
+	case 0:
+		return nil
+	case 1, 2:
+		message, _ := conn.out.ReadString('\n')
+		return errors.New(strings.TrimSpace(message))
+	default:
+		return fmt.Errorf("unexpected scp response %q", response)
+	}
+}
+
+func (conn *scpConnection) sendLine(format string, args ...interface{}) error {
+	_, err := fmt.Fprintf(conn.in, format+"\n", args...)
+	if err != nil {
+		return err
+	}
+	return conn.readAck()
+}
+
+func (conn *scpConnection) send(path string, info os.FileInfo) error {
+	if conn.preserve {
+		err := conn.sendLine("T%d 0 %d 0", info.ModTime().Unix(), info.ModTime().Unix())
+		if err != nil {
+			return err
+		}
+	}
+
+	if info.IsDir() {
+		return conn.sendDirectory(path, info)
+	}
+	return conn.sendFile(path, info)
+}
+
+func (conn *scpConnection) sendDirectory(path string, info os.FileInfo) error {
+	err := conn.sendLine("D%04o 0 %s", info.Mode().Perm(), info.Name())
+	if err != nil {
+		return err
+	}
+
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		entryPath := filepath.Join(path, entry.Name())
+
+		// Follow symbolic links like scp does.
+		entryInfo, err := os.Stat(entryPath)
+		if err != nil {
+			return err
+		}
+		if !entryInfo.IsDir() && !entryInfo.Mode().IsRegular() {
+			continue
+		}
+
+		err = conn.send(entryPath, renamedFileInfo{FileInfo: entryInfo, name: entry.Name()})
+		if err != nil {
+			return err
+		}
+	}
+
+	return conn.sendLine("E")
+}
+
+func (conn *scpConnection) sendFile(path string, info os.FileInfo) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	err = conn.sendLine("C%04o %d %s", info.Mode().Perm(), info.Size(), info.Name())
+	if err != nil {
+		return err
+	}
+
+	var dest io.Writer = conn.in
+	if conn.progress != nil {
+		dest = io.MultiWriter(conn.in, conn.progress.Start(info.Name(), info.Size()))
+		defer conn.progress.Finish()
+	}
+
+	_, err = io.CopyN(dest, file, info.Size())
+	if err != nil {
+		return err
+	}
+
+	err = conn.ack()
+	if err != nil {
+		return err
+	}
+	return conn.readAck()
+}
+
+func (conn *scpConnection) receive(target string) error {
+	err := conn.ack()
+	if err != nil {
+		return err
+	}
+
+	var (
+		dirs     []string
+		dirTimes []*scpTimes
+		times    *scpTimes
+	)
+
+	for {
+		line, err := conn.out.ReadString('\n')
+		if err == io.EOF && line == "" {
+			if len(dirs) > 0 {
+				return io.ErrUnexpectedEOF
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return errors.New("unexpected empty scp response")
+		}
+
+		switch line[0] {
+		case 1, 2:
+			return errors.New(strings.TrimSpace(line[1:]))
+		case 'T':
+			times, err = parseSCPTimes(line[1:])
+		case 'C':
+			var header scpHeader
+			header, err = parseSCPHeader(line[1:])
+			if err == nil {
+				err = conn.receiveFile(destinationPath(target, dirs, header.name), header, times)
+			}
+			times = nil
+		case 'D':
+			var header scpHeader
+			header, err = parseSCPHeader(line[1:])
+			if err == nil {
+				dir := destinationPath(target, dirs, header.name)
+				err = receiveDirectory(dir, header)
+				dirs = append(dirs, dir)
+				dirTimes = append(dirTimes, times)
+			}
+			times = nil
+		case 'E':
+			if len(dirs) == 0 {
+				return errors.New("unexpected end of directory in scp response")
+			}
+			dir, dirTime := dirs[len(dirs)-1], dirTimes[len(dirTimes)-1]
+			dirs, dirTimes = dirs[:len(dirs)-1], dirTimes[:len(dirTimes)-1]
+			if conn.preserve && dirTime != nil {
+				err = os.Chtimes(dir, dirTime.accessTime, dirTime.modTime)
+			}
+		default:
+			return fmt.Errorf("unexpected scp response %q", line)
+		}
+		if err != nil {
+			return err
+		}
+
+		err = conn.ack()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (conn *scpConnection) receiveFile(path string, header scpHeader, times *scpTimes) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, header.mode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	err = conn.ack()
+	if err != nil {
+		return err
+	}
+
+	var dest io.Writer = file
+	if conn.progress != nil {
+		dest = io.MultiWriter(file, conn.progress.Start(header.name, header.size))
+		defer conn.progress.Finish()
+	}
+
+	_, err = io.CopyN(dest, conn.out, header.size)
+	if err != nil {
+		return err
+	}
+
+	err = conn.readAck()
+	if err != nil {
+		return err
+	}
+
+	if conn.preserve {
+		err = file.Chmod(header.mode)
+		if err != nil {
+			return err
+		}
+		if times != nil {
+			err = os.Chtimes(path, times.accessTime, times.modTime)
+		}
+	}
+	return err
+}
+
+func receiveDirectory(path string, header scpHeader) error {
+	err := os.Mkdir(path, header.mode)
+	if err != nil {
+		if info, statErr := os.Stat(path); statErr != nil || !info.IsDir() {
+			return err
+		}
+	}
+	return nil
+}
+
+// destinationPath returns where an entry received from the remote scp goes:
+// inside the current directory being received, inside the target if it is an
+// existing directory, or the target itself.
+func destinationPath(target string, dirs []string, name string) string {
+	if len(dirs) > 0 {
+		return filepath.Join(dirs[len(dirs)-1], name)
+	}
+
+	if info, err := os.Stat(target); err == nil && info.IsDir() {
+		return filepath.Join(target, name)
+	}
+	return target
+}
+
+// scpHeader is the "MODE SIZE NAME" part of a C (file) or D (directory) scp
+// message.
+type scpHeader struct {
+	mode os.FileMode
+	size int64
+	name string
+}
+
+func parseSCPHeader(header string) (scpHeader, error) {
+	parts := strings.SplitN(header, " ", 3)
+	if len(parts) != 3 {
+		return scpHeader{}, fmt.Errorf("invalid scp header %q", header)
+	}
+
+	mode, err := strconv.ParseUint(parts[0], 8, 32)
+	if err != nil {
+		return scpHeader{}, fmt.Errorf("invalid scp header %q", header)
+	}
+
+	size, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || size < 0 {
+		return scpHeader{}, fmt.Errorf("invalid scp header %q", header)
+	}
+
+	// Names are not allowed to escape the target directory.
+	name := parts[2]
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return scpHeader{}, fmt.Errorf("invalid file name %q in scp header", name)
+	}
+
+	return scpHeader{mode: os.FileMode(mode).Perm(), size: size, name: name}, nil
+}
+
+// parseSCPTimes parses the "MTIME 0 ATIME 0" part of a T scp message.
+func parseSCPTimes(header string) (*scpTimes, error) {
+	parts := strings.Split(header, " ")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("invalid scp times %q", header)
+	}
+
+	modTime, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scp times %q", header)
+	}
+
+	accessTime, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scp times %q", header)
+	}
+
+	return &scpTimes{
+		modTime:    time.Unix(modTime, 0),
+		accessTime: time.Unix(accessTime, 0),
+	}, nil
+}
+
+// renamedFileInfo keeps the name of a symbolic link when sending the file it
+// points to.
+type renamedFileInfo struct {
+	os.FileInfo
+	name string
+}
+
+func (info renamedFileInfo) Name() string {
+	return info.name
+}
@@ -0,0 +1,323 @@
+// +build !windows,!386
+
+package sshCmd_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/cf/ssh/terminal"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("SCP", func() {
+	var (
+		fakeSecureClient  *sshfakes.FakeSecureClient
+		fakeSecureDialer  *sshfakes.FakeSecureDialer
+		fakeSecureSession *sshfakes.FakeSecureSession
+		fakeProgress      *sshfakes.FakeCopyProgress
+
+		secureShell sshCmd.SecureShell
+		copyOpts    sshCmd.CopyOptions
+
+		stdin    *Buffer
+		stdout   string
+		progress *bytes.Buffer
+
+		tmpdir string
+	)
+
+	BeforeEach(func() {
+		fakeSecureClient = new(sshfakes.FakeSecureClient)
+		fakeSecureDialer = new(sshfakes.FakeSecureDialer)
+		fakeSecureSession = new(sshfakes.FakeSecureSession)
+		fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+		fakeSecureClient.NewSessionReturns(fakeSecureSession, nil)
+
+		stdin = NewBuffer()
+		stdout = ""
+		fakeSecureSession.StdinPipeReturns(stdin, nil)
+		fakeSecureSession.StderrPipeReturns(strings.NewReader(""), nil)
+
+		progress = &bytes.Buffer{}
+		fakeProgress = new(sshfakes.FakeCopyProgress)
+		fakeProgress.StartReturns(progress)
+		copyOpts = sshCmd.CopyOptions{Progress: fakeProgress}
+
+		var err error
+		tmpdir, err = ioutil.TempDir("", "scp")
+		Expect(err).ToNot(HaveOccurred())
+
+		secureShell = sshCmd.NewSecureShell(
+			fakeSecureDialer,
+			terminal.DefaultHelper(),
+			new(sshfakes.FakeListenerFactory),
+			30*time.Second,
+			models.Application{ApplicationFields: models.ApplicationFields{State: "STARTED", Diego: true}},
+			"",
+			"ssh.example.com:2222",
+			"",
+		)
+		Expect(secureShell.Connect(&options.SSHOptions{AppName: "app-1", SkipHostValidation: true})).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		fakeSecureSession.StdoutPipeReturns(strings.NewReader(stdout), nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpdir)).To(Succeed())
+	})
+
+	Describe("CopyToRemote", func() {
+		var (
+			localPath string
+			copyErr   error
+		)
+
+		BeforeEach(func() {
+			localPath = filepath.Join(tmpdir, "file.txt")
+			Expect(ioutil.WriteFile(localPath, []byte("hello"), 0640)).To(Succeed())
+			stdout = strings.Repeat("\x00", 3)
+		})
+
+		JustBeforeEach(func() {
+			copyErr = secureShell.CopyToRemote(localPath, "~/app/file.txt", copyOpts)
+		})
+
+		It("runs scp in sink mode and sends the file", func() {
+			Expect(copyErr).ToNot(HaveOccurred())
+
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("scp -t -- 'app/file.txt'"))
+			Expect(string(stdin.Contents())).To(Equal("C0640 5 file.txt\nhello\x00"))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		It("reports the progress of the file", func() {
+			Expect(fakeProgress.StartCallCount()).To(Equal(1))
+			name, size := fakeProgress.StartArgsForCall(0)
+			Expect(name).To(Equal("file.txt"))
+			Expect(size).To(BeEquivalentTo(5))
+			Expect(progress.String()).To(Equal("hello"))
+			Expect(fakeProgress.FinishCallCount()).To(Equal(1))
+		})
+
+		Context("when the remote scp rejects the file", func() {
+			BeforeEach(func() {
+				stdout = "\x00\x01scp: app/file.txt: Permission denied\n"
+			})
+
+			It("returns the error reported by scp", func() {
+				Expect(copyErr).To(MatchError("scp: app/file.txt: Permission denied"))
+			})
+		})
+
+		Context("when the local path is a directory", func() {
+			BeforeEach(func() {
+				localPath = filepath.Join(tmpdir, "dir")
+				Expect(os.Mkdir(localPath, 0750)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(localPath, "inner.txt"), []byte("hi"), 0600)).To(Succeed())
+				stdout = strings.Repeat("\x00", 5)
+			})
+
+			It("returns an error unless copying recursively", func() {
+				Expect(copyErr).To(MatchError(ContainSubstring("is a directory")))
+				Expect(fakeSecureSession.StartCallCount()).To(Equal(0))
+			})
+
+			Context("when copying recursively", func() {
+				BeforeEach(func() {
+					copyOpts.Recursive = true
+				})
+
+				It("sends the directory and its contents", func() {
+					Expect(copyErr).ToNot(HaveOccurred())
+					Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("scp -t -r -- 'app/file.txt'"))
+					Expect(string(stdin.Contents())).To(Equal("D0750 0 dir\nC0600 2 inner.txt\nhi\x00E\n"))
+				})
+			})
+		})
+
+		Context("when preserving times and modes", func() {
+			BeforeEach(func() {
+				copyOpts.Preserve = true
+				Expect(os.Chtimes(localPath, time.Unix(1500000000, 0), time.Unix(1500000000, 0))).To(Succeed())
+				stdout = strings.Repeat("\x00", 4)
+			})
+
+			It("sends the modification time first", func() {
+				Expect(copyErr).ToNot(HaveOccurred())
+				Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("scp -t -p -- 'app/file.txt'"))
+				Expect(string(stdin.Contents())).To(HavePrefix("T1500000000 0 1500000000 0\nC0640 5 file.txt\n"))
+			})
+		})
+	})
+
+	Describe("CopyFromRemote", func() {
+		var (
+			remotePath string
+			localPath  string
+			copyErr    error
+		)
+
+		BeforeEach(func() {
+			remotePath = "app/it's.txt"
+			localPath = filepath.Join(tmpdir, "local.txt")
+			stdout = "C0600 5 it's.txt\nhello\x00"
+		})
+
+		JustBeforeEach(func() {
+			copyErr = secureShell.CopyFromRemote(remotePath, localPath, copyOpts)
+		})
+
+		It("runs scp in source mode and writes the file", func() {
+			Expect(copyErr).ToNot(HaveOccurred())
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal(`scp -f -- 'app/it'\''s.txt'`))
+			Expect(string(stdin.Contents())).To(Equal("\x00\x00\x00"))
+
+			contents, err := ioutil.ReadFile(localPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("hello"))
+			Expect(progress.String()).To(Equal("hello"))
+		})
+
+		Context("when the local path is an existing directory", func() {
+			BeforeEach(func() {
+				localPath = tmpdir
+			})
+
+			It("writes the file inside it", func() {
+				Expect(copyErr).ToNot(HaveOccurred())
+				Expect(filepath.Join(tmpdir, "it's.txt")).To(BeAnExistingFile())
+			})
+		})
+
+		Context("when receiving a directory", func() {
+			BeforeEach(func() {
+				copyOpts.Recursive = true
+				copyOpts.Preserve = true
+				localPath = filepath.Join(tmpdir, "copy")
+				stdout = "T1500000000 0 1500000000 0\nD0700 0 dir\nC0640 2 inner.txt\nhi\x00E\n"
+			})
+
+			It("creates the directory and its contents", func() {
+				Expect(copyErr).ToNot(HaveOccurred())
+				Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal(`scp -f -r -p -- 'app/it'\''s.txt'`))
+
+				contents, err := ioutil.ReadFile(filepath.Join(localPath, "inner.txt"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("hi"))
+
+				info, err := os.Stat(localPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.ModTime().Unix()).To(BeEquivalentTo(1500000000))
+			})
+		})
+
+		Context("when a file name tries to escape the target directory", func() {
+			BeforeEach(func() {
+				localPath = tmpdir
+				stdout = "C0600 5 ../evil\nhello\x00"
+			})
+
+			It("refuses it", func() {
+				Expect(copyErr).To(MatchError(`invalid file name "../evil" in scp header`))
+				Expect(filepath.Join(tmpdir, "..", "evil")).ToNot(BeAnExistingFile())
+			})
+		})
+
+		Context("when the remote scp reports an error", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("exit status 1"))
+				stdout = "\x01scp: app/it's.txt: No such file or directory\n"
+			})
+
+			It("returns it", func() {
+				Expect(copyErr).To(MatchError("scp: app/it's.txt: No such file or directory"))
+			})
+		})
+	})
+
+	Describe("Subsystem", func() {
+		It("relays stdin and stdout to the requested subsystem", func() {
+			stdoutPipe := strings.NewReader("from remote")
+			fakeSecureSession.StdoutPipeReturns(stdoutPipe, nil)
+
+			output := &bytes.Buffer{}
+			err := secureShell.Subsystem("sftp", strings.NewReader("from local"), output)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeSecureSession.RequestSubsystemCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.RequestSubsystemArgsForCall(0)).To(Equal("sftp"))
+			Eventually(stdin.Contents).Should(Equal([]byte("from local")))
+			Expect(output.String()).To(Equal("from remote"))
+		})
+
+		Context("when requesting the subsystem fails", func() {
+			It("returns the error", func() {
+				fakeSecureSession.RequestSubsystemReturns(errors.New("no sftp"))
+				err := secureShell.Subsystem("sftp", strings.NewReader(""), ioutil.Discard)
+				Expect(err).To(MatchError("no sftp"))
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		var (
+			output    *bytes.Buffer
+			errOutput *bytes.Buffer
+			runErr    error
+		)
+
+		BeforeEach(func() {
+			stdout = "from stdout"
+			fakeSecureSession.StderrPipeReturns(strings.NewReader("from stderr"), nil)
+			fakeSecureSession.WaitReturns(errors.New("exit status 3"))
+			output = &bytes.Buffer{}
+			errOutput = &bytes.Buffer{}
+		})
+
+		JustBeforeEach(func() {
+			runErr = secureShell.Run("uptime", output, errOutput)
+		})
+
+		It("runs the command without a terminal and copies its output", func() {
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("uptime"))
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StdinPipeCallCount()).To(Equal(0))
+
+			Expect(output.String()).To(Equal("from stdout"))
+			Expect(errOutput.String()).To(Equal("from stderr"))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		It("returns the result of the command", func() {
+			Expect(runErr).To(MatchError("exit status 3"))
+		})
+
+		Context("when starting the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StartReturns(errors.New("no shell"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("no shell"))
+				Expect(fakeSecureSession.WaitCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -38,6 +38,13 @@ type SecureShell interface {
 	Connect(opts *options.SSHOptions) error
 	InteractiveSession() error
 	LocalPortForward() error
+	DynamicPortForward() error
+	RemotePortForward() error
+	CopyToRemote(localPath string, remotePath string, copyOpts CopyOptions) error
+	CopyFromRemote(remotePath string, localPath string, copyOpts CopyOptions) error
+	Subsystem(name string, stdin io.Reader, stdout io.Writer) error
+	Relay(hostKey ssh.Signer, stdin io.Reader, stdout io.Writer) error
+	Run(command string, stdout io.Writer, stderr io.Writer) error
 	Wait() error
 	Close() error
 }
@@ -54,6 +61,7 @@ type SecureClient interface {
 	NewSession() (SecureSession, error)
 	Conn() ssh.Conn
 	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
 	Wait() error
 	Close() error
 }
@@ -68,6 +76,7 @@ type ListenerFactory interface {
 
 type SecureSession interface {
 	RequestPty(term string, height, width int, termModes ssh.TerminalModes) error
+	RequestSubsystem(subsystem string) error
 	SendRequest(name string, wantReply bool, payload []byte) (bool, error)
 	StdinPipe() (io.WriteCloser, error)
 	StdoutPipe() (io.Reader, error)
@@ -90,7 +99,8 @@ type secureShell struct {
 	secureClient           SecureClient
 	opts                   *options.SSHOptions
 
-	localListeners []net.Listener
+	localListeners  []net.Listener
+	remoteListeners []net.Listener
 }
 
 func NewSecureShell(
@@ -144,6 +154,9 @@ func (c *secureShell) Close() error {
 	for _, listener := range c.localListeners {
 		_ = listener.Close()
 	}
+	for _, listener := range c.remoteListeners {
+		_ = listener.Close()
+	}
 	return c.secureClient.Close()
 }
 
@@ -188,12 +201,7 @@ func (c *secureShell) handleForwardConnection(conn net.Conn, targetAddr string)
 	}
 	defer target.Close()
 
-	wg := &sync.WaitGroup{}
-	wg.Add(2)
-
-	go copyAndClose(wg, conn, target)
-	go copyAndClose(wg, target, conn)
-	wg.Wait()
+	proxyConnection(conn, target)
 }
 
 func copyAndClose(wg *sync.WaitGroup, dest io.WriteCloser, src io.Reader) {
@@ -313,6 +321,87 @@ func (c *secureShell) InteractiveSession() error {
 	return result
 }
 
+// Subsystem starts the named subsystem, such as sftp, on the application
+// instance and connects it to stdin and stdout until it exits.
+func (c *secureShell) Subsystem(name string, stdin io.Reader, stdout io.Writer) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	inPipe, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.RequestSubsystem(name)
+	if err != nil {
+		return err
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+
+	go copyAndClose(nil, inPipe, stdin)
+	go copyAndDone(wg, stdout, outPipe)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	result := session.Wait()
+	wg.Wait()
+	return result
+}
+
+// Run runs the command on the application instance without a terminal or
+// standard input and copies its output to stdout and stderr until it exits.
+// A command that exits with a non-zero status returns an *ssh.ExitError.
+func (c *secureShell) Run(command string, stdout io.Writer, stderr io.Writer) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.Start(command)
+	if err != nil {
+		return err
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndDone(wg, stdout, outPipe)
+	go copyAndDone(wg, stderr, errPipe)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	result := session.Wait()
+	wg.Wait()
+	return result
+}
+
 func (c *secureShell) Wait() error {
 	keepaliveStopCh := make(chan struct{})
 	defer close(keepaliveStopCh)
@@ -349,6 +438,9 @@ func base64Sha256Fingerprint(key ssh.PublicKey) string {
 	return base64.RawStdEncoding.EncodeToString(sum[:])
 }
 
+// fingerprintCallback checks the host key against the fingerprint advertised
+// by the API and, when opts has a known hosts file, against the key pinned
+// for the endpoint.
 func fingerprintCallback(opts *options.SSHOptions, expectedFingerprint string) ssh.HostKeyCallback {
 	if opts.SkipHostValidation {
 		return nil
@@ -374,6 +466,10 @@ func fingerprintCallback(opts *options.SSHOptions, expectedFingerprint string) s
 		if fingerprint != expectedFingerprint {
 			return fmt.Errorf("Host key verification failed.\n\nThe fingerprint of the received key was %q.", fingerprint)
 		}
+
+		if opts.KnownHostsFile != "" {
+			return knownHostsCallback(opts.KnownHostsFile, opts.ResetHostKey)(hostname, remote, key)
+		}
 		return nil
 	}
 }
@@ -477,6 +573,9 @@ func (sc *secureClient) Wait() error    { return sc.client.Wait() }
 func (sc *secureClient) Dial(n, addr string) (net.Conn, error) {
 	return sc.client.Dial(n, addr)
 }
+func (sc *secureClient) Listen(n, addr string) (net.Listener, error) {
+	return sc.client.Listen(n, addr)
+}
 func (sc *secureClient) NewSession() (SecureSession, error) {
 	return sc.client.NewSession()
 }
@@ -39,6 +39,22 @@ type FakeConfig struct {
 		name string
 		url  string
 	}
+	AddScheduledTaskStub        func(scheduledTask configv3.ScheduledTask)
+	addScheduledTaskMutex       sync.RWMutex
+	addScheduledTaskArgsForCall []struct {
+		scheduledTask configv3.ScheduledTask
+	}
+	AppendServiceKeyRotationRecordStub        func(record configv3.ServiceKeyRotationRecord) error
+	appendServiceKeyRotationRecordMutex       sync.RWMutex
+	appendServiceKeyRotationRecordArgsForCall []struct {
+		record configv3.ServiceKeyRotationRecord
+	}
+	appendServiceKeyRotationRecordReturns struct {
+		result1 error
+	}
+	appendServiceKeyRotationRecordReturnsOnCall map[int]struct {
+		result1 error
+	}
 	BinaryNameStub        func() string
 	binaryNameMutex       sync.RWMutex
 	binaryNameArgsForCall []struct{}
@@ -121,6 +137,28 @@ type FakeConfig struct {
 		result1 configv3.Plugin
 		result2 bool
 	}
+	GetScheduledTaskStub        func(name string) (configv3.ScheduledTask, bool)
+	getScheduledTaskMutex       sync.RWMutex
+	getScheduledTaskArgsForCall []struct {
+		name string
+	}
+	getScheduledTaskReturns struct {
+		result1 configv3.ScheduledTask
+		result2 bool
+	}
+	getScheduledTaskReturnsOnCall map[int]struct {
+		result1 configv3.ScheduledTask
+		result2 bool
+	}
+	HTTPSProxyStub        func() string
+	hTTPSProxyMutex       sync.RWMutex
+	hTTPSProxyArgsForCall []struct{}
+	hTTPSProxyReturns     struct {
+		result1 string
+	}
+	hTTPSProxyReturnsOnCall map[int]struct {
+		result1 string
+	}
 	HasTargetedOrganizationStub        func() bool
 	hasTargetedOrganizationMutex       sync.RWMutex
 	hasTargetedOrganizationArgsForCall []struct{}
@@ -139,6 +177,24 @@ type FakeConfig struct {
 	hasTargetedSpaceReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	KnownHostsFilePathStub        func() string
+	knownHostsFilePathMutex       sync.RWMutex
+	knownHostsFilePathArgsForCall []struct{}
+	knownHostsFilePathReturns     struct {
+		result1 string
+	}
+	knownHostsFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
+	LoadScheduledTasksConfigStub        func() error
+	loadScheduledTasksConfigMutex       sync.RWMutex
+	loadScheduledTasksConfigArgsForCall []struct{}
+	loadScheduledTasksConfigReturns     struct {
+		result1 error
+	}
+	loadScheduledTasksConfigReturnsOnCall map[int]struct {
+		result1 error
+	}
 	LocaleStub        func() string
 	localeMutex       sync.RWMutex
 	localeArgsForCall []struct{}
@@ -148,6 +204,17 @@ type FakeConfig struct {
 	localeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	MarkServiceKeyRotationOldKeyDeletedStub        func(newServiceKeyGUID string) error
+	markServiceKeyRotationOldKeyDeletedMutex       sync.RWMutex
+	markServiceKeyRotationOldKeyDeletedArgsForCall []struct {
+		newServiceKeyGUID string
+	}
+	markServiceKeyRotationOldKeyDeletedReturns struct {
+		result1 error
+	}
+	markServiceKeyRotationOldKeyDeletedReturnsOnCall map[int]struct {
+		result1 error
+	}
 	MinCLIVersionStub        func() string
 	minCLIVersionMutex       sync.RWMutex
 	minCLIVersionArgsForCall []struct{}
@@ -202,6 +269,18 @@ type FakeConfig struct {
 	pollingIntervalReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	RecordScheduledTaskRunStub        func(name string, run configv3.ScheduledTaskRun) error
+	recordScheduledTaskRunMutex       sync.RWMutex
+	recordScheduledTaskRunArgsForCall []struct {
+		name string
+		run  configv3.ScheduledTaskRun
+	}
+	recordScheduledTaskRunReturns struct {
+		result1 error
+	}
+	recordScheduledTaskRunReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct{}
@@ -216,6 +295,11 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RemoveScheduledTaskStub        func(name string)
+	removeScheduledTaskMutex       sync.RWMutex
+	removeScheduledTaskArgsForCall []struct {
+		name string
+	}
 	SSHOAuthClientStub        func() string
 	sSHOAuthClientMutex       sync.RWMutex
 	sSHOAuthClientArgsForCall []struct{}
@@ -225,6 +309,42 @@ type FakeConfig struct {
 	sSHOAuthClientReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SSHRelayHostKeyFilePathStub        func() string
+	sSHRelayHostKeyFilePathMutex       sync.RWMutex
+	sSHRelayHostKeyFilePathArgsForCall []struct{}
+	sSHRelayHostKeyFilePathReturns     struct {
+		result1 string
+	}
+	sSHRelayHostKeyFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
+	SSHRelayKnownHostsFilePathStub        func() string
+	sSHRelayKnownHostsFilePathMutex       sync.RWMutex
+	sSHRelayKnownHostsFilePathArgsForCall []struct{}
+	sSHRelayKnownHostsFilePathReturns     struct {
+		result1 string
+	}
+	sSHRelayKnownHostsFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ScheduledTasksStub        func() []configv3.ScheduledTask
+	scheduledTasksMutex       sync.RWMutex
+	scheduledTasksArgsForCall []struct{}
+	scheduledTasksReturns     struct {
+		result1 []configv3.ScheduledTask
+	}
+	scheduledTasksReturnsOnCall map[int]struct {
+		result1 []configv3.ScheduledTask
+	}
+	ServiceKeyRotationsFilePathStub        func() string
+	serviceKeyRotationsFilePathMutex       sync.RWMutex
+	serviceKeyRotationsFilePathArgsForCall []struct{}
+	serviceKeyRotationsFilePathReturns     struct {
+		result1 string
+	}
+	serviceKeyRotationsFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
 	SetAccessTokenStub        func(token string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
@@ -365,6 +485,15 @@ type FakeConfig struct {
 	writePluginConfigReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WriteScheduledTasksConfigStub        func() error
+	writeScheduledTasksConfigMutex       sync.RWMutex
+	writeScheduledTasksConfigArgsForCall []struct{}
+	writeScheduledTasksConfigReturns     struct {
+		result1 error
+	}
+	writeScheduledTasksConfigReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -498,6 +627,78 @@ func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
 	return fake.addPluginRepositoryArgsForCall[i].name, fake.addPluginRepositoryArgsForCall[i].url
 }
 
+func (fake *FakeConfig) AddScheduledTask(scheduledTask configv3.ScheduledTask) {
+	fake.addScheduledTaskMutex.Lock()
+	fake.addScheduledTaskArgsForCall = append(fake.addScheduledTaskArgsForCall, struct {
+		scheduledTask configv3.ScheduledTask
+	}{scheduledTask})
+	fake.recordInvocation("AddScheduledTask", []interface{}{scheduledTask})
+	fake.addScheduledTaskMutex.Unlock()
+	if fake.AddScheduledTaskStub != nil {
+		fake.AddScheduledTaskStub(scheduledTask)
+	}
+}
+
+func (fake *FakeConfig) AddScheduledTaskCallCount() int {
+	fake.addScheduledTaskMutex.RLock()
+	defer fake.addScheduledTaskMutex.RUnlock()
+	return len(fake.addScheduledTaskArgsForCall)
+}
+
+func (fake *FakeConfig) AddScheduledTaskArgsForCall(i int) configv3.ScheduledTask {
+	fake.addScheduledTaskMutex.RLock()
+	defer fake.addScheduledTaskMutex.RUnlock()
+	return fake.addScheduledTaskArgsForCall[i].scheduledTask
+}
+
+func (fake *FakeConfig) AppendServiceKeyRotationRecord(record configv3.ServiceKeyRotationRecord) error {
+	fake.appendServiceKeyRotationRecordMutex.Lock()
+	ret, specificReturn := fake.appendServiceKeyRotationRecordReturnsOnCall[len(fake.appendServiceKeyRotationRecordArgsForCall)]
+	fake.appendServiceKeyRotationRecordArgsForCall = append(fake.appendServiceKeyRotationRecordArgsForCall, struct {
+		record configv3.ServiceKeyRotationRecord
+	}{record})
+	fake.recordInvocation("AppendServiceKeyRotationRecord", []interface{}{record})
+	fake.appendServiceKeyRotationRecordMutex.Unlock()
+	if fake.AppendServiceKeyRotationRecordStub != nil {
+		return fake.AppendServiceKeyRotationRecordStub(record)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.appendServiceKeyRotationRecordReturns.result1
+}
+
+func (fake *FakeConfig) AppendServiceKeyRotationRecordCallCount() int {
+	fake.appendServiceKeyRotationRecordMutex.RLock()
+	defer fake.appendServiceKeyRotationRecordMutex.RUnlock()
+	return len(fake.appendServiceKeyRotationRecordArgsForCall)
+}
+
+func (fake *FakeConfig) AppendServiceKeyRotationRecordArgsForCall(i int) configv3.ServiceKeyRotationRecord {
+	fake.appendServiceKeyRotationRecordMutex.RLock()
+	defer fake.appendServiceKeyRotationRecordMutex.RUnlock()
+	return fake.appendServiceKeyRotationRecordArgsForCall[i].record
+}
+
+func (fake *FakeConfig) AppendServiceKeyRotationRecordReturns(result1 error) {
+	fake.AppendServiceKeyRotationRecordStub = nil
+	fake.appendServiceKeyRotationRecordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) AppendServiceKeyRotationRecordReturnsOnCall(i int, result1 error) {
+	fake.AppendServiceKeyRotationRecordStub = nil
+	if fake.appendServiceKeyRotationRecordReturnsOnCall == nil {
+		fake.appendServiceKeyRotationRecordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.appendServiceKeyRotationRecordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) BinaryName() string {
 	fake.binaryNameMutex.Lock()
 	ret, specificReturn := fake.binaryNameReturnsOnCall[len(fake.binaryNameArgsForCall)]
@@ -843,6 +1044,97 @@ func (fake *FakeConfig) GetPluginCaseInsensitiveReturnsOnCall(i int, result1 con
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) GetScheduledTask(name string) (configv3.ScheduledTask, bool) {
+	fake.getScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.getScheduledTaskReturnsOnCall[len(fake.getScheduledTaskArgsForCall)]
+	fake.getScheduledTaskArgsForCall = append(fake.getScheduledTaskArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetScheduledTask", []interface{}{name})
+	fake.getScheduledTaskMutex.Unlock()
+	if fake.GetScheduledTaskStub != nil {
+		return fake.GetScheduledTaskStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getScheduledTaskReturns.result1, fake.getScheduledTaskReturns.result2
+}
+
+func (fake *FakeConfig) GetScheduledTaskCallCount() int {
+	fake.getScheduledTaskMutex.RLock()
+	defer fake.getScheduledTaskMutex.RUnlock()
+	return len(fake.getScheduledTaskArgsForCall)
+}
+
+func (fake *FakeConfig) GetScheduledTaskArgsForCall(i int) string {
+	fake.getScheduledTaskMutex.RLock()
+	defer fake.getScheduledTaskMutex.RUnlock()
+	return fake.getScheduledTaskArgsForCall[i].name
+}
+
+func (fake *FakeConfig) GetScheduledTaskReturns(result1 configv3.ScheduledTask, result2 bool) {
+	fake.GetScheduledTaskStub = nil
+	fake.getScheduledTaskReturns = struct {
+		result1 configv3.ScheduledTask
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) GetScheduledTaskReturnsOnCall(i int, result1 configv3.ScheduledTask, result2 bool) {
+	fake.GetScheduledTaskStub = nil
+	if fake.getScheduledTaskReturnsOnCall == nil {
+		fake.getScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 configv3.ScheduledTask
+			result2 bool
+		})
+	}
+	fake.getScheduledTaskReturnsOnCall[i] = struct {
+		result1 configv3.ScheduledTask
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) HTTPSProxy() string {
+	fake.hTTPSProxyMutex.Lock()
+	ret, specificReturn := fake.hTTPSProxyReturnsOnCall[len(fake.hTTPSProxyArgsForCall)]
+	fake.hTTPSProxyArgsForCall = append(fake.hTTPSProxyArgsForCall, struct{}{})
+	fake.recordInvocation("HTTPSProxy", []interface{}{})
+	fake.hTTPSProxyMutex.Unlock()
+	if fake.HTTPSProxyStub != nil {
+		return fake.HTTPSProxyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.hTTPSProxyReturns.result1
+}
+
+func (fake *FakeConfig) HTTPSProxyCallCount() int {
+	fake.hTTPSProxyMutex.RLock()
+	defer fake.hTTPSProxyMutex.RUnlock()
+	return len(fake.hTTPSProxyArgsForCall)
+}
+
+func (fake *FakeConfig) HTTPSProxyReturns(result1 string) {
+	fake.HTTPSProxyStub = nil
+	fake.hTTPSProxyReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) HTTPSProxyReturnsOnCall(i int, result1 string) {
+	fake.HTTPSProxyStub = nil
+	if fake.hTTPSProxyReturnsOnCall == nil {
+		fake.hTTPSProxyReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.hTTPSProxyReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) HasTargetedOrganization() bool {
 	fake.hasTargetedOrganizationMutex.Lock()
 	ret, specificReturn := fake.hasTargetedOrganizationReturnsOnCall[len(fake.hasTargetedOrganizationArgsForCall)]
@@ -923,6 +1215,86 @@ func (fake *FakeConfig) HasTargetedSpaceReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) KnownHostsFilePath() string {
+	fake.knownHostsFilePathMutex.Lock()
+	ret, specificReturn := fake.knownHostsFilePathReturnsOnCall[len(fake.knownHostsFilePathArgsForCall)]
+	fake.knownHostsFilePathArgsForCall = append(fake.knownHostsFilePathArgsForCall, struct{}{})
+	fake.recordInvocation("KnownHostsFilePath", []interface{}{})
+	fake.knownHostsFilePathMutex.Unlock()
+	if fake.KnownHostsFilePathStub != nil {
+		return fake.KnownHostsFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.knownHostsFilePathReturns.result1
+}
+
+func (fake *FakeConfig) KnownHostsFilePathCallCount() int {
+	fake.knownHostsFilePathMutex.RLock()
+	defer fake.knownHostsFilePathMutex.RUnlock()
+	return len(fake.knownHostsFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) KnownHostsFilePathReturns(result1 string) {
+	fake.KnownHostsFilePathStub = nil
+	fake.knownHostsFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) KnownHostsFilePathReturnsOnCall(i int, result1 string) {
+	fake.KnownHostsFilePathStub = nil
+	if fake.knownHostsFilePathReturnsOnCall == nil {
+		fake.knownHostsFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.knownHostsFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) LoadScheduledTasksConfig() error {
+	fake.loadScheduledTasksConfigMutex.Lock()
+	ret, specificReturn := fake.loadScheduledTasksConfigReturnsOnCall[len(fake.loadScheduledTasksConfigArgsForCall)]
+	fake.loadScheduledTasksConfigArgsForCall = append(fake.loadScheduledTasksConfigArgsForCall, struct{}{})
+	fake.recordInvocation("LoadScheduledTasksConfig", []interface{}{})
+	fake.loadScheduledTasksConfigMutex.Unlock()
+	if fake.LoadScheduledTasksConfigStub != nil {
+		return fake.LoadScheduledTasksConfigStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.loadScheduledTasksConfigReturns.result1
+}
+
+func (fake *FakeConfig) LoadScheduledTasksConfigCallCount() int {
+	fake.loadScheduledTasksConfigMutex.RLock()
+	defer fake.loadScheduledTasksConfigMutex.RUnlock()
+	return len(fake.loadScheduledTasksConfigArgsForCall)
+}
+
+func (fake *FakeConfig) LoadScheduledTasksConfigReturns(result1 error) {
+	fake.LoadScheduledTasksConfigStub = nil
+	fake.loadScheduledTasksConfigReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) LoadScheduledTasksConfigReturnsOnCall(i int, result1 error) {
+	fake.LoadScheduledTasksConfigStub = nil
+	if fake.loadScheduledTasksConfigReturnsOnCall == nil {
+		fake.loadScheduledTasksConfigReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.loadScheduledTasksConfigReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) Locale() string {
 	fake.localeMutex.Lock()
 	ret, specificReturn := fake.localeReturnsOnCall[len(fake.localeArgsForCall)]
@@ -963,6 +1335,54 @@ func (fake *FakeConfig) LocaleReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) MarkServiceKeyRotationOldKeyDeleted(newServiceKeyGUID string) error {
+	fake.markServiceKeyRotationOldKeyDeletedMutex.Lock()
+	ret, specificReturn := fake.markServiceKeyRotationOldKeyDeletedReturnsOnCall[len(fake.markServiceKeyRotationOldKeyDeletedArgsForCall)]
+	fake.markServiceKeyRotationOldKeyDeletedArgsForCall = append(fake.markServiceKeyRotationOldKeyDeletedArgsForCall, struct {
+		newServiceKeyGUID string
+	}{newServiceKeyGUID})
+	fake.recordInvocation("MarkServiceKeyRotationOldKeyDeleted", []interface{}{newServiceKeyGUID})
+	fake.markServiceKeyRotationOldKeyDeletedMutex.Unlock()
+	if fake.MarkServiceKeyRotationOldKeyDeletedStub != nil {
+		return fake.MarkServiceKeyRotationOldKeyDeletedStub(newServiceKeyGUID)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.markServiceKeyRotationOldKeyDeletedReturns.result1
+}
+
+func (fake *FakeConfig) MarkServiceKeyRotationOldKeyDeletedCallCount() int {
+	fake.markServiceKeyRotationOldKeyDeletedMutex.RLock()
+	defer fake.markServiceKeyRotationOldKeyDeletedMutex.RUnlock()
+	return len(fake.markServiceKeyRotationOldKeyDeletedArgsForCall)
+}
+
+func (fake *FakeConfig) MarkServiceKeyRotationOldKeyDeletedArgsForCall(i int) string {
+	fake.markServiceKeyRotationOldKeyDeletedMutex.RLock()
+	defer fake.markServiceKeyRotationOldKeyDeletedMutex.RUnlock()
+	return fake.markServiceKeyRotationOldKeyDeletedArgsForCall[i].newServiceKeyGUID
+}
+
+func (fake *FakeConfig) MarkServiceKeyRotationOldKeyDeletedReturns(result1 error) {
+	fake.MarkServiceKeyRotationOldKeyDeletedStub = nil
+	fake.markServiceKeyRotationOldKeyDeletedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) MarkServiceKeyRotationOldKeyDeletedReturnsOnCall(i int, result1 error) {
+	fake.MarkServiceKeyRotationOldKeyDeletedStub = nil
+	if fake.markServiceKeyRotationOldKeyDeletedReturnsOnCall == nil {
+		fake.markServiceKeyRotationOldKeyDeletedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markServiceKeyRotationOldKeyDeletedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) MinCLIVersion() string {
 	fake.minCLIVersionMutex.Lock()
 	ret, specificReturn := fake.minCLIVersionReturnsOnCall[len(fake.minCLIVersionArgsForCall)]
@@ -1203,6 +1623,55 @@ func (fake *FakeConfig) PollingIntervalReturnsOnCall(i int, result1 time.Duratio
 	}{result1}
 }
 
+func (fake *FakeConfig) RecordScheduledTaskRun(name string, run configv3.ScheduledTaskRun) error {
+	fake.recordScheduledTaskRunMutex.Lock()
+	ret, specificReturn := fake.recordScheduledTaskRunReturnsOnCall[len(fake.recordScheduledTaskRunArgsForCall)]
+	fake.recordScheduledTaskRunArgsForCall = append(fake.recordScheduledTaskRunArgsForCall, struct {
+		name string
+		run  configv3.ScheduledTaskRun
+	}{name, run})
+	fake.recordInvocation("RecordScheduledTaskRun", []interface{}{name, run})
+	fake.recordScheduledTaskRunMutex.Unlock()
+	if fake.RecordScheduledTaskRunStub != nil {
+		return fake.RecordScheduledTaskRunStub(name, run)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.recordScheduledTaskRunReturns.result1
+}
+
+func (fake *FakeConfig) RecordScheduledTaskRunCallCount() int {
+	fake.recordScheduledTaskRunMutex.RLock()
+	defer fake.recordScheduledTaskRunMutex.RUnlock()
+	return len(fake.recordScheduledTaskRunArgsForCall)
+}
+
+func (fake *FakeConfig) RecordScheduledTaskRunArgsForCall(i int) (string, configv3.ScheduledTaskRun) {
+	fake.recordScheduledTaskRunMutex.RLock()
+	defer fake.recordScheduledTaskRunMutex.RUnlock()
+	return fake.recordScheduledTaskRunArgsForCall[i].name, fake.recordScheduledTaskRunArgsForCall[i].run
+}
+
+func (fake *FakeConfig) RecordScheduledTaskRunReturns(result1 error) {
+	fake.RecordScheduledTaskRunStub = nil
+	fake.recordScheduledTaskRunReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) RecordScheduledTaskRunReturnsOnCall(i int, result1 error) {
+	fake.RecordScheduledTaskRunStub = nil
+	if fake.recordScheduledTaskRunReturnsOnCall == nil {
+		fake.recordScheduledTaskRunReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordScheduledTaskRunReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -1267,6 +1736,30 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) RemoveScheduledTask(name string) {
+	fake.removeScheduledTaskMutex.Lock()
+	fake.removeScheduledTaskArgsForCall = append(fake.removeScheduledTaskArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("RemoveScheduledTask", []interface{}{name})
+	fake.removeScheduledTaskMutex.Unlock()
+	if fake.RemoveScheduledTaskStub != nil {
+		fake.RemoveScheduledTaskStub(name)
+	}
+}
+
+func (fake *FakeConfig) RemoveScheduledTaskCallCount() int {
+	fake.removeScheduledTaskMutex.RLock()
+	defer fake.removeScheduledTaskMutex.RUnlock()
+	return len(fake.removeScheduledTaskArgsForCall)
+}
+
+func (fake *FakeConfig) RemoveScheduledTaskArgsForCall(i int) string {
+	fake.removeScheduledTaskMutex.RLock()
+	defer fake.removeScheduledTaskMutex.RUnlock()
+	return fake.removeScheduledTaskArgsForCall[i].name
+}
+
 func (fake *FakeConfig) SSHOAuthClient() string {
 	fake.sSHOAuthClientMutex.Lock()
 	ret, specificReturn := fake.sSHOAuthClientReturnsOnCall[len(fake.sSHOAuthClientArgsForCall)]
@@ -1307,6 +1800,166 @@ func (fake *FakeConfig) SSHOAuthClientReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) SSHRelayHostKeyFilePath() string {
+	fake.sSHRelayHostKeyFilePathMutex.Lock()
+	ret, specificReturn := fake.sSHRelayHostKeyFilePathReturnsOnCall[len(fake.sSHRelayHostKeyFilePathArgsForCall)]
+	fake.sSHRelayHostKeyFilePathArgsForCall = append(fake.sSHRelayHostKeyFilePathArgsForCall, struct{}{})
+	fake.recordInvocation("SSHRelayHostKeyFilePath", []interface{}{})
+	fake.sSHRelayHostKeyFilePathMutex.Unlock()
+	if fake.SSHRelayHostKeyFilePathStub != nil {
+		return fake.SSHRelayHostKeyFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.sSHRelayHostKeyFilePathReturns.result1
+}
+
+func (fake *FakeConfig) SSHRelayHostKeyFilePathCallCount() int {
+	fake.sSHRelayHostKeyFilePathMutex.RLock()
+	defer fake.sSHRelayHostKeyFilePathMutex.RUnlock()
+	return len(fake.sSHRelayHostKeyFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) SSHRelayHostKeyFilePathReturns(result1 string) {
+	fake.SSHRelayHostKeyFilePathStub = nil
+	fake.sSHRelayHostKeyFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) SSHRelayHostKeyFilePathReturnsOnCall(i int, result1 string) {
+	fake.SSHRelayHostKeyFilePathStub = nil
+	if fake.sSHRelayHostKeyFilePathReturnsOnCall == nil {
+		fake.sSHRelayHostKeyFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.sSHRelayHostKeyFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) SSHRelayKnownHostsFilePath() string {
+	fake.sSHRelayKnownHostsFilePathMutex.Lock()
+	ret, specificReturn := fake.sSHRelayKnownHostsFilePathReturnsOnCall[len(fake.sSHRelayKnownHostsFilePathArgsForCall)]
+	fake.sSHRelayKnownHostsFilePathArgsForCall = append(fake.sSHRelayKnownHostsFilePathArgsForCall, struct{}{})
+	fake.recordInvocation("SSHRelayKnownHostsFilePath", []interface{}{})
+	fake.sSHRelayKnownHostsFilePathMutex.Unlock()
+	if fake.SSHRelayKnownHostsFilePathStub != nil {
+		return fake.SSHRelayKnownHostsFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.sSHRelayKnownHostsFilePathReturns.result1
+}
+
+func (fake *FakeConfig) SSHRelayKnownHostsFilePathCallCount() int {
+	fake.sSHRelayKnownHostsFilePathMutex.RLock()
+	defer fake.sSHRelayKnownHostsFilePathMutex.RUnlock()
+	return len(fake.sSHRelayKnownHostsFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) SSHRelayKnownHostsFilePathReturns(result1 string) {
+	fake.SSHRelayKnownHostsFilePathStub = nil
+	fake.sSHRelayKnownHostsFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) SSHRelayKnownHostsFilePathReturnsOnCall(i int, result1 string) {
+	fake.SSHRelayKnownHostsFilePathStub = nil
+	if fake.sSHRelayKnownHostsFilePathReturnsOnCall == nil {
+		fake.sSHRelayKnownHostsFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.sSHRelayKnownHostsFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ScheduledTasks() []configv3.ScheduledTask {
+	fake.scheduledTasksMutex.Lock()
+	ret, specificReturn := fake.scheduledTasksReturnsOnCall[len(fake.scheduledTasksArgsForCall)]
+	fake.scheduledTasksArgsForCall = append(fake.scheduledTasksArgsForCall, struct{}{})
+	fake.recordInvocation("ScheduledTasks", []interface{}{})
+	fake.scheduledTasksMutex.Unlock()
+	if fake.ScheduledTasksStub != nil {
+		return fake.ScheduledTasksStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.scheduledTasksReturns.result1
+}
+
+func (fake *FakeConfig) ScheduledTasksCallCount() int {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	return len(fake.scheduledTasksArgsForCall)
+}
+
+func (fake *FakeConfig) ScheduledTasksReturns(result1 []configv3.ScheduledTask) {
+	fake.ScheduledTasksStub = nil
+	fake.scheduledTasksReturns = struct {
+		result1 []configv3.ScheduledTask
+	}{result1}
+}
+
+func (fake *FakeConfig) ScheduledTasksReturnsOnCall(i int, result1 []configv3.ScheduledTask) {
+	fake.ScheduledTasksStub = nil
+	if fake.scheduledTasksReturnsOnCall == nil {
+		fake.scheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 []configv3.ScheduledTask
+		})
+	}
+	fake.scheduledTasksReturnsOnCall[i] = struct {
+		result1 []configv3.ScheduledTask
+	}{result1}
+}
+
+func (fake *FakeConfig) ServiceKeyRotationsFilePath() string {
+	fake.serviceKeyRotationsFilePathMutex.Lock()
+	ret, specificReturn := fake.serviceKeyRotationsFilePathReturnsOnCall[len(fake.serviceKeyRotationsFilePathArgsForCall)]
+	fake.serviceKeyRotationsFilePathArgsForCall = append(fake.serviceKeyRotationsFilePathArgsForCall, struct{}{})
+	fake.recordInvocation("ServiceKeyRotationsFilePath", []interface{}{})
+	fake.serviceKeyRotationsFilePathMutex.Unlock()
+	if fake.ServiceKeyRotationsFilePathStub != nil {
+		return fake.ServiceKeyRotationsFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.serviceKeyRotationsFilePathReturns.result1
+}
+
+func (fake *FakeConfig) ServiceKeyRotationsFilePathCallCount() int {
+	fake.serviceKeyRotationsFilePathMutex.RLock()
+	defer fake.serviceKeyRotationsFilePathMutex.RUnlock()
+	return len(fake.serviceKeyRotationsFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) ServiceKeyRotationsFilePathReturns(result1 string) {
+	fake.ServiceKeyRotationsFilePathStub = nil
+	fake.serviceKeyRotationsFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ServiceKeyRotationsFilePathReturnsOnCall(i int, result1 string) {
+	fake.ServiceKeyRotationsFilePathStub = nil
+	if fake.serviceKeyRotationsFilePathReturnsOnCall == nil {
+		fake.serviceKeyRotationsFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.serviceKeyRotationsFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) SetAccessToken(token string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -1898,6 +2551,46 @@ func (fake *FakeConfig) WritePluginConfigReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeConfig) WriteScheduledTasksConfig() error {
+	fake.writeScheduledTasksConfigMutex.Lock()
+	ret, specificReturn := fake.writeScheduledTasksConfigReturnsOnCall[len(fake.writeScheduledTasksConfigArgsForCall)]
+	fake.writeScheduledTasksConfigArgsForCall = append(fake.writeScheduledTasksConfigArgsForCall, struct{}{})
+	fake.recordInvocation("WriteScheduledTasksConfig", []interface{}{})
+	fake.writeScheduledTasksConfigMutex.Unlock()
+	if fake.WriteScheduledTasksConfigStub != nil {
+		return fake.WriteScheduledTasksConfigStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.writeScheduledTasksConfigReturns.result1
+}
+
+func (fake *FakeConfig) WriteScheduledTasksConfigCallCount() int {
+	fake.writeScheduledTasksConfigMutex.RLock()
+	defer fake.writeScheduledTasksConfigMutex.RUnlock()
+	return len(fake.writeScheduledTasksConfigArgsForCall)
+}
+
+func (fake *FakeConfig) WriteScheduledTasksConfigReturns(result1 error) {
+	fake.WriteScheduledTasksConfigStub = nil
+	fake.writeScheduledTasksConfigReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) WriteScheduledTasksConfigReturnsOnCall(i int, result1 error) {
+	fake.WriteScheduledTasksConfigStub = nil
+	if fake.writeScheduledTasksConfigReturnsOnCall == nil {
+		fake.writeScheduledTasksConfigReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeScheduledTasksConfigReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1909,6 +2602,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.addPluginMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
+	fake.addScheduledTaskMutex.RLock()
+	defer fake.addScheduledTaskMutex.RUnlock()
+	fake.appendServiceKeyRotationRecordMutex.RLock()
+	defer fake.appendServiceKeyRotationRecordMutex.RUnlock()
 	fake.binaryNameMutex.RLock()
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
@@ -1925,12 +2622,22 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.getPluginMutex.RUnlock()
 	fake.getPluginCaseInsensitiveMutex.RLock()
 	defer fake.getPluginCaseInsensitiveMutex.RUnlock()
+	fake.getScheduledTaskMutex.RLock()
+	defer fake.getScheduledTaskMutex.RUnlock()
+	fake.hTTPSProxyMutex.RLock()
+	defer fake.hTTPSProxyMutex.RUnlock()
 	fake.hasTargetedOrganizationMutex.RLock()
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
 	defer fake.hasTargetedSpaceMutex.RUnlock()
+	fake.knownHostsFilePathMutex.RLock()
+	defer fake.knownHostsFilePathMutex.RUnlock()
+	fake.loadScheduledTasksConfigMutex.RLock()
+	defer fake.loadScheduledTasksConfigMutex.RUnlock()
 	fake.localeMutex.RLock()
 	defer fake.localeMutex.RUnlock()
+	fake.markServiceKeyRotationOldKeyDeletedMutex.RLock()
+	defer fake.markServiceKeyRotationOldKeyDeletedMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
@@ -1943,12 +2650,24 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.pluginsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.recordScheduledTaskRunMutex.RLock()
+	defer fake.recordScheduledTaskRunMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.removeScheduledTaskMutex.RLock()
+	defer fake.removeScheduledTaskMutex.RUnlock()
 	fake.sSHOAuthClientMutex.RLock()
 	defer fake.sSHOAuthClientMutex.RUnlock()
+	fake.sSHRelayHostKeyFilePathMutex.RLock()
+	defer fake.sSHRelayHostKeyFilePathMutex.RUnlock()
+	fake.sSHRelayKnownHostsFilePathMutex.RLock()
+	defer fake.sSHRelayKnownHostsFilePathMutex.RUnlock()
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	fake.serviceKeyRotationsFilePathMutex.RLock()
+	defer fake.serviceKeyRotationsFilePathMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
@@ -1985,6 +2704,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.verboseMutex.RUnlock()
 	fake.writePluginConfigMutex.RLock()
 	defer fake.writePluginConfigMutex.RUnlock()
+	fake.writeScheduledTasksConfigMutex.RLock()
+	defer fake.writeScheduledTasksConfigMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -15,11 +15,12 @@ var HelpCategoryList = []HelpCategory{
 			{"push", "scale", "delete", "rename"},
 			{"start", "stop", "restart", "restage", "restart-app-instance"},
 			{"run-task", "tasks", "terminate-task"},
+			{"schedule-task", "scheduled-tasks", "unschedule-task", "task-scheduler"},
 			{"events", "files", "logs"},
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
 			{"copy-source", "create-app-manifest"},
-			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh", "ssh-all", "ssh-config", "scp", "sftp"},
 		},
 	},
 	{
@@ -27,8 +28,9 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"marketplace", "services", "service"},
 			{"create-service", "update-service", "delete-service", "rename-service"},
-			{"create-service-key", "service-keys", "service-key", "delete-service-key"},
-			{"bind-service", "unbind-service"},
+			{"create-service-key", "service-keys", "service-key", "delete-service-key", "rotate-service-key"},
+			{"bind-service", "unbind-service", "service-binding"},
+			{"share-service", "unshare-service"},
 			{"bind-route-service", "unbind-route-service"},
 			{"create-user-provided-service", "update-user-provided-service"},
 		},
@@ -73,6 +75,7 @@ var HelpCategoryList = []HelpCategory{
 			{"create-user", "delete-user"},
 			{"org-users", "set-org-role", "unset-org-role"},
 			{"space-users", "set-space-role", "unset-space-role"},
+			{"apply-roles"},
 		},
 	},
 	{
@@ -80,6 +83,8 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"quotas", "quota", "set-quota"},
 			{"create-quota", "delete-quota", "update-quota"},
+			{"usage"},
+			{"audit-events"},
 			{"share-private-domain", "unshare-private-domain"},
 		},
 	},
@@ -106,6 +111,8 @@ var HelpCategoryList = []HelpCategory{
 			{"security-group", "security-groups", "create-security-group", "update-security-group", "delete-security-group", "bind-security-group", "unbind-security-group"},
 			{"bind-staging-security-group", "staging-security-groups", "unbind-staging-security-group"},
 			{"bind-running-security-group", "running-security-groups", "unbind-running-security-group"},
+			{"space-security-rules"},
+			{"export-security-groups", "sync-security-groups"},
 		},
 	},
 	{
@@ -117,7 +124,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ISOLATION SEGMENTS:",
 		CommandList: [][]string{
-			{"isolation-segments", "create-isolation-segment", "delete-isolation-segment", "enable-org-isolation", "disable-org-isolation", "set-org-default-isolation-segment", "reset-org-default-isolation-segment", "set-space-isolation-segment", "reset-space-isolation-segment"},
+			{"isolation-segments", "isolation-segment", "create-isolation-segment", "delete-isolation-segment", "enable-org-isolation", "disable-org-isolation", "set-org-default-isolation-segment", "reset-org-default-isolation-segment", "set-space-isolation-segment", "reset-space-isolation-segment"},
 		},
 	},
 	{
@@ -129,7 +136,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADVANCED:",
 		CommandList: [][]string{
-			{"curl", "config", "oauth-token", "ssh-code"},
+			{"curl", "config", "oauth-token", "ssh-code", "doctor"},
 		},
 	},
 	{
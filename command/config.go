@@ -14,6 +14,8 @@ type Config interface {
 	AccessToken() string
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(name string, url string)
+	AddScheduledTask(scheduledTask configv3.ScheduledTask)
+	AppendServiceKeyRotationRecord(record configv3.ServiceKeyRotationRecord) error
 	BinaryName() string
 	BinaryVersion() string
 	ColorEnabled() configv3.ColorSetting
@@ -22,18 +24,29 @@ type Config interface {
 	Experimental() bool
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
+	GetScheduledTask(name string) (configv3.ScheduledTask, bool)
+	HTTPSProxy() string
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	KnownHostsFilePath() string
+	LoadScheduledTasksConfig() error
 	Locale() string
+	MarkServiceKeyRotationOldKeyDeleted(newServiceKeyGUID string) error
 	MinCLIVersion() string
 	OverallPollingTimeout() time.Duration
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
+	RecordScheduledTaskRun(name string, run configv3.ScheduledTaskRun) error
 	RefreshToken() string
 	RemovePlugin(string)
+	RemoveScheduledTask(name string)
 	SSHOAuthClient() string
+	SSHRelayHostKeyFilePath() string
+	SSHRelayKnownHostsFilePath() string
+	ScheduledTasks() []configv3.ScheduledTask
+	ServiceKeyRotationsFilePath() string
 	SetAccessToken(token string)
 	SetOrganizationInformation(guid string, name string)
 	SetRefreshToken(token string)
@@ -52,4 +65,5 @@ type Config interface {
 	UnsetSpaceInformation()
 	Verbose() (bool, []string)
 	WritePluginConfig() error
+	WriteScheduledTasksConfig() error
 }
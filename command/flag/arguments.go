@@ -8,6 +8,10 @@ type OptionalAppName struct {
 	AppName string `positional-arg-name:"APP_NAME" description:"The application name"`
 }
 
+type OptionalAppNames struct {
+	AppNames []string `positional-arg-name:"APP_NAME" description:"The application names"`
+}
+
 type Buildpack struct {
 	Buildpack string `positional-arg-name:"BUILDPACK" required:"true" description:"The buildpack"`
 }
@@ -237,6 +241,10 @@ type SetSpaceRoleArgs struct {
 	Role         SpaceRole `positional-arg-name:"ROLE" required:"true" description:"The space role"`
 }
 
+type RolesFileArgs struct {
+	Path PathWithExistenceCheck `positional-arg-name:"FILE" required:"true" description:"Path to a YAML or CSV file of role assignments"`
+}
+
 type ServiceAuthTokenArgs struct {
 	Label    string `positional-arg-name:"LABEL" required:"true" description:"The token label"`
 	Provider string `positional-arg-name:"PROVIDER" required:"true" description:"The token provider"`
@@ -273,6 +281,10 @@ type SecurityGroupArgs struct {
 	PathToJsonRules PathWithExistenceCheck `positional-arg-name:"PATH_TO_JSON_RULES_FILE" required:"true" description:"Path to file of JSON describing security group rules"`
 }
 
+type SecurityGroupsFileArgs struct {
+	Path PathWithExistenceCheck `positional-arg-name:"FILE" required:"true" description:"Path to a YAML file declaring security groups, as written by export-security-groups"`
+}
+
 type AddPluginRepoArgs struct {
 	PluginRepoName string `positional-arg-name:"REPO_NAME" required:"true" description:"The plugin repo name"`
 	PluginRepoURL  string `positional-arg-name:"URL" required:"true" description:"The URL to the plugin repo"`
@@ -287,6 +299,14 @@ type RunTaskArgs struct {
 	Command string `positional-arg-name:"COMMAND" required:"true" description:"The command to execute"`
 }
 
+type ScheduledTaskName struct {
+	ScheduledTaskName string `positional-arg-name:"SCHEDULE_NAME" required:"true" description:"The scheduled task name"`
+}
+
+type TaskSchedulerArgs struct {
+	Action TaskSchedulerAction `positional-arg-name:"ACTION" required:"true" description:"The action to perform"`
+}
+
 type TerminateTaskArgs struct {
 	AppName    string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	SequenceID string `positional-arg-name:"TASK_ID" required:"true" description:"The task's unique sequence ID"`
@@ -317,3 +337,12 @@ type ResetOrgDefaultIsolationArgs struct {
 type AllowNetworkAccessArgs struct {
 	SourceApp string `positional-arg-name:"SOURCE_APP" required:"true" description:"The source app"`
 }
+
+type SCPArgs struct {
+	Source string `positional-arg-name:"SOURCE" required:"true" description:"The local path or APP_NAME:PATH to copy from"`
+	Target string `positional-arg-name:"TARGET" required:"true" description:"The local path or APP_NAME:PATH to copy to"`
+}
+
+type AppGUID struct {
+	AppGUID string `positional-arg-name:"APP_GUID" required:"true" description:"The application GUID"`
+}
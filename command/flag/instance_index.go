@@ -0,0 +1,21 @@
+package flag
+
+import (
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type InstanceIndex struct {
+	types.NullInt
+}
+
+func (i *InstanceIndex) UnmarshalFlag(val string) error {
+	err := i.ParseFlagValue(val)
+	if err != nil || i.Value < 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "invalid argument for flag '--instance' (expected int >= 0)",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LogSource struct {
+	Source string
+}
+
+func (LogSource) Complete(prefix string) []flags.Completion {
+	return completions([]string{"APP", "RTR", "STG", "CELL", "API"}, prefix, false)
+}
+
+func (l *LogSource) UnmarshalFlag(val string) error {
+	valUpper := strings.ToUpper(val)
+	switch valUpper {
+	case "APP", "RTR", "STG", "CELL", "API":
+		l.Source = valUpper
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `SOURCE must be "APP", "RTR", "STG", "CELL" or "API"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogSource", func() {
+	var source LogSource
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := source.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'APP' and 'API' when passed 'a'", "a",
+				[]flags.Completion{{Item: "APP"}, {Item: "API"}}),
+			Entry("returns 'CELL' when passed 'C'", "C",
+				[]flags.Completion{{Item: "CELL"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			source = LogSource{}
+		})
+
+		DescribeTable("upcases and sets the source",
+			func(settingSource string, expectedSource string) {
+				err := source.UnmarshalFlag(settingSource)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(source.Source).To(Equal(expectedSource))
+			},
+			Entry("sets 'APP' when passed 'app'", "app", "APP"),
+			Entry("sets 'RTR' when passed 'Rtr'", "Rtr", "RTR"),
+			Entry("sets 'STG' when passed 'STG'", "STG", "STG"),
+			Entry("sets 'CELL' when passed 'cell'", "cell", "CELL"),
+			Entry("sets 'API' when passed 'api'", "api", "API"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := source.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `SOURCE must be "APP", "RTR", "STG", "CELL" or "API"`,
+				}))
+				Expect(source.Source).To(BeEmpty())
+			})
+		})
+	})
+})
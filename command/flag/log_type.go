@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LogType struct {
+	Type string
+}
+
+func (LogType) Complete(prefix string) []flags.Completion {
+	return completions([]string{"out", "err"}, prefix, false)
+}
+
+func (l *LogType) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "out", "err":
+		l.Type = strings.ToUpper(valLower)
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `TYPE must be "out" or "err"`,
+		}
+	}
+	return nil
+}
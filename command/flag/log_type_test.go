@@ -0,0 +1,55 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogType", func() {
+	var logType LogType
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := logType.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'out' when passed 'o'", "o",
+				[]flags.Completion{{Item: "out"}}),
+			Entry("returns 'err' when passed 'E'", "E",
+				[]flags.Completion{{Item: "err"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			logType = LogType{}
+		})
+
+		DescribeTable("sets the upcased type",
+			func(settingType string, expectedType string) {
+				err := logType.UnmarshalFlag(settingType)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(logType.Type).To(Equal(expectedType))
+			},
+			Entry("sets 'OUT' when passed 'out'", "out", "OUT"),
+			Entry("sets 'ERR' when passed 'ERR'", "ERR", "ERR"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := logType.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `TYPE must be "out" or "err"`,
+				}))
+				Expect(logType.Type).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,23 @@
+package flag
+
+import (
+	"regexp"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type Regexp struct {
+	*regexp.Regexp
+}
+
+func (r *Regexp) UnmarshalFlag(val string) error {
+	compiled, err := regexp.Compile(val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "invalid argument for flag '--grep' (expected a valid regular expression)",
+		}
+	}
+	r.Regexp = compiled
+	return nil
+}
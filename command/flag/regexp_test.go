@@ -0,0 +1,38 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Regexp", func() {
+	var re Regexp
+
+	BeforeEach(func() {
+		re = Regexp{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when passed a valid regular expression", func() {
+			It("compiles and stores it", func() {
+				err := re.UnmarshalFlag("GET /api/.*")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(re.Regexp).ToNot(BeNil())
+				Expect(re.MatchString("GET /api/v1/users")).To(BeTrue())
+			})
+		})
+
+		Context("when passed an invalid regular expression", func() {
+			It("returns an error", func() {
+				err := re.UnmarshalFlag("(unclosed")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '--grep' (expected a valid regular expression)",
+				}))
+				Expect(re.Regexp).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,53 @@
+package flag
+
+import (
+	"fmt"
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Since is a point in time given either as a duration before now (e.g. 24h)
+// or as an RFC3339 timestamp.
+type Since struct {
+	Time time.Time
+}
+
+func (s *Since) UnmarshalFlag(val string) error {
+	t, err := parsePointInTime("SINCE", val)
+	if err != nil {
+		return err
+	}
+	s.Time = t
+	return nil
+}
+
+// Until is a point in time given either as a duration before now (e.g. 24h)
+// or as an RFC3339 timestamp.
+type Until struct {
+	Time time.Time
+}
+
+func (u *Until) UnmarshalFlag(val string) error {
+	t, err := parsePointInTime("UNTIL", val)
+	if err != nil {
+		return err
+	}
+	u.Time = t
+	return nil
+}
+
+func parsePointInTime(name string, val string) (time.Time, error) {
+	if duration, err := time.ParseDuration(val); err == nil && duration >= 0 {
+		return time.Now().Add(-duration), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return time.Time{}, &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: fmt.Sprintf("%s must be a duration (e.g. 30m, 24h) or an RFC3339 timestamp", name),
+		}
+	}
+	return t, nil
+}
@@ -0,0 +1,91 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Since", func() {
+	var since Since
+
+	BeforeEach(func() {
+		since = Since{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when passed a duration", func() {
+			It("sets the time to that long ago", func() {
+				err := since.UnmarshalFlag("24h")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(since.Time).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+			})
+		})
+
+		Context("when passed an RFC3339 timestamp", func() {
+			It("sets the time", func() {
+				err := since.UnmarshalFlag("2017-08-16T10:30:00Z")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(since.Time).To(Equal(time.Date(2017, time.August, 16, 10, 30, 0, 0, time.UTC)))
+			})
+		})
+
+		DescribeTable("returns an error for unparseable values",
+			func(val string) {
+				err := since.UnmarshalFlag(val)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `SINCE must be a duration (e.g. 30m, 24h) or an RFC3339 timestamp`,
+				}))
+				Expect(since.Time).To(BeZero())
+			},
+			Entry("when passed 'banana'", "banana"),
+			Entry("when passed a negative duration", "-5m"),
+			Entry("when passed a date without a time", "2017-08-16"),
+		)
+	})
+})
+
+var _ = Describe("Until", func() {
+	var until Until
+
+	BeforeEach(func() {
+		until = Until{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when passed a duration", func() {
+			It("sets the time to that long ago", func() {
+				err := until.UnmarshalFlag("24h")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(until.Time).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+			})
+		})
+
+		Context("when passed an RFC3339 timestamp", func() {
+			It("sets the time", func() {
+				err := until.UnmarshalFlag("2017-08-16T10:30:00Z")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(until.Time).To(Equal(time.Date(2017, time.August, 16, 10, 30, 0, 0, time.UTC)))
+			})
+		})
+
+		DescribeTable("returns an error for unparseable values",
+			func(val string) {
+				err := until.UnmarshalFlag(val)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `UNTIL must be a duration (e.g. 30m, 24h) or an RFC3339 timestamp`,
+				}))
+				Expect(until.Time).To(BeZero())
+			},
+			Entry("when passed 'banana'", "banana"),
+			Entry("when passed a negative duration", "-5m"),
+			Entry("when passed a date without a time", "2017-08-16"),
+		)
+	})
+})
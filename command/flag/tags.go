@@ -0,0 +1,21 @@
+package flag
+
+import "strings"
+
+// Tags is a comma-separated list of user provided tags. IsSet distinguishes
+// an empty list, which clears existing tags, from a flag that was not given.
+type Tags struct {
+	IsSet bool
+	Value []string
+}
+
+func (t *Tags) UnmarshalFlag(val string) error {
+	t.IsSet = true
+	t.Value = []string{}
+	for _, tag := range strings.Split(strings.Trim(val, `"`), ",") {
+		if trimmed := strings.TrimSpace(tag); trimmed != "" {
+			t.Value = append(t.Value, trimmed)
+		}
+	}
+	return nil
+}
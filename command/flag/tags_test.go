@@ -0,0 +1,32 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tags", func() {
+	var tags Tags
+
+	BeforeEach(func() {
+		tags = Tags{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("splits the list and trims each tag",
+			func(input string, expected []string) {
+				err := tags.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tags.IsSet).To(BeTrue())
+				Expect(tags.Value).To(Equal(expected))
+			},
+			Entry("single tag", "some-tag", []string{"some-tag"}),
+			Entry("list with spaces", "list, of, tags", []string{"list", "of", "tags"}),
+			Entry("quoted list", `"list,of"`, []string{"list", "of"}),
+			Entry("empty entries", "a,,b, ", []string{"a", "b"}),
+			Entry("empty string", "", []string{}),
+		)
+	})
+})
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type TaskSchedulerAction struct {
+	Action string
+}
+
+func (TaskSchedulerAction) Complete(prefix string) []flags.Completion {
+	return completions([]string{"run"}, prefix, false)
+}
+
+func (a *TaskSchedulerAction) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "run":
+		a.Action = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `ACTION must be "run"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskSchedulerAction", func() {
+	var action TaskSchedulerAction
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := action.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'run' when passed 'r'", "r",
+				[]flags.Completion{{Item: "run"}}),
+			Entry("returns 'run' when passed nothing", "",
+				[]flags.Completion{{Item: "run"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			action = TaskSchedulerAction{}
+		})
+
+		It("downcases and sets the action", func() {
+			err := action.UnmarshalFlag("RUN")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(action.Action).To(Equal("run"))
+		})
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := action.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `ACTION must be "run"`,
+				}))
+				Expect(action.Action).To(BeEmpty())
+			})
+		})
+	})
+})
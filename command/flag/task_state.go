@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type TaskState struct {
+	State string
+}
+
+func (TaskState) Complete(prefix string) []flags.Completion {
+	return completions([]string{"PENDING", "RUNNING", "SUCCEEDED", "FAILED", "CANCELING"}, prefix, false)
+}
+
+func (t *TaskState) UnmarshalFlag(val string) error {
+	valUpper := strings.ToUpper(val)
+	switch valUpper {
+	case "PENDING", "RUNNING", "SUCCEEDED", "FAILED", "CANCELING":
+		t.State = valUpper
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `STATE must be "PENDING", "RUNNING", "SUCCEEDED", "FAILED" or "CANCELING"`,
+		}
+	}
+	return nil
+}
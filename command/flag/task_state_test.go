@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskState", func() {
+	var state TaskState
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := state.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'RUNNING' when passed 'r'", "r",
+				[]flags.Completion{{Item: "RUNNING"}}),
+			Entry("returns 'SUCCEEDED' when passed 'S'", "S",
+				[]flags.Completion{{Item: "SUCCEEDED"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			state = TaskState{}
+		})
+
+		DescribeTable("upcases and sets the state",
+			func(settingState string, expectedState string) {
+				err := state.UnmarshalFlag(settingState)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(state.State).To(Equal(expectedState))
+			},
+			Entry("sets 'PENDING' when passed 'pending'", "pending", "PENDING"),
+			Entry("sets 'RUNNING' when passed 'Running'", "Running", "RUNNING"),
+			Entry("sets 'SUCCEEDED' when passed 'SUCCEEDED'", "SUCCEEDED", "SUCCEEDED"),
+			Entry("sets 'FAILED' when passed 'failed'", "failed", "FAILED"),
+			Entry("sets 'CANCELING' when passed 'canceling'", "canceling", "CANCELING"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := state.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `STATE must be "PENDING", "RUNNING", "SUCCEEDED", "FAILED" or "CANCELING"`,
+				}))
+				Expect(state.State).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -10,9 +10,13 @@ const (
 	MinVersionLifecyleStagingV2         = "2.68.0"
 	MinVersionHTTPEndpointHealthCheckV2 = "2.68.0"
 	MinVersionProcessHealthCheckV2      = "2.47.0"
+	MinVersionShareServiceV2            = "2.100.0"
+	MinVersionBindingParametersV2       = "2.103.0"
+	MinVersionSetRolesByUsernameV2      = "2.37.0"
 
 	MinVersionRunTaskV3          = "3.0.0"
 	MinVersionIsolationSegmentV3 = "3.11.0"
+	MinVersionShareServiceV3     = "3.36.0"
 )
 
 func MinimumAPIVersionCheck(current string, minimum string) error {
@@ -0,0 +1,19 @@
+package translatableerror
+
+// ApplicationInstanceNotFoundError is returned when an application does not
+// have an instance with the given index.
+type ApplicationInstanceNotFoundError struct {
+	AppName       string
+	InstanceIndex int
+}
+
+func (ApplicationInstanceNotFoundError) Error() string {
+	return "Instance {{.InstanceIndex}} of app {{.AppName}} not found"
+}
+
+func (e ApplicationInstanceNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":       e.AppName,
+		"InstanceIndex": e.InstanceIndex,
+	})
+}
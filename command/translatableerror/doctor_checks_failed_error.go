@@ -0,0 +1,19 @@
+package translatableerror
+
+// DoctorChecksFailedError is returned when some of the checks run by doctor
+// did not pass.
+type DoctorChecksFailedError struct {
+	FailedCount int
+	CheckCount  int
+}
+
+func (DoctorChecksFailedError) Error() string {
+	return "{{.FailedCount}} of {{.CheckCount}} checks failed"
+}
+
+func (e DoctorChecksFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FailedCount": e.FailedCount,
+		"CheckCount":  e.CheckCount,
+	})
+}
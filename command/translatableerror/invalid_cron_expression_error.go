@@ -0,0 +1,21 @@
+package translatableerror
+
+// InvalidCronExpressionError is returned when a schedule's cron expression
+// cannot be parsed.
+type InvalidCronExpressionError struct {
+	Expression string
+	Reason     string
+}
+
+func (InvalidCronExpressionError) DisplayUsage() {}
+
+func (InvalidCronExpressionError) Error() string {
+	return "Incorrect Usage: Invalid cron expression '{{.Expression}}': {{.Reason}}"
+}
+
+func (e InvalidCronExpressionError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Expression": e.Expression,
+		"Reason":     e.Reason,
+	})
+}
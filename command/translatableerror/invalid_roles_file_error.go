@@ -0,0 +1,21 @@
+package translatableerror
+
+import "strings"
+
+// InvalidRolesFileError is returned when a roles file passed to apply-roles
+// cannot be parsed or has invalid entries.
+type InvalidRolesFileError struct {
+	Path     string
+	Problems []string
+}
+
+func (InvalidRolesFileError) Error() string {
+	return "Invalid roles file: {{.Path}}\n{{.Problems}}"
+}
+
+func (e InvalidRolesFileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":     e.Path,
+		"Problems": strings.Join(e.Problems, "\n"),
+	})
+}
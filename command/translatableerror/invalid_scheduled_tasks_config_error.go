@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidScheduledTasksConfigError is returned when the scheduled tasks file
+// cannot be read or parsed.
+type InvalidScheduledTasksConfigError struct {
+	FilePath string
+	Message  string
+}
+
+func (InvalidScheduledTasksConfigError) Error() string {
+	return "Unable to read scheduled tasks file {{.FilePath}}: {{.Message}}"
+}
+
+func (e InvalidScheduledTasksConfigError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FilePath": e.FilePath,
+		"Message":  e.Message,
+	})
+}
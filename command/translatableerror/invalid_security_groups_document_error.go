@@ -0,0 +1,21 @@
+package translatableerror
+
+import "strings"
+
+// InvalidSecurityGroupsDocumentError is returned when a security groups file
+// passed to sync-security-groups declares invalid security groups.
+type InvalidSecurityGroupsDocumentError struct {
+	Path     string
+	Problems []string
+}
+
+func (InvalidSecurityGroupsDocumentError) Error() string {
+	return "Invalid security groups file: {{.Path}}\n{{.Problems}}"
+}
+
+func (e InvalidSecurityGroupsDocumentError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":     e.Path,
+		"Problems": strings.Join(e.Problems, "\n"),
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// ManifestCreationError is returned when an app manifest cannot be written to
+// disk.
+type ManifestCreationError struct {
+	Err error
+}
+
+func (ManifestCreationError) Error() string {
+	return "Error creating manifest file: {{.Error}}"
+}
+
+func (e ManifestCreationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Error": e.Err.Error(),
+	})
+}
@@ -0,0 +1,22 @@
+package translatableerror
+
+// ManifestServiceInstanceMissingFieldError is returned when a service
+// instance in the manifest services block is missing a required attribute.
+type ManifestServiceInstanceMissingFieldError struct {
+	Name  string
+	Field string
+}
+
+func (e ManifestServiceInstanceMissingFieldError) Error() string {
+	if e.Name == "" {
+		return "Every service instance in the manifest services block must have a '{{.Field}}'."
+	}
+	return "Service instance '{{.ServiceInstanceName}}' in the manifest services block must have a '{{.Field}}'."
+}
+
+func (e ManifestServiceInstanceMissingFieldError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServiceInstanceName": e.Name,
+		"Field":               e.Field,
+	})
+}
@@ -0,0 +1,22 @@
+package translatableerror
+
+// ManifestServiceInstanceParametersError is returned when the parameters of a
+// service instance in the manifest services block are not a JSON object.
+type ManifestServiceInstanceParametersError struct {
+	Name string
+	Path string
+}
+
+func (e ManifestServiceInstanceParametersError) Error() string {
+	if e.Path == "" {
+		return "The parameters of service instance '{{.ServiceInstanceName}}' must be an object or the path to a JSON file."
+	}
+	return "The parameters file '{{.Path}}' of service instance '{{.ServiceInstanceName}}' does not contain a valid JSON object."
+}
+
+func (e ManifestServiceInstanceParametersError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServiceInstanceName": e.Name,
+		"Path":                e.Path,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// RolesNotAppliedError is returned when the role changes of some users could
+// not be applied.
+type RolesNotAppliedError struct {
+	FailedCount int
+	UserCount   int
+}
+
+func (RolesNotAppliedError) Error() string {
+	return "Failed to apply the roles of {{.FailedCount}} of {{.UserCount}} users"
+}
+
+func (e RolesNotAppliedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FailedCount": e.FailedCount,
+		"UserCount":   e.UserCount,
+	})
+}
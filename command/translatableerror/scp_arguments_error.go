@@ -0,0 +1,15 @@
+package translatableerror
+
+// SCPArgumentsError is returned when neither or both of the scp source and
+// target refer to a path on an application instance.
+type SCPArgumentsError struct{}
+
+func (SCPArgumentsError) DisplayUsage() {}
+
+func (SCPArgumentsError) Error() string {
+	return "Incorrect Usage: exactly one of SOURCE and TARGET must be of the form APP_NAME:PATH"
+}
+
+func (e SCPArgumentsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type ServiceBindingNotFoundError struct {
+	AppName             string
+	ServiceInstanceName string
+}
+
+func (ServiceBindingNotFoundError) Error() string {
+	return "App {{.AppName}} is not bound to service instance {{.ServiceInstanceName}}."
+}
+
+func (e ServiceBindingNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":             e.AppName,
+		"ServiceInstanceName": e.ServiceInstanceName,
+	})
+}
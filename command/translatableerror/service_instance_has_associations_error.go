@@ -0,0 +1,15 @@
+package translatableerror
+
+// ServiceInstanceHasAssociationsError is returned when deleting a service
+// instance that still has service bindings or service keys.
+type ServiceInstanceHasAssociationsError struct {
+	Name string
+}
+
+func (ServiceInstanceHasAssociationsError) Error() string {
+	return "Cannot delete service instance, service keys and bindings must first be deleted"
+}
+
+func (e ServiceInstanceHasAssociationsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+// ServiceInstanceNotShareableError is returned when the service broker of a
+// service instance does not allow it to be shared.
+type ServiceInstanceNotShareableError struct{}
+
+func (ServiceInstanceNotShareableError) Error() string {
+	return "Service instance sharing is not supported by the service broker for this service offering."
+}
+
+func (e ServiceInstanceNotShareableError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
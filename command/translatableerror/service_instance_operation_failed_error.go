@@ -0,0 +1,21 @@
+package translatableerror
+
+// ServiceInstanceOperationFailedError is returned when the service broker
+// reports that a create, update or delete of a service instance failed.
+type ServiceInstanceOperationFailedError struct {
+	Name        string
+	Operation   string
+	Description string
+}
+
+func (ServiceInstanceOperationFailedError) Error() string {
+	return "The {{.Operation}} operation on service instance {{.ServiceInstance}} failed: {{.Description}}"
+}
+
+func (e ServiceInstanceOperationFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Operation":       e.Operation,
+		"ServiceInstance": e.Name,
+		"Description":     e.Description,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// ServiceInstanceOperationTimeoutError is returned when the service broker has
+// not finished an operation on a service instance before the --wait timeout.
+type ServiceInstanceOperationTimeoutError struct {
+	Name       string
+	BinaryName string
+}
+
+func (ServiceInstanceOperationTimeoutError) Error() string {
+	return "Timed out waiting for service instance {{.ServiceInstance}}. The operation may still be running; use '{{.BinaryName}} service {{.ServiceInstance}}' to check its status."
+}
+
+func (e ServiceInstanceOperationTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServiceInstance": e.Name,
+		"BinaryName":      e.BinaryName,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+// ServiceInstanceSharingDisabledError is returned when the
+// service_instance_sharing feature flag is disabled.
+type ServiceInstanceSharingDisabledError struct{}
+
+func (ServiceInstanceSharingDisabledError) Error() string {
+	return `The "service_instance_sharing" feature flag is disabled for this Cloud Foundry platform. Ask your administrator to enable it with "{{.Command}}".`
+}
+
+func (e ServiceInstanceSharingDisabledError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Command": "cf enable-feature-flag service_instance_sharing",
+	})
+}
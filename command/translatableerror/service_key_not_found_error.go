@@ -0,0 +1,17 @@
+package translatableerror
+
+type ServiceKeyNotFoundError struct {
+	Name                string
+	ServiceInstanceName string
+}
+
+func (ServiceKeyNotFoundError) Error() string {
+	return "Service key {{.ServiceKey}} for service instance {{.ServiceInstance}} not found"
+}
+
+func (e ServiceKeyNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServiceKey":      e.Name,
+		"ServiceInstance": e.ServiceInstanceName,
+	})
+}
@@ -0,0 +1,18 @@
+package translatableerror
+
+// ServiceKeyParametersNotRetrievableError is returned when the parameters of
+// a service key are needed but neither the Cloud Controller nor the service
+// broker can provide them.
+type ServiceKeyParametersNotRetrievableError struct {
+	Name string
+}
+
+func (ServiceKeyParametersNotRetrievableError) Error() string {
+	return "The parameters of service key {{.ServiceKey}} cannot be retrieved. Provide them with -c."
+}
+
+func (e ServiceKeyParametersNotRetrievableError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServiceKey": e.Name,
+	})
+}
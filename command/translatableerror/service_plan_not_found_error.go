@@ -0,0 +1,17 @@
+package translatableerror
+
+type ServicePlanNotFoundError struct {
+	PlanName    string
+	ServiceName string
+}
+
+func (ServicePlanNotFoundError) Error() string {
+	return "Plan {{.PlanName}} does not exist for the {{.ServiceName}} service"
+}
+
+func (e ServicePlanNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PlanName":    e.PlanName,
+		"ServiceName": e.ServiceName,
+	})
+}
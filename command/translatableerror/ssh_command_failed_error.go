@@ -0,0 +1,19 @@
+package translatableerror
+
+// SSHCommandFailedError is returned when a command run over SSH on several
+// application instances did not succeed on all of them.
+type SSHCommandFailedError struct {
+	FailedCount   int
+	InstanceCount int
+}
+
+func (SSHCommandFailedError) Error() string {
+	return "Command failed on {{.FailedCount}} of {{.InstanceCount}} instances"
+}
+
+func (e SSHCommandFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FailedCount":   e.FailedCount,
+		"InstanceCount": e.InstanceCount,
+	})
+}
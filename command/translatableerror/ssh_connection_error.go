@@ -0,0 +1,17 @@
+package translatableerror
+
+// SSHConnectionError is returned when an SSH connection to an application
+// instance cannot be opened.
+type SSHConnectionError struct {
+	Message string
+}
+
+func (SSHConnectionError) Error() string {
+	return "Error opening SSH connection: {{.Message}}"
+}
+
+func (e SSHConnectionError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Message": e.Message,
+	})
+}
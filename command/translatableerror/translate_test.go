@@ -39,12 +39,14 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("AddPluginRepositoryError", AddPluginRepositoryError{}),
 		Entry("APINotFoundError", APINotFoundError{}),
 		Entry("APIRequestError", APIRequestError{}),
+		Entry("ApplicationInstanceNotFoundError", ApplicationInstanceNotFoundError{}),
 		Entry("ApplicationNotFoundError", ApplicationNotFoundError{}),
 		Entry("AppNotFoundInManifestError", AppNotFoundInManifestError{}),
 		Entry("ArgumentCombinationError", ArgumentCombinationError{}),
 		Entry("AssignDropletError", AssignDropletError{}),
 		Entry("BadCredentialsError", BadCredentialsError{}),
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
+		Entry("DoctorChecksFailedError", DoctorChecksFailedError{}),
 		Entry("DownloadPluginHTTPError", DownloadPluginHTTPError{}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
 		Entry("FetchingPluginInfoFromRepositoriesError", FetchingPluginInfoFromRepositoriesError{}),
@@ -53,12 +55,19 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("GettingPluginRepositoryError", GettingPluginRepositoryError{}),
 		Entry("HealthCheckTypeUnsupportedError", HealthCheckTypeUnsupportedError{SupportedTypes: []string{"some-type", "another-type"}}),
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
+		Entry("InvalidCronExpressionError", InvalidCronExpressionError{}),
+		Entry("InvalidRolesFileError", InvalidRolesFileError{}),
 		Entry("InvalidSSLCertError", InvalidSSLCertError{}),
+		Entry("InvalidScheduledTasksConfigError", InvalidScheduledTasksConfigError{}),
+		Entry("InvalidSecurityGroupsDocumentError", InvalidSecurityGroupsDocumentError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
 		Entry("JobFailedError", JobFailedError{}),
 		Entry("JobTimeoutError", JobTimeoutError{}),
 		Entry("JSONSyntaxError", JSONSyntaxError{Err: errors.New("some-error")}),
 		Entry("LifecycleMinimumAPIVersionNotMetError", LifecycleMinimumAPIVersionNotMetError{}),
+		Entry("ManifestCreationError", ManifestCreationError{Err: errors.New("some-error")}),
+		Entry("ManifestServiceInstanceMissingFieldError", ManifestServiceInstanceMissingFieldError{}),
+		Entry("ManifestServiceInstanceParametersError", ManifestServiceInstanceParametersError{}),
 		Entry("MinimumAPIVersionNotMetError", MinimumAPIVersionNotMetError{}),
 		Entry("NoAPISetError", NoAPISetError{}),
 		Entry("NoCompatibleBinaryError", NoCompatibleBinaryError{}),
@@ -83,9 +92,23 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("RequiredNameForPushError", RequiredNameForPushError{}),
 		Entry("RouteInDifferentSpaceError", RouteInDifferentSpaceError{}),
 		Entry("RunTaskError", RunTaskError{}),
+		Entry("SCPArgumentsError", SCPArgumentsError{}),
 		Entry("SecurityGroupNotFoundError", SecurityGroupNotFoundError{}),
+		Entry("ServiceBindingNotFoundError", ServiceBindingNotFoundError{}),
+		Entry("ServiceInstanceHasAssociationsError", ServiceInstanceHasAssociationsError{}),
 		Entry("ServiceInstanceNotFoundError", ServiceInstanceNotFoundError{}),
+		Entry("ServiceInstanceNotShareableError", ServiceInstanceNotShareableError{}),
+		Entry("ServiceInstanceOperationFailedError", ServiceInstanceOperationFailedError{}),
+		Entry("ServiceInstanceOperationTimeoutError", ServiceInstanceOperationTimeoutError{}),
+		Entry("ServiceInstanceSharingDisabledError", ServiceInstanceSharingDisabledError{}),
+		Entry("ServiceKeyNotFoundError", ServiceKeyNotFoundError{}),
+		Entry("ServiceKeyParametersNotRetrievableError", ServiceKeyParametersNotRetrievableError{}),
+		Entry("ServiceNotFoundError", ServiceNotFoundError{}),
+		Entry("ServicePlanNotFoundError", ServicePlanNotFoundError{}),
+		Entry("RolesNotAppliedError", RolesNotAppliedError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
+		Entry("SSHCommandFailedError", SSHCommandFailedError{}),
+		Entry("SSHConnectionError", SSHConnectionError{}),
 		Entry("SSLCertError", SSLCertError{}),
 		Entry("StackNotFoundError with name", SpaceNotFoundError{Name: "steve"}),
 		Entry("StackNotFoundError without name", SpaceNotFoundError{}),
@@ -17,6 +17,8 @@ type UI interface {
 	DisplayKeyValueTable(prefix string, table [][]string, padding int)
 	DisplayKeyValueTableForApp(table [][]string)
 	DisplayKeyValueTableForV3App(table [][]string, crashedProcesses []string)
+	DisplayJSONLogMessage(appName string, message ui.LogMessage) error
+	DisplayLabeledLogMessage(label string, message ui.LogMessage, displayHeader bool)
 	DisplayLogMessage(message ui.LogMessage, displayHeader bool)
 	DisplayNewline()
 	DisplayNonWrappingTable(prefix string, table [][]string, padding int)
@@ -27,6 +29,7 @@ type UI interface {
 	DisplayTextWithBold(text string, keys ...map[string]interface{})
 	DisplayWarning(formattedString string, keys ...map[string]interface{})
 	DisplayWarnings(warnings []string)
+	LogMessageFileWriter(filePath string, maxSize int64, asJSON bool) *ui.LogMessageFileWriter
 	RequestLoggerFileWriter(filePaths []string) *ui.RequestLoggerFileWriter
 	RequestLoggerTerminalDisplay() *ui.RequestLoggerTerminalDisplay
 	TranslateText(template string, data ...map[string]interface{}) string
@@ -0,0 +1,174 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . ApplyRolesActor
+
+type ApplyRolesActor interface {
+	ApplyRoleChanges(changes []v2action.RoleChange, concurrency int) ([]v2action.UserRoleChangesResult, v2action.Warnings)
+	CloudControllerAPIVersion() string
+	GetRoleChanges(assignments []v2action.RoleAssignment, prune bool, currentUsername string) ([]v2action.RoleChange, v2action.Warnings, error)
+}
+
+type ApplyRolesCommand struct {
+	RequiredArgs    flag.RolesFileArgs `positional-args:"yes"`
+	Prune           bool               `long:"prune" description:"Remove roles in the listed orgs and spaces that are not in the file"`
+	DryRun          bool               `long:"dry-run" description:"Display the changes without applying them"`
+	Concurrency     int                `long:"concurrency" default:"5" description:"Number of users whose roles are changed at the same time"`
+	usage           interface{}        `usage:"CF_NAME apply-roles FILE [--prune] [--dry-run] [--concurrency NUMBER]\n\n   FILE is either a CSV file with a user,org,space,role header or a YAML file listing roles:\n\n   roles:\n   - user: alice@example.com\n     org: my-org\n     role: OrgManager\n   - user: bob@example.com\n     org: my-org\n     space: dev\n     role: SpaceDeveloper\n\n   Org roles are OrgManager, BillingManager and OrgAuditor. Space roles are SpaceManager, SpaceDeveloper and SpaceAuditor.\n   Only the orgs and spaces listed in the file are pruned, and roles of the current user are never pruned."`
+	relatedCommands interface{}        `related_commands:"org-users, set-org-role, set-space-role, space-users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyRolesActor
+}
+
+func (cmd *ApplyRolesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd ApplyRolesCommand) Execute(args []string) error {
+	if cmd.Concurrency < 1 {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--concurrency",
+			ExpectedType: "integer greater than 0",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), command.MinVersionSetRolesByUsernameV2)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	assignments, err := v2action.ReadRoleAssignments(string(cmd.RequiredArgs.Path))
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying roles from {{.Path}} as {{.CurrentUser}}...", map[string]interface{}{
+		"Path":        cmd.RequiredArgs.Path,
+		"CurrentUser": user.Name,
+	})
+
+	changes, warnings, err := cmd.Actor.GetRoleChanges(assignments, cmd.Prune, user.Name)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(changes) == 0 {
+		cmd.UI.DisplayText("Roles are already up to date.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.displayChanges(changes)
+
+	if cmd.DryRun {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Dry run: {{.Count}} change(s) not applied.", map[string]interface{}{
+			"Count": len(changes),
+		})
+		return nil
+	}
+
+	results, warnings := cmd.Actor.ApplyRoleChanges(changes, cmd.Concurrency)
+	cmd.UI.DisplayWarnings(warnings)
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("user"),
+			cmd.UI.TranslateText("result"),
+		},
+	}
+	failedCount := 0
+	for _, result := range results {
+		status := cmd.UI.TranslateText("OK")
+		if result.Err != nil {
+			failedCount++
+			status = cmd.failureStatus(result.Err)
+		}
+		table = append(table, []string{result.Username, status})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if failedCount > 0 {
+		return translatableerror.RolesNotAppliedError{
+			FailedCount: failedCount,
+			UserCount:   len(results),
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd ApplyRolesCommand) displayChanges(changes []v2action.RoleChange) {
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("user"),
+			cmd.UI.TranslateText("role"),
+			cmd.UI.TranslateText("org / space"),
+		},
+	}
+	for _, change := range changes {
+		action := "+"
+		if change.Remove {
+			action = "-"
+		}
+		table = append(table, []string{action, change.Username, string(change.Role), change.Target()})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
+
+func (cmd ApplyRolesCommand) failureStatus(err error) string {
+	changeErr, ok := err.(v2action.RoleChangeError)
+	if !ok {
+		return err.Error()
+	}
+
+	templateValues := map[string]interface{}{
+		"Role":   changeErr.Change.Role,
+		"Target": changeErr.Change.Target(),
+		"Error":  changeErr.Err.Error(),
+	}
+	if changeErr.Change.Remove {
+		return cmd.UI.TranslateText("failed to remove {{.Role}} in {{.Target}}: {{.Error}}", templateValues)
+	}
+	return cmd.UI.TranslateText("failed to assign {{.Role}} in {{.Target}}: {{.Error}}", templateValues)
+}
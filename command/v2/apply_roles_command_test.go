@@ -0,0 +1,276 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-roles Command", func() {
+	var (
+		cmd             ApplyRolesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeApplyRolesActor
+		binaryName      string
+		tempDir         string
+		rolesPath       string
+		executeErr      error
+
+		bobOrgAuditor   v2action.RoleChange
+		bobDeveloper    v2action.RoleChange
+		carolDeveloper  v2action.RoleChange
+		expectedChanges []v2action.RoleChange
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeApplyRolesActor)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "apply-roles")
+		Expect(err).ToNot(HaveOccurred())
+		rolesPath = filepath.Join(tempDir, "roles.yml")
+		Expect(ioutil.WriteFile(rolesPath, []byte(`---
+roles:
+- user: bob@example.com
+  org: my-org
+  role: OrgAuditor
+- user: bob@example.com
+  org: my-org
+  space: dev
+  role: SpaceDeveloper
+`), 0600)).To(Succeed())
+
+		cmd = ApplyRolesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Concurrency: 5,
+		}
+		cmd.RequiredArgs.Path = flag.PathWithExistenceCheck(rolesPath)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(command.MinVersionSetRolesByUsernameV2)
+
+		bobOrgAuditor = v2action.RoleChange{
+			RoleAssignment:   v2action.RoleAssignment{Username: "bob@example.com", OrganizationName: "my-org", Role: v2action.OrgAuditorRole},
+			OrganizationGUID: "my-org-guid",
+		}
+		bobDeveloper = v2action.RoleChange{
+			RoleAssignment:   v2action.RoleAssignment{Username: "bob@example.com", OrganizationName: "my-org", SpaceName: "dev", Role: v2action.SpaceDeveloperRole},
+			OrganizationGUID: "my-org-guid",
+			SpaceGUID:        "dev-guid",
+		}
+		carolDeveloper = v2action.RoleChange{
+			RoleAssignment:   v2action.RoleAssignment{Username: "carol@example.com", OrganizationName: "my-org", SpaceName: "dev", Role: v2action.SpaceDeveloperRole},
+			Remove:           true,
+			UserGUID:         "carol-guid",
+			OrganizationGUID: "my-org-guid",
+			SpaceGUID:        "dev-guid",
+		}
+		expectedChanges = []v2action.RoleChange{bobOrgAuditor, bobDeveloper, carolDeveloper}
+		fakeActor.GetRoleChangesReturns(expectedChanges, v2action.Warnings{"changes-warning"}, nil)
+		fakeActor.ApplyRoleChangesReturns(
+			[]v2action.UserRoleChangesResult{
+				{Username: "bob@example.com"},
+				{Username: "carol@example.com"},
+			},
+			v2action.Warnings{"apply-warning"})
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the concurrency is less than 1", func() {
+		BeforeEach(func() {
+			cmd.Concurrency = 0
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--concurrency",
+				ExpectedType: "integer greater than 0",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+			Expect(fakeActor.GetRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the API does not support assigning roles by username", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("2.36.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "2.36.0",
+				MinimumVersion: command.MinVersionSetRolesByUsernameV2,
+			}))
+			Expect(fakeActor.GetRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the file has invalid entries", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(rolesPath, []byte("roles:\n- org: my-org\n  role: OrgManager\n"), 0600)).To(Succeed())
+		})
+
+		It("returns a translatable error without comparing", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidRolesFileError{
+				Path:     rolesPath,
+				Problems: []string{"entry 1: user is required"},
+			}))
+			Expect(fakeActor.GetRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	It("displays the changes, applies them and displays the result of every user", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.GetRoleChangesCallCount()).To(Equal(1))
+		assignments, prune, currentUsername := fakeActor.GetRoleChangesArgsForCall(0)
+		Expect(assignments).To(Equal([]v2action.RoleAssignment{
+			{Username: "bob@example.com", OrganizationName: "my-org", Role: v2action.OrgAuditorRole},
+			{Username: "bob@example.com", OrganizationName: "my-org", SpaceName: "dev", Role: v2action.SpaceDeveloperRole},
+		}))
+		Expect(prune).To(BeFalse())
+		Expect(currentUsername).To(Equal("some-user"))
+
+		Expect(fakeActor.ApplyRoleChangesCallCount()).To(Equal(1))
+		changes, concurrency := fakeActor.ApplyRoleChangesArgsForCall(0)
+		Expect(changes).To(Equal(expectedChanges))
+		Expect(concurrency).To(Equal(5))
+
+		Expect(testUI.Out).To(Say("Applying roles from %s as some-user...", rolesPath))
+		Expect(testUI.Out).To(Say(`user\s+role\s+org / space`))
+		Expect(testUI.Out).To(Say(`\+\s+bob@example.com\s+OrgAuditor\s+my-org\n`))
+		Expect(testUI.Out).To(Say(`\+\s+bob@example.com\s+SpaceDeveloper\s+my-org / dev\n`))
+		Expect(testUI.Out).To(Say(`-\s+carol@example.com\s+SpaceDeveloper\s+my-org / dev\n`))
+		Expect(testUI.Out).To(Say(`user\s+result`))
+		Expect(testUI.Out).To(Say(`bob@example.com\s+OK`))
+		Expect(testUI.Out).To(Say(`carol@example.com\s+OK`))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(testUI.Err).To(Say("changes-warning"))
+		Expect(testUI.Err).To(Say("apply-warning"))
+	})
+
+	Context("when --prune and --concurrency are provided", func() {
+		BeforeEach(func() {
+			cmd.Prune = true
+			cmd.Concurrency = 2
+		})
+
+		It("passes them to the actor", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, prune, _ := fakeActor.GetRoleChangesArgsForCall(0)
+			Expect(prune).To(BeTrue())
+			_, concurrency := fakeActor.ApplyRoleChangesArgsForCall(0)
+			Expect(concurrency).To(Equal(2))
+		})
+	})
+
+	Context("when --dry-run is provided", func() {
+		BeforeEach(func() {
+			cmd.DryRun = true
+		})
+
+		It("displays the changes without applying them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`\+\s+bob@example.com\s+OrgAuditor\s+my-org\n`))
+			Expect(testUI.Out).To(Say(`Dry run: 3 change\(s\) not applied.`))
+			Expect(fakeActor.ApplyRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the roles are already up to date", func() {
+		BeforeEach(func() {
+			fakeActor.GetRoleChangesReturns(nil, nil, nil)
+		})
+
+		It("displays that nothing changed", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Roles are already up to date."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.ApplyRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when comparing fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetRoleChangesReturns(nil, v2action.Warnings{"changes-warning"}, v2action.OrganizationNotFoundError{Name: "my-org"})
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "my-org"}))
+			Expect(testUI.Err).To(Say("changes-warning"))
+			Expect(fakeActor.ApplyRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the changes of a user fail", func() {
+		BeforeEach(func() {
+			fakeActor.ApplyRoleChangesReturns(
+				[]v2action.UserRoleChangesResult{
+					{Username: "bob@example.com", Err: v2action.RoleChangeError{Change: bobDeveloper, Err: errors.New("not authorized")}},
+					{Username: "carol@example.com"},
+				},
+				nil)
+		})
+
+		It("displays the failure of the user and returns a RolesNotAppliedError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RolesNotAppliedError{
+				FailedCount: 1,
+				UserCount:   2,
+			}))
+
+			Expect(testUI.Out).To(Say(`bob@example.com\s+failed to assign SpaceDeveloper in my-org / dev: not authorized`))
+			Expect(testUI.Out).To(Say(`carol@example.com\s+OK`))
+		})
+	})
+})
@@ -0,0 +1,221 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . AuditEventsActor
+
+type AuditEventsActor interface {
+	GetEvents(filter v2action.EventFilter) ([]v2action.Event, v2action.Warnings, error)
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+}
+
+type AuditEventsCommand struct {
+	ActeeTypes      string      `long:"actee-type" description:"Comma-separated resource types the events are about, e.g. app,space,service_instance"`
+	Types           string      `long:"type" description:"Comma-separated event types, e.g. audit.app.update,audit.app.delete-request"`
+	ActorName       string      `long:"actor" description:"Name, username or GUID of the user or process that caused the events"`
+	Organization    string      `short:"o" description:"Only show events in this org"`
+	Space           string      `short:"s" description:"Only show events in this space of the org given with -o, or of the targeted org"`
+	Since           flag.Since  `long:"since" description:"Only show events at or after this time, as a duration before now (e.g. 24h) or an RFC3339 timestamp"`
+	Until           flag.Until  `long:"until" description:"Only show events at or before this time, as a duration before now (e.g. 1h) or an RFC3339 timestamp"`
+	JSON            bool        `long:"json" description:"Output the events as JSON"`
+	usage           interface{} `usage:"CF_NAME audit-events [--actee-type TYPES] [--type EVENT_TYPES] [--actor ACTOR] [-o ORG] [-s SPACE] [--since TIME] [--until TIME] [--json]\n\nEXAMPLES:\n   CF_NAME audit-events -o my-org --actee-type app --since 24h\n   CF_NAME audit-events --type audit.space.delete-request,audit.organization.delete-request --since 2017-08-01T00:00:00Z --json"`
+	relatedCommands interface{} `related_commands:"events, org-users, space-users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AuditEventsActor
+}
+
+type auditEventJSON struct {
+	GUID             string                 `json:"guid"`
+	Type             string                 `json:"type"`
+	Timestamp        time.Time              `json:"timestamp"`
+	Actor            string                 `json:"actor"`
+	ActorType        string                 `json:"actor_type"`
+	ActorName        string                 `json:"actor_name"`
+	ActorUsername    string                 `json:"actor_username"`
+	Actee            string                 `json:"actee"`
+	ActeeType        string                 `json:"actee_type"`
+	ActeeName        string                 `json:"actee_name"`
+	SpaceGUID        string                 `json:"space_guid"`
+	OrganizationGUID string                 `json:"organization_guid"`
+	Metadata         map[string]interface{} `json:"metadata"`
+}
+
+func (cmd *AuditEventsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd AuditEventsCommand) Execute(args []string) error {
+	orgName := cmd.Organization
+	err := cmd.SharedActor.CheckTarget(cmd.Config, cmd.Space != "" && orgName == "", false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	if cmd.Space != "" && orgName == "" {
+		orgName = cmd.Config.TargetedOrganization().Name
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if !cmd.JSON {
+		cmd.displayFlavorText(orgName, user.Name)
+	}
+
+	filter := v2action.EventFilter{
+		ActeeTypes: splitCommaSeparated(cmd.ActeeTypes),
+		Types:      splitCommaSeparated(cmd.Types),
+		Actor:      cmd.ActorName,
+		Since:      cmd.Since.Time,
+		Until:      cmd.Until.Time,
+	}
+
+	if orgName != "" {
+		org, warnings, err := cmd.Actor.GetOrganizationByName(orgName)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		filter.OrganizationGUID = org.GUID
+
+		if cmd.Space != "" {
+			space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(org.GUID, cmd.Space)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return shared.HandleError(err)
+			}
+			filter.SpaceGUID = space.GUID
+		}
+	}
+
+	events, warnings, err := cmd.Actor.GetEvents(filter)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.JSON {
+		return cmd.displayJSON(events)
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(events) == 0 {
+		cmd.UI.DisplayText("No events found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("event"),
+			cmd.UI.TranslateText("actor"),
+			cmd.UI.TranslateText("actee type"),
+			cmd.UI.TranslateText("actee"),
+		},
+	}
+
+	for _, event := range events {
+		actor := event.ActorUsername
+		if actor == "" {
+			actor = event.ActorName
+		}
+
+		table = append(table, []string{
+			event.Timestamp.Local().Format("2006-01-02T15:04:05.00-0700"),
+			event.Type,
+			actor,
+			event.ActeeType,
+			event.ActeeName,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	return nil
+}
+
+func (cmd AuditEventsCommand) displayFlavorText(orgName string, username string) {
+	switch {
+	case cmd.Space != "":
+		cmd.UI.DisplayTextWithFlavor("Getting audit events in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   orgName,
+			"SpaceName": cmd.Space,
+			"Username":  username,
+		})
+	case orgName != "":
+		cmd.UI.DisplayTextWithFlavor("Getting audit events in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  orgName,
+			"Username": username,
+		})
+	default:
+		cmd.UI.DisplayTextWithFlavor("Getting audit events as {{.Username}}...", map[string]interface{}{
+			"Username": username,
+		})
+	}
+}
+
+func (cmd AuditEventsCommand) displayJSON(events []v2action.Event) error {
+	output := []auditEventJSON{}
+	for _, event := range events {
+		output = append(output, auditEventJSON{
+			GUID:             event.GUID,
+			Type:             event.Type,
+			Timestamp:        event.Timestamp,
+			Actor:            event.ActorGUID,
+			ActorType:        event.ActorType,
+			ActorName:        event.ActorName,
+			ActorUsername:    event.ActorUsername,
+			Actee:            event.ActeeGUID,
+			ActeeType:        event.ActeeType,
+			ActeeName:        event.ActeeName,
+			SpaceGUID:        event.SpaceGUID,
+			OrganizationGUID: event.OrganizationGUID,
+			Metadata:         event.Metadata,
+		})
+	}
+
+	raw, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(raw))
+	return err
+}
+
+func splitCommaSeparated(list string) []string {
+	var values []string
+	for _, value := range strings.Split(list, ",") {
+		value = strings.TrimSpace(value)
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
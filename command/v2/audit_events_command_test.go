@@ -0,0 +1,279 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("audit-events Command", func() {
+	var (
+		cmd             AuditEventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAuditEventsActor
+		binaryName      string
+		executeErr      error
+
+		firstEventTime  time.Time
+		secondEventTime time.Time
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAuditEventsActor)
+
+		cmd = AuditEventsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "targeted-org", GUID: "targeted-org-guid"})
+
+		firstEventTime = time.Date(2017, time.August, 16, 10, 30, 0, 0, time.UTC)
+		secondEventTime = time.Date(2017, time.August, 16, 11, 0, 0, 0, time.UTC)
+		fakeActor.GetEventsReturns([]v2action.Event{
+			{
+				GUID:          "event-guid-1",
+				Type:          "audit.app.update",
+				ActorGUID:     "user-guid",
+				ActorType:     "user",
+				ActorName:     "some-user",
+				ActorUsername: "some-user@example.com",
+				ActeeGUID:     "app-guid",
+				ActeeType:     "app",
+				ActeeName:     "some-app",
+				Timestamp:     firstEventTime,
+				Metadata: map[string]interface{}{
+					"request": map[string]interface{}{"instances": float64(2)},
+				},
+				SpaceGUID:        "space-guid",
+				OrganizationGUID: "org-guid",
+			},
+			{
+				GUID:             "event-guid-2",
+				Type:             "audit.space.create",
+				ActorGUID:        "system-guid",
+				ActorType:        "system",
+				ActorName:        "system",
+				ActeeGUID:        "space-guid",
+				ActeeType:        "space",
+				ActeeName:        "some-space",
+				Timestamp:        secondEventTime,
+				SpaceGUID:        "space-guid",
+				OrganizationGUID: "org-guid",
+			},
+		}, v2action.Warnings{"get-events-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+			Expect(fakeActor.GetEventsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when no org or space is provided", func() {
+		BeforeEach(func() {
+			cmd.ActeeTypes = "app, space"
+			cmd.Types = "audit.app.update,audit.space.create,"
+			cmd.ActorName = "some-user"
+			cmd.Since.Time = firstEventTime
+			cmd.Until.Time = secondEventTime
+		})
+
+		It("displays the events across all orgs", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+			Expect(fakeActor.GetEventsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v2action.EventFilter{
+				ActeeTypes: []string{"app", "space"},
+				Types:      []string{"audit.app.update", "audit.space.create"},
+				Actor:      "some-user",
+				Since:      firstEventTime,
+				Until:      secondEventTime,
+			}))
+
+			Expect(testUI.Out).To(Say("Getting audit events as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("time\\s+event\\s+actor\\s+actee type\\s+actee"))
+			Expect(testUI.Out).To(Say("%s\\s+audit.app.update\\s+some-user@example.com\\s+app\\s+some-app", regexp.QuoteMeta(firstEventTime.Local().Format("2006-01-02T15:04:05.00-0700"))))
+			Expect(testUI.Out).To(Say("%s\\s+audit.space.create\\s+system\\s+space\\s+some-space", regexp.QuoteMeta(secondEventTime.Local().Format("2006-01-02T15:04:05.00-0700"))))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+		})
+	})
+
+	Context("when an org is provided", func() {
+		BeforeEach(func() {
+			cmd.Organization = "some-org"
+			fakeActor.GetOrganizationByNameReturns(v2action.Organization{Name: "some-org", GUID: "some-org-guid"}, v2action.Warnings{"get-org-warning"}, nil)
+		})
+
+		It("filters the events by the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+			Expect(fakeActor.GetSpaceByOrganizationAndNameCallCount()).To(Equal(0))
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v2action.EventFilter{OrganizationGUID: "some-org-guid"}))
+
+			Expect(testUI.Out).To(Say("Getting audit events in org some-org as some-user\\.\\.\\."))
+			Expect(testUI.Err).To(Say("get-org-warning"))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+		})
+
+		Context("when the org cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationByNameReturns(v2action.Organization{}, v2action.Warnings{"get-org-warning"}, v2action.OrganizationNotFoundError{Name: "some-org"})
+			})
+
+			It("returns a translatable error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(testUI.Err).To(Say("get-org-warning"))
+				Expect(fakeActor.GetEventsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a space is provided", func() {
+			BeforeEach(func() {
+				cmd.Space = "some-space"
+				fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{Name: "some-space", GUID: "some-space-guid"}, v2action.Warnings{"get-space-warning"}, nil)
+			})
+
+			It("filters the events by the space of the org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeFalse())
+
+				orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(spaceName).To(Equal("some-space"))
+				Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v2action.EventFilter{
+					OrganizationGUID: "some-org-guid",
+					SpaceGUID:        "some-space-guid",
+				}))
+
+				Expect(testUI.Out).To(Say("Getting audit events in org some-org / space some-space as some-user\\.\\.\\."))
+				Expect(testUI.Err).To(Say("get-space-warning"))
+			})
+		})
+	})
+
+	Context("when only a space is provided", func() {
+		BeforeEach(func() {
+			cmd.Space = "some-space"
+			fakeActor.GetOrganizationByNameReturns(v2action.Organization{Name: "targeted-org", GUID: "targeted-org-guid"}, nil, nil)
+			fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{Name: "some-space", GUID: "some-space-guid"}, nil, nil)
+		})
+
+		It("requires a targeted org and uses it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("targeted-org"))
+
+			Expect(testUI.Out).To(Say("Getting audit events in org targeted-org / space some-space as some-user\\.\\.\\."))
+		})
+	})
+
+	Context("when there are no events", func() {
+		BeforeEach(func() {
+			fakeActor.GetEventsReturns(nil, nil, nil)
+		})
+
+		It("displays a message", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No events found\\."))
+		})
+
+		Context("when --json is provided", func() {
+			BeforeEach(func() {
+				cmd.JSON = true
+			})
+
+			It("displays an empty list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("\\[\\]"))
+			})
+		})
+	})
+
+	Context("when --json is provided", func() {
+		BeforeEach(func() {
+			cmd.JSON = true
+		})
+
+		It("displays only the events as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Getting audit events"))
+
+			var output []map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &output)).To(Succeed())
+			Expect(output).To(HaveLen(2))
+			Expect(output[0]).To(Equal(map[string]interface{}{
+				"guid":              "event-guid-1",
+				"type":              "audit.app.update",
+				"timestamp":         "2017-08-16T10:30:00Z",
+				"actor":             "user-guid",
+				"actor_type":        "user",
+				"actor_name":        "some-user",
+				"actor_username":    "some-user@example.com",
+				"actee":             "app-guid",
+				"actee_type":        "app",
+				"actee_name":        "some-app",
+				"space_guid":        "space-guid",
+				"organization_guid": "org-guid",
+				"metadata": map[string]interface{}{
+					"request": map[string]interface{}{"instances": float64(2)},
+				},
+			}))
+			Expect(output[1]["guid"]).To(Equal("event-guid-2"))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+		})
+	})
+
+	Context("when getting the events fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetEventsReturns(nil, v2action.Warnings{"get-events-warning"}, errors.New("get-events-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("get-events-error"))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+		})
+	})
+})
@@ -1,26 +1,154 @@
 package v2
 
 import (
-	"os"
+	"fmt"
+	"strings"
+	"time"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . CreateServiceActor
+
+type CreateServiceActor interface {
+	CreateServiceInstance(spaceGUID string, servicePlanGUID string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (v2action.ServiceInstance, v2action.Warnings, error)
+	GetServicePlanByNameAndServiceAndSpace(planName string, serviceName string, spaceGUID string) (v2action.ServicePlan, v2action.Warnings, error)
+	PollServiceInstanceOperation(serviceInstance v2action.ServiceInstance, timeout time.Duration) (<-chan v2action.ServiceInstance, <-chan string, <-chan error)
+}
+
 type CreateServiceCommand struct {
-	RequiredArgs      flag.CreateServiceArgs `positional-args:"yes"`
-	ConfigurationFile flag.Path              `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
-	Tags              string                 `short:"t" description:"User provided tags"`
-	usage             interface{}            `usage:"CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [-t TAGS]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line:\n\n   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object.\n   The path to the parameters file can be an absolute or relative path to a file:\n\n   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"cluster_nodes\": {\n         \"count\": 5,\n         \"memory_mb\": 1024\n      }\n   }\n\nTIP:\n   Use 'CF_NAME create-user-provided-service' to make user-provided services available to CF apps\n\nEXAMPLES:\n   Linux/Mac:\n      CF_NAME create-service db-service silver mydb -c '{\"ram_gb\":4}'\n\n   Windows Command Line:\n      CF_NAME create-service db-service silver mydb -c \"{\\\"ram_gb\\\":4}\"\n\n   Windows PowerShell:\n      CF_NAME create-service db-service silver mydb -c '{\\\"ram_gb\\\":4}'\n\n   CF_NAME create-service db-service silver mydb -c ~/workspace/tmp/instance_config.json\n\n   CF_NAME create-service db-service silver mydb -t \"list, of, tags\""`
-	relatedCommands   interface{}            `related_commands:"bind-service, create-user-provided-service, marketplace, services"`
+	RequiredArgs      flag.CreateServiceArgs        `positional-args:"yes"`
+	ConfigurationFile flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
+	Tags              flag.Tags                     `short:"t" description:"User provided tags"`
+	Wait              bool                          `long:"wait" description:"Wait for the service broker to finish creating the service instance, showing each change of its status"`
+	Timeout           int                           `long:"timeout" description:"Seconds to wait when using --wait before giving up (Default: no limit)"`
+	usage             interface{}                   `usage:"CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [-t TAGS] [--wait [--timeout SECONDS]]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line:\n\n   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object.\n   The path to the parameters file can be an absolute or relative path to a file:\n\n   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"cluster_nodes\": {\n         \"count\": 5,\n         \"memory_mb\": 1024\n      }\n   }\n\nTIP:\n   Use 'CF_NAME create-user-provided-service' to make user-provided services available to CF apps\n\nEXAMPLES:\n   Linux/Mac:\n      CF_NAME create-service db-service silver mydb -c '{\"ram_gb\":4}'\n\n   Windows Command Line:\n      CF_NAME create-service db-service silver mydb -c \"{\\\"ram_gb\\\":4}\"\n\n   Windows PowerShell:\n      CF_NAME create-service db-service silver mydb -c '{\\\"ram_gb\\\":4}'\n\n   CF_NAME create-service db-service silver mydb -c ~/workspace/tmp/instance_config.json\n\n   CF_NAME create-service db-service silver mydb -t \"list, of, tags\"\n\n   CF_NAME create-service db-service silver mydb --wait --timeout 600"`
+	relatedCommands   interface{}                   `related_commands:"bind-service, create-user-provided-service, marketplace, services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateServiceActor
 }
 
-func (CreateServiceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *CreateServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (CreateServiceCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd CreateServiceCommand) Execute(args []string) error {
+	if cmd.Timeout < 0 {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--timeout",
+			ExpectedType: "integer greater than or equal to 0",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+	cmd.UI.DisplayTextWithFlavor("Creating service instance {{.ServiceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ServiceName": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	plan, warnings, err := cmd.Actor.GetServicePlanByNameAndServiceAndSpace(cmd.RequiredArgs.ServicePlan, cmd.RequiredArgs.ServiceOffering, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	instance, warnings, err := cmd.Actor.CreateServiceInstance(space.GUID, plan.GUID, cmd.RequiredArgs.ServiceInstance, cmd.ConfigurationFile, cmd.Tags.Value)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.ServiceInstanceAlreadyExistsError); ok {
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayWarning("Service {{.ServiceName}} already exists", map[string]interface{}{
+				"ServiceName": cmd.RequiredArgs.ServiceInstance,
+			})
+			return nil
+		}
+		return shared.HandleError(err)
+	}
+
+	err = displayServiceInstanceOperation(cmd.UI, cmd.Config, cmd.Actor, instance, cmd.Wait, cmd.Timeout)
+	if err != nil {
+		return err
+	}
+
+	if !plan.Free {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Attention: The plan `{{.PlanName}}` of service `{{.ServiceName}}` is not free.  The instance `{{.ServiceInstanceName}}` will incur a cost.  Contact your administrator if you think this is in error.", map[string]interface{}{
+			"PlanName":            plan.Name,
+			"ServiceName":         cmd.RequiredArgs.ServiceOffering,
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	return nil
+}
+
+type serviceInstanceOperationPoller interface {
+	PollServiceInstanceOperation(serviceInstance v2action.ServiceInstance, timeout time.Duration) (<-chan v2action.ServiceInstance, <-chan string, <-chan error)
+}
+
+// displayServiceInstanceOperation finishes the output of create-service,
+// update-service and delete-service. When the service broker is still working
+// on the instance, it either waits for the operation and streams its status
+// or tells the user how to check on it later.
+func displayServiceInstanceOperation(ui command.UI, config command.Config, actor serviceInstanceOperationPoller, instance v2action.ServiceInstance, wait bool, timeoutSeconds int) error {
+	if !instance.OperationInProgress() {
+		ui.DisplayOK()
+		return nil
+	}
+
+	if !wait {
+		ui.DisplayOK()
+		ui.DisplayNewline()
+		ui.DisplayText("{{.State}} in progress. Use '{{.ServicesCommand}}' or '{{.ServiceCommand}}' to check operation status.", map[string]interface{}{
+			"State":           strings.Title(instance.LastOperation.Type),
+			"ServicesCommand": fmt.Sprintf("%s services", config.BinaryName()),
+			"ServiceCommand":  fmt.Sprintf("%s service %s", config.BinaryName(), instance.Name),
+		})
+		return nil
+	}
+
+	timeout := config.OverallPollingTimeout()
+	if timeoutSeconds > 0 {
+		timeout = time.Duration(timeoutSeconds) * time.Second
+	}
+
+	instances, warnings, errs := actor.PollServiceInstanceOperation(instance, timeout)
+	err := shared.PollServiceInstanceOperation(ui, config, instances, warnings, errs)
+	if err != nil {
+		return err
+	}
+
+	ui.DisplayOK()
 	return nil
 }
@@ -0,0 +1,344 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-service Command", func() {
+	var (
+		cmd             CreateServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateServiceActor)
+
+		cmd = CreateServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.ServiceOffering = "some-service"
+		cmd.RequiredArgs.ServicePlan = "some-plan"
+		cmd.RequiredArgs.ServiceInstance = "some-instance"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the timeout is negative", func() {
+		BeforeEach(func() {
+			cmd.Wait = true
+			cmd.Timeout = -1
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--timeout",
+				ExpectedType: "integer greater than or equal to 0",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and an org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+				GUID: "some-org-guid",
+				Name: "some-org",
+			})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{
+				GUID: "some-space-guid",
+				Name: "some-space",
+			})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when getting the current user returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("got bananapants??")
+				fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when the service plan cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetServicePlanByNameAndServiceAndSpaceReturns(
+					v2action.ServicePlan{},
+					v2action.Warnings{"plan-warning"},
+					v2action.ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"})
+			})
+
+			It("displays warnings and returns a translatable error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"}))
+				Expect(testUI.Err).To(Say("plan-warning"))
+
+				Expect(fakeActor.GetServicePlanByNameAndServiceAndSpaceCallCount()).To(Equal(1))
+				planName, serviceName, spaceGUID := fakeActor.GetServicePlanByNameAndServiceAndSpaceArgsForCall(0)
+				Expect(planName).To(Equal("some-plan"))
+				Expect(serviceName).To(Equal("some-service"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeActor.CreateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the service plan is found", func() {
+			BeforeEach(func() {
+				cmd.ConfigurationFile = map[string]interface{}{"some-parameter": "some-value"}
+				cmd.Tags.Value = []string{"tag-1", "tag-2"}
+
+				fakeActor.GetServicePlanByNameAndServiceAndSpaceReturns(
+					v2action.ServicePlan{GUID: "some-plan-guid", Name: "some-plan", Free: true},
+					v2action.Warnings{"plan-warning"},
+					nil)
+			})
+
+			It("creates the service instance with the given parameters and tags", func() {
+				Expect(fakeActor.CreateServiceInstanceCallCount()).To(Equal(1))
+				spaceGUID, planGUID, name, parameters, tags := fakeActor.CreateServiceInstanceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(planGUID).To(Equal("some-plan-guid"))
+				Expect(name).To(Equal("some-instance"))
+				Expect(parameters).To(Equal(map[string]interface{}{"some-parameter": "some-value"}))
+				Expect(tags).To(Equal([]string{"tag-1", "tag-2"}))
+			})
+
+			Context("when the service instance is created synchronously", func() {
+				BeforeEach(func() {
+					fakeActor.CreateServiceInstanceReturns(
+						v2action.ServiceInstance{
+							Name:          "some-instance",
+							LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationSucceeded},
+						},
+						v2action.Warnings{"create-warning"},
+						nil)
+				})
+
+				It("displays flavor text, warnings and OK without polling", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Creating service instance some-instance in org some-org / space some-space as some-user..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("plan-warning"))
+					Expect(testUI.Err).To(Say("create-warning"))
+
+					Expect(fakeActor.PollServiceInstanceOperationCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the service instance already exists", func() {
+				BeforeEach(func() {
+					fakeActor.CreateServiceInstanceReturns(
+						v2action.ServiceInstance{},
+						v2action.Warnings{"create-warning"},
+						v2action.ServiceInstanceAlreadyExistsError{Name: "some-instance"})
+				})
+
+				It("displays OK and a warning", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("create-warning"))
+					Expect(testUI.Err).To(Say("Service some-instance already exists"))
+				})
+			})
+
+			Context("when creating the service instance fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("create failed")
+					fakeActor.CreateServiceInstanceReturns(
+						v2action.ServiceInstance{},
+						v2action.Warnings{"create-warning"},
+						expectedErr)
+				})
+
+				It("displays warnings and returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("create-warning"))
+				})
+			})
+
+			Context("when the service broker provisions the instance asynchronously", func() {
+				var instance v2action.ServiceInstance
+
+				BeforeEach(func() {
+					instance = v2action.ServiceInstance{
+						GUID:          "some-instance-guid",
+						Name:          "some-instance",
+						LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationInProgress},
+					}
+					fakeActor.CreateServiceInstanceReturns(instance, nil, nil)
+				})
+
+				Context("when --wait is not provided", func() {
+					It("tells the user how to check on the operation", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).To(Say("Create in progress. Use 'faceman services' or 'faceman service some-instance' to check operation status."))
+
+						Expect(fakeActor.PollServiceInstanceOperationCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when --wait is provided", func() {
+					var (
+						instances   chan v2action.ServiceInstance
+						apiWarnings chan string
+						apiErrs     chan error
+					)
+
+					BeforeEach(func() {
+						cmd.Wait = true
+
+						instances = make(chan v2action.ServiceInstance, 2)
+						apiWarnings = make(chan string, 1)
+						apiErrs = make(chan error, 1)
+						fakeActor.PollServiceInstanceOperationReturns(instances, apiWarnings, apiErrs)
+					})
+
+					Context("when the operation succeeds", func() {
+						BeforeEach(func() {
+							instances <- v2action.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationInProgress, Description: "provisioning"}}
+							instances <- v2action.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationSucceeded}}
+							apiWarnings <- "poll-warning"
+							close(instances)
+							close(apiWarnings)
+							close(apiErrs)
+						})
+
+						It("streams each status change and displays OK", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("create in progress: provisioning"))
+							Expect(testUI.Out).To(Say("create succeeded"))
+							Expect(testUI.Out).To(Say("OK"))
+							Expect(testUI.Err).To(Say("poll-warning"))
+
+							Expect(fakeActor.PollServiceInstanceOperationCallCount()).To(Equal(1))
+							polledInstance, timeout := fakeActor.PollServiceInstanceOperationArgsForCall(0)
+							Expect(polledInstance).To(Equal(instance))
+							Expect(timeout).To(Equal(time.Minute))
+						})
+					})
+
+					Context("when a timeout is provided", func() {
+						BeforeEach(func() {
+							cmd.Timeout = 600
+							close(instances)
+							close(apiWarnings)
+							close(apiErrs)
+						})
+
+						It("polls with the given timeout", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							_, timeout := fakeActor.PollServiceInstanceOperationArgsForCall(0)
+							Expect(timeout).To(Equal(600 * time.Second))
+						})
+					})
+
+					Context("when the operation fails", func() {
+						BeforeEach(func() {
+							apiErrs <- v2action.ServiceInstanceOperationFailedError{
+								Name:        "some-instance",
+								Operation:   "create",
+								Description: "out of capacity",
+							}
+						})
+
+						It("returns a ServiceInstanceOperationFailedError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceOperationFailedError{
+								Name:        "some-instance",
+								Operation:   "create",
+								Description: "out of capacity",
+							}))
+							Expect(testUI.Out).ToNot(Say("OK"))
+						})
+					})
+
+					Context("when the operation times out", func() {
+						BeforeEach(func() {
+							apiErrs <- v2action.ServiceInstanceOperationTimeoutError{Name: "some-instance", Timeout: time.Minute}
+						})
+
+						It("returns a ServiceInstanceOperationTimeoutError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceOperationTimeoutError{
+								Name:       "some-instance",
+								BinaryName: "faceman",
+							}))
+						})
+					})
+				})
+			})
+
+			Context("when the service plan is not free", func() {
+				BeforeEach(func() {
+					fakeActor.GetServicePlanByNameAndServiceAndSpaceReturns(
+						v2action.ServicePlan{GUID: "some-plan-guid", Name: "some-plan", Free: false},
+						nil,
+						nil)
+					fakeActor.CreateServiceInstanceReturns(v2action.ServiceInstance{Name: "some-instance"}, nil, nil)
+				})
+
+				It("displays a cost warning", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Attention: The plan `some-plan` of service `some-service` is not free."))
+				})
+			})
+		})
+	})
+})
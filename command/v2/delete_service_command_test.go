@@ -0,0 +1,365 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-service Command", func() {
+	var (
+		cmd             DeleteServiceCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDeleteServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDeleteServiceActor)
+
+		cmd = DeleteServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.ServiceInstance = "some-instance"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the timeout is negative", func() {
+		BeforeEach(func() {
+			cmd.Wait = true
+			cmd.Timeout = -1
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--timeout",
+				ExpectedType: "integer greater than or equal to 0",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and an org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+				GUID: "some-org-guid",
+				Name: "some-org",
+			})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{
+				GUID: "some-space-guid",
+				Name: "some-space",
+			})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+			fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+				v2action.ServiceInstance{GUID: "some-instance-guid", Name: "some-instance"},
+				v2action.Warnings{"get-warning"},
+				nil)
+			fakeActor.DeleteServiceInstanceReturns(
+				v2action.ServiceInstance{
+					GUID:          "some-instance-guid",
+					Name:          "some-instance",
+					LastOperation: ccv2.LastOperation{Type: "delete", State: ccv2.LastOperationSucceeded},
+				},
+				v2action.Warnings{"delete-warning"},
+				nil)
+		})
+
+		Context("when getting the current user returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("got bananapants??")
+				fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when the -f flag is not provided", func() {
+			Context("when the user inputs yes", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("y\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("prompts for confirmation and deletes the service instance", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say(`Really delete the service some-instance\? \[yN\]:`))
+					Expect(testUI.Out).To(Say("Deleting service some-instance in org some-org / space some-space as some-user..."))
+					Expect(testUI.Out).To(Say("OK"))
+
+					Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the user inputs no", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("n\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("cancels the delete", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Delete cancelled"))
+					Expect(testUI.Out).ToNot(Say("Deleting service"))
+
+					Expect(fakeActor.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(0))
+					Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the user chooses the default", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("cancels the delete", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Delete cancelled"))
+					Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the -f flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			It("deletes the service instance without prompting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Really delete the service"))
+				Expect(testUI.Out).To(Say("Deleting service some-instance in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("get-warning"))
+				Expect(testUI.Err).To(Say("delete-warning"))
+
+				Expect(fakeActor.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(1))
+				name, spaceGUID := fakeActor.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+				Expect(name).To(Equal("some-instance"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(1))
+				Expect(fakeActor.DeleteServiceInstanceArgsForCall(0)).To(Equal(v2action.ServiceInstance{GUID: "some-instance-guid", Name: "some-instance"}))
+
+				Expect(fakeActor.PollServiceInstanceOperationCallCount()).To(Equal(0))
+			})
+
+			Context("when the service instance does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+						v2action.ServiceInstance{},
+						v2action.Warnings{"get-warning"},
+						v2action.ServiceInstanceNotFoundError{Name: "some-instance"})
+				})
+
+				It("displays OK and a warning that the service does not exist", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("get-warning"))
+					Expect(testUI.Err).To(Say("Service some-instance does not exist."))
+
+					Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when getting the service instance fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get failed")
+					fakeActor.GetServiceInstanceByNameAndSpaceReturns(v2action.ServiceInstance{}, v2action.Warnings{"get-warning"}, expectedErr)
+				})
+
+				It("displays warnings and returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("get-warning"))
+
+					Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the service instance has bindings or keys", func() {
+				BeforeEach(func() {
+					fakeActor.DeleteServiceInstanceReturns(
+						v2action.ServiceInstance{},
+						v2action.Warnings{"delete-warning"},
+						v2action.ServiceInstanceHasAssociationsError{Name: "some-instance"})
+				})
+
+				It("displays warnings and returns a ServiceInstanceHasAssociationsError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceHasAssociationsError{Name: "some-instance"}))
+					Expect(testUI.Err).To(Say("delete-warning"))
+					Expect(testUI.Out).ToNot(Say("OK"))
+				})
+			})
+
+			Context("when the service broker deletes the instance asynchronously", func() {
+				var instance v2action.ServiceInstance
+
+				BeforeEach(func() {
+					instance = v2action.ServiceInstance{
+						GUID:          "some-instance-guid",
+						Name:          "some-instance",
+						LastOperation: ccv2.LastOperation{Type: "delete", State: ccv2.LastOperationInProgress},
+					}
+					fakeActor.DeleteServiceInstanceReturns(instance, nil, nil)
+				})
+
+				Context("when --wait is not provided", func() {
+					It("tells the user how to check on the operation", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).To(Say("Delete in progress. Use 'faceman services' or 'faceman service some-instance' to check operation status."))
+
+						Expect(fakeActor.PollServiceInstanceOperationCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when --wait is provided", func() {
+					var (
+						instances   chan v2action.ServiceInstance
+						apiWarnings chan string
+						apiErrs     chan error
+					)
+
+					BeforeEach(func() {
+						cmd.Wait = true
+
+						instances = make(chan v2action.ServiceInstance, 1)
+						apiWarnings = make(chan string, 1)
+						apiErrs = make(chan error, 1)
+						fakeActor.PollServiceInstanceOperationReturns(instances, apiWarnings, apiErrs)
+					})
+
+					Context("when the service instance is deleted", func() {
+						BeforeEach(func() {
+							instances <- v2action.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "delete", State: ccv2.LastOperationInProgress, Description: "deprovisioning"}}
+							apiWarnings <- "poll-warning"
+							close(instances)
+							close(apiWarnings)
+							close(apiErrs)
+						})
+
+						It("streams each status change and displays OK", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("delete in progress: deprovisioning"))
+							Expect(testUI.Out).To(Say("OK"))
+							Expect(testUI.Err).To(Say("poll-warning"))
+
+							Expect(fakeActor.PollServiceInstanceOperationCallCount()).To(Equal(1))
+							polledInstance, timeout := fakeActor.PollServiceInstanceOperationArgsForCall(0)
+							Expect(polledInstance).To(Equal(instance))
+							Expect(timeout).To(Equal(time.Minute))
+						})
+					})
+
+					Context("when a timeout is provided", func() {
+						BeforeEach(func() {
+							cmd.Timeout = 90
+							close(instances)
+							close(apiWarnings)
+							close(apiErrs)
+						})
+
+						It("polls with the given timeout", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							_, timeout := fakeActor.PollServiceInstanceOperationArgsForCall(0)
+							Expect(timeout).To(Equal(90 * time.Second))
+						})
+					})
+
+					Context("when the operation fails", func() {
+						BeforeEach(func() {
+							apiErrs <- v2action.ServiceInstanceOperationFailedError{
+								Name:        "some-instance",
+								Operation:   "delete",
+								Description: "broker unavailable",
+							}
+						})
+
+						It("returns a ServiceInstanceOperationFailedError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceOperationFailedError{
+								Name:        "some-instance",
+								Operation:   "delete",
+								Description: "broker unavailable",
+							}))
+							Expect(testUI.Out).ToNot(Say("OK"))
+						})
+					})
+
+					Context("when the operation times out", func() {
+						BeforeEach(func() {
+							apiErrs <- v2action.ServiceInstanceOperationTimeoutError{Name: "some-instance", Timeout: time.Minute}
+						})
+
+						It("returns a ServiceInstanceOperationTimeoutError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceOperationTimeoutError{
+								Name:       "some-instance",
+								BinaryName: "faceman",
+							}))
+						})
+					})
+				})
+			})
+		})
+	})
+})
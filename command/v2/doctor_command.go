@@ -0,0 +1,376 @@
+package v2
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// maxClockSkew is the largest difference between the local clock and the
+// clock of the Cloud Controller that doctor accepts. Larger differences make
+// tokens look expired or not yet valid.
+const maxClockSkew = time.Minute
+
+//go:generate counterfeiter . DoctorActor
+
+type DoctorActor interface {
+	CheckEndpoint(endpoint string) error
+	GetAccessTokenExpiration() (time.Time, error)
+	GetAPIInformation(settings v2action.TargetSettings) (v2action.APIInformation, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . DoctorPluginActor
+
+type DoctorPluginActor interface {
+	ValidatePluginBinary(plugin configv3.Plugin) error
+}
+
+//go:generate counterfeiter . DoctorSharedActor
+
+type DoctorSharedActor interface {
+	CheckFilePermissions(path string) error
+}
+
+type DoctorCommand struct {
+	usage           interface{} `usage:"CF_NAME doctor"`
+	relatedCommands interface{} `related_commands:"api, login, plugins, version"`
+
+	UI             command.UI
+	Config         command.Config
+	SharedActor    DoctorSharedActor
+	Actor          DoctorActor
+	PluginActor    DoctorPluginActor
+	ConfigFilePath string
+}
+
+type doctorCheck struct {
+	name    string
+	result  string
+	details string
+}
+
+func (cmd *DoctorCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, false)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, nil, config)
+	cmd.PluginActor = pluginaction.NewActor(config, nil)
+	cmd.ConfigFilePath = configv3.ConfigFilePath()
+
+	return nil
+}
+
+// Execute runs every check, even when earlier ones fail, so that a single run
+// reports all the problems with the target and the local environment.
+func (cmd DoctorCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Running diagnostics...")
+	cmd.UI.DisplayNewline()
+
+	checks := cmd.checkTarget()
+	checks = append(checks, cmd.checkAccessToken())
+	checks = append(checks, cmd.checkConfigFile())
+	checks = append(checks, cmd.checkPlugins()...)
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("check"),
+			cmd.UI.TranslateText("result"),
+			cmd.UI.TranslateText("details"),
+		},
+	}
+	failedCount := 0
+	for _, check := range checks {
+		if check.result == "FAILED" {
+			failedCount++
+		}
+		table = append(table, []string{check.name, cmd.UI.TranslateText(check.result), check.details})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	cmd.UI.DisplayNewline()
+
+	if failedCount > 0 {
+		return translatableerror.DoctorChecksFailedError{
+			FailedCount: failedCount,
+			CheckCount:  len(checks),
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// checkTarget checks the API endpoint, the endpoints it advertises, CLI
+// version compatibility and clock skew.
+func (cmd DoctorCommand) checkTarget() []doctorCheck {
+	checks := []doctorCheck{
+		cmd.newCheck("API endpoint"),
+		cmd.newCheck("CLI version"),
+		cmd.newCheck("clock"),
+		cmd.newCheck("UAA"),
+		cmd.newCheck("Doppler"),
+		cmd.newCheck("SSH"),
+	}
+	apiCheck := &checks[0]
+
+	if cmd.Config.Target() == "" {
+		apiCheck.fail(cmd.UI.TranslateText("No API endpoint set. Use '{{.LoginTip}}' or '{{.APITip}}' to target an endpoint.", map[string]interface{}{
+			"LoginTip": cmd.Config.BinaryName() + " login",
+			"APITip":   cmd.Config.BinaryName() + " api",
+		}))
+		skipChecks(checks[1:], cmd.UI.TranslateText("no API endpoint"))
+		return checks
+	}
+
+	info, warnings, err := cmd.Actor.GetAPIInformation(v2action.TargetSettings{
+		URL:               cmd.Config.Target(),
+		SkipSSLValidation: cmd.Config.SkipSSLValidation(),
+		DialTimeout:       cmd.Config.DialTimeout(),
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		apiCheck.fail(cmd.apiErrorDetails(err))
+		skipChecks(checks[1:], cmd.UI.TranslateText("API endpoint unreachable"))
+		return checks
+	}
+
+	if cmd.Config.SkipSSLValidation() {
+		apiCheck.pass(cmd.UI.TranslateText("{{.API}} (API version {{.APIVersion}}, SSL validation skipped)", map[string]interface{}{
+			"API":        cmd.Config.Target(),
+			"APIVersion": info.APIVersion,
+		}))
+	} else {
+		apiCheck.pass(cmd.UI.TranslateText("{{.API}} (API version {{.APIVersion}})", map[string]interface{}{
+			"API":        cmd.Config.Target(),
+			"APIVersion": info.APIVersion,
+		}))
+	}
+
+	cmd.checkCLIVersion(&checks[1], info.MinCLIVersion)
+	cmd.checkClock(&checks[2], info.ServerTime)
+	cmd.checkEndpoint(&checks[3], info.AuthorizationEndpoint)
+	cmd.checkEndpoint(&checks[4], info.DopplerEndpoint)
+	cmd.checkEndpoint(&checks[5], info.AppSSHEndpoint)
+
+	return checks
+}
+
+func (cmd DoctorCommand) apiErrorDetails(err error) string {
+	switch e := err.(type) {
+	case ccerror.UnverifiedServerError:
+		return cmd.UI.TranslateText("The SSL certificate of {{.URL}} is not trusted. Use '{{.APITip}}' only if you trust the endpoint.", map[string]interface{}{
+			"URL":    e.URL,
+			"APITip": cmd.Config.BinaryName() + " api --skip-ssl-validation",
+		})
+	case ccerror.SSLValidationHostnameError:
+		return cmd.UI.TranslateText("The SSL certificate does not match the host name: {{.Message}}", map[string]interface{}{
+			"Message": e.Message,
+		})
+	case ccerror.RequestError:
+		if proxy := cmd.Config.HTTPSProxy(); proxy != "" {
+			return cmd.UI.TranslateText("Request through proxy {{.Proxy}} failed: {{.Error}}", map[string]interface{}{
+				"Proxy": proxy,
+				"Error": e.Error(),
+			})
+		}
+		return cmd.UI.TranslateText("Request failed: {{.Error}}", map[string]interface{}{
+			"Error": e.Error(),
+		})
+	default:
+		return err.Error()
+	}
+}
+
+func (cmd DoctorCommand) checkCLIVersion(check *doctorCheck, minCLIVersion string) {
+	err := command.MinimumAPIVersionCheck(cmd.Config.BinaryVersion(), minCLIVersion)
+	switch err.(type) {
+	case nil:
+		if minCLIVersion == "" {
+			check.pass(cmd.Config.BinaryVersion())
+			return
+		}
+		check.pass(cmd.UI.TranslateText("{{.BinaryVersion}} (API requires {{.MinCLIVersion}} or later)", map[string]interface{}{
+			"BinaryVersion": cmd.Config.BinaryVersion(),
+			"MinCLIVersion": minCLIVersion,
+		}))
+	case translatableerror.MinimumAPIVersionNotMetError:
+		check.fail(cmd.UI.TranslateText("API requires CLI version {{.MinCLIVersion}} or later, this is {{.BinaryVersion}}. To upgrade your CLI, please visit: https://github.com/cloudfoundry/cli#downloads", map[string]interface{}{
+			"BinaryVersion": cmd.Config.BinaryVersion(),
+			"MinCLIVersion": minCLIVersion,
+		}))
+	default:
+		check.fail(err.Error())
+	}
+}
+
+func (cmd DoctorCommand) checkClock(check *doctorCheck, serverTime time.Time) {
+	if serverTime.IsZero() {
+		check.skip(cmd.UI.TranslateText("API did not report its time"))
+		return
+	}
+
+	skew := time.Now().Sub(serverTime).Round(time.Second)
+	switch {
+	case skew > maxClockSkew:
+		check.fail(cmd.UI.TranslateText("Local clock is {{.Skew}} ahead of the API", map[string]interface{}{
+			"Skew": skew,
+		}))
+	case skew < -maxClockSkew:
+		check.fail(cmd.UI.TranslateText("Local clock is {{.Skew}} behind the API", map[string]interface{}{
+			"Skew": -skew,
+		}))
+	default:
+		check.pass(cmd.UI.TranslateText("within {{.MaxSkew}} of the API", map[string]interface{}{
+			"MaxSkew": maxClockSkew,
+		}))
+	}
+}
+
+func (cmd DoctorCommand) checkEndpoint(check *doctorCheck, endpoint string) {
+	if endpoint == "" {
+		check.skip(cmd.UI.TranslateText("not advertised by the API"))
+		return
+	}
+
+	err := cmd.Actor.CheckEndpoint(endpoint)
+	if err != nil {
+		check.fail(cmd.UI.TranslateText("{{.Endpoint}}: {{.Error}}", map[string]interface{}{
+			"Endpoint": endpoint,
+			"Error":    err.Error(),
+		}))
+		return
+	}
+	check.pass(endpoint)
+}
+
+func (cmd DoctorCommand) checkAccessToken() doctorCheck {
+	check := cmd.newCheck("access token")
+	loginTip := map[string]interface{}{"LoginTip": cmd.Config.BinaryName() + " login"}
+
+	if cmd.Config.AccessToken() == "" {
+		check.fail(cmd.UI.TranslateText("Not logged in. Use '{{.LoginTip}}' to log in.", loginTip))
+		return check
+	}
+
+	expiration, err := cmd.Actor.GetAccessTokenExpiration()
+	switch {
+	case err != nil:
+		check.fail(cmd.UI.TranslateText("The access token cannot be read: {{.Error}}. Use '{{.LoginTip}}' to log in again.", map[string]interface{}{
+			"Error":    err.Error(),
+			"LoginTip": cmd.Config.BinaryName() + " login",
+		}))
+	case expiration.IsZero():
+		check.pass(cmd.UI.TranslateText("does not expire"))
+	case expiration.After(time.Now()):
+		check.pass(cmd.UI.TranslateText("expires {{.Expiration}}", map[string]interface{}{
+			"Expiration": cmd.UI.UserFriendlyDate(expiration),
+		}))
+	case cmd.Config.RefreshToken() != "":
+		check.pass(cmd.UI.TranslateText("expired {{.Expiration}}, the next request refreshes it", map[string]interface{}{
+			"Expiration": cmd.UI.UserFriendlyDate(expiration),
+		}))
+	default:
+		check.fail(cmd.UI.TranslateText("expired {{.Expiration}}. Use '{{.LoginTip}}' to log in again.", map[string]interface{}{
+			"Expiration": cmd.UI.UserFriendlyDate(expiration),
+			"LoginTip":   cmd.Config.BinaryName() + " login",
+		}))
+	}
+	return check
+}
+
+func (cmd DoctorCommand) checkConfigFile() doctorCheck {
+	check := cmd.newCheck("config file")
+
+	err := cmd.SharedActor.CheckFilePermissions(cmd.ConfigFilePath)
+	switch e := err.(type) {
+	case nil:
+		check.pass(cmd.ConfigFilePath)
+	case sharedaction.FilePermissionsTooOpenError:
+		check.fail(cmd.UI.TranslateText("{{.Path}} can be accessed by other users (mode {{.Mode}}). Restrict it to its owner, for example with 'chmod 600'.", map[string]interface{}{
+			"Path": e.Path,
+			"Mode": e.Mode.Perm().String(),
+		}))
+	default:
+		if os.IsNotExist(err) {
+			check.pass(cmd.UI.TranslateText("{{.Path}} (not created yet)", map[string]interface{}{
+				"Path": cmd.ConfigFilePath,
+			}))
+			return check
+		}
+		check.fail(err.Error())
+	}
+	return check
+}
+
+func (cmd DoctorCommand) checkPlugins() []doctorCheck {
+	plugins := cmd.Config.Plugins()
+	if len(plugins) == 0 {
+		check := cmd.newCheck("plugins")
+		check.pass(cmd.UI.TranslateText("none installed"))
+		return []doctorCheck{check}
+	}
+
+	checks := make([]doctorCheck, 0, len(plugins))
+	for _, plugin := range plugins {
+		check := doctorCheck{
+			name: cmd.UI.TranslateText("plugin {{.PluginName}}", map[string]interface{}{
+				"PluginName": plugin.Name,
+			}),
+		}
+
+		err := cmd.PluginActor.ValidatePluginBinary(plugin)
+		switch e := err.(type) {
+		case nil:
+			check.pass(plugin.Location)
+		case pluginaction.PluginBinaryMissingError:
+			check.fail(cmd.UI.TranslateText("Binary {{.Path}} does not exist. Reinstall the plugin.", map[string]interface{}{
+				"Path": e.Path,
+			}))
+		case pluginaction.PluginBinaryNotExecutableError:
+			check.fail(cmd.UI.TranslateText("Binary {{.Path}} is not executable. Reinstall the plugin.", map[string]interface{}{
+				"Path": e.Path,
+			}))
+		default:
+			check.fail(err.Error())
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+func (cmd DoctorCommand) newCheck(name string) doctorCheck {
+	return doctorCheck{name: cmd.UI.TranslateText(name)}
+}
+
+func (check *doctorCheck) pass(details string) {
+	check.result = "ok"
+	check.details = details
+}
+
+func (check *doctorCheck) fail(details string) {
+	check.result = "FAILED"
+	check.details = details
+}
+
+func (check *doctorCheck) skip(details string) {
+	check.result = "skipped"
+	check.details = details
+}
+
+func skipChecks(checks []doctorCheck, details string) {
+	for i := range checks {
+		checks[i].skip(details)
+	}
+}
@@ -0,0 +1,329 @@
+package v2_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("doctor Command", func() {
+	var (
+		cmd             DoctorCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *v2fakes.FakeDoctorSharedActor
+		fakeActor       *v2fakes.FakeDoctorActor
+		fakePluginActor *v2fakes.FakeDoctorPluginActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(v2fakes.FakeDoctorSharedActor)
+		fakeActor = new(v2fakes.FakeDoctorActor)
+		fakePluginActor = new(v2fakes.FakeDoctorPluginActor)
+
+		cmd = DoctorCommand{
+			UI:             testUI,
+			Config:         fakeConfig,
+			SharedActor:    fakeSharedActor,
+			Actor:          fakeActor,
+			PluginActor:    fakePluginActor,
+			ConfigFilePath: "/home/some-user/.cf/config.json",
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.BinaryVersionReturns("6.30.0")
+		fakeConfig.TargetReturns("https://api.example.com")
+		fakeConfig.DialTimeoutReturns(5 * time.Second)
+		fakeConfig.AccessTokenReturns("bearer some-token")
+		fakeConfig.RefreshTokenReturns("some-refresh-token")
+
+		fakeActor.GetAPIInformationReturns(v2action.APIInformation{
+			APIVersion:            "2.75.0",
+			AppSSHEndpoint:        "ssh.example.com:2222",
+			AuthorizationEndpoint: "https://login.example.com",
+			DopplerEndpoint:       "wss://doppler.example.com:4443",
+			MinCLIVersion:         "6.22.0",
+			ServerTime:            time.Now(),
+		}, v2action.Warnings{"info-warning"}, nil)
+		fakeActor.GetAccessTokenExpirationReturns(time.Now().Add(time.Hour), nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("reports that every check passed", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Running diagnostics..."))
+		Expect(testUI.Out).To(Say(`check\s+result\s+details`))
+		Expect(testUI.Out).To(Say(`API endpoint\s+ok\s+https://api\.example\.com \(API version 2\.75\.0\)`))
+		Expect(testUI.Out).To(Say(`CLI version\s+ok\s+6\.30\.0 \(API requires 6\.22\.0 or later\)`))
+		Expect(testUI.Out).To(Say(`clock\s+ok\s+within 1m0s of the API`))
+		Expect(testUI.Out).To(Say(`UAA\s+ok\s+https://login\.example\.com`))
+		Expect(testUI.Out).To(Say(`Doppler\s+ok\s+wss://doppler\.example\.com:4443`))
+		Expect(testUI.Out).To(Say(`SSH\s+ok\s+ssh\.example\.com:2222`))
+		Expect(testUI.Out).To(Say(`access token\s+ok\s+expires`))
+		Expect(testUI.Out).To(Say(`config file\s+ok\s+/home/some-user/\.cf/config\.json`))
+		Expect(testUI.Out).To(Say(`plugins\s+ok\s+none installed`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("info-warning"))
+
+		Expect(fakeActor.GetAPIInformationArgsForCall(0)).To(Equal(v2action.TargetSettings{
+			URL:         "https://api.example.com",
+			DialTimeout: 5 * time.Second,
+		}))
+		Expect(fakeActor.CheckEndpointCallCount()).To(Equal(3))
+		Expect(fakeActor.CheckEndpointArgsForCall(0)).To(Equal("https://login.example.com"))
+		Expect(fakeActor.CheckEndpointArgsForCall(1)).To(Equal("wss://doppler.example.com:4443"))
+		Expect(fakeActor.CheckEndpointArgsForCall(2)).To(Equal("ssh.example.com:2222"))
+		Expect(fakeSharedActor.CheckFilePermissionsArgsForCall(0)).To(Equal("/home/some-user/.cf/config.json"))
+	})
+
+	Context("when no API endpoint is set", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("")
+		})
+
+		It("fails the API check and skips the checks that need it", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DoctorChecksFailedError{FailedCount: 1, CheckCount: 9}))
+
+			Expect(testUI.Out).To(Say(`API endpoint\s+FAILED\s+No API endpoint set\. Use 'faceman login' or 'faceman api' to target an endpoint\.`))
+			Expect(testUI.Out).To(Say(`CLI version\s+skipped\s+no API endpoint`))
+			Expect(testUI.Out).To(Say(`SSH\s+skipped\s+no API endpoint`))
+			Expect(testUI.Out).To(Say(`access token\s+ok`))
+			Expect(fakeActor.GetAPIInformationCallCount()).To(Equal(0))
+			Expect(fakeActor.CheckEndpointCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the API endpoint cannot be reached", func() {
+		Context("because its certificate is not trusted", func() {
+			BeforeEach(func() {
+				fakeActor.GetAPIInformationReturns(v2action.APIInformation{}, nil, ccerror.UnverifiedServerError{URL: "https://api.example.com"})
+			})
+
+			It("suggests skipping SSL validation", func() {
+				Expect(executeErr).To(MatchError(translatableerror.DoctorChecksFailedError{FailedCount: 1, CheckCount: 9}))
+				Expect(testUI.Out).To(Say(`API endpoint\s+FAILED\s+The SSL certificate of https://api\.example\.com is not trusted\. Use 'faceman api --skip-ssl-validation' only if you trust the endpoint\.`))
+				Expect(testUI.Out).To(Say(`UAA\s+skipped\s+API endpoint unreachable`))
+			})
+		})
+
+		Context("because the request fails through a proxy", func() {
+			BeforeEach(func() {
+				fakeConfig.HTTPSProxyReturns("http://proxy.example.com:8080")
+				fakeActor.GetAPIInformationReturns(v2action.APIInformation{}, nil, ccerror.RequestError{Err: errors.New("proxyconnect tcp: connection refused")})
+			})
+
+			It("names the proxy", func() {
+				Expect(executeErr).To(HaveOccurred())
+				Expect(testUI.Out).To(Say(`API endpoint\s+FAILED\s+Request through proxy http://proxy\.example\.com:8080 failed: proxyconnect tcp: connection refused`))
+			})
+		})
+	})
+
+	Context("when SSL validation is skipped", func() {
+		BeforeEach(func() {
+			fakeConfig.SkipSSLValidationReturns(true)
+		})
+
+		It("says so in the API check", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`API endpoint\s+ok\s+https://api\.example\.com \(API version 2\.75\.0, SSL validation skipped\)`))
+			Expect(fakeActor.GetAPIInformationArgsForCall(0).SkipSSLValidation).To(BeTrue())
+		})
+	})
+
+	Context("when the CLI is older than the API requires", func() {
+		BeforeEach(func() {
+			fakeConfig.BinaryVersionReturns("6.20.0")
+		})
+
+		It("fails the CLI version check", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DoctorChecksFailedError{FailedCount: 1, CheckCount: 9}))
+			Expect(testUI.Out).To(Say(`CLI version\s+FAILED\s+API requires CLI version 6\.22\.0 or later, this is 6\.20\.0\.`))
+		})
+	})
+
+	Context("when the local clock is ahead of the API", func() {
+		BeforeEach(func() {
+			fakeActor.GetAPIInformationReturns(v2action.APIInformation{ServerTime: time.Now().Add(-10 * time.Minute)}, nil, nil)
+		})
+
+		It("fails the clock check", func() {
+			Expect(executeErr).To(HaveOccurred())
+			Expect(testUI.Out).To(Say(`clock\s+FAILED\s+Local clock is 10m0s ahead of the API`))
+		})
+	})
+
+	Context("when the local clock is behind the API", func() {
+		BeforeEach(func() {
+			fakeActor.GetAPIInformationReturns(v2action.APIInformation{ServerTime: time.Now().Add(2 * time.Hour)}, nil, nil)
+		})
+
+		It("fails the clock check", func() {
+			Expect(executeErr).To(HaveOccurred())
+			Expect(testUI.Out).To(Say(`clock\s+FAILED\s+Local clock is 2h0m0s behind the API`))
+		})
+	})
+
+	Context("when the API does not report its time", func() {
+		BeforeEach(func() {
+			fakeActor.GetAPIInformationReturns(v2action.APIInformation{}, nil, nil)
+		})
+
+		It("skips the clock check", func() {
+			Expect(testUI.Out).To(Say(`clock\s+skipped\s+API did not report its time`))
+		})
+	})
+
+	Context("when the API does not advertise an endpoint", func() {
+		BeforeEach(func() {
+			fakeActor.GetAPIInformationReturns(v2action.APIInformation{
+				AuthorizationEndpoint: "https://login.example.com",
+				ServerTime:            time.Now(),
+			}, nil, nil)
+		})
+
+		It("skips the check of that endpoint", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Doppler\s+skipped\s+not advertised by the API`))
+			Expect(testUI.Out).To(Say(`SSH\s+skipped\s+not advertised by the API`))
+			Expect(fakeActor.CheckEndpointCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when an endpoint cannot be reached", func() {
+		BeforeEach(func() {
+			fakeActor.CheckEndpointStub = func(endpoint string) error {
+				if endpoint == "ssh.example.com:2222" {
+					return errors.New("dial tcp: i/o timeout")
+				}
+				return nil
+			}
+		})
+
+		It("fails the check of that endpoint", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DoctorChecksFailedError{FailedCount: 1, CheckCount: 9}))
+			Expect(testUI.Out).To(Say(`UAA\s+ok`))
+			Expect(testUI.Out).To(Say(`SSH\s+FAILED\s+ssh\.example\.com:2222: dial tcp: i/o timeout`))
+		})
+	})
+
+	Context("when the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.AccessTokenReturns("")
+		})
+
+		It("fails the access token check", func() {
+			Expect(executeErr).To(HaveOccurred())
+			Expect(testUI.Out).To(Say(`access token\s+FAILED\s+Not logged in\. Use 'faceman login' to log in\.`))
+			Expect(fakeActor.GetAccessTokenExpirationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the access token has expired", func() {
+		BeforeEach(func() {
+			fakeActor.GetAccessTokenExpirationReturns(time.Now().Add(-time.Hour), nil)
+		})
+
+		It("passes because the refresh token renews it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`access token\s+ok\s+expired .*, the next request refreshes it`))
+		})
+
+		Context("when there is no refresh token", func() {
+			BeforeEach(func() {
+				fakeConfig.RefreshTokenReturns("")
+			})
+
+			It("fails the access token check", func() {
+				Expect(executeErr).To(HaveOccurred())
+				Expect(testUI.Out).To(Say(`access token\s+FAILED\s+expired .*\. Use 'faceman login' to log in again\.`))
+			})
+		})
+	})
+
+	Context("when the access token cannot be parsed", func() {
+		BeforeEach(func() {
+			fakeActor.GetAccessTokenExpirationReturns(time.Time{}, errors.New("not a JWT"))
+		})
+
+		It("fails the access token check", func() {
+			Expect(executeErr).To(HaveOccurred())
+			Expect(testUI.Out).To(Say(`access token\s+FAILED\s+The access token cannot be read: not a JWT\. Use 'faceman login' to log in again\.`))
+		})
+	})
+
+	Context("when the config file", func() {
+		Context("can be accessed by other users", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckFilePermissionsReturns(sharedaction.FilePermissionsTooOpenError{
+					Path: "/home/some-user/.cf/config.json",
+					Mode: 0644,
+				})
+			})
+
+			It("fails the config file check", func() {
+				Expect(executeErr).To(MatchError(translatableerror.DoctorChecksFailedError{FailedCount: 1, CheckCount: 9}))
+				Expect(testUI.Out).To(Say(`config file\s+FAILED\s+/home/some-user/\.cf/config\.json can be accessed by other users \(mode -rw-r--r--\)\.`))
+			})
+		})
+
+		Context("does not exist", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckFilePermissionsReturns(&os.PathError{Op: "stat", Path: "/home/some-user/.cf/config.json", Err: os.ErrNotExist})
+			})
+
+			It("passes the config file check", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`config file\s+ok\s+/home/some-user/\.cf/config\.json \(not created yet\)`))
+			})
+		})
+	})
+
+	Context("when plugins are installed", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginsReturns([]configv3.Plugin{
+				{Name: "good-plugin", Location: "/plugins/good"},
+				{Name: "missing-plugin", Location: "/plugins/missing"},
+				{Name: "broken-plugin", Location: "/plugins/broken"},
+			})
+			fakePluginActor.ValidatePluginBinaryStub = func(plugin configv3.Plugin) error {
+				switch plugin.Name {
+				case "missing-plugin":
+					return pluginaction.PluginBinaryMissingError{Path: plugin.Location}
+				case "broken-plugin":
+					return pluginaction.PluginBinaryNotExecutableError{Path: plugin.Location}
+				}
+				return nil
+			}
+		})
+
+		It("checks the binary of each plugin", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DoctorChecksFailedError{FailedCount: 2, CheckCount: 11}))
+			Expect(testUI.Out).To(Say(`plugin good-plugin\s+ok\s+/plugins/good`))
+			Expect(testUI.Out).To(Say(`plugin missing-plugin\s+FAILED\s+Binary /plugins/missing does not exist\. Reinstall the plugin\.`))
+			Expect(testUI.Out).To(Say(`plugin broken-plugin\s+FAILED\s+Binary /plugins/broken is not executable\. Reinstall the plugin\.`))
+			Expect(fakePluginActor.ValidatePluginBinaryCallCount()).To(Equal(3))
+		})
+	})
+})
@@ -0,0 +1,90 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . ExportSecurityGroupsActor
+
+type ExportSecurityGroupsActor interface {
+	CloudControllerAPIVersion() string
+	GetSecurityGroupsDocument(includeStaging bool) (v2action.SecurityGroupsDocument, v2action.Warnings, error)
+}
+
+type ExportSecurityGroupsCommand struct {
+	FilePath        flag.Path   `short:"p" description:"Write the security groups to a file instead of displaying them"`
+	usage           interface{} `usage:"CF_NAME export-security-groups [-p PATH]\n\n   Writes every security group with its rules and its running and staging bindings as a single YAML document.\n   The document can be edited and applied with sync-security-groups.\n\nEXAMPLES:\n   CF_NAME export-security-groups -p security-groups.yml"`
+	relatedCommands interface{} `related_commands:"security-groups, sync-security-groups"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ExportSecurityGroupsActor
+}
+
+func (cmd *ExportSecurityGroupsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd ExportSecurityGroupsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	// Without a path the document is the only output, so that it can be
+	// redirected to a file.
+	if cmd.FilePath != "" {
+		cmd.UI.DisplayTextWithFlavor("Exporting security groups to {{.Path}} as {{.Username}}...", map[string]interface{}{
+			"Path":     cmd.FilePath,
+			"Username": user.Name,
+		})
+	}
+
+	includeStaging := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), command.MinVersionLifecyleStagingV2) == nil
+
+	document, warnings, err := cmd.Actor.GetSecurityGroupsDocument(includeStaging)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.FilePath == "" {
+		return v2action.WriteSecurityGroupsDocument(cmd.UI.Writer(), document)
+	}
+
+	file, err := os.Create(string(cmd.FilePath))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	err = v2action.WriteSecurityGroupsDocument(file, document)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,158 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-security-groups Command", func() {
+	var (
+		cmd             ExportSecurityGroupsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeExportSecurityGroupsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeExportSecurityGroupsActor)
+
+		cmd = ExportSecurityGroupsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns(command.MinVersionLifecyleStagingV2)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the user is logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.GetSecurityGroupsDocumentReturns(
+				v2action.SecurityGroupsDocument{
+					SecurityGroups: []v2action.SecurityGroupDocument{
+						{
+							Name:           "public",
+							Rules:          []v2action.SecurityGroupRuleDocument{{Protocol: "all", Destination: "0.0.0.0/0"}},
+							RunningDefault: true,
+						},
+					},
+				},
+				v2action.Warnings{"document-warning"},
+				nil)
+		})
+
+		Context("when no path is provided", func() {
+			It("displays only the document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`security_groups:
+- name: public
+  rules:
+  - protocol: all
+    destination: 0.0.0.0/0
+  running_default: true
+`))
+				Expect(testUI.Out).ToNot(Say("OK"))
+				Expect(testUI.Err).To(Say("document-warning"))
+			})
+
+			It("includes staging bindings when the API supports them", func() {
+				Expect(fakeActor.GetSecurityGroupsDocumentArgsForCall(0)).To(BeTrue())
+			})
+		})
+
+		Context("when a path is provided", func() {
+			var dir string
+
+			BeforeEach(func() {
+				var err error
+				dir, err = ioutil.TempDir("", "export-security-groups")
+				Expect(err).ToNot(HaveOccurred())
+				cmd.FilePath = flag.Path(filepath.Join(dir, "security-groups.yml"))
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(dir)).To(Succeed())
+			})
+
+			It("writes the document to the file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Exporting security groups to %s as some-user...", cmd.FilePath))
+				Expect(testUI.Out).To(Say("OK"))
+
+				document, err := v2action.ReadSecurityGroupsDocument(string(cmd.FilePath))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(document.SecurityGroups).To(HaveLen(1))
+				Expect(document.SecurityGroups[0].Name).To(Equal("public"))
+			})
+		})
+
+		Context("when the API does not support staging space bindings", func() {
+			BeforeEach(func() {
+				fakeActor.CloudControllerAPIVersionReturns("2.67.0")
+			})
+
+			It("excludes staging bindings", func() {
+				Expect(fakeActor.GetSecurityGroupsDocumentArgsForCall(0)).To(BeFalse())
+			})
+		})
+
+		Context("when getting the document fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("document error")
+				fakeActor.GetSecurityGroupsDocumentReturns(v2action.SecurityGroupsDocument{}, v2action.Warnings{"document-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("document-warning"))
+			})
+		})
+	})
+})
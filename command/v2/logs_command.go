@@ -1,35 +1,62 @@
 package v2
 
 import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/clock"
 	"github.com/cloudfoundry/noaa/consumer"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate counterfeiter . LogsActor
 
 type LogsActor interface {
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
 	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
 }
 
 type LogsCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	OptionalArgs      flag.OptionalAppNames `positional-args:"yes"`
+	Space             bool                  `long:"space" description:"Show logs for every app in the targeted space"`
+	Recent            bool                  `long:"recent" description:"Dump recent logs instead of tailing"`
+	Source            flag.LogSource        `long:"source" description:"Only show logs from this source (APP, RTR, STG, CELL or API)"`
+	Instance          flag.InstanceIndex    `long:"instance" description:"Only show logs from this instance index"`
+	Type              flag.LogType          `long:"type" description:"Only show logs of this type (out or err)"`
+	Grep              flag.Regexp           `long:"grep" description:"Only show logs whose message matches this regular expression"`
+	Format            string                `long:"format" choice:"text" choice:"json" default:"text" description:"Show logs as text or as one JSON object per log message"`
+	OutputFile        flag.Path             `long:"output-file" description:"Write logs to this file instead of the terminal"`
+	OutputFileMaxSize flag.Megabytes        `long:"output-file-max-size" description:"Size after which the output file is rotated, with a unit of measurement like M or G (Default: 10M)"`
+	usage             interface{}           `usage:"CF_NAME logs (APP_NAME... | --space) [--recent] [--source SOURCE] [--instance INDEX] [--type (out | err)] [--grep REGEX] [--format (text | json)] [--output-file PATH [--output-file-max-size SIZE]]"`
+	relatedCommands   interface{}           `related_commands:"app, apps, ssh"`
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       LogsActor
 	NOAAClient  *consumer.Consumer
+	Clock       clock.Clock
 }
 
+// logsAppRefreshInterval is how often the list of apps is refreshed while
+// tailing the logs of multiple apps, to pick up apps that were pushed or
+// recreated after tailing started.
+const logsAppRefreshInterval = 15 * time.Second
+
+// defaultLogsOutputFileMaxSize is the size, in megabytes, after which the
+// --output-file is rotated when --output-file-max-size is not provided.
+const defaultLogsOutputFileMaxSize = 10
+
 func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
@@ -42,11 +69,20 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
 
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	cmd.Clock = clock.NewClock()
 
 	return nil
 }
 
 func (cmd LogsCommand) Execute(args []string) error {
+	appNames := cmd.OptionalArgs.AppNames
+	if len(appNames) == 0 && !cmd.Space {
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	}
+	if len(appNames) > 0 && cmd.Space {
+		return translatableerror.ArgumentCombinationError{Arg1: "APP_NAME", Arg2: "--space"}
+	}
+
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -57,41 +93,115 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-		map[string]interface{}{
-			"AppName":   cmd.RequiredArgs.AppName,
-			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	display := logMessageDisplay{
+		ui:      cmd.UI,
+		asJSON:  cmd.Format == "json",
+		labeled: cmd.Space || len(appNames) > 1,
+	}
+	if cmd.OutputFile != "" {
+		display.fileWriter = cmd.UI.LogMessageFileWriter(cmd.OutputFile.String(), cmd.outputFileMaxSize(), display.asJSON)
+		err = display.fileWriter.Start()
+		if err != nil {
+			return err
+		}
+		defer display.fileWriter.Stop()
+	}
+
+	if !display.jsonToTerminal() {
+		cmd.displayFlavorText(user.Name, appNames)
+	}
+
+	if !cmd.Space && len(appNames) == 1 {
+		if cmd.Recent {
+			return cmd.displayRecentLogs(appNames[0], display)
+		}
+		return cmd.streamLogs(appNames[0], display)
+	}
+
+	if cmd.Recent && !cmd.Space {
+		return cmd.displayRecentLogsForApps(appNames, display)
+	}
+
+	apps, warnings, err := cmd.Actor.GetApplicationsBySpace(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Space {
+		appNames = nil
+		for _, app := range apps {
+			appNames = append(appNames, app.Name)
+		}
+	}
 
 	if cmd.Recent {
-		return cmd.displayRecentLogs()
+		return cmd.displayRecentLogsForApps(appNames, display)
+	}
+
+	return cmd.streamLogsForApps(appNames, apps, display)
+}
+
+func (cmd LogsCommand) displayFlavorText(username string, appNames []string) {
+	switch {
+	case cmd.Space:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for all apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  username,
+			})
+	case len(appNames) > 1:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for apps {{.AppNames}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppNames":  strings.Join(appNames, ", "),
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  username,
+			})
+	default:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppName":   appNames[0],
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  username,
+			})
 	}
 
-	return cmd.streamLogs()
+	if cmd.OutputFile != "" {
+		cmd.UI.DisplayText("Writing logs to {{.Path}}...", map[string]interface{}{
+			"Path": cmd.OutputFile.String(),
+		})
+	}
+	cmd.UI.DisplayNewline()
 }
 
-func (cmd LogsCommand) displayRecentLogs() error {
+func (cmd LogsCommand) displayRecentLogs(appName string, display logMessageDisplay) error {
 	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
+		appName,
 		cmd.Config.TargetedSpace().GUID,
 		cmd.NOAAClient,
 		cmd.Config,
 	)
 
-	for _, message := range messages {
-		cmd.UI.DisplayLogMessage(message, true)
+	filter := cmd.logMessageFilter()
+	for i := range messages {
+		if filter.Matches(messages[i]) {
+			displayErr := display.display(appName, &messages[i])
+			if displayErr != nil {
+				return displayErr
+			}
+		}
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
 	return err
 }
 
-func (cmd LogsCommand) streamLogs() error {
+func (cmd LogsCommand) streamLogs(appName string, display logMessageDisplay) error {
 	messages, logErrs, warnings, err := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
+		appName,
 		cmd.Config.TargetedSpace().GUID,
 		cmd.NOAAClient,
 		cmd.Config,
@@ -102,6 +212,7 @@ func (cmd LogsCommand) streamLogs() error {
 		return err
 	}
 
+	filter := cmd.logMessageFilter()
 	var messagesClosed, errLogsClosed bool
 	for {
 		select {
@@ -111,7 +222,13 @@ func (cmd LogsCommand) streamLogs() error {
 				break
 			}
 
-			cmd.UI.DisplayLogMessage(message, true)
+			if filter.Matches(*message) {
+				err = display.display(appName, message)
+				if err != nil {
+					cmd.NOAAClient.Close()
+					return err
+				}
+			}
 		case logErr, ok := <-logErrs:
 			if !ok {
 				errLogsClosed = true
@@ -129,3 +246,273 @@ func (cmd LogsCommand) streamLogs() error {
 
 	return nil
 }
+
+func (cmd LogsCommand) displayRecentLogsForApps(appNames []string, display logMessageDisplay) error {
+	var labeledMessages []labeledLogMessage
+	for _, appName := range appNames {
+		messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
+			appName,
+			cmd.Config.TargetedSpace().GUID,
+			cmd.NOAAClient,
+			cmd.Config,
+		)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		for i := range messages {
+			labeledMessages = append(labeledMessages, labeledLogMessage{appName: appName, message: &messages[i]})
+		}
+	}
+
+	sort.SliceStable(labeledMessages, func(i int, j int) bool {
+		return labeledMessages[i].message.Timestamp().Before(labeledMessages[j].message.Timestamp())
+	})
+
+	filter := cmd.logMessageFilter()
+	for _, labeled := range labeledMessages {
+		if filter.Matches(*labeled.message) {
+			err := display.display(labeled.appName, labeled.message)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// streamLogsForApps merges the log streams of the given apps into one output
+// until one of the streams returns an error. Every logsAppRefreshInterval the
+// apps in the space are listed again: apps that have been pushed since (when
+// tailing the whole space), recreated with a new GUID, or whose stream has
+// ended are tailed again.
+func (cmd LogsCommand) streamLogsForApps(appNames []string, apps []v2action.Application, display logMessageDisplay) error {
+	tailer := appLogTailer{
+		cmd:       cmd,
+		display:   display,
+		spaceGUID: cmd.Config.TargetedSpace().GUID,
+		appNames:  map[string]bool{},
+		tailing:   map[string]appLogSubscription{},
+		messages:  make(chan labeledLogMessage),
+		logErrs:   make(chan error),
+		ended:     make(chan appLogSubscription),
+		stop:      make(chan struct{}),
+	}
+	defer close(tailer.stop)
+
+	if !cmd.Space {
+		for _, appName := range appNames {
+			tailer.appNames[appName] = true
+		}
+	}
+
+	appGUIDs := map[string]string{}
+	for _, app := range apps {
+		appGUIDs[app.Name] = app.GUID
+	}
+
+	for _, appName := range appNames {
+		err := tailer.subscribe(appName, appGUIDs[appName])
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	ticker := cmd.Clock.NewTicker(logsAppRefreshInterval)
+	defer ticker.Stop()
+
+	filter := cmd.logMessageFilter()
+	for {
+		select {
+		case labeled := <-tailer.messages:
+			if filter.Matches(*labeled.message) {
+				err := display.display(labeled.appName, labeled.message)
+				if err != nil {
+					cmd.NOAAClient.Close()
+					return err
+				}
+			}
+		case logErr := <-tailer.logErrs:
+			cmd.NOAAClient.Close()
+			return logErr
+		case subscription := <-tailer.ended:
+			if tailer.tailing[subscription.appName].id == subscription.id {
+				delete(tailer.tailing, subscription.appName)
+			}
+		case <-ticker.C():
+			tailer.refresh()
+		}
+	}
+}
+
+type labeledLogMessage struct {
+	appName string
+	message *v2action.LogMessage
+}
+
+type appLogSubscription struct {
+	id      int
+	appName string
+	appGUID string
+}
+
+// appLogTailer keeps track of the log streams opened by streamLogsForApps.
+type appLogTailer struct {
+	cmd       LogsCommand
+	display   logMessageDisplay
+	spaceGUID string
+
+	// appNames are the apps requested by the user. It is empty when tailing
+	// every app in the space.
+	appNames map[string]bool
+	tailing  map[string]appLogSubscription
+	lastID   int
+
+	messages chan labeledLogMessage
+	logErrs  chan error
+	ended    chan appLogSubscription
+	stop     chan struct{}
+}
+
+func (tailer *appLogTailer) subscribe(appName string, appGUID string) error {
+	messages, logErrs, warnings, err := tailer.cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
+		appName,
+		tailer.spaceGUID,
+		tailer.cmd.NOAAClient,
+		tailer.cmd.Config,
+	)
+	tailer.cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	tailer.lastID++
+	subscription := appLogSubscription{id: tailer.lastID, appName: appName, appGUID: appGUID}
+	tailer.tailing[appName] = subscription
+
+	go tailer.forward(subscription, messages, logErrs)
+	return nil
+}
+
+func (tailer *appLogTailer) forward(subscription appLogSubscription, messages <-chan *v2action.LogMessage, logErrs <-chan error) {
+	for messages != nil || logErrs != nil {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				messages = nil
+				continue
+			}
+			select {
+			case tailer.messages <- labeledLogMessage{appName: subscription.appName, message: message}:
+			case <-tailer.stop:
+				return
+			}
+		case logErr, ok := <-logErrs:
+			if !ok {
+				logErrs = nil
+				continue
+			}
+			select {
+			case tailer.logErrs <- logErr:
+			case <-tailer.stop:
+				return
+			}
+		}
+	}
+
+	select {
+	case tailer.ended <- subscription:
+	case <-tailer.stop:
+	}
+}
+
+func (tailer *appLogTailer) refresh() {
+	apps, warnings, err := tailer.cmd.Actor.GetApplicationsBySpace(tailer.spaceGUID)
+	tailer.cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		tailer.cmd.UI.DisplayWarning("Unable to refresh the list of apps: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	for _, app := range apps {
+		if len(tailer.appNames) > 0 && !tailer.appNames[app.Name] {
+			continue
+		}
+
+		if subscription, ok := tailer.tailing[app.Name]; ok && subscription.appGUID == app.GUID {
+			continue
+		}
+
+		err = tailer.subscribe(app.Name, app.GUID)
+		if err != nil {
+			tailer.cmd.UI.DisplayWarning("Unable to tail logs for app {{.AppName}}: {{.Error}}", map[string]interface{}{
+				"AppName": app.Name,
+				"Error":   err.Error(),
+			})
+			continue
+		}
+
+		if !tailer.display.jsonToTerminal() {
+			tailer.cmd.UI.DisplayText("Tailing logs for app {{.AppName}}...", map[string]interface{}{
+				"AppName": app.Name,
+			})
+		}
+	}
+}
+
+func (cmd LogsCommand) outputFileMaxSize() int64 {
+	size := cmd.OutputFileMaxSize.Size
+	if size == 0 {
+		size = defaultLogsOutputFileMaxSize
+	}
+	return int64(size) * 1024 * 1024
+}
+
+// logMessageDisplay outputs log messages in the format requested with
+// --format, to the terminal or to the --output-file.
+type logMessageDisplay struct {
+	ui         command.UI
+	fileWriter *ui.LogMessageFileWriter
+	asJSON     bool
+
+	// labeled is set when logs of multiple apps are displayed, so every line
+	// on the terminal shows which app it belongs to.
+	labeled bool
+}
+
+func (display logMessageDisplay) display(appName string, message *v2action.LogMessage) error {
+	switch {
+	case display.fileWriter != nil:
+		return display.fileWriter.WriteLogMessage(appName, message)
+	case display.asJSON:
+		return display.ui.DisplayJSONLogMessage(appName, message)
+	case display.labeled:
+		display.ui.DisplayLabeledLogMessage(appName, message, true)
+	default:
+		display.ui.DisplayLogMessage(message, true)
+	}
+	return nil
+}
+
+// jsonToTerminal returns true when the JSON log messages are written to the
+// terminal, in which case no other text is displayed on it so the output can
+// be consumed by other tools.
+func (display logMessageDisplay) jsonToTerminal() bool {
+	return display.asJSON && display.fileWriter == nil
+}
+
+func (cmd LogsCommand) logMessageFilter() v2action.LogMessageFilter {
+	filter := v2action.LogMessageFilter{
+		SourceType:  cmd.Source.Source,
+		MessageType: cmd.Type.Type,
+		Pattern:     cmd.Grep.Regexp,
+	}
+	if cmd.Instance.IsSet {
+		filter.SourceInstance = strconv.Itoa(cmd.Instance.Value)
+	}
+	return filter
+}
@@ -2,16 +2,24 @@ package v2_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/clock/fakeclock"
 	"github.com/cloudfoundry/noaa/consumer"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -47,7 +55,7 @@ var _ = Describe("logs command", func() {
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
-		cmd.RequiredArgs.AppName = "some-app"
+		cmd.OptionalArgs.AppNames = []string{"some-app"}
 		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
 	})
 
@@ -55,6 +63,28 @@ var _ = Describe("logs command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when neither app names nor --space are provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppNames = nil
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when both app names and --space are provided", func() {
+		BeforeEach(func() {
+			cmd.Space = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Arg1: "APP_NAME", Arg2: "--space"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when the checkTarget fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(
@@ -146,6 +176,113 @@ var _ = Describe("logs command", func() {
 					Expect(client).To(Equal(noaaClient))
 					Expect(config).To(Equal(fakeConfig))
 				})
+
+				Context("when filters are provided", func() {
+					BeforeEach(func() {
+						cmd.Instance = flag.InstanceIndex{NullInt: types.NullInt{Value: 2, IsSet: true}}
+						cmd.Type = flag.LogType{Type: "OUT"}
+					})
+
+					It("only displays the matching log messages", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).ToNot(Say("i am message 1"))
+						Expect(testUI.Out).To(Say("i am message 2"))
+					})
+				})
+
+				Context("when a filter matches no messages", func() {
+					BeforeEach(func() {
+						cmd.Type = flag.LogType{Type: "ERR"}
+					})
+
+					It("displays no log messages", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).ToNot(Say("i am message"))
+					})
+				})
+
+				Context("when --format json is provided", func() {
+					BeforeEach(func() {
+						cmd.Format = "json"
+					})
+
+					It("displays only one JSON object per log message", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+
+						lines := strings.Split(strings.TrimSpace(string(testUI.Out.(*Buffer).Contents())), "\n")
+						Expect(lines).To(HaveLen(2))
+						Expect(lines[0]).To(MatchJSON(`{
+							"timestamp": "1970-01-01T00:00:00Z",
+							"app": "some-app",
+							"source_type": "app",
+							"source_instance": "1",
+							"stream": "out",
+							"message": "i am message 1"
+						}`))
+						Expect(lines[1]).To(ContainSubstring(`"message":"i am message 2"`))
+
+						Expect(testUI.Err).To(Say("some-warning-1"))
+					})
+				})
+
+				Context("when --output-file is provided", func() {
+					var (
+						tmpDir     string
+						outputFile string
+					)
+
+					BeforeEach(func() {
+						var err error
+						tmpDir, err = ioutil.TempDir("", "logs-command")
+						Expect(err).ToNot(HaveOccurred())
+						outputFile = filepath.Join(tmpDir, "app.log")
+						cmd.OutputFile = flag.Path(outputFile)
+					})
+
+					AfterEach(func() {
+						Expect(os.RemoveAll(tmpDir)).To(Succeed())
+					})
+
+					It("writes the log messages to the file instead of the terminal", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).To(Say("Retrieving logs for app some-app in org some-org-name / space some-space-name as some-user..."))
+						Expect(testUI.Out).To(Say("Writing logs to %s...", regexp.QuoteMeta(outputFile)))
+						Expect(testUI.Out).ToNot(Say("i am message"))
+
+						contents, err := ioutil.ReadFile(outputFile)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(string(contents)).To(MatchRegexp(`some-app \| .* \[app/1\] OUT i am message 1\n`))
+						Expect(string(contents)).To(MatchRegexp(`some-app \| .* \[another-app/2\] OUT i am message 2\n`))
+					})
+
+					Context("when --format json is provided", func() {
+						BeforeEach(func() {
+							cmd.Format = "json"
+						})
+
+						It("writes one JSON object per log message to the file", func() {
+							Expect(executeErr).NotTo(HaveOccurred())
+							Expect(testUI.Out).To(Say("Retrieving logs for app some-app"))
+
+							contents, err := ioutil.ReadFile(outputFile)
+							Expect(err).ToNot(HaveOccurred())
+							lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+							Expect(lines).To(HaveLen(2))
+							Expect(lines[1]).To(ContainSubstring(`"message":"i am message 2"`))
+						})
+					})
+
+					Context("when the file cannot be created", func() {
+						BeforeEach(func() {
+							Expect(os.Mkdir(outputFile, 0700)).To(Succeed())
+						})
+
+						It("returns the error without retrieving logs", func() {
+							Expect(executeErr).To(HaveOccurred())
+							Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+						})
+					})
+				})
 			})
 		})
 
@@ -247,7 +384,211 @@ var _ = Describe("logs command", func() {
 					Expect(client).To(Equal(noaaClient))
 					Expect(config).To(Equal(fakeConfig))
 				})
+
+				Context("when filters are provided", func() {
+					BeforeEach(func() {
+						cmd.Grep = flag.Regexp{Regexp: regexp.MustCompile(`message \d$`)}
+						cmd.Instance = flag.InstanceIndex{NullInt: types.NullInt{Value: 1, IsSet: true}}
+					})
+
+					It("only displays the matching log messages", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).To(Say(`\[app/1\] OUT i am message 1`))
+						Expect(testUI.Out).ToNot(Say("i am message 2"))
+					})
+				})
+			})
+		})
+
+		Context("when multiple app names are provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.AppNames = []string{"app-1", "app-2"}
+				fakeActor.GetApplicationsBySpaceReturns(
+					[]v2action.Application{
+						{Name: "app-1", GUID: "app-guid-1"},
+						{Name: "app-2", GUID: "app-guid-2"},
+						{Name: "app-3", GUID: "app-guid-3"},
+					},
+					v2action.Warnings{"get-apps-warning"},
+					nil)
+			})
+
+			Context("when the --recent flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Recent = true
+					fakeActor.GetRecentLogsForApplicationByNameAndSpaceStub = func(appName string, _ string, _ v2action.NOAAClient, _ v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error) {
+						if appName == "app-1" {
+							return []v2action.LogMessage{
+								*v2action.NewLogMessage("app-1 message 1", 1, time.Unix(0, 0), "APP", "0"),
+								*v2action.NewLogMessage("app-1 message 2", 1, time.Unix(2, 0), "APP", "0"),
+							}, v2action.Warnings{"app-1-warning"}, nil
+						}
+						return []v2action.LogMessage{
+							*v2action.NewLogMessage("app-2 message 1", 1, time.Unix(1, 0), "RTR", "0"),
+						}, v2action.Warnings{"app-2-warning"}, nil
+					}
+				})
+
+				It("displays the labeled log messages of all apps ordered by time", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("Retrieving logs for apps app-1, app-2 in org some-org-name / space some-space-name as some-user..."))
+					Expect(testUI.Out).To(Say(`app-1 \| .* app-1 message 1`))
+					Expect(testUI.Out).To(Say(`app-2 \| .* app-2 message 1`))
+					Expect(testUI.Out).To(Say(`app-1 \| .* app-1 message 2`))
+
+					Expect(testUI.Err).To(Say("app-1-warning"))
+					Expect(testUI.Err).To(Say("app-2-warning"))
+
+					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(2))
+				})
+
+				Context("when filters are provided", func() {
+					BeforeEach(func() {
+						cmd.Source = flag.LogSource{Source: "RTR"}
+					})
+
+					It("only displays the matching log messages", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).To(Say(`app-2 \| .* app-2 message 1`))
+						Expect(testUI.Out).ToNot(Say("app-1 message"))
+					})
+				})
+
+				Context("when getting the logs of an app fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(nil, v2action.Warnings{"some-warning"}, v2action.ApplicationNotFoundError{Name: "app-1"})
+						fakeActor.GetRecentLogsForApplicationByNameAndSpaceStub = nil
+					})
+
+					It("returns the translated error and all warnings", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "app-1"}))
+						Expect(testUI.Err).To(Say("some-warning"))
+					})
+				})
+			})
+
+			Context("when tailing logs", func() {
+				var (
+					fakeClock            *fakeclock.FakeClock
+					expectedErr          error
+					expectedApp3Messages int
+				)
+
+				BeforeEach(func() {
+					fakeClock = fakeclock.NewFakeClock(time.Now())
+					cmd.Clock = fakeClock
+					expectedErr = errors.New("some-error")
+					failStream := make(chan struct{})
+
+					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(appName string, _ string, _ v2action.NOAAClient, _ v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+						messages := make(chan *v2action.LogMessage)
+						logErrs := make(chan error)
+
+						go func() {
+							messages <- v2action.NewLogMessage(appName+" message", 1, time.Unix(0, 0), "APP", "0")
+							if appName == "app-1" {
+								<-failStream
+								logErrs <- expectedErr
+							}
+						}()
+
+						return messages, logErrs, v2action.Warnings{appName + "-warning"}, nil
+					}
+
+					// Once the initial streams are displayed, advance the clock so the
+					// apps are refreshed, then fail the stream once app-3 has been
+					// tailed so that Execute returns.
+					out := testUI.Out.(*Buffer)
+					clk := fakeClock
+					go func() {
+						waitForOutput(out, "app-1 message", 1)
+						waitForOutput(out, "app-2 message", 1)
+						clk.WaitForWatcherAndIncrement(time.Minute)
+						waitForOutput(out, "app-3 message", expectedApp3Messages)
+						close(failStream)
+					}()
+				})
+
+				Context("when a requested app is recreated while tailing", func() {
+					BeforeEach(func() {
+						cmd.OptionalArgs.AppNames = []string{"app-1", "app-2", "app-3"}
+						expectedApp3Messages = 2
+						fakeActor.GetApplicationsBySpaceReturnsOnCall(0,
+							[]v2action.Application{
+								{Name: "app-1", GUID: "app-guid-1"},
+								{Name: "app-2", GUID: "app-guid-2"},
+								{Name: "app-3", GUID: "app-guid-3"},
+							},
+							nil,
+							nil)
+						fakeActor.GetApplicationsBySpaceReturnsOnCall(1,
+							[]v2action.Application{
+								{Name: "app-1", GUID: "app-guid-1"},
+								{Name: "app-2", GUID: "app-guid-2"},
+								{Name: "app-3", GUID: "app-guid-3-new"},
+							},
+							nil,
+							nil)
+					})
+
+					It("tails it again", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(testUI.Out).To(Say(`app-3 \| .* app-3 message`))
+						Expect(testUI.Out).To(Say("Tailing logs for app app-3..."))
+						Expect(testUI.Out).To(Say(`app-3 \| .* app-3 message`))
+
+						Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(4))
+						appName, _, _, _ := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(3)
+						Expect(appName).To(Equal("app-3"))
+					})
+				})
+
+				Context("when tailing the whole space", func() {
+					BeforeEach(func() {
+						cmd.OptionalArgs.AppNames = nil
+						cmd.Space = true
+						expectedApp3Messages = 1
+						fakeActor.GetApplicationsBySpaceReturnsOnCall(0,
+							[]v2action.Application{
+								{Name: "app-1", GUID: "app-guid-1"},
+								{Name: "app-2", GUID: "app-guid-2"},
+							},
+							v2action.Warnings{"get-apps-warning"},
+							nil)
+						fakeActor.GetApplicationsBySpaceReturnsOnCall(1,
+							[]v2action.Application{
+								{Name: "app-1", GUID: "app-guid-1"},
+								{Name: "app-2", GUID: "app-guid-2"},
+								{Name: "app-3", GUID: "app-guid-3"},
+							},
+							nil,
+							nil)
+					})
+
+					It("merges the streams of all apps and picks up new apps", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(testUI.Out).To(Say("Retrieving logs for all apps in org some-org-name / space some-space-name as some-user..."))
+						Expect(testUI.Out).To(Say("Tailing logs for app app-3..."))
+						Expect(testUI.Out).To(Say(`app-3 \| .* app-3 message`))
+
+						contents := string(testUI.Out.(*Buffer).Contents())
+						Expect(contents).To(MatchRegexp(`app-1 \| .* app-1 message`))
+						Expect(contents).To(MatchRegexp(`app-2 \| .* app-2 message`))
+
+						Expect(testUI.Err).To(Say("get-apps-warning"))
+						Expect(fakeActor.GetApplicationsBySpaceCallCount()).To(Equal(2))
+						spaceGUID := fakeActor.GetApplicationsBySpaceArgsForCall(0)
+						Expect(spaceGUID).To(Equal("some-space-guid"))
+						Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(3))
+					})
+				})
 			})
 		})
 	})
 })
+
+func waitForOutput(out *Buffer, text string, count int) {
+	for strings.Count(string(out.Contents()), text) < count {
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,212 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate counterfeiter . RotateServiceKeyActor
+
+type RotateServiceKeyActor interface {
+	CloudControllerAPIVersion() string
+	CreateRotatedServiceKey(serviceInstanceName string, keyName string, newKeyName string, spaceGUID string, parameters map[string]interface{}) (v2action.ServiceKeyRotation, v2action.Warnings, error)
+	DeleteServiceKey(serviceKeyGUID string) (v2action.Warnings, error)
+}
+
+type RotateServiceKeyCommand struct {
+	RequiredArgs     flag.ServiceInstanceKey       `positional-args:"yes"`
+	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters for the new key, provided either in-line or in a file. Defaults to the parameters of the current key."`
+	DeleteOld        bool                          `long:"delete-old" description:"Delete the current key without confirmation once the new key is created"`
+	OutputFile       flag.Path                     `long:"output-file" description:"Write the credentials of the new key to a file as JSON instead of displaying them"`
+	usage            interface{}                   `usage:"CF_NAME rotate-service-key SERVICE_INSTANCE SERVICE_KEY [-c PARAMETERS_AS_JSON] [--delete-old] [--output-file PATH]\n\n   The new key is named after the current key with the rotation time appended, for example mykey-20180101120000.\n   Every rotation is recorded in service_key_rotations.json in the CLI configuration directory.\n\nEXAMPLES:\n   CF_NAME rotate-service-key mydb mykey\n   CF_NAME rotate-service-key mydb mykey --delete-old --output-file ~/mykey.json"`
+	relatedCommands  interface{}                   `related_commands:"create-service-key, delete-service-key, service-key, service-keys"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RotateServiceKeyActor
+}
+
+func (cmd *RotateServiceKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd RotateServiceKeyCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.ParametersAsJSON == nil && command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), command.MinVersionBindingParametersV2) != nil {
+		return translatableerror.ServiceKeyParametersNotRetrievableError{Name: cmd.RequiredArgs.ServiceKey}
+	}
+
+	rotatedAt := time.Now()
+	newKeyName := v2action.RotatedServiceKeyName(cmd.RequiredArgs.ServiceKey, rotatedAt)
+
+	cmd.UI.DisplayTextWithFlavor("Creating service key {{.NewServiceKey}} to replace {{.ServiceKey}} for service instance {{.ServiceInstance}} as {{.User}}...", map[string]interface{}{
+		"NewServiceKey":   newKeyName,
+		"ServiceKey":      cmd.RequiredArgs.ServiceKey,
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		"User":            user.Name,
+	})
+
+	rotation, warnings, err := cmd.Actor.CreateRotatedServiceKey(cmd.RequiredArgs.ServiceInstance, cmd.RequiredArgs.ServiceKey, newKeyName, cmd.Config.TargetedSpace().GUID, cmd.ParametersAsJSON)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	// The new key exists from here on, so it is recorded before anything else
+	// can fail.
+	recorded := cmd.recordRotation(configv3.ServiceKeyRotationRecord{
+		RotatedAt:         rotatedAt.UTC(),
+		API:               cmd.Config.Target(),
+		Organization:      cmd.Config.TargetedOrganization().Name,
+		Space:             cmd.Config.TargetedSpace().Name,
+		ServiceInstance:   rotation.ServiceInstance.Name,
+		OldServiceKeyName: rotation.OldServiceKey.Name,
+		OldServiceKeyGUID: rotation.OldServiceKey.GUID,
+		NewServiceKeyName: rotation.NewServiceKey.Name,
+		NewServiceKeyGUID: rotation.NewServiceKey.GUID,
+	})
+
+	err = cmd.displayCredentials(rotation.NewServiceKey)
+	if err != nil {
+		return err
+	}
+
+	deleteOld, err := cmd.confirmDeleteOld()
+	if err != nil {
+		return err
+	}
+
+	if !deleteOld {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("TIP: Once every consumer uses the new key, delete the previous one with '{{.Command}}'.", map[string]interface{}{
+			"Command": fmt.Sprintf("%s delete-service-key %s %s", cmd.Config.BinaryName(), rotation.ServiceInstance.Name, rotation.OldServiceKey.Name),
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Deleting service key {{.ServiceKey}} for service instance {{.ServiceInstance}} as {{.User}}...", map[string]interface{}{
+		"ServiceKey":      rotation.OldServiceKey.Name,
+		"ServiceInstance": rotation.ServiceInstance.Name,
+		"User":            user.Name,
+	})
+
+	warnings, err = cmd.Actor.DeleteServiceKey(rotation.OldServiceKey.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.UI.DisplayOK()
+
+	if recorded {
+		cmd.recordOldKeyDeleted(rotation.NewServiceKey.GUID)
+	}
+	return nil
+}
+
+// displayCredentials writes the credentials of the new key to the output
+// file, or displays them. When the output file cannot be written the
+// credentials are displayed instead, since the new key has already been
+// created.
+func (cmd RotateServiceKeyCommand) displayCredentials(serviceKey v2action.ServiceKey) error {
+	raw, err := json.MarshalIndent(serviceKey.Credentials, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if cmd.OutputFile != "" {
+		err = ioutil.WriteFile(string(cmd.OutputFile), append(raw, '\n'), 0600)
+		if err == nil {
+			cmd.UI.DisplayText("Credentials of service key {{.ServiceKey}} written to {{.Path}}", map[string]interface{}{
+				"ServiceKey": serviceKey.Name,
+				"Path":       cmd.OutputFile,
+			})
+			return nil
+		}
+
+		log.Errorln("writing service key credentials:", err)
+		cmd.UI.DisplayWarning("Unable to write the credentials of service key {{.ServiceKey}} to {{.Path}}: {{.Error}}", map[string]interface{}{
+			"ServiceKey": serviceKey.Name,
+			"Path":       cmd.OutputFile,
+			"Error":      err,
+		})
+	}
+
+	cmd.UI.DisplayText("Credentials of service key {{.ServiceKey}}:", map[string]interface{}{
+		"ServiceKey": serviceKey.Name,
+	})
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(raw))
+	return err
+}
+
+func (cmd RotateServiceKeyCommand) confirmDeleteOld() (bool, error) {
+	if cmd.DeleteOld {
+		return true, nil
+	}
+
+	cmd.UI.DisplayNewline()
+	return cmd.UI.DisplayBoolPrompt(false, "Really delete the previous service key {{.ServiceKey}}?", map[string]interface{}{
+		"ServiceKey": cmd.RequiredArgs.ServiceKey,
+	})
+}
+
+// recordRotation appends the rotation to the local record and returns
+// whether it was recorded. The new key already exists at this point, so
+// failing to record is only a warning.
+func (cmd RotateServiceKeyCommand) recordRotation(record configv3.ServiceKeyRotationRecord) bool {
+	err := cmd.Config.AppendServiceKeyRotationRecord(record)
+	if err != nil {
+		log.Errorln("recording service key rotation:", err)
+		cmd.UI.DisplayWarning("Unable to record the rotation in {{.Path}}: {{.Error}}", map[string]interface{}{
+			"Path":  cmd.Config.ServiceKeyRotationsFilePath(),
+			"Error": err,
+		})
+		return false
+	}
+	return true
+}
+
+// recordOldKeyDeleted marks the old key of the recorded rotation as deleted.
+func (cmd RotateServiceKeyCommand) recordOldKeyDeleted(newServiceKeyGUID string) {
+	err := cmd.Config.MarkServiceKeyRotationOldKeyDeleted(newServiceKeyGUID)
+	if err != nil {
+		log.Errorln("recording deletion of rotated service key:", err)
+		cmd.UI.DisplayWarning("Unable to record the rotation in {{.Path}}: {{.Error}}", map[string]interface{}{
+			"Path":  cmd.Config.ServiceKeyRotationsFilePath(),
+			"Error": err,
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rotate-service-key Command", func() {
+	var (
+		cmd             RotateServiceKeyCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRotateServiceKeyActor
+		binaryName      string
+		tmpDir          string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRotateServiceKeyActor)
+
+		cmd = RotateServiceKeyCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+		cmd.RequiredArgs.ServiceKey = "some-key"
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "rotate-service-key-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.ServiceKeyRotationsFilePathReturns("/home/some-user/.cf/service_key_rotations.json")
+		fakeActor.CloudControllerAPIVersionReturns(command.MinVersionBindingParametersV2)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in and a space is targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetReturns("https://api.some-domain.com")
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+			fakeActor.CreateRotatedServiceKeyStub = func(serviceInstanceName string, keyName string, newKeyName string, spaceGUID string, parameters map[string]interface{}) (v2action.ServiceKeyRotation, v2action.Warnings, error) {
+				return v2action.ServiceKeyRotation{
+					ServiceInstance: v2action.ServiceInstance{GUID: "some-service-instance-guid", Name: serviceInstanceName},
+					OldServiceKey:   v2action.ServiceKey{GUID: "old-key-guid", Name: keyName},
+					NewServiceKey: v2action.ServiceKey{
+						GUID:        "new-key-guid",
+						Name:        newKeyName,
+						Credentials: map[string]interface{}{"password": "new-password"},
+					},
+				}, v2action.Warnings{"create-warning"}, nil
+			}
+			fakeActor.DeleteServiceKeyReturns(v2action.Warnings{"delete-warning"}, nil)
+		})
+
+		Context("when the old key should be deleted", func() {
+			BeforeEach(func() {
+				cmd.DeleteOld = true
+			})
+
+			It("creates the new key, displays its credentials, deletes the old key and records the rotation", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Creating service key some-key-\\d{14} to replace some-key for service instance some-service-instance as some-user\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Credentials of service key some-key-\\d{14}:"))
+				Expect(testUI.Out).To(Say(`"password": "new-password"`))
+				Expect(testUI.Out).To(Say("Deleting service key some-key for service instance some-service-instance as some-user\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).ToNot(Say("Really delete"))
+				Expect(testUI.Err).To(Say("create-warning"))
+				Expect(testUI.Err).To(Say("delete-warning"))
+
+				Expect(fakeActor.CreateRotatedServiceKeyCallCount()).To(Equal(1))
+				serviceInstanceName, keyName, newKeyName, spaceGUID, parameters := fakeActor.CreateRotatedServiceKeyArgsForCall(0)
+				Expect(serviceInstanceName).To(Equal("some-service-instance"))
+				Expect(keyName).To(Equal("some-key"))
+				Expect(newKeyName).To(MatchRegexp(`^some-key-\d{14}$`))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(parameters).To(BeNil())
+
+				Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(1))
+				Expect(fakeActor.DeleteServiceKeyArgsForCall(0)).To(Equal("old-key-guid"))
+
+				Expect(fakeConfig.AppendServiceKeyRotationRecordCallCount()).To(Equal(1))
+				record := fakeConfig.AppendServiceKeyRotationRecordArgsForCall(0)
+				Expect(record.API).To(Equal("https://api.some-domain.com"))
+				Expect(record.Organization).To(Equal("some-org"))
+				Expect(record.Space).To(Equal("some-space"))
+				Expect(record.ServiceInstance).To(Equal("some-service-instance"))
+				Expect(record.OldServiceKeyName).To(Equal("some-key"))
+				Expect(record.OldServiceKeyGUID).To(Equal("old-key-guid"))
+				Expect(record.NewServiceKeyName).To(MatchRegexp(`^some-key-\d{14}$`))
+				Expect(record.NewServiceKeyGUID).To(Equal("new-key-guid"))
+				Expect(record.OldServiceKeyDeleted).To(BeFalse())
+
+				Expect(fakeConfig.MarkServiceKeyRotationOldKeyDeletedCallCount()).To(Equal(1))
+				Expect(fakeConfig.MarkServiceKeyRotationOldKeyDeletedArgsForCall(0)).To(Equal("new-key-guid"))
+			})
+
+			Context("when deleting the old key fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("delete error")
+					fakeActor.DeleteServiceKeyReturns(v2action.Warnings{"delete-warning"}, expectedErr)
+				})
+
+				It("records the rotation and returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+
+					Expect(fakeConfig.AppendServiceKeyRotationRecordCallCount()).To(Equal(1))
+					Expect(fakeConfig.MarkServiceKeyRotationOldKeyDeletedCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the user confirms deleting the old key", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("deletes the old key", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Really delete the previous service key some-key\\?"))
+				Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the user declines deleting the old key", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("keeps the old key and explains how to delete it later", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Really delete the previous service key some-key\\?"))
+				Expect(testUI.Out).To(Say("TIP: Once every consumer uses the new key, delete the previous one with 'faceman delete-service-key some-service-instance some-key'\\."))
+				Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(0))
+
+				Expect(fakeConfig.AppendServiceKeyRotationRecordCallCount()).To(Equal(1))
+				Expect(fakeConfig.MarkServiceKeyRotationOldKeyDeletedCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when --output-file is provided", func() {
+			var outputPath string
+
+			BeforeEach(func() {
+				cmd.DeleteOld = true
+				outputPath = filepath.Join(tmpDir, "credentials.json")
+				cmd.OutputFile = flag.Path(outputPath)
+			})
+
+			It("writes the credentials to the file instead of displaying them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Credentials of service key some-key-\\d{14} written to %s", regexp.QuoteMeta(outputPath)))
+				Expect(testUI.Out).ToNot(Say("new-password"))
+
+				raw, err := ioutil.ReadFile(outputPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(raw).To(MatchJSON(`{"password": "new-password"}`))
+			})
+
+			Context("when the output file cannot be written", func() {
+				BeforeEach(func() {
+					outputPath = filepath.Join(tmpDir, "missing-dir", "credentials.json")
+					cmd.OutputFile = flag.Path(outputPath)
+				})
+
+				It("warns, displays the credentials instead and still records the rotation", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Err).To(Say("Unable to write the credentials of service key some-key-\\d{14} to %s", regexp.QuoteMeta(outputPath)))
+					Expect(testUI.Out).To(Say("Credentials of service key some-key-\\d{14}:"))
+					Expect(testUI.Out).To(Say(`"password": "new-password"`))
+					Expect(outputPath).ToNot(BeAnExistingFile())
+
+					Expect(fakeConfig.AppendServiceKeyRotationRecordCallCount()).To(Equal(1))
+					Expect(fakeConfig.AppendServiceKeyRotationRecordArgsForCall(0).NewServiceKeyGUID).To(Equal("new-key-guid"))
+					Expect(fakeConfig.MarkServiceKeyRotationOldKeyDeletedCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the rotation cannot be recorded", func() {
+			BeforeEach(func() {
+				cmd.DeleteOld = true
+				fakeConfig.AppendServiceKeyRotationRecordReturns(errors.New("some-record-error"))
+			})
+
+			It("warns and still displays the credentials and deletes the old key", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Err).To(Say(`Unable to record the rotation in /home/some-user/\.cf/service_key_rotations\.json: some-record-error`))
+				Expect(testUI.Out).To(Say(`"password": "new-password"`))
+				Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(1))
+				Expect(fakeConfig.MarkServiceKeyRotationOldKeyDeletedCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the deletion of the old key cannot be recorded", func() {
+			BeforeEach(func() {
+				cmd.DeleteOld = true
+				fakeConfig.MarkServiceKeyRotationOldKeyDeletedReturns(errors.New("some-mark-error"))
+			})
+
+			It("warns after deleting the old key", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(1))
+				Expect(testUI.Err).To(Say(`Unable to record the rotation in /home/some-user/\.cf/service_key_rotations\.json: some-mark-error`))
+			})
+		})
+
+		Context("when parameters are provided", func() {
+			BeforeEach(func() {
+				cmd.DeleteOld = true
+				cmd.ParametersAsJSON = map[string]interface{}{"permissions": "read-only"}
+				fakeActor.CloudControllerAPIVersionReturns("2.50.0")
+			})
+
+			It("passes them to the new key regardless of the API version", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, _, _, _, parameters := fakeActor.CreateRotatedServiceKeyArgsForCall(0)
+				Expect(parameters).To(Equal(map[string]interface{}{"permissions": "read-only"}))
+			})
+		})
+
+		Context("when the API cannot retrieve the parameters of the old key", func() {
+			BeforeEach(func() {
+				fakeActor.CloudControllerAPIVersionReturns("2.50.0")
+			})
+
+			It("asks for the parameters to be provided", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceKeyParametersNotRetrievableError{Name: "some-key"}))
+				Expect(fakeActor.CreateRotatedServiceKeyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the new key fails", func() {
+			BeforeEach(func() {
+				fakeActor.CreateRotatedServiceKeyStub = nil
+				fakeActor.CreateRotatedServiceKeyReturns(
+					v2action.ServiceKeyRotation{},
+					v2action.Warnings{"create-warning"},
+					v2action.ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"})
+			})
+
+			It("returns a translated error without recording anything", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"}))
+				Expect(testUI.Err).To(Say("create-warning"))
+				Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(0))
+				Expect(fakeConfig.AppendServiceKeyRotationRecordCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,166 @@
+package v2
+
+import (
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . SCPActor
+
+type SCPActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetSSHAuthentication() (v2action.SSHAuthentication, error)
+}
+
+//go:generate counterfeiter . SecureShellFactory
+
+type SecureShellFactory interface {
+	NewSecureShell(app v2action.Application, auth v2action.SSHAuthentication) sshCmd.SecureShell
+}
+
+type SCPCommand struct {
+	RequiredArgs       flag.SCPArgs `positional-args:"yes"`
+	AppInstanceIndex   int          `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
+	Preserve           bool         `long:"preserve" short:"p" description:"Preserve modification times and modes of the copied files"`
+	Quiet              bool         `long:"quiet" short:"q" description:"Do not display the progress of the copy"`
+	Recursive          bool         `long:"recursive" short:"r" description:"Recursively copy directories"`
+	ResetHostKey       bool         `long:"reset-host-key" description:"Replace the pinned host key of the SSH proxy with the key it presents"`
+	SkipHostValidation bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
+	usage              interface{}  `usage:"CF_NAME scp [-i app-instance-index] [-r] [-p] [-q] [--skip-host-validation] [--reset-host-key] SOURCE TARGET\n\n   Exactly one of SOURCE and TARGET is a path on the app instance, written as APP_NAME:PATH. Relative paths on the app instance start in the home directory of the vcap user.\n\nEXAMPLES:\n   CF_NAME scp ./config.yml my-app:app/config.yml\n   CF_NAME scp -r -i 1 my-app:logs ./logs"`
+	relatedCommands    interface{}  `related_commands:"sftp, ssh, ssh-enabled"`
+
+	UI                 command.UI
+	Config             command.Config
+	SharedActor        command.SharedActor
+	Actor              SCPActor
+	SecureShellFactory SecureShellFactory
+}
+
+func (cmd *SCPCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+	cmd.SecureShellFactory = shared.SecureShellFactory{}
+
+	return nil
+}
+
+func (cmd SCPCommand) Execute(args []string) error {
+	sourceApp, sourcePath, sourceIsRemote := parseSCPPath(cmd.RequiredArgs.Source)
+	targetApp, targetPath, targetIsRemote := parseSCPPath(cmd.RequiredArgs.Target)
+	if sourceIsRemote == targetIsRemote {
+		return translatableerror.SCPArgumentsError{}
+	}
+
+	appName := sourceApp
+	if targetIsRemote {
+		appName = targetApp
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Copying {{.Source}} to {{.Target}} for app {{.AppName}} instance {{.InstanceIndex}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"Source":        cmd.RequiredArgs.Source,
+			"Target":        cmd.RequiredArgs.Target,
+			"AppName":       appName,
+			"InstanceIndex": cmd.AppInstanceIndex,
+			"OrgName":       cmd.Config.TargetedOrganization().Name,
+			"SpaceName":     cmd.Config.TargetedSpace().Name,
+			"CurrentUser":   user.Name,
+		})
+
+	secureShell, err := connectToAppInstance(cmd.Actor, cmd.SecureShellFactory, cmd.UI, cmd.Config, appName, cmd.AppInstanceIndex, cmd.SkipHostValidation, cmd.ResetHostKey)
+	if err != nil {
+		return err
+	}
+	defer secureShell.Close()
+
+	copyOpts := sshCmd.CopyOptions{
+		Recursive: cmd.Recursive,
+		Preserve:  cmd.Preserve,
+	}
+	if !cmd.Quiet {
+		copyOpts.Progress = shared.NewCopyProgressBar(cmd.UI.Writer())
+	}
+
+	if targetIsRemote {
+		err = secureShell.CopyToRemote(sourcePath, targetPath, copyOpts)
+	} else {
+		err = secureShell.CopyFromRemote(sourcePath, targetPath, copyOpts)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// connectToAppInstance opens an SSH connection to the given instance of the
+// app in the targeted space.
+func connectToAppInstance(actor SCPActor, factory SecureShellFactory, ui command.UI, config command.Config, appName string, index int, skipHostValidation bool, resetHostKey bool) (sshCmd.SecureShell, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, config.TargetedSpace().GUID)
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		return nil, shared.HandleError(err)
+	}
+
+	if index < 0 || index >= app.Instances {
+		return nil, translatableerror.ApplicationInstanceNotFoundError{AppName: appName, InstanceIndex: index}
+	}
+
+	auth, err := actor.GetSSHAuthentication()
+	if err != nil {
+		return nil, shared.HandleError(err)
+	}
+
+	secureShell := factory.NewSecureShell(app, auth)
+	err = secureShell.Connect(&options.SSHOptions{
+		AppName:            appName,
+		Index:              uint(index),
+		SkipHostValidation: skipHostValidation,
+		KnownHostsFile:     config.KnownHostsFilePath(),
+		ResetHostKey:       resetHostKey,
+	})
+	if err != nil {
+		return nil, translatableerror.SSHConnectionError{Message: err.Error()}
+	}
+
+	return secureShell, nil
+}
+
+// parseSCPPath splits an APP_NAME:PATH argument into the app name and path.
+// Arguments without a colon, or starting with a path or Windows volume, are
+// local paths.
+func parseSCPPath(arg string) (string, string, bool) {
+	colon := strings.Index(arg, ":")
+	if colon < 1 || filepath.VolumeName(arg) != "" || strings.ContainsAny(arg[:colon], `/\`) {
+		return "", arg, false
+	}
+	return arg[:colon], arg[colon+1:], true
+}
@@ -0,0 +1,225 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("scp Command", func() {
+	var (
+		cmd             SCPCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSCPActor
+		fakeFactory     *v2fakes.FakeSecureShellFactory
+		fakeSecureShell *sshfakes.FakeSecureShell
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSCPActor)
+		fakeFactory = new(v2fakes.FakeSecureShellFactory)
+		fakeSecureShell = new(sshfakes.FakeSecureShell)
+		fakeFactory.NewSecureShellReturns(fakeSecureShell)
+
+		cmd = SCPCommand{
+			UI:                 testUI,
+			Config:             fakeConfig,
+			SharedActor:        fakeSharedActor,
+			Actor:              fakeActor,
+			SecureShellFactory: fakeFactory,
+		}
+		cmd.RequiredArgs.Source = "./local.txt"
+		cmd.RequiredArgs.Target = "some-app:app/remote.txt"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.KnownHostsFilePathReturns("/home/some-user/.cf/known_hosts")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	DescribeTable("when neither or both arguments refer to the app",
+		func(source string, target string) {
+			cmd.RequiredArgs.Source = source
+			cmd.RequiredArgs.Target = target
+			Expect(cmd.Execute(nil)).To(MatchError(translatableerror.SCPArgumentsError{}))
+		},
+
+		Entry("both local", "./local.txt", "/tmp/other.txt"),
+		Entry("both remote", "some-app:a.txt", "other-app:b.txt"),
+		Entry("colon inside a local path", "./dir:a/b", "../x:y"),
+	)
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrganizationRequired).To(BeTrue())
+			Expect(targetedSpaceRequired).To(BeTrue())
+		})
+	})
+
+	Context("when the app exists", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v2action.Application{GUID: "some-app-guid", Name: "some-app", Instances: 2},
+				v2action.Warnings{"get-app-warning"}, nil)
+			fakeActor.GetSSHAuthenticationReturns(v2action.SSHAuthentication{
+				Endpoint:           "ssh.example.com:2222",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+			}, nil)
+		})
+
+		It("copies the local file to the app instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Copying \./local\.txt to some-app:app/remote\.txt for app some-app instance 0 in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeFactory.NewSecureShellCallCount()).To(Equal(1))
+			app, auth := fakeFactory.NewSecureShellArgsForCall(0)
+			Expect(app.GUID).To(Equal("some-app-guid"))
+			Expect(auth.Passcode).To(Equal("some-passcode"))
+
+			Expect(fakeSecureShell.ConnectCallCount()).To(Equal(1))
+			Expect(fakeSecureShell.ConnectArgsForCall(0)).To(Equal(&options.SSHOptions{
+				AppName:        "some-app",
+				KnownHostsFile: "/home/some-user/.cf/known_hosts",
+			}))
+
+			Expect(fakeSecureShell.CopyToRemoteCallCount()).To(Equal(1))
+			localPath, remotePath, copyOpts := fakeSecureShell.CopyToRemoteArgsForCall(0)
+			Expect(localPath).To(Equal("./local.txt"))
+			Expect(remotePath).To(Equal("app/remote.txt"))
+			Expect(copyOpts.Recursive).To(BeFalse())
+			Expect(copyOpts.Progress).ToNot(BeNil())
+
+			Expect(fakeSecureShell.CloseCallCount()).To(Equal(1))
+		})
+
+		Context("when copying from the app instance with flags", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Source = "some-app:logs"
+				cmd.RequiredArgs.Target = "./logs"
+				cmd.AppInstanceIndex = 1
+				cmd.Recursive = true
+				cmd.Preserve = true
+				cmd.Quiet = true
+				cmd.SkipHostValidation = true
+				cmd.ResetHostKey = true
+			})
+
+			It("copies from the requested instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeSecureShell.ConnectArgsForCall(0)).To(Equal(&options.SSHOptions{
+					AppName:            "some-app",
+					Index:              1,
+					SkipHostValidation: true,
+					KnownHostsFile:     "/home/some-user/.cf/known_hosts",
+					ResetHostKey:       true,
+				}))
+
+				Expect(fakeSecureShell.CopyFromRemoteCallCount()).To(Equal(1))
+				remotePath, localPath, copyOpts := fakeSecureShell.CopyFromRemoteArgsForCall(0)
+				Expect(remotePath).To(Equal("logs"))
+				Expect(localPath).To(Equal("./logs"))
+				Expect(copyOpts.Recursive).To(BeTrue())
+				Expect(copyOpts.Preserve).To(BeTrue())
+				Expect(copyOpts.Progress).To(BeNil())
+			})
+		})
+
+		Context("when the instance does not exist", func() {
+			BeforeEach(func() {
+				cmd.AppInstanceIndex = 2
+			})
+
+			It("returns an ApplicationInstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationInstanceNotFoundError{AppName: "some-app", InstanceIndex: 2}))
+				Expect(fakeActor.GetSSHAuthenticationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the ssh authentication fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetSSHAuthenticationReturns(v2action.SSHAuthentication{}, errors.New("uaa down"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("uaa down"))
+				Expect(fakeFactory.NewSecureShellCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShell.ConnectReturns(errors.New("app not started"))
+			})
+
+			It("returns an SSHConnectionError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SSHConnectionError{Message: "app not started"}))
+				Expect(fakeSecureShell.CopyToRemoteCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the copy fails", func() {
+			BeforeEach(func() {
+				fakeSecureShell.CopyToRemoteReturns(errors.New("permission denied"))
+			})
+
+			It("returns the error and closes the connection", func() {
+				Expect(executeErr).To(MatchError("permission denied"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+				Expect(fakeSecureShell.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, v2action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+		})
+	})
+})
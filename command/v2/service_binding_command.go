@@ -0,0 +1,160 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . ServiceBindingActor
+
+type ServiceBindingActor interface {
+	CloudControllerAPIVersion() string
+	GetServiceBindingSummaryBySpace(appName string, serviceInstanceName string, spaceGUID string, includeParameters bool) (v2action.ServiceBindingSummary, v2action.Warnings, error)
+}
+
+type ServiceBindingCommand struct {
+	RequiredArgs    flag.BindServiceArgs `positional-args:"yes"`
+	Reveal          bool                 `long:"reveal" description:"Display the credential values instead of hiding them"`
+	usage           interface{}          `usage:"CF_NAME service-binding APP_NAME SERVICE_INSTANCE [--reveal]"`
+	relatedCommands interface{}          `related_commands:"bind-service, env, service, unbind-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServiceBindingActor
+}
+
+func (cmd *ServiceBindingCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd ServiceBindingCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Showing binding of service {{.ServiceInstanceName}} to app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstanceName,
+		"AppName":             cmd.RequiredArgs.AppName,
+		"OrgName":             cmd.Config.TargetedOrganization().Name,
+		"SpaceName":           cmd.Config.TargetedSpace().Name,
+		"Username":            user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	includeParameters := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), command.MinVersionBindingParametersV2) == nil
+
+	summary, warnings, err := cmd.Actor.GetServiceBindingSummaryBySpace(cmd.RequiredArgs.AppName, cmd.RequiredArgs.ServiceInstanceName, cmd.Config.TargetedSpace().GUID, includeParameters)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.ServiceBindingNotFoundError); ok {
+			return translatableerror.ServiceBindingNotFoundError{
+				AppName:             cmd.RequiredArgs.AppName,
+				ServiceInstanceName: cmd.RequiredArgs.ServiceInstanceName,
+			}
+		}
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("binding guid:"), summary.GUID},
+		{cmd.UI.TranslateText("syslog drain url:"), summary.SyslogDrainURL},
+	}, 3)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Parameters:")
+	switch {
+	case !includeParameters:
+		cmd.UI.DisplayText("Retrieving binding parameters requires CC API version {{.MinVersion}} or higher.", map[string]interface{}{
+			"MinVersion": command.MinVersionBindingParametersV2,
+		})
+	case summary.ParametersNotSupported:
+		cmd.UI.DisplayText("The service broker does not support retrieving binding parameters.")
+	case len(summary.Parameters) == 0:
+		cmd.UI.DisplayText("none")
+	default:
+		err = cmd.displayJSON(summary.Parameters)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Credentials in VCAP_SERVICES:")
+	if len(summary.Credentials) == 0 {
+		cmd.UI.DisplayText("none")
+		return nil
+	}
+
+	if cmd.Reveal {
+		return cmd.displayJSON(summary.Credentials)
+	}
+
+	err = cmd.displayJSON(redactCredentials(summary.Credentials))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use '{{.Command}}' to display the credential values.", map[string]interface{}{
+		"Command": fmt.Sprintf("%s service-binding %s %s --reveal", cmd.Config.BinaryName(), cmd.RequiredArgs.AppName, cmd.RequiredArgs.ServiceInstanceName),
+	})
+
+	return nil
+}
+
+func (cmd ServiceBindingCommand) displayJSON(value interface{}) error {
+	raw, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(raw))
+	return err
+}
+
+// redactCredentials replaces every value in the credentials with the redacted
+// placeholder, keeping the keys so the structure of the credentials is still
+// visible.
+func redactCredentials(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		redacted := map[string]interface{}{}
+		for key, nested := range typed {
+			redacted[key] = redactCredentials(nested)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(typed))
+		for i, nested := range typed {
+			redacted[i] = redactCredentials(nested)
+		}
+		return redacted
+	default:
+		return ui.RedactedValue
+	}
+}
@@ -0,0 +1,222 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-binding Command", func() {
+	var (
+		cmd             ServiceBindingCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeServiceBindingActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeServiceBindingActor)
+
+		cmd = ServiceBindingCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.ServiceInstanceName = "some-service-instance"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns(command.MinVersionBindingParametersV2)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in and a space is targeted", func() {
+		var summary v2action.ServiceBindingSummary
+
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+			summary = v2action.ServiceBindingSummary{
+				ServiceBinding: v2action.ServiceBinding{
+					GUID:           "some-binding-guid",
+					SyslogDrainURL: "syslog://some-drain",
+					Credentials: map[string]interface{}{
+						"username": "some-username",
+						"password": "some-password",
+						"hosts":    []interface{}{"host-1"},
+					},
+				},
+				Parameters: map[string]interface{}{"permissions": "read-only"},
+			}
+		})
+
+		Context("when the binding is found", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceBindingSummaryBySpaceStub = func(string, string, string, bool) (v2action.ServiceBindingSummary, v2action.Warnings, error) {
+					return summary, v2action.Warnings{"summary-warning"}, nil
+				}
+			})
+
+			It("displays the binding, its parameters and redacted credentials", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Showing binding of service some-service-instance to app some-app in org some-org / space some-space as some-user\\.\\.\\."))
+				Expect(testUI.Out).To(Say("binding guid:\\s+some-binding-guid"))
+				Expect(testUI.Out).To(Say("syslog drain url:\\s+syslog://some-drain"))
+				Expect(testUI.Out).To(Say("Parameters:"))
+				Expect(testUI.Out).To(Say(`"permissions": "read-only"`))
+				Expect(testUI.Out).To(Say("Credentials in VCAP_SERVICES:"))
+				Expect(testUI.Out).To(Say(`"hosts": \[\s+"\[PRIVATE DATA HIDDEN\]"\s+\]`))
+				Expect(testUI.Out).To(Say(`"password": "\[PRIVATE DATA HIDDEN\]"`))
+				Expect(testUI.Out).To(Say(`"username": "\[PRIVATE DATA HIDDEN\]"`))
+				Expect(testUI.Out).To(Say("TIP: Use 'faceman service-binding some-app some-service-instance --reveal' to display the credential values\\."))
+				Expect(testUI.Out).ToNot(Say("some-password"))
+				Expect(testUI.Err).To(Say("summary-warning"))
+
+				Expect(fakeActor.GetServiceBindingSummaryBySpaceCallCount()).To(Equal(1))
+				appName, serviceInstanceName, spaceGUID, includeParameters := fakeActor.GetServiceBindingSummaryBySpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(serviceInstanceName).To(Equal("some-service-instance"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(includeParameters).To(BeTrue())
+			})
+
+			Context("when --reveal is provided", func() {
+				BeforeEach(func() {
+					cmd.Reveal = true
+				})
+
+				It("displays the credential values", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`"password": "some-password"`))
+					Expect(testUI.Out).To(Say(`"username": "some-username"`))
+					Expect(testUI.Out).ToNot(Say("TIP"))
+				})
+			})
+
+			Context("when the service broker does not support retrieving parameters", func() {
+				BeforeEach(func() {
+					summary.Parameters = nil
+					summary.ParametersNotSupported = true
+				})
+
+				It("says so", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Parameters:"))
+					Expect(testUI.Out).To(Say("The service broker does not support retrieving binding parameters\\."))
+				})
+			})
+
+			Context("when the binding has no parameters or credentials", func() {
+				BeforeEach(func() {
+					summary.Parameters = nil
+					summary.Credentials = nil
+				})
+
+				It("displays none for both", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Parameters:\nnone"))
+					Expect(testUI.Out).To(Say("Credentials in VCAP_SERVICES:\nnone"))
+					Expect(testUI.Out).ToNot(Say("TIP"))
+				})
+			})
+
+			Context("when the API does not support retrieving parameters", func() {
+				BeforeEach(func() {
+					fakeActor.CloudControllerAPIVersionReturns("2.102.0")
+				})
+
+				It("does not request the parameters", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Retrieving binding parameters requires CC API version 2\\.103\\.0 or higher\\."))
+
+					_, _, _, includeParameters := fakeActor.GetServiceBindingSummaryBySpaceArgsForCall(0)
+					Expect(includeParameters).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when the app is not bound to the service instance", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceBindingSummaryBySpaceReturns(
+					v2action.ServiceBindingSummary{},
+					v2action.Warnings{"summary-warning"},
+					v2action.ServiceBindingNotFoundError{AppGUID: "some-app-guid", ServiceInstanceGUID: "some-service-instance-guid"})
+			})
+
+			It("returns a ServiceBindingNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceBindingNotFoundError{
+					AppName:             "some-app",
+					ServiceInstanceName: "some-service-instance",
+				}))
+				Expect(testUI.Err).To(Say("summary-warning"))
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceBindingSummaryBySpaceReturns(
+					v2action.ServiceBindingSummary{},
+					nil,
+					v2action.ServiceInstanceNotFoundError{Name: "some-service-instance"})
+			})
+
+			It("returns a ServiceInstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+			})
+		})
+
+		Context("when getting the binding fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("summary error")
+				fakeActor.GetServiceBindingSummaryBySpaceReturns(v2action.ServiceBindingSummary{}, nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
@@ -1,25 +1,160 @@
 package v2
 
 import (
-	"os"
+	"strconv"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . ServiceActor
+
+type ServiceActor interface {
+	CloudControllerAPIVersion() string
+	GetServiceInstanceSummaryByNameAndSpace(name string, spaceGUID string, includeSharing bool) (v2action.ServiceInstanceSummary, v2action.Warnings, error)
+}
+
 type ServiceCommand struct {
 	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
 	GUID            bool                 `long:"guid" description:"Retrieve and display the given service's guid.  All other output for the service is suppressed."`
 	usage           interface{}          `usage:"CF_NAME service SERVICE_INSTANCE"`
-	relatedCommands interface{}          `related_commands:"bind-service, rename-service, update-service"`
+	relatedCommands interface{}          `related_commands:"bind-service, rename-service, share-service, unshare-service, update-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServiceActor
 }
 
-func (ServiceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *ServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (ServiceCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd ServiceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	includeSharing := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), command.MinVersionShareServiceV2) == nil
+
+	if cmd.GUID {
+		summary, _, summaryErr := cmd.Actor.GetServiceInstanceSummaryByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID, false)
+		if summaryErr != nil {
+			return shared.HandleError(summaryErr)
+		}
+		cmd.UI.DisplayText(summary.GUID)
+		return nil
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Showing info of service {{.ServiceInstanceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":             cmd.Config.TargetedOrganization().Name,
+		"SpaceName":           cmd.Config.TargetedSpace().Name,
+		"Username":            user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	summary, warnings, err := cmd.Actor.GetServiceInstanceSummaryByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID, includeSharing)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if summary.Managed() {
+		cmd.displayManagedServiceInstanceSummary(summary)
+	} else {
+		cmd.displayUserProvidedServiceInstanceSummary(summary)
+	}
+
 	return nil
 }
+
+func (cmd ServiceCommand) displayUserProvidedServiceInstanceSummary(summary v2action.ServiceInstanceSummary) {
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("Service instance:"), summary.Name},
+		{cmd.UI.TranslateText("Service:"), cmd.UI.TranslateText("user-provided")},
+		{cmd.UI.TranslateText("Bound apps:"), strings.Join(summary.BoundApplications, ", ")},
+	}, 3)
+}
+
+func (cmd ServiceCommand) displayManagedServiceInstanceSummary(summary v2action.ServiceInstanceSummary) {
+	table := [][]string{
+		{cmd.UI.TranslateText("Service instance:"), summary.Name},
+		{cmd.UI.TranslateText("Service:"), summary.Service.Label},
+		{cmd.UI.TranslateText("Bound apps:"), strings.Join(summary.BoundApplications, ", ")},
+		{cmd.UI.TranslateText("Tags:"), strings.Join(summary.Tags, ", ")},
+		{cmd.UI.TranslateText("Plan:"), summary.ServicePlan.Name},
+		{cmd.UI.TranslateText("Description:"), summary.Service.Description},
+		{cmd.UI.TranslateText("Documentation url:"), summary.Service.DocumentationURL},
+		{cmd.UI.TranslateText("Dashboard:"), summary.DashboardURL},
+	}
+	if summary.IsSharedFrom() {
+		table = append(table, []string{
+			cmd.UI.TranslateText("Shared from:"),
+			cmd.UI.TranslateText("org {{.OrgName}} / space {{.SpaceName}}", map[string]interface{}{
+				"OrgName":   summary.ServiceInstanceSharedFrom.OrganizationName,
+				"SpaceName": summary.ServiceInstanceSharedFrom.SpaceName,
+			}),
+		})
+	}
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+
+	if summary.IsSharedTo() {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Shared with spaces:")
+
+		sharedTosTable := [][]string{{
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("bindings"),
+		}}
+		for _, sharedTo := range summary.ServiceInstanceSharedTos {
+			sharedTosTable = append(sharedTosTable, []string{
+				sharedTo.OrganizationName,
+				sharedTo.SpaceName,
+				strconv.Itoa(sharedTo.BoundAppCount),
+			})
+		}
+		cmd.UI.DisplayTableWithHeader("", sharedTosTable, 3)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Last Operation")
+
+	lastOperation := [][]string{
+		{cmd.UI.TranslateText("Status:"), lastOperationStatus(summary.LastOperation.Type, string(summary.LastOperation.State))},
+		{cmd.UI.TranslateText("Message:"), summary.LastOperation.Description},
+	}
+	if summary.LastOperation.CreatedAt != "" {
+		lastOperation = append(lastOperation, []string{cmd.UI.TranslateText("Started:"), summary.LastOperation.CreatedAt})
+	}
+	lastOperation = append(lastOperation, []string{cmd.UI.TranslateText("Updated:"), summary.LastOperation.UpdatedAt})
+	cmd.UI.DisplayKeyValueTable("", lastOperation, 3)
+}
+
+func lastOperationStatus(operationType string, state string) string {
+	if operationType == "" || state == "" {
+		return ""
+	}
+	return operationType + " " + state
+}
@@ -0,0 +1,262 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service Command", func() {
+	var (
+		cmd             ServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeServiceActor)
+
+		cmd = ServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns(command.MinVersionShareServiceV2)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in and a space is targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		})
+
+		Context("when the --guid flag is provided", func() {
+			BeforeEach(func() {
+				cmd.GUID = true
+				fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(
+					v2action.ServiceInstanceSummary{ServiceInstance: v2action.ServiceInstance{GUID: "some-service-instance-guid"}},
+					v2action.Warnings{"summary-warning"},
+					nil)
+			})
+
+			It("displays only the guid without sharing information", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("some-service-instance-guid"))
+				Expect(testUI.Out).ToNot(Say("Showing info"))
+				Expect(testUI.Err).ToNot(Say("summary-warning"))
+
+				_, _, includeSharing := fakeActor.GetServiceInstanceSummaryByNameAndSpaceArgsForCall(0)
+				Expect(includeSharing).To(BeFalse())
+			})
+		})
+
+		Context("when the service instance is user provided", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(
+					v2action.ServiceInstanceSummary{
+						ServiceInstance: v2action.ServiceInstance{
+							Name: "some-service-instance",
+							Type: ccv2.UserProvidedService,
+						},
+						BoundApplications: []string{"app-1", "app-2"},
+					},
+					v2action.Warnings{"summary-warning"},
+					nil)
+			})
+
+			It("displays the service instance and its bound apps", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Showing info of service some-service-instance in org some-org / space some-space as some-user\\.\\.\\."))
+				Expect(testUI.Out).To(Say("Service instance:\\s+some-service-instance"))
+				Expect(testUI.Out).To(Say("Service:\\s+user-provided"))
+				Expect(testUI.Out).To(Say("Bound apps:\\s+app-1, app-2"))
+				Expect(testUI.Out).ToNot(Say("Last Operation"))
+				Expect(testUI.Err).To(Say("summary-warning"))
+
+				name, spaceGUID, includeSharing := fakeActor.GetServiceInstanceSummaryByNameAndSpaceArgsForCall(0)
+				Expect(name).To(Equal("some-service-instance"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(includeSharing).To(BeTrue())
+			})
+		})
+
+		Context("when the service instance is managed", func() {
+			var summary v2action.ServiceInstanceSummary
+
+			BeforeEach(func() {
+				summary = v2action.ServiceInstanceSummary{
+					ServiceInstance: v2action.ServiceInstance{
+						Name:         "some-service-instance",
+						Type:         ccv2.ManagedService,
+						Tags:         []string{"tag-1", "tag-2"},
+						DashboardURL: "some-dashboard",
+						LastOperation: ccv2.LastOperation{
+							Type:        "create",
+							State:       ccv2.LastOperationSucceeded,
+							Description: "some-message",
+							CreatedAt:   "some-created-at",
+							UpdatedAt:   "some-updated-at",
+						},
+					},
+					ServicePlan: v2action.ServicePlan{Name: "some-plan"},
+					Service: v2action.Service{
+						Label:            "some-service",
+						Description:      "some-description",
+						DocumentationURL: "some-docs-url",
+					},
+					BoundApplications: []string{"app-1"},
+				}
+			})
+
+			JustBeforeEach(func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+			})
+
+			Context("when the service instance is not shared", func() {
+				BeforeEach(func() {
+					fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(summary, v2action.Warnings{"summary-warning"}, nil)
+				})
+
+				It("displays the service instance and its last operation", func() {
+					Expect(testUI.Out).To(Say("Service instance:\\s+some-service-instance"))
+					Expect(testUI.Out).To(Say("Service:\\s+some-service"))
+					Expect(testUI.Out).To(Say("Bound apps:\\s+app-1"))
+					Expect(testUI.Out).To(Say("Tags:\\s+tag-1, tag-2"))
+					Expect(testUI.Out).To(Say("Plan:\\s+some-plan"))
+					Expect(testUI.Out).To(Say("Description:\\s+some-description"))
+					Expect(testUI.Out).To(Say("Documentation url:\\s+some-docs-url"))
+					Expect(testUI.Out).To(Say("Dashboard:\\s+some-dashboard"))
+					Expect(testUI.Out).To(Say("Last Operation"))
+					Expect(testUI.Out).To(Say("Status:\\s+create succeeded"))
+					Expect(testUI.Out).To(Say("Message:\\s+some-message"))
+					Expect(testUI.Out).To(Say("Started:\\s+some-created-at"))
+					Expect(testUI.Out).To(Say("Updated:\\s+some-updated-at"))
+
+					Expect(testUI.Out).ToNot(Say("Shared"))
+				})
+			})
+
+			Context("when the service instance was shared from another space", func() {
+				BeforeEach(func() {
+					summary.ServiceInstanceSharedFrom = v2action.ServiceInstanceSharedFrom{
+						SpaceGUID:        "other-space-guid",
+						SpaceName:        "other-space",
+						OrganizationName: "other-org",
+					}
+					fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(summary, nil, nil)
+				})
+
+				It("displays the org and space it was shared from", func() {
+					Expect(testUI.Out).To(Say("Dashboard:\\s+some-dashboard"))
+					Expect(testUI.Out).To(Say("Shared from:\\s+org other-org / space other-space"))
+					Expect(testUI.Out).To(Say("Last Operation"))
+				})
+			})
+
+			Context("when the service instance is shared with other spaces", func() {
+				BeforeEach(func() {
+					summary.ServiceInstanceSharedTos = []v2action.ServiceInstanceSharedTo{
+						{SpaceGUID: "space-guid-1", SpaceName: "space-1", OrganizationName: "org-1", BoundAppCount: 2},
+						{SpaceGUID: "space-guid-2", SpaceName: "space-2", OrganizationName: "org-2", BoundAppCount: 0},
+					}
+					fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(summary, nil, nil)
+				})
+
+				It("displays a table of the spaces it is shared with", func() {
+					Expect(testUI.Out).To(Say("Dashboard:\\s+some-dashboard"))
+					Expect(testUI.Out).To(Say("Shared with spaces:"))
+					Expect(testUI.Out).To(Say("org\\s+space\\s+bindings"))
+					Expect(testUI.Out).To(Say("org-1\\s+space-1\\s+2"))
+					Expect(testUI.Out).To(Say("org-2\\s+space-2\\s+0"))
+					Expect(testUI.Out).To(Say("Last Operation"))
+				})
+			})
+
+			Context("when the API does not support service instance sharing", func() {
+				BeforeEach(func() {
+					fakeActor.CloudControllerAPIVersionReturns("2.99.0")
+					fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(summary, nil, nil)
+				})
+
+				It("does not request sharing information", func() {
+					_, _, includeSharing := fakeActor.GetServiceInstanceSummaryByNameAndSpaceArgsForCall(0)
+					Expect(includeSharing).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(
+					v2action.ServiceInstanceSummary{},
+					v2action.Warnings{"summary-warning"},
+					v2action.ServiceInstanceNotFoundError{Name: "some-service-instance"})
+			})
+
+			It("returns a ServiceInstanceNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(testUI.Err).To(Say("summary-warning"))
+			})
+		})
+
+		Context("when getting the summary fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("summary error")
+				fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(v2action.ServiceInstanceSummary{}, nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
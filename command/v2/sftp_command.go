@@ -0,0 +1,63 @@
+package v2
+
+import (
+	"io"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+type SFTPCommand struct {
+	RequiredArgs       flag.AppName `positional-args:"yes"`
+	AppInstanceIndex   int          `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
+	ResetHostKey       bool         `long:"reset-host-key" description:"Replace the pinned host key of the SSH proxy with the key it presents"`
+	SkipHostValidation bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
+	usage              interface{}  `usage:"CF_NAME sftp APP_NAME [-i app-instance-index] [--skip-host-validation] [--reset-host-key]\n\n   Speaks the SFTP protocol on standard input and output, relaying it to the SFTP server of the app instance. Use it as the server of an SFTP client.\n\nEXAMPLES:\n   sftp -D \"CF_NAME sftp my-app\""`
+	relatedCommands    interface{}  `related_commands:"scp, ssh, ssh-enabled"`
+
+	UI                 command.UI
+	Config             command.Config
+	SharedActor        command.SharedActor
+	Actor              SCPActor
+	SecureShellFactory SecureShellFactory
+	Stdin              io.Reader
+	Stdout             io.Writer
+}
+
+func (cmd *SFTPCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+	cmd.SecureShellFactory = shared.SecureShellFactory{}
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+
+	return nil
+}
+
+// Execute does not display anything on standard output, which carries the
+// SFTP protocol.
+func (cmd SFTPCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	secureShell, err := connectToAppInstance(cmd.Actor, cmd.SecureShellFactory, cmd.UI, cmd.Config, cmd.RequiredArgs.AppName, cmd.AppInstanceIndex, cmd.SkipHostValidation, cmd.ResetHostKey)
+	if err != nil {
+		return err
+	}
+	defer secureShell.Close()
+
+	return secureShell.Subsystem("sftp", cmd.Stdin, cmd.Stdout)
+}
@@ -0,0 +1,109 @@
+package v2_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("sftp Command", func() {
+	var (
+		cmd             SFTPCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSCPActor
+		fakeFactory     *v2fakes.FakeSecureShellFactory
+		fakeSecureShell *sshfakes.FakeSecureShell
+		stdin           *strings.Reader
+		stdout          *bytes.Buffer
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSCPActor)
+		fakeFactory = new(v2fakes.FakeSecureShellFactory)
+		fakeSecureShell = new(sshfakes.FakeSecureShell)
+		fakeFactory.NewSecureShellReturns(fakeSecureShell)
+		stdin = strings.NewReader("")
+		stdout = &bytes.Buffer{}
+
+		cmd = SFTPCommand{
+			UI:                 testUI,
+			Config:             fakeConfig,
+			SharedActor:        fakeSharedActor,
+			Actor:              fakeActor,
+			SecureShellFactory: fakeFactory,
+			Stdin:              stdin,
+			Stdout:             stdout,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.KnownHostsFilePathReturns("/home/some-user/.cf/known_hosts")
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app", Instances: 1},
+			v2action.Warnings{"get-app-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("relays standard input and output to the sftp subsystem", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeSecureShell.ConnectArgsForCall(0)).To(Equal(&options.SSHOptions{
+			AppName:        "some-app",
+			KnownHostsFile: "/home/some-user/.cf/known_hosts",
+		}))
+		Expect(fakeSecureShell.SubsystemCallCount()).To(Equal(1))
+		name, in, out := fakeSecureShell.SubsystemArgsForCall(0)
+		Expect(name).To(Equal("sftp"))
+		Expect(in).To(Equal(stdin))
+		Expect(out).To(Equal(stdout))
+		Expect(fakeSecureShell.CloseCallCount()).To(Equal(1))
+	})
+
+	It("only displays warnings, on standard error", func() {
+		Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+		Expect(testUI.Err).To(Say("get-app-warning"))
+	})
+
+	Context("when the subsystem fails", func() {
+		BeforeEach(func() {
+			fakeSecureShell.SubsystemReturns(errors.New("no sftp server"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("no sftp server"))
+		})
+	})
+
+	Context("when the instance does not exist", func() {
+		BeforeEach(func() {
+			cmd.AppInstanceIndex = 3
+		})
+
+		It("returns an ApplicationInstanceNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationInstanceNotFoundError{AppName: "some-app", InstanceIndex: 3}))
+			Expect(fakeSecureShell.ConnectCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,31 @@
+package shared
+
+import (
+	"io"
+
+	pb "gopkg.in/cheggaaa/pb.v1"
+)
+
+// CopyProgressBar displays a progress bar for every file copied over SSH.
+type CopyProgressBar struct {
+	writer io.Writer
+	bar    *pb.ProgressBar
+}
+
+func NewCopyProgressBar(writer io.Writer) *CopyProgressBar {
+	return &CopyProgressBar{writer: writer}
+}
+
+// Start displays a new progress bar, labeled with the file name, that
+// advances as the returned writer is written to.
+func (p *CopyProgressBar) Start(name string, size int64) io.Writer {
+	p.bar = pb.New64(size).SetUnits(pb.U_BYTES).Prefix(name + " ")
+	p.bar.Output = p.writer
+	p.bar.ShowSpeed = true
+	p.bar.Start()
+	return p.bar
+}
+
+func (p *CopyProgressBar) Finish() {
+	p.bar.Finish()
+}
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -43,8 +44,18 @@ func HandleError(err error) error {
 		return translatableerror.OrganizationNotFoundError{Name: e.Name}
 	case v2action.SecurityGroupNotFoundError:
 		return translatableerror.SecurityGroupNotFoundError(e)
+	case v2action.ServiceInstanceHasAssociationsError:
+		return translatableerror.ServiceInstanceHasAssociationsError(e)
 	case v2action.ServiceInstanceNotFoundError:
 		return translatableerror.ServiceInstanceNotFoundError(e)
+	case v2action.ServiceKeyNotFoundError:
+		return translatableerror.ServiceKeyNotFoundError(e)
+	case v2action.ServiceKeyParametersNotSupportedError:
+		return translatableerror.ServiceKeyParametersNotRetrievableError(e)
+	case v2action.ServiceNotFoundError:
+		return translatableerror.ServiceNotFoundError(e)
+	case v2action.ServicePlanNotFoundError:
+		return translatableerror.ServicePlanNotFoundError(e)
 	case v2action.SpaceNotFoundError:
 		return translatableerror.SpaceNotFoundError{Name: e.Name}
 	case v2action.StackNotFoundError:
@@ -57,6 +68,10 @@ func HandleError(err error) error {
 		return translatableerror.FileChangedError(e)
 	case v2action.EmptyDirectoryError:
 		return translatableerror.EmptyDirectoryError(e)
+	case v2action.InvalidSecurityGroupsDocumentError:
+		return translatableerror.InvalidSecurityGroupsDocumentError(e)
+	case v2action.InvalidRolesFileError:
+		return translatableerror.InvalidRolesFileError(e)
 
 	case pushaction.AppNotFoundInManifestError:
 		return translatableerror.AppNotFoundInManifestError(e)
@@ -68,8 +83,13 @@ func HandleError(err error) error {
 		return translatableerror.FileNotFoundError(e)
 	case pushaction.MissingNameError:
 		return translatableerror.RequiredNameForPushError{}
+	case pushaction.MissingServiceInstanceFieldError:
+		return translatableerror.ManifestServiceInstanceMissingFieldError(e)
 	case pushaction.UploadFailedError:
 		return translatableerror.UploadFailedError{Err: HandleError(e.Err)}
+
+	case manifest.InvalidServiceInstanceParametersError:
+		return translatableerror.ManifestServiceInstanceParametersError(e)
 	}
 
 	return err
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -48,10 +49,37 @@ var _ = Describe("HandleError", func() {
 			v2action.SecurityGroupNotFoundError{Name: "some-security-group"},
 			translatableerror.SecurityGroupNotFoundError{Name: "some-security-group"}),
 
+		Entry("v2action.InvalidSecurityGroupsDocumentError -> InvalidSecurityGroupsDocumentError",
+			v2action.InvalidSecurityGroupsDocumentError{Path: "some-path", Problems: []string{"some-problem"}},
+			translatableerror.InvalidSecurityGroupsDocumentError{Path: "some-path", Problems: []string{"some-problem"}}),
+
+		Entry("v2action.InvalidRolesFileError -> InvalidRolesFileError",
+			v2action.InvalidRolesFileError{Path: "some-path", Problems: []string{"some-problem"}},
+			translatableerror.InvalidRolesFileError{Path: "some-path", Problems: []string{"some-problem"}}),
+
+		Entry("v2action.ServiceInstanceHasAssociationsError -> ServiceInstanceHasAssociationsError",
+			v2action.ServiceInstanceHasAssociationsError{Name: "some-service-instance"},
+			translatableerror.ServiceInstanceHasAssociationsError{Name: "some-service-instance"}),
 		Entry("v2action.ServiceInstanceNotFoundError -> ServiceInstanceNotFoundError",
 			v2action.ServiceInstanceNotFoundError{Name: "some-service-instance"},
 			translatableerror.ServiceInstanceNotFoundError{Name: "some-service-instance"}),
 
+		Entry("v2action.ServiceKeyNotFoundError -> ServiceKeyNotFoundError",
+			v2action.ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"},
+			translatableerror.ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"}),
+
+		Entry("v2action.ServiceKeyParametersNotSupportedError -> ServiceKeyParametersNotRetrievableError",
+			v2action.ServiceKeyParametersNotSupportedError{Name: "some-key"},
+			translatableerror.ServiceKeyParametersNotRetrievableError{Name: "some-key"}),
+
+		Entry("v2action.ServiceNotFoundError -> ServiceNotFoundError",
+			v2action.ServiceNotFoundError{Name: "some-service"},
+			translatableerror.ServiceNotFoundError{Name: "some-service"}),
+
+		Entry("v2action.ServicePlanNotFoundError -> ServicePlanNotFoundError",
+			v2action.ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"},
+			translatableerror.ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"}),
+
 		Entry("v2action.StackNotFoundError -> StackNotFoundError",
 			v2action.StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"},
 			translatableerror.StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"}),
@@ -129,6 +157,16 @@ var _ = Describe("HandleError", func() {
 			translatableerror.RequiredNameForPushError{},
 		),
 
+		Entry("pushaction.MissingServiceInstanceFieldError -> ManifestServiceInstanceMissingFieldError",
+			pushaction.MissingServiceInstanceFieldError{Name: "some-instance", Field: "plan"},
+			translatableerror.ManifestServiceInstanceMissingFieldError{Name: "some-instance", Field: "plan"},
+		),
+
+		Entry("manifest.InvalidServiceInstanceParametersError -> ManifestServiceInstanceParametersError",
+			manifest.InvalidServiceInstanceParametersError{Name: "some-instance", Path: "some-path"},
+			translatableerror.ManifestServiceInstanceParametersError{Name: "some-instance", Path: "some-path"},
+		),
+
 		Entry("pushaction.UploadFailedError -> UploadFailedError",
 			pushaction.UploadFailedError{Err: pushaction.NoDomainsFoundError{}},
 			translatableerror.UploadFailedError{Err: translatableerror.NoDomainsFoundError{}},
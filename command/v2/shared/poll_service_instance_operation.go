@@ -0,0 +1,59 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// PollServiceInstanceOperation displays every change of a service instance's
+// last operation and warning until the channels are closed, returning a
+// translated error if the operation failed or timed out.
+func PollServiceInstanceOperation(ui command.UI, config command.Config, instances <-chan v2action.ServiceInstance, apiWarnings <-chan string, apiErrs <-chan error) error {
+	for instances != nil || apiWarnings != nil || apiErrs != nil {
+		select {
+		case instance, ok := <-instances:
+			if !ok {
+				instances = nil
+				break
+			}
+
+			operation := instance.LastOperation
+			if operation.Description == "" {
+				ui.DisplayText("{{.Operation}} {{.State}}", map[string]interface{}{
+					"Operation": operation.Type,
+					"State":     operation.State,
+				})
+			} else {
+				ui.DisplayText("{{.Operation}} {{.State}}: {{.Description}}", map[string]interface{}{
+					"Operation":   operation.Type,
+					"State":       operation.State,
+					"Description": operation.Description,
+				})
+			}
+		case warning, ok := <-apiWarnings:
+			if !ok {
+				apiWarnings = nil
+				break
+			}
+
+			ui.DisplayWarning(warning)
+		case apiErr, ok := <-apiErrs:
+			if !ok {
+				apiErrs = nil
+				break
+			}
+
+			switch err := apiErr.(type) {
+			case v2action.ServiceInstanceOperationFailedError:
+				return translatableerror.ServiceInstanceOperationFailedError(err)
+			case v2action.ServiceInstanceOperationTimeoutError:
+				return translatableerror.ServiceInstanceOperationTimeoutError{Name: err.Name, BinaryName: config.BinaryName()}
+			default:
+				return HandleError(apiErr)
+			}
+		}
+	}
+
+	return nil
+}
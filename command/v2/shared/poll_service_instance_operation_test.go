@@ -0,0 +1,93 @@
+package shared_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Poll Service Instance Operation", func() {
+	var (
+		testUI      *ui.UI
+		fakeConfig  *commandfakes.FakeConfig
+		instances   chan v2action.ServiceInstance
+		apiWarnings chan string
+		apiErrs     chan error
+		err         error
+		block       chan bool
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		instances = make(chan v2action.ServiceInstance)
+		apiWarnings = make(chan string)
+		apiErrs = make(chan error)
+		block = make(chan bool)
+
+		err = errors.New("This should never occur.")
+	})
+
+	JustBeforeEach(func() {
+		go func() {
+			err = PollServiceInstanceOperation(testUI, fakeConfig, instances, apiWarnings, apiErrs)
+			close(block)
+		}()
+	})
+
+	Context("when the operation finishes", func() {
+		It("displays each change and warning and returns no error", func() {
+			instances <- v2action.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationInProgress, Description: "half done"}}
+			apiWarnings <- "some-warning"
+			instances <- v2action.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "create", State: ccv2.LastOperationSucceeded}}
+			close(instances)
+			close(apiWarnings)
+			close(apiErrs)
+
+			Eventually(block).Should(BeClosed())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("create in progress: half done"))
+			Expect(testUI.Out).To(Say("create succeeded"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	Context("when the operation fails", func() {
+		It("returns a ServiceInstanceOperationFailedError", func() {
+			apiErrs <- v2action.ServiceInstanceOperationFailedError{Name: "some-instance", Operation: "update", Description: "no capacity"}
+
+			Eventually(block).Should(BeClosed())
+			Expect(err).To(MatchError(translatableerror.ServiceInstanceOperationFailedError{Name: "some-instance", Operation: "update", Description: "no capacity"}))
+		})
+	})
+
+	Context("when the operation times out", func() {
+		It("returns a ServiceInstanceOperationTimeoutError", func() {
+			apiErrs <- v2action.ServiceInstanceOperationTimeoutError{Name: "some-instance", Timeout: time.Second}
+
+			Eventually(block).Should(BeClosed())
+			Expect(err).To(MatchError(translatableerror.ServiceInstanceOperationTimeoutError{Name: "some-instance", BinaryName: "faceman"}))
+		})
+	})
+
+	Context("when another error occurs", func() {
+		It("handles the error", func() {
+			apiErrs <- v2action.ServiceInstanceNotFoundError{Name: "some-instance"}
+
+			Eventually(block).Should(BeClosed())
+			Expect(err).To(MatchError(translatableerror.ServiceInstanceNotFoundError{Name: "some-instance"}))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package shared
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// PrefixedWriter writes every complete line written to it to the underlying
+// writer with a prefix. Writers sharing a lock never interleave their lines.
+type PrefixedWriter struct {
+	lock   *sync.Mutex
+	writer io.Writer
+	prefix []byte
+	buffer []byte
+}
+
+func NewPrefixedWriter(lock *sync.Mutex, writer io.Writer, prefix string) *PrefixedWriter {
+	return &PrefixedWriter{
+		lock:   lock,
+		writer: writer,
+		prefix: []byte(prefix),
+	}
+}
+
+// Write buffers p and writes out every line it completes. The length of p
+// is always returned, so callers copying output are never cut short.
+func (w *PrefixedWriter) Write(p []byte) (int, error) {
+	w.buffer = append(w.buffer, p...)
+
+	for {
+		newline := bytes.IndexByte(w.buffer, '\n')
+		if newline < 0 {
+			break
+		}
+		err := w.writeLine(w.buffer[:newline+1])
+		w.buffer = w.buffer[newline+1:]
+		if err != nil {
+			return len(p), err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Flush writes out the last line if it did not end in a newline.
+func (w *PrefixedWriter) Flush() error {
+	if len(w.buffer) == 0 {
+		return nil
+	}
+
+	line := append(w.buffer, '\n')
+	w.buffer = nil
+	return w.writeLine(line)
+}
+
+func (w *PrefixedWriter) writeLine(line []byte) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	_, err := w.writer.Write(append(append([]byte{}, w.prefix...), line...))
+	return err
+}
@@ -0,0 +1,82 @@
+package shared_test
+
+import (
+	"bytes"
+	"sync"
+
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PrefixedWriter", func() {
+	var (
+		lock   *sync.Mutex
+		output *bytes.Buffer
+		writer *PrefixedWriter
+	)
+
+	BeforeEach(func() {
+		lock = &sync.Mutex{}
+		output = &bytes.Buffer{}
+		writer = NewPrefixedWriter(lock, output, "[1] ")
+	})
+
+	It("prefixes every complete line", func() {
+		n, err := writer.Write([]byte("first\nsecond\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(13))
+		Expect(output.String()).To(Equal("[1] first\n[1] second\n"))
+	})
+
+	It("holds partial lines until they are completed", func() {
+		_, err := writer.Write([]byte("par"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.String()).To(BeEmpty())
+
+		_, err = writer.Write([]byte("tial\nnext"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.String()).To(Equal("[1] partial\n"))
+	})
+
+	Describe("Flush", func() {
+		It("writes the remaining partial line with a newline", func() {
+			_, err := writer.Write([]byte("done\nno newline"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(writer.Flush()).To(Succeed())
+			Expect(output.String()).To(Equal("[1] done\n[1] no newline\n"))
+		})
+
+		It("writes nothing when every line is complete", func() {
+			_, err := writer.Write([]byte("done\n"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(writer.Flush()).To(Succeed())
+			Expect(output.String()).To(Equal("[1] done\n"))
+		})
+	})
+
+	It("does not interleave lines from writers sharing a lock", func() {
+		other := NewPrefixedWriter(lock, output, "[2] ")
+
+		wg := &sync.WaitGroup{}
+		for _, w := range []*PrefixedWriter{writer, other} {
+			wg.Add(1)
+			go func(w *PrefixedWriter) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					_, err := w.Write([]byte("some output\n"))
+					Expect(err).ToNot(HaveOccurred())
+				}
+			}(w)
+		}
+		wg.Wait()
+
+		lines := bytes.Split(bytes.TrimSuffix(output.Bytes(), []byte("\n")), []byte("\n"))
+		Expect(lines).To(HaveLen(200))
+		for _, line := range lines {
+			Expect(string(line)).To(MatchRegexp(`^\[[12]\] some output$`))
+		}
+	})
+})
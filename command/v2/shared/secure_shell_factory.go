@@ -0,0 +1,39 @@
+package shared
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/cf/models"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	sshTerminal "code.cloudfoundry.org/cli/cf/ssh/terminal"
+)
+
+// SSHKeepAliveInterval is how often keepalive requests are sent over SSH
+// connections to application instances.
+const SSHKeepAliveInterval = 30 * time.Second
+
+// SecureShellFactory creates secure shells to application instances.
+type SecureShellFactory struct{}
+
+// NewSecureShell returns a secure shell to the given application that
+// authenticates with the given SSH authentication.
+func (SecureShellFactory) NewSecureShell(app v2action.Application, auth v2action.SSHAuthentication) sshCmd.SecureShell {
+	return sshCmd.NewSecureShell(
+		sshCmd.DefaultSecureDialer(),
+		sshTerminal.DefaultHelper(),
+		sshCmd.DefaultListenerFactory(),
+		SSHKeepAliveInterval,
+		models.Application{
+			ApplicationFields: models.ApplicationFields{
+				GUID:  app.GUID,
+				Name:  app.Name,
+				State: string(app.State),
+				Diego: app.Diego,
+			},
+		},
+		auth.HostKeyFingerprint,
+		auth.Endpoint,
+		auth.Passcode,
+	)
+}
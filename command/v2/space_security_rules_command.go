@@ -0,0 +1,141 @@
+package v2
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . SpaceSecurityRulesActor
+
+type SpaceSecurityRulesActor interface {
+	CloudControllerAPIVersion() string
+	GetEffectiveSecurityGroupRulesBySpace(spaceGUID string, includeStaging bool) ([]v2action.EffectiveSecurityGroupRule, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+}
+
+type SpaceSecurityRulesCommand struct {
+	RequiredArgs    flag.Space  `positional-args:"yes"`
+	usage           interface{} `usage:"CF_NAME space-security-rules SPACE\n\n   Lists the rules of every security group applying to the space, including globally enabled groups.\n   Rules overlapping other rules of the same lifecycle and rules allowing all destinations are flagged."`
+	relatedCommands interface{} `related_commands:"bind-security-group, security-group, security-groups, space"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SpaceSecurityRulesActor
+}
+
+func (cmd *SpaceSecurityRulesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd SpaceSecurityRulesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting security group rules for space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"SpaceName": cmd.RequiredArgs.Space,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(cmd.Config.TargetedOrganization().GUID, cmd.RequiredArgs.Space)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	includeStaging := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), command.MinVersionLifecyleStagingV2) == nil
+
+	rules, warnings, err := cmd.Actor.GetEffectiveSecurityGroupRulesBySpace(space.GUID, includeStaging)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(rules) == 0 {
+		cmd.UI.DisplayText("No security group rules apply to this space.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("lifecycle"),
+			cmd.UI.TranslateText("security group"),
+			cmd.UI.TranslateText("scope"),
+			cmd.UI.TranslateText("protocol"),
+			cmd.UI.TranslateText("destination"),
+			cmd.UI.TranslateText("ports"),
+			cmd.UI.TranslateText("description"),
+			cmd.UI.TranslateText("findings"),
+		},
+	}
+
+	var broadRules, overlappingRules int
+	for _, rule := range rules {
+		scope := cmd.UI.TranslateText("space")
+		if rule.Global {
+			scope = cmd.UI.TranslateText("global")
+		}
+
+		var findings []string
+		if rule.AllowsAllDestinations {
+			broadRules++
+			findings = append(findings, cmd.UI.TranslateText("allows all destinations"))
+		}
+		if len(rule.OverlappingSecurityGroups) > 0 {
+			overlappingRules++
+			findings = append(findings, cmd.UI.TranslateText("overlaps {{.SecurityGroups}}", map[string]interface{}{
+				"SecurityGroups": strings.Join(rule.OverlappingSecurityGroups, ", "),
+			}))
+		}
+
+		table = append(table, []string{
+			string(rule.Lifecycle),
+			rule.Name,
+			scope,
+			rule.Protocol,
+			rule.Destination,
+			rule.Ports,
+			rule.Description,
+			strings.Join(findings, "; "),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if broadRules > 0 {
+		cmd.UI.DisplayWarning("{{.Count}} rule(s) allow traffic to all destinations (0.0.0.0/0).", map[string]interface{}{
+			"Count": broadRules,
+		})
+	}
+	if overlappingRules > 0 {
+		cmd.UI.DisplayWarning("{{.Count}} rule(s) overlap other rules of the same lifecycle.", map[string]interface{}{
+			"Count": overlappingRules,
+		})
+	}
+
+	return nil
+}
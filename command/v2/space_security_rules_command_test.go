@@ -0,0 +1,177 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("space-security-rules Command", func() {
+	var (
+		cmd             SpaceSecurityRulesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSpaceSecurityRulesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSpaceSecurityRulesActor)
+
+		cmd = SpaceSecurityRulesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.Space = "some-space"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns(command.MinVersionLifecyleStagingV2)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the user is logged in and an org is targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+			fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "some-space-guid", Name: "some-space"}, v2action.Warnings{"space-warning"}, nil)
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{}, v2action.Warnings{"space-warning"}, v2action.SpaceNotFoundError{Name: "some-space"})
+			})
+
+			It("returns a translatable error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "some-space"}))
+				Expect(testUI.Err).To(Say("space-warning"))
+				Expect(fakeActor.GetEffectiveSecurityGroupRulesBySpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the rules fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("rules error")
+				fakeActor.GetEffectiveSecurityGroupRulesBySpaceReturns(nil, v2action.Warnings{"rules-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("space-warning"))
+				Expect(testUI.Err).To(Say("rules-warning"))
+			})
+		})
+
+		Context("when no rules apply to the space", func() {
+			BeforeEach(func() {
+				fakeActor.GetEffectiveSecurityGroupRulesBySpaceReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No security group rules apply to this space\\."))
+			})
+		})
+
+		Context("when rules apply to the space", func() {
+			BeforeEach(func() {
+				fakeActor.GetEffectiveSecurityGroupRulesBySpaceReturns(
+					[]v2action.EffectiveSecurityGroupRule{
+						{
+							SecurityGroupRule:         v2action.SecurityGroupRule{Name: "public-networks", Destination: "0.0.0.0/0", Lifecycle: ccv2.SecurityGroupLifecycleRunning, Protocol: "all"},
+							Global:                    true,
+							AllowsAllDestinations:     true,
+							OverlappingSecurityGroups: []string{"database", "dns"},
+						},
+						{
+							SecurityGroupRule:         v2action.SecurityGroupRule{Name: "database", Description: "postgres", Destination: "10.0.0.5", Lifecycle: ccv2.SecurityGroupLifecycleRunning, Ports: "5432", Protocol: "tcp"},
+							OverlappingSecurityGroups: []string{"public-networks"},
+						},
+						{
+							SecurityGroupRule: v2action.SecurityGroupRule{Name: "dns", Destination: "10.0.0.1", Lifecycle: ccv2.SecurityGroupLifecycleStaging, Ports: "53", Protocol: "udp"},
+							Global:            true,
+						},
+					},
+					v2action.Warnings{"rules-warning"},
+					nil)
+			})
+
+			It("displays the rules with their findings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Getting security group rules for space some-space in org some-org as some-user\\.\\.\\."))
+				Expect(testUI.Out).To(Say("lifecycle\\s+security group\\s+scope\\s+protocol\\s+destination\\s+ports\\s+description\\s+findings"))
+				Expect(testUI.Out).To(Say("running\\s+public-networks\\s+global\\s+all\\s+0\\.0\\.0\\.0/0\\s+allows all destinations; overlaps database, dns"))
+				Expect(testUI.Out).To(Say("running\\s+database\\s+space\\s+tcp\\s+10\\.0\\.0\\.5\\s+5432\\s+postgres\\s+overlaps public-networks"))
+				Expect(testUI.Out).To(Say("staging\\s+dns\\s+global\\s+udp\\s+10\\.0\\.0\\.1\\s+53"))
+
+				Expect(testUI.Err).To(Say("space-warning"))
+				Expect(testUI.Err).To(Say("rules-warning"))
+				Expect(testUI.Err).To(Say("1 rule\\(s\\) allow traffic to all destinations \\(0\\.0\\.0\\.0/0\\)\\."))
+				Expect(testUI.Err).To(Say("2 rule\\(s\\) overlap other rules of the same lifecycle\\."))
+
+				Expect(fakeActor.GetSpaceByOrganizationAndNameCallCount()).To(Equal(1))
+				orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(spaceName).To(Equal("some-space"))
+
+				Expect(fakeActor.GetEffectiveSecurityGroupRulesBySpaceCallCount()).To(Equal(1))
+				spaceGUID, includeStaging := fakeActor.GetEffectiveSecurityGroupRulesBySpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(includeStaging).To(BeTrue())
+			})
+		})
+
+		Context("when the API does not support staging security groups", func() {
+			BeforeEach(func() {
+				fakeActor.CloudControllerAPIVersionReturns("2.50.0")
+			})
+
+			It("only requests running rules", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, includeStaging := fakeActor.GetEffectiveSecurityGroupRulesBySpaceArgsForCall(0)
+				Expect(includeStaging).To(BeFalse())
+			})
+		})
+	})
+})
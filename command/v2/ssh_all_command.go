@@ -0,0 +1,307 @@
+package v2
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+type SSHAllCommand struct {
+	RequiredArgs       flag.AppName `positional-args:"yes"`
+	AppInstanceIndexes []int        `long:"app-instance-index" short:"i" description:"Application instance index to run the command on, can be repeated (Default: all instances)"`
+	Command            string       `long:"command" short:"c" description:"Command to run on each instance"`
+	Concurrency        int          `long:"concurrency" default:"10" description:"Maximum number of instances to run the command on at the same time"`
+	ResetHostKey       bool         `long:"reset-host-key" description:"Replace the pinned host key of the SSH proxy with the key it presents"`
+	SkipHostValidation bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
+	Timeout            int          `long:"timeout" description:"Seconds to wait for the command on each instance before giving up (Default: no limit)"`
+	usage              interface{}  `usage:"CF_NAME ssh-all APP_NAME -c COMMAND [-i app-instance-index]... [--concurrency LIMIT] [--timeout SECONDS] [--skip-host-validation] [--reset-host-key]\n\nEXAMPLES:\n   CF_NAME ssh-all my-app -c \"df -h /home/vcap\"\n   CF_NAME ssh-all my-app -c \"kill -USR1 1\" -i 0 -i 2 --timeout 30"`
+	relatedCommands    interface{}  `related_commands:"app, ssh, ssh-enabled"`
+
+	UI                 command.UI
+	Config             command.Config
+	SharedActor        command.SharedActor
+	Actor              SCPActor
+	SecureShellFactory SecureShellFactory
+	Stdout             io.Writer
+	Stderr             io.Writer
+}
+
+// exitStatusError is implemented by *ssh.ExitError, returned when the command
+// exits with a non-zero status.
+type exitStatusError interface {
+	error
+	ExitStatus() int
+}
+
+type sshAllResult struct {
+	index  int
+	failed bool
+	status string
+}
+
+func (cmd *SSHAllCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+	cmd.SecureShellFactory = shared.SecureShellFactory{}
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return nil
+}
+
+func (cmd SSHAllCommand) Execute(args []string) error {
+	if cmd.Command == "" {
+		return translatableerror.RequiredArgumentError{ArgumentName: "-c"}
+	}
+	if cmd.Concurrency < 1 {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--concurrency",
+			ExpectedType: "integer greater than 0",
+		}
+	}
+	if cmd.Timeout < 0 {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--timeout",
+			ExpectedType: "integer greater than or equal to 0",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	indexes, err := cmd.instanceIndexes(app)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Running {{.Command}} on {{.InstanceCount}} instances of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"Command":       cmd.Command,
+			"InstanceCount": len(indexes),
+			"AppName":       app.Name,
+			"OrgName":       cmd.Config.TargetedOrganization().Name,
+			"SpaceName":     cmd.Config.TargetedSpace().Name,
+			"CurrentUser":   user.Name,
+		})
+	cmd.UI.DisplayNewline()
+
+	results := make([]sshAllResult, len(indexes))
+	outputLock := &sync.Mutex{}
+	inFlight := make(chan struct{}, cmd.Concurrency)
+	wg := &sync.WaitGroup{}
+
+	for i, index := range indexes {
+		wg.Add(1)
+		go func(i int, index int) {
+			defer wg.Done()
+			inFlight <- struct{}{}
+			results[i] = cmd.runOnInstance(app, index, outputLock, func() { <-inFlight })
+		}(i, index)
+	}
+	wg.Wait()
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("instance"),
+			cmd.UI.TranslateText("result"),
+		},
+	}
+	failedCount := 0
+	for _, result := range results {
+		if result.failed {
+			failedCount++
+		}
+		table = append(table, []string{fmt.Sprintf("#%d", result.index), result.status})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if failedCount > 0 {
+		return translatableerror.SSHCommandFailedError{
+			FailedCount:   failedCount,
+			InstanceCount: len(indexes),
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// instanceIndexes returns the sorted, distinct indexes of the instances to run
+// the command on.
+func (cmd SSHAllCommand) instanceIndexes(app v2action.Application) ([]int, error) {
+	if len(cmd.AppInstanceIndexes) == 0 {
+		indexes := make([]int, app.Instances)
+		for i := range indexes {
+			indexes[i] = i
+		}
+		return indexes, nil
+	}
+
+	seen := map[int]bool{}
+	indexes := []int{}
+	for _, index := range cmd.AppInstanceIndexes {
+		if index < 0 || index >= app.Instances {
+			return nil, translatableerror.ApplicationInstanceNotFoundError{AppName: app.Name, InstanceIndex: index}
+		}
+		if !seen[index] {
+			seen[index] = true
+			indexes = append(indexes, index)
+		}
+	}
+	sort.Ints(indexes)
+
+	return indexes, nil
+}
+
+// runOnInstance connects to the instance and runs the command on it, writing
+// its output prefixed with the instance index. The timeout covers getting a
+// one-time passcode and connecting as well as running the command; a running
+// command is stopped by closing its connection. release is called once the
+// connection is done, which can be after a timeout is reported.
+func (cmd SSHAllCommand) runOnInstance(app v2action.Application, index int, outputLock *sync.Mutex, release func()) sshAllResult {
+	prefix := fmt.Sprintf("[%d] ", index)
+	stdout := shared.NewPrefixedWriter(outputLock, cmd.Stdout, prefix)
+	stderr := shared.NewPrefixedWriter(outputLock, cmd.Stderr, prefix)
+
+	var timeout <-chan time.Time
+	if cmd.Timeout > 0 {
+		timer := time.NewTimer(time.Duration(cmd.Timeout) * time.Second)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	stop := make(chan struct{})
+	running := make(chan struct{})
+	done := make(chan sshAllResult, 1)
+	go func() {
+		defer release()
+		done <- cmd.connectAndRun(app, index, stdout, stderr, running, stop)
+	}()
+
+	var result sshAllResult
+	select {
+	case result = <-done:
+	case <-timeout:
+		// Getting a passcode and connecting cannot be interrupted, so they
+		// are abandoned; connectAndRun closes a connection established after
+		// the timeout without running the command.
+		close(stop)
+		select {
+		case <-running:
+			<-done
+		default:
+		}
+
+		result = sshAllResult{
+			index:  index,
+			failed: true,
+			status: cmd.UI.TranslateText("timed out after {{.Timeout}} seconds", map[string]interface{}{
+				"Timeout": cmd.Timeout,
+			}),
+		}
+	}
+
+	_ = stdout.Flush()
+	_ = stderr.Flush()
+
+	return result
+}
+
+// connectAndRun connects to the instance and runs the command on it. running
+// is closed once the connection is established; closing stop closes the
+// connection.
+func (cmd SSHAllCommand) connectAndRun(app v2action.Application, index int, stdout io.Writer, stderr io.Writer, running chan<- struct{}, stop <-chan struct{}) sshAllResult {
+	auth, err := cmd.Actor.GetSSHAuthentication()
+	if err != nil {
+		return sshAllResult{index: index, failed: true, status: err.Error()}
+	}
+
+	secureShell := cmd.SecureShellFactory.NewSecureShell(app, auth)
+	err = secureShell.Connect(&options.SSHOptions{
+		AppName:            app.Name,
+		Index:              uint(index),
+		SkipHostValidation: cmd.SkipHostValidation,
+		KnownHostsFile:     cmd.Config.KnownHostsFilePath(),
+		ResetHostKey:       cmd.ResetHostKey,
+	})
+	if err != nil {
+		return sshAllResult{
+			index:  index,
+			failed: true,
+			status: cmd.UI.TranslateText("connection failed: {{.Message}}", map[string]interface{}{
+				"Message": err.Error(),
+			}),
+		}
+	}
+	defer secureShell.Close()
+
+	close(running)
+	select {
+	case <-stop:
+		return sshAllResult{index: index, failed: true}
+	default:
+	}
+
+	runDone := make(chan error, 1)
+	go func() {
+		runDone <- secureShell.Run(cmd.Command, stdout, stderr)
+	}()
+
+	select {
+	case err = <-runDone:
+	case <-stop:
+		_ = secureShell.Close()
+		<-runDone
+		return sshAllResult{index: index, failed: true}
+	}
+
+	switch e := err.(type) {
+	case nil:
+		return sshAllResult{index: index, status: cmd.exitStatus(0)}
+	case exitStatusError:
+		return sshAllResult{index: index, failed: true, status: cmd.exitStatus(e.ExitStatus())}
+	default:
+		return sshAllResult{index: index, failed: true, status: err.Error()}
+	}
+}
+
+func (cmd SSHAllCommand) exitStatus(status int) string {
+	return cmd.UI.TranslateText("exit status {{.ExitStatus}}", map[string]interface{}{
+		"ExitStatus": status,
+	})
+}
@@ -0,0 +1,468 @@
+package v2_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+type fakeExitError struct {
+	status int
+}
+
+func (e fakeExitError) Error() string {
+	return fmt.Sprintf("Process exited with status %d", e.status)
+}
+
+func (e fakeExitError) ExitStatus() int {
+	return e.status
+}
+
+var _ = Describe("ssh-all Command", func() {
+	var (
+		cmd             SSHAllCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSCPActor
+		fakeFactory     *v2fakes.FakeSecureShellFactory
+		stdout          *Buffer
+		stderr          *Buffer
+		binaryName      string
+		executeErr      error
+
+		shellsLock sync.Mutex
+		shells     []*sshfakes.FakeSecureShell
+		runStub    func(index uint, stdout io.Writer, stderr io.Writer) error
+	)
+
+	shellForIndex := func(index uint) *sshfakes.FakeSecureShell {
+		shellsLock.Lock()
+		defer shellsLock.Unlock()
+		for _, shell := range shells {
+			if shell.ConnectCallCount() > 0 && shell.ConnectArgsForCall(0).Index == index {
+				return shell
+			}
+		}
+		return nil
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSCPActor)
+		fakeFactory = new(v2fakes.FakeSecureShellFactory)
+		stdout = NewBuffer()
+		stderr = NewBuffer()
+		shells = nil
+
+		runStub = func(index uint, stdout io.Writer, stderr io.Writer) error {
+			fmt.Fprintf(stdout, "hello from %d\n", index)
+			return nil
+		}
+		fakeFactory.NewSecureShellStub = func(v2action.Application, v2action.SSHAuthentication) sshCmd.SecureShell {
+			shell := new(sshfakes.FakeSecureShell)
+			shell.RunStub = func(command string, stdout io.Writer, stderr io.Writer) error {
+				return runStub(shell.ConnectArgsForCall(0).Index, stdout, stderr)
+			}
+
+			shellsLock.Lock()
+			defer shellsLock.Unlock()
+			shells = append(shells, shell)
+			return shell
+		}
+
+		cmd = SSHAllCommand{
+			UI:                 testUI,
+			Config:             fakeConfig,
+			SharedActor:        fakeSharedActor,
+			Actor:              fakeActor,
+			SecureShellFactory: fakeFactory,
+			Stdout:             stdout,
+			Stderr:             stderr,
+			Command:            "uptime",
+			Concurrency:        10,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.KnownHostsFilePathReturns("/home/some-user/.cf/known_hosts")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app", Instances: 3},
+			v2action.Warnings{"get-app-warning"}, nil)
+		fakeActor.GetSSHAuthenticationReturns(v2action.SSHAuthentication{Passcode: "some-passcode"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when no command is given", func() {
+		BeforeEach(func() {
+			cmd.Command = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "-c"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the concurrency is less than 1", func() {
+		BeforeEach(func() {
+			cmd.Concurrency = 0
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--concurrency",
+				ExpectedType: "integer greater than 0",
+			}))
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrganizationRequired).To(BeTrue())
+			Expect(targetedSpaceRequired).To(BeTrue())
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, v2action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+		})
+	})
+
+	It("runs the command on every instance", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Running uptime on 3 instances of app some-app in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Err).To(Say("get-app-warning"))
+
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(fakeActor.GetSSHAuthenticationCallCount()).To(Equal(3))
+		Expect(fakeFactory.NewSecureShellCallCount()).To(Equal(3))
+		for index := uint(0); index < 3; index++ {
+			shell := shellForIndex(index)
+			Expect(shell).ToNot(BeNil())
+			Expect(shell.ConnectArgsForCall(0)).To(Equal(&options.SSHOptions{
+				AppName:        "some-app",
+				Index:          index,
+				KnownHostsFile: "/home/some-user/.cf/known_hosts",
+			}))
+			command, _, _ := shell.RunArgsForCall(0)
+			Expect(command).To(Equal("uptime"))
+			Expect(shell.CloseCallCount()).To(Equal(1))
+		}
+	})
+
+	It("prefixes the output with the instance index", func() {
+		output := string(stdout.Contents())
+		Expect(output).To(ContainSubstring("[0] hello from 0\n"))
+		Expect(output).To(ContainSubstring("[1] hello from 1\n"))
+		Expect(output).To(ContainSubstring("[2] hello from 2\n"))
+	})
+
+	It("displays the result of every instance", func() {
+		Expect(testUI.Out).To(Say(`instance\s+result`))
+		Expect(testUI.Out).To(Say(`#0\s+exit status 0`))
+		Expect(testUI.Out).To(Say(`#1\s+exit status 0`))
+		Expect(testUI.Out).To(Say(`#2\s+exit status 0`))
+		Expect(testUI.Out).To(Say("OK"))
+	})
+
+	Context("when instances are selected", func() {
+		BeforeEach(func() {
+			cmd.AppInstanceIndexes = []int{2, 0, 2}
+			cmd.SkipHostValidation = true
+			cmd.ResetHostKey = true
+		})
+
+		It("runs the command once on each selected instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Running uptime on 2 instances"))
+			Expect(fakeFactory.NewSecureShellCallCount()).To(Equal(2))
+			Expect(shellForIndex(1)).To(BeNil())
+			Expect(shellForIndex(2).ConnectArgsForCall(0).SkipHostValidation).To(BeTrue())
+			Expect(shellForIndex(2).ConnectArgsForCall(0).ResetHostKey).To(BeTrue())
+		})
+
+		Context("when a selected instance does not exist", func() {
+			BeforeEach(func() {
+				cmd.AppInstanceIndexes = []int{0, 3}
+			})
+
+			It("returns an ApplicationInstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationInstanceNotFoundError{AppName: "some-app", InstanceIndex: 3}))
+				Expect(fakeFactory.NewSecureShellCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the command fails on some instances", func() {
+		BeforeEach(func() {
+			runStub = func(index uint, stdout io.Writer, stderr io.Writer) error {
+				switch index {
+				case 1:
+					fmt.Fprint(stderr, "no such file")
+					return fakeExitError{status: 2}
+				case 2:
+					return errors.New("session closed")
+				}
+				return nil
+			}
+		})
+
+		It("displays each result and returns an SSHCommandFailedError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedError{FailedCount: 2, InstanceCount: 3}))
+
+			Expect(stderr).To(Say(`\[1\] no such file\n`))
+			Expect(testUI.Out).To(Say(`#0\s+exit status 0`))
+			Expect(testUI.Out).To(Say(`#1\s+exit status 2`))
+			Expect(testUI.Out).To(Say(`#2\s+session closed`))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	Context("when connecting to an instance fails", func() {
+		BeforeEach(func() {
+			cmd.AppInstanceIndexes = []int{0}
+			fakeFactory.NewSecureShellStub = nil
+			fakeShell := new(sshfakes.FakeSecureShell)
+			fakeShell.ConnectReturns(errors.New("app not started"))
+			fakeFactory.NewSecureShellReturns(fakeShell)
+		})
+
+		It("reports the connection failure", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedError{FailedCount: 1, InstanceCount: 1}))
+			Expect(testUI.Out).To(Say(`#0\s+connection failed: app not started`))
+		})
+	})
+
+	Context("when getting the ssh authentication fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSSHAuthenticationReturns(v2action.SSHAuthentication{}, errors.New("uaa down"))
+		})
+
+		It("reports the failure for every instance", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedError{FailedCount: 3, InstanceCount: 3}))
+			Expect(testUI.Out).To(Say(`#0\s+uaa down`))
+			Expect(fakeFactory.NewSecureShellCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the concurrency is limited", func() {
+		var (
+			running    chan struct{}
+			maxRunning int
+		)
+
+		BeforeEach(func() {
+			cmd.Concurrency = 2
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v2action.Application{GUID: "some-app-guid", Name: "some-app", Instances: 6}, nil, nil)
+
+			running = make(chan struct{}, 6)
+			maxRunning = 0
+			var lock sync.Mutex
+			runStub = func(uint, io.Writer, io.Writer) error {
+				running <- struct{}{}
+				lock.Lock()
+				if len(running) > maxRunning {
+					maxRunning = len(running)
+				}
+				lock.Unlock()
+				<-running
+				return nil
+			}
+		})
+
+		It("never runs on more instances at the same time", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeFactory.NewSecureShellCallCount()).To(Equal(6))
+			Expect(maxRunning).To(BeNumerically("<=", 2))
+		})
+	})
+
+	Context("when the command does not exit before the timeout", func() {
+		BeforeEach(func() {
+			cmd.AppInstanceIndexes = []int{0}
+			cmd.Timeout = 1
+
+			closed := make(chan struct{})
+			var closeOnce sync.Once
+			fakeShell := new(sshfakes.FakeSecureShell)
+			fakeShell.RunStub = func(command string, stdout io.Writer, stderr io.Writer) error {
+				fmt.Fprint(stdout, "partial")
+				<-closed
+				return errors.New("connection closed")
+			}
+			fakeShell.CloseStub = func() error {
+				closeOnce.Do(func() { close(closed) })
+				return nil
+			}
+			fakeFactory.NewSecureShellStub = nil
+			fakeFactory.NewSecureShellReturns(fakeShell)
+		})
+
+		It("closes the connection and reports the timeout", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedError{FailedCount: 1, InstanceCount: 1}))
+			Expect(stdout).To(Say(`\[0\] partial\n`))
+			Expect(testUI.Out).To(Say(`#0\s+timed out after 1 seconds`))
+		})
+	})
+
+	Context("when connecting does not finish before the timeout", func() {
+		var (
+			fakeShell      *sshfakes.FakeSecureShell
+			releaseConnect chan struct{}
+		)
+
+		BeforeEach(func() {
+			cmd.AppInstanceIndexes = []int{0}
+			cmd.Timeout = 1
+
+			release := make(chan struct{})
+			releaseConnect = release
+			fakeShell = new(sshfakes.FakeSecureShell)
+			fakeShell.ConnectStub = func(*options.SSHOptions) error {
+				<-release
+				return nil
+			}
+			fakeFactory.NewSecureShellStub = nil
+			fakeFactory.NewSecureShellReturns(fakeShell)
+		})
+
+		AfterEach(func() {
+			select {
+			case <-releaseConnect:
+			default:
+				close(releaseConnect)
+			}
+		})
+
+		It("reports the timeout without waiting for the connection", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedError{FailedCount: 1, InstanceCount: 1}))
+			Expect(testUI.Out).To(Say(`#0\s+timed out after 1 seconds`))
+		})
+
+		It("closes the connection without running the command once it is established", func() {
+			close(releaseConnect)
+
+			Eventually(fakeShell.CloseCallCount).Should(Equal(1))
+			Expect(fakeShell.RunCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when connecting times out while other instances are waiting", func() {
+		var (
+			connectLock            sync.Mutex
+			connectCount           int
+			firstConnectReturned   bool
+			startedAfterConnection bool
+		)
+
+		BeforeEach(func() {
+			cmd.AppInstanceIndexes = []int{0, 1}
+			cmd.Concurrency = 1
+			cmd.Timeout = 1
+
+			connectCount = 0
+			firstConnectReturned = false
+			startedAfterConnection = false
+			fakeFactory.NewSecureShellStub = func(v2action.Application, v2action.SSHAuthentication) sshCmd.SecureShell {
+				shell := new(sshfakes.FakeSecureShell)
+				shell.ConnectStub = func(*options.SSHOptions) error {
+					connectLock.Lock()
+					connectCount++
+					first := connectCount == 1
+					startedAfterConnection = firstConnectReturned
+					connectLock.Unlock()
+
+					if first {
+						time.Sleep(1500 * time.Millisecond)
+						connectLock.Lock()
+						firstConnectReturned = true
+						connectLock.Unlock()
+					}
+					return nil
+				}
+				return shell
+			}
+		})
+
+		It("keeps the slot of the timed out instance until its connection is done", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedError{FailedCount: 1, InstanceCount: 2}))
+			Expect(testUI.Out).To(Say(`timed out after 1 seconds`))
+
+			connectLock.Lock()
+			defer connectLock.Unlock()
+			Expect(connectCount).To(Equal(2))
+			Expect(startedAfterConnection).To(BeTrue())
+		})
+	})
+
+	Context("when getting the one-time passcode does not finish before the timeout", func() {
+		var releaseAuthentication chan struct{}
+
+		BeforeEach(func() {
+			cmd.AppInstanceIndexes = []int{0}
+			cmd.Timeout = 1
+
+			release := make(chan struct{})
+			releaseAuthentication = release
+			fakeActor.GetSSHAuthenticationStub = func() (v2action.SSHAuthentication, error) {
+				<-release
+				return v2action.SSHAuthentication{}, nil
+			}
+		})
+
+		AfterEach(func() {
+			close(releaseAuthentication)
+		})
+
+		It("reports the timeout", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedError{FailedCount: 1, InstanceCount: 1}))
+			Expect(testUI.Out).To(Say(`#0\s+timed out after 1 seconds`))
+		})
+	})
+})
@@ -0,0 +1,108 @@
+package v2
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . SSHConfigActor
+
+type SSHConfigActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+}
+
+type SSHConfigCommand struct {
+	RequiredArgs       flag.AppName `positional-args:"yes"`
+	AppInstanceIndex   int          `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
+	Host               string       `long:"host" description:"Host name to use with ssh (Default: APP_NAME)"`
+	SkipHostValidation bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
+	usage              interface{}  `usage:"CF_NAME ssh-config APP_NAME [-i app-instance-index] [--host HOST] [--skip-host-validation]\n\n   Prints an OpenSSH configuration block for the app instance. The block connects through CF_NAME, which authenticates with a one-time passcode, verifies the SSH proxy host key and relays the ssh session to the app instance, so ssh, scp, rsync and other OpenSSH tools can reach the app instance. ssh verifies CF_NAME with a host key that the block pins and does not authenticate itself.\n\nEXAMPLES:\n   CF_NAME ssh-config my-app >> ~/.ssh/config\n   ssh my-app\n   rsync -av ./assets my-app:app/public"`
+	relatedCommands    interface{}  `related_commands:"scp, sftp, ssh"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SSHConfigActor
+	BinaryPath  string
+}
+
+func (cmd *SSHConfigCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	cmd.BinaryPath, err = os.Executable()
+	if err != nil {
+		cmd.BinaryPath = os.Args[0]
+	}
+
+	return nil
+}
+
+// Execute only displays the configuration block on standard output, so that
+// it can be appended to an ssh configuration file.
+func (cmd SSHConfigCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.AppInstanceIndex < 0 || cmd.AppInstanceIndex >= app.Instances {
+		return translatableerror.ApplicationInstanceNotFoundError{AppName: app.Name, InstanceIndex: cmd.AppInstanceIndex}
+	}
+
+	host := cmd.Host
+	if host == "" {
+		host = app.Name
+	}
+
+	err = sshCmd.EnsureRelayHostKey(cmd.Config.SSHRelayHostKeyFilePath(), cmd.Config.SSHRelayKnownHostsFilePath())
+	if err != nil {
+		return err
+	}
+
+	proxyCommand := []string{quoteSSHConfigArg(cmd.BinaryPath), "ssh-proxy-command", app.GUID, "-i", fmt.Sprint(cmd.AppInstanceIndex)}
+	if cmd.SkipHostValidation {
+		proxyCommand = append(proxyCommand, "--skip-host-validation")
+	}
+
+	// ssh talks to the relay in the proxy command rather than to the app
+	// instance, so it checks the relay host key, which is the same for every
+	// app and pinned under a single alias.
+	fmt.Fprintf(cmd.UI.Writer(), "Host %s\n", host)
+	fmt.Fprintf(cmd.UI.Writer(), "  User vcap\n")
+	fmt.Fprintf(cmd.UI.Writer(), "  ProxyCommand %s\n", strings.Join(proxyCommand, " "))
+	fmt.Fprintf(cmd.UI.Writer(), "  HostKeyAlias %s\n", sshCmd.RelayHostKeyAlias)
+	fmt.Fprintf(cmd.UI.Writer(), "  UserKnownHostsFile %s\n", quoteSSHConfigArg(cmd.Config.SSHRelayKnownHostsFilePath()))
+	fmt.Fprintf(cmd.UI.Writer(), "  StrictHostKeyChecking yes\n")
+
+	return nil
+}
+
+func quoteSSHConfigArg(arg string) string {
+	if strings.ContainsAny(arg, " \t") {
+		return `"` + arg + `"`
+	}
+	return arg
+}
@@ -0,0 +1,170 @@
+package v2_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+var _ = Describe("ssh-config Command", func() {
+	var (
+		cmd             SSHConfigCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSSHConfigActor
+		configDir       string
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSSHConfigActor)
+
+		cmd = SSHConfigCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			BinaryPath:  "/usr/local/bin/cf",
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		var err error
+		configDir, err = ioutil.TempDir("", "ssh-config")
+		Expect(err).ToNot(HaveOccurred())
+		fakeConfig.SSHRelayHostKeyFilePathReturns(filepath.Join(configDir, "ssh_relay_host_key"))
+		fakeConfig.SSHRelayKnownHostsFilePathReturns(filepath.Join(configDir, "ssh_relay_known_hosts"))
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app", Instances: 2},
+			v2action.Warnings{"get-app-warning"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(configDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrganizationRequired).To(BeTrue())
+			Expect(targetedSpaceRequired).To(BeTrue())
+		})
+	})
+
+	It("displays a host block that connects through the proxy command", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(`Host some-app
+  User vcap
+  ProxyCommand /usr/local/bin/cf ssh-proxy-command some-app-guid -i 0
+  HostKeyAlias cf-ssh-relay
+  UserKnownHostsFile ` + filepath.Join(configDir, "ssh_relay_known_hosts") + `
+  StrictHostKeyChecking yes
+`))
+		Expect(testUI.Err).To(Say("get-app-warning"))
+	})
+
+	It("pins the relay host key in the known hosts file used by the block", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		hostKey, err := sshCmd.LoadRelayHostKey(filepath.Join(configDir, "ssh_relay_host_key"))
+		Expect(err).ToNot(HaveOccurred())
+
+		knownHosts, err := ioutil.ReadFile(filepath.Join(configDir, "ssh_relay_known_hosts"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(knownHosts)).To(Equal(knownhosts.Line([]string{"cf-ssh-relay"}, hostKey.PublicKey()) + "\n"))
+	})
+
+	Context("when the relay host key already exists", func() {
+		var existingKey []byte
+
+		BeforeEach(func() {
+			Expect(sshCmd.EnsureRelayHostKey(filepath.Join(configDir, "ssh_relay_host_key"), filepath.Join(configDir, "other_known_hosts"))).To(Succeed())
+
+			var err error
+			existingKey, err = ioutil.ReadFile(filepath.Join(configDir, "ssh_relay_host_key"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("keeps using it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			key, err := ioutil.ReadFile(filepath.Join(configDir, "ssh_relay_host_key"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key).To(Equal(existingKey))
+		})
+	})
+
+	Context("when a host, instance and skipping host validation are requested", func() {
+		BeforeEach(func() {
+			cmd.Host = "worker-1"
+			cmd.AppInstanceIndex = 1
+			cmd.SkipHostValidation = true
+			cmd.BinaryPath = `C:\Program Files\cf\cf.exe`
+		})
+
+		It("uses them in the host block", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Host worker-1\n`))
+			Expect(testUI.Out).To(Say(`  ProxyCommand "C:\\Program Files\\cf\\cf\.exe" ssh-proxy-command some-app-guid -i 1 --skip-host-validation\n`))
+		})
+	})
+
+	Context("when the instance does not exist", func() {
+		BeforeEach(func() {
+			cmd.AppInstanceIndex = 2
+		})
+
+		It("returns an ApplicationInstanceNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationInstanceNotFoundError{AppName: "some-app", InstanceIndex: 2}))
+			Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, v2action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package v2
+
+import (
+	"io"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . SSHProxyCommandActor
+
+type SSHProxyCommandActor interface {
+	GetApplication(guid string) (v2action.Application, v2action.Warnings, error)
+	GetSSHAuthentication() (v2action.SSHAuthentication, error)
+}
+
+type SSHProxyCommandCommand struct {
+	RequiredArgs       flag.AppGUID `positional-args:"yes"`
+	AppInstanceIndex   int          `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
+	ResetHostKey       bool         `long:"reset-host-key" description:"Replace the pinned host key of the SSH proxy with the key it presents"`
+	SkipHostValidation bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
+	usage              interface{}  `usage:"CF_NAME ssh-proxy-command APP_GUID [-i app-instance-index] [--skip-host-validation] [--reset-host-key]"`
+
+	UI                 command.UI
+	Config             command.Config
+	SharedActor        command.SharedActor
+	Actor              SSHProxyCommandActor
+	SecureShellFactory SecureShellFactory
+	Stdin              io.Reader
+	Stdout             io.Writer
+}
+
+func (cmd *SSHProxyCommandCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+	cmd.SecureShellFactory = shared.SecureShellFactory{}
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+
+	return nil
+}
+
+// Execute serves the ssh connection of an OpenSSH client on standard input
+// and output, and relays its sessions to the app instance through an SSH
+// proxy connection whose host key has been verified. It is the ProxyCommand
+// of the blocks printed by ssh-config.
+func (cmd SSHProxyCommandCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	app, warnings, err := cmd.Actor.GetApplication(cmd.RequiredArgs.AppGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.AppInstanceIndex < 0 || cmd.AppInstanceIndex >= app.Instances {
+		return translatableerror.ApplicationInstanceNotFoundError{AppName: app.Name, InstanceIndex: cmd.AppInstanceIndex}
+	}
+
+	hostKey, err := sshCmd.LoadRelayHostKey(cmd.Config.SSHRelayHostKeyFilePath())
+	if err != nil {
+		return err
+	}
+
+	auth, err := cmd.Actor.GetSSHAuthentication()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	secureShell := cmd.SecureShellFactory.NewSecureShell(app, auth)
+	err = secureShell.Connect(&options.SSHOptions{
+		AppName:            app.Name,
+		Index:              uint(cmd.AppInstanceIndex),
+		SkipHostValidation: cmd.SkipHostValidation,
+		KnownHostsFile:     cmd.Config.KnownHostsFilePath(),
+		ResetHostKey:       cmd.ResetHostKey,
+	})
+	if err != nil {
+		return translatableerror.SSHConnectionError{Message: err.Error()}
+	}
+	defer secureShell.Close()
+
+	return secureShell.Relay(hostKey, cmd.Stdin, cmd.Stdout)
+}
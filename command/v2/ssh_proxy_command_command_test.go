@@ -0,0 +1,193 @@
+package v2_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ssh-proxy-command Command", func() {
+	var (
+		cmd             SSHProxyCommandCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSSHProxyCommandActor
+		fakeFactory     *v2fakes.FakeSecureShellFactory
+		fakeSecureShell *sshfakes.FakeSecureShell
+		stdin           *strings.Reader
+		stdout          *bytes.Buffer
+		configDir       string
+		hostKeyPath     string
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSSHProxyCommandActor)
+		fakeFactory = new(v2fakes.FakeSecureShellFactory)
+		fakeSecureShell = new(sshfakes.FakeSecureShell)
+		fakeFactory.NewSecureShellReturns(fakeSecureShell)
+		stdin = strings.NewReader("")
+		stdout = &bytes.Buffer{}
+
+		cmd = SSHProxyCommandCommand{
+			UI:                 testUI,
+			Config:             fakeConfig,
+			SharedActor:        fakeSharedActor,
+			Actor:              fakeActor,
+			SecureShellFactory: fakeFactory,
+			Stdin:              stdin,
+			Stdout:             stdout,
+		}
+		cmd.RequiredArgs.AppGUID = "some-app-guid"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.KnownHostsFilePathReturns("/home/some-user/.cf/known_hosts")
+
+		var err error
+		configDir, err = ioutil.TempDir("", "ssh-proxy-command")
+		Expect(err).ToNot(HaveOccurred())
+		hostKeyPath = filepath.Join(configDir, "ssh_relay_host_key")
+		Expect(sshCmd.EnsureRelayHostKey(hostKeyPath, filepath.Join(configDir, "ssh_relay_known_hosts"))).To(Succeed())
+		fakeConfig.SSHRelayHostKeyFilePathReturns(hostKeyPath)
+
+		fakeActor.GetApplicationReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app", Instances: 2},
+			v2action.Warnings{"get-app-warning"}, nil)
+		fakeActor.GetSSHAuthenticationReturns(v2action.SSHAuthentication{Passcode: "some-passcode"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(configDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error without requiring a target", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			_, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrganizationRequired).To(BeFalse())
+			Expect(targetedSpaceRequired).To(BeFalse())
+		})
+	})
+
+	It("relays the ssh connection on standard input and output to the instance", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.GetApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+		app, auth := fakeFactory.NewSecureShellArgsForCall(0)
+		Expect(app.GUID).To(Equal("some-app-guid"))
+		Expect(auth.Passcode).To(Equal("some-passcode"))
+
+		Expect(fakeSecureShell.ConnectArgsForCall(0)).To(Equal(&options.SSHOptions{
+			AppName:        "some-app",
+			KnownHostsFile: "/home/some-user/.cf/known_hosts",
+		}))
+		Expect(fakeSecureShell.RelayCallCount()).To(Equal(1))
+		hostKey, in, out := fakeSecureShell.RelayArgsForCall(0)
+		expectedHostKey, err := sshCmd.LoadRelayHostKey(hostKeyPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hostKey.PublicKey().Marshal()).To(Equal(expectedHostKey.PublicKey().Marshal()))
+		Expect(in).To(Equal(stdin))
+		Expect(out).To(Equal(stdout))
+		Expect(fakeSecureShell.CloseCallCount()).To(Equal(1))
+	})
+
+	It("only displays warnings, on standard error", func() {
+		Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+		Expect(testUI.Err).To(Say("get-app-warning"))
+	})
+
+	Context("when a different instance, skipping host validation and resetting the host key are requested", func() {
+		BeforeEach(func() {
+			cmd.AppInstanceIndex = 1
+			cmd.SkipHostValidation = true
+			cmd.ResetHostKey = true
+		})
+
+		It("connects to that instance", func() {
+			Expect(fakeSecureShell.ConnectArgsForCall(0)).To(Equal(&options.SSHOptions{
+				AppName:            "some-app",
+				Index:              1,
+				SkipHostValidation: true,
+				KnownHostsFile:     "/home/some-user/.cf/known_hosts",
+				ResetHostKey:       true,
+			}))
+		})
+	})
+
+	Context("when the instance does not exist", func() {
+		BeforeEach(func() {
+			cmd.AppInstanceIndex = 2
+		})
+
+		It("returns an ApplicationInstanceNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationInstanceNotFoundError{AppName: "some-app", InstanceIndex: 2}))
+			Expect(fakeActor.GetSSHAuthenticationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the relay host key does not exist", func() {
+		BeforeEach(func() {
+			Expect(os.Remove(hostKeyPath)).To(Succeed())
+		})
+
+		It("returns the error without connecting", func() {
+			Expect(os.IsNotExist(executeErr)).To(BeTrue())
+			Expect(fakeActor.GetSSHAuthenticationCallCount()).To(Equal(0))
+			Expect(fakeSecureShell.ConnectCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when connecting fails", func() {
+		BeforeEach(func() {
+			fakeSecureShell.ConnectReturns(errors.New("Host key verification failed."))
+		})
+
+		It("returns an SSHConnectionError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SSHConnectionError{Message: "Host key verification failed."}))
+			Expect(fakeSecureShell.RelayCallCount()).To(Equal(0))
+			Expect(fakeSecureShell.CloseCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the relay fails", func() {
+		BeforeEach(func() {
+			fakeSecureShell.RelayReturns(errors.New("ssh: handshake failed"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("ssh: handshake failed"))
+		})
+	})
+})
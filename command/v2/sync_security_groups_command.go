@@ -0,0 +1,135 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . SyncSecurityGroupsActor
+
+type SyncSecurityGroupsActor interface {
+	ApplySecurityGroupChange(change v2action.SecurityGroupChange) (v2action.Warnings, error)
+	CloudControllerAPIVersion() string
+	GetSecurityGroupChanges(document v2action.SecurityGroupsDocument, includeStaging bool) ([]v2action.SecurityGroupChange, v2action.Warnings, error)
+}
+
+type SyncSecurityGroupsCommand struct {
+	RequiredArgs    flag.SecurityGroupsFileArgs `positional-args:"yes"`
+	DryRun          bool                        `long:"dry-run" description:"Display the changes without applying them"`
+	usage           interface{}                 `usage:"CF_NAME sync-security-groups FILE [--dry-run]\n\n   Creates, updates, binds and unbinds security groups to match the YAML document written by export-security-groups.\n   Security groups that are not declared in the document are left untouched.\n\nEXAMPLES:\n   CF_NAME sync-security-groups security-groups.yml --dry-run"`
+	relatedCommands interface{}                 `related_commands:"export-security-groups, security-groups"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SyncSecurityGroupsActor
+}
+
+func (cmd *SyncSecurityGroupsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd SyncSecurityGroupsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	document, err := v2action.ReadSecurityGroupsDocument(string(cmd.RequiredArgs.Path))
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.DryRun {
+		cmd.UI.DisplayTextWithFlavor("Comparing security groups with {{.Path}} as {{.Username}}...", map[string]interface{}{
+			"Path":     cmd.RequiredArgs.Path,
+			"Username": user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Syncing security groups with {{.Path}} as {{.Username}}...", map[string]interface{}{
+			"Path":     cmd.RequiredArgs.Path,
+			"Username": user.Name,
+		})
+	}
+
+	includeStaging := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), command.MinVersionLifecyleStagingV2) == nil
+
+	changes, warnings, err := cmd.Actor.GetSecurityGroupChanges(document, includeStaging)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(changes) == 0 {
+		cmd.UI.DisplayText("Security groups are already in sync.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	for _, change := range changes {
+		cmd.displayChange(change)
+		if cmd.DryRun {
+			continue
+		}
+
+		warnings, err = cmd.Actor.ApplySecurityGroupChange(change)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	if cmd.DryRun {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Dry run: {{.Count}} change(s) not applied.", map[string]interface{}{
+			"Count": len(changes),
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd SyncSecurityGroupsCommand) displayChange(change v2action.SecurityGroupChange) {
+	templateValues := map[string]interface{}{
+		"SecurityGroupName": change.SecurityGroupName,
+		"Lifecycle":         change.Lifecycle,
+		"OrgName":           change.OrganizationName,
+		"SpaceName":         change.SpaceName,
+		"Count":             len(change.Rules),
+	}
+
+	switch {
+	case change.Type == v2action.SecurityGroupCreate:
+		cmd.UI.DisplayText("  create security group {{.SecurityGroupName}} with {{.Count}} rule(s)", templateValues)
+	case change.Type == v2action.SecurityGroupUpdate:
+		cmd.UI.DisplayText("  update security group {{.SecurityGroupName}} to {{.Count}} rule(s)", templateValues)
+	case change.Type == v2action.SecurityGroupBind && change.Global():
+		cmd.UI.DisplayText("  bind security group {{.SecurityGroupName}} to all spaces ({{.Lifecycle}})", templateValues)
+	case change.Type == v2action.SecurityGroupBind:
+		cmd.UI.DisplayText("  bind security group {{.SecurityGroupName}} to space {{.SpaceName}} in org {{.OrgName}} ({{.Lifecycle}})", templateValues)
+	case change.Type == v2action.SecurityGroupUnbind && change.Global():
+		cmd.UI.DisplayText("  unbind security group {{.SecurityGroupName}} from all spaces ({{.Lifecycle}})", templateValues)
+	case change.Type == v2action.SecurityGroupUnbind:
+		cmd.UI.DisplayText("  unbind security group {{.SecurityGroupName}} from space {{.SpaceName}} in org {{.OrgName}} ({{.Lifecycle}})", templateValues)
+	}
+}
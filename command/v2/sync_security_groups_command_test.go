@@ -0,0 +1,202 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("sync-security-groups Command", func() {
+	var (
+		cmd             SyncSecurityGroupsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSyncSecurityGroupsActor
+		binaryName      string
+		documentPath    string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSyncSecurityGroupsActor)
+
+		file, err := ioutil.TempFile("", "sync-security-groups")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = file.WriteString(`security_groups:
+- name: dns
+  rules:
+  - protocol: udp
+    destination: 10.0.0.1
+    ports: "53"
+  running_spaces:
+  - org: some-org
+    space: some-space
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(file.Close()).To(Succeed())
+		documentPath = file.Name()
+
+		cmd = SyncSecurityGroupsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Path = flag.PathWithExistenceCheck(documentPath)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(command.MinVersionLifecyleStagingV2)
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(documentPath)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.GetSecurityGroupChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the file declares invalid security groups", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(documentPath, []byte("security_groups:\n- rules: []\n"), 0600)).To(Succeed())
+		})
+
+		It("returns a translatable error without comparing", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidSecurityGroupsDocumentError{
+				Path:     documentPath,
+				Problems: []string{"security group 1: name is required"},
+			}))
+			Expect(fakeActor.GetSecurityGroupChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when there are changes", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecurityGroupChangesReturns(
+				[]v2action.SecurityGroupChange{
+					{Type: v2action.SecurityGroupCreate, SecurityGroupName: "dns", Rules: []ccv2.SecurityGroupRule{{Protocol: "udp", Destination: "10.0.0.1", Ports: "53"}}},
+					{Type: v2action.SecurityGroupBind, SecurityGroupName: "dns", Lifecycle: ccv2.SecurityGroupLifecycleRunning, OrganizationName: "some-org", SpaceName: "some-space"},
+					{Type: v2action.SecurityGroupUnbind, SecurityGroupName: "dns", Lifecycle: ccv2.SecurityGroupLifecycleStaging},
+				},
+				v2action.Warnings{"changes-warning"},
+				nil)
+			fakeActor.ApplySecurityGroupChangeReturns(v2action.Warnings{"apply-warning"}, nil)
+		})
+
+		It("compares the declared security groups with the existing ones", func() {
+			Expect(fakeActor.GetSecurityGroupChangesCallCount()).To(Equal(1))
+			document, includeStaging := fakeActor.GetSecurityGroupChangesArgsForCall(0)
+			Expect(document.SecurityGroups).To(HaveLen(1))
+			Expect(document.SecurityGroups[0].Name).To(Equal("dns"))
+			Expect(includeStaging).To(BeTrue())
+		})
+
+		It("displays and applies every change", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Syncing security groups with %s as some-user...", documentPath))
+			Expect(testUI.Out).To(Say(`create security group dns with 1 rule\(s\)`))
+			Expect(testUI.Out).To(Say(`bind security group dns to space some-space in org some-org \(running\)`))
+			Expect(testUI.Out).To(Say(`unbind security group dns from all spaces \(staging\)`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("changes-warning"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+
+			Expect(fakeActor.ApplySecurityGroupChangeCallCount()).To(Equal(3))
+			Expect(fakeActor.ApplySecurityGroupChangeArgsForCall(1).SpaceName).To(Equal("some-space"))
+		})
+
+		Context("when --dry-run is provided", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("displays the changes without applying them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Comparing security groups with %s as some-user...", documentPath))
+				Expect(testUI.Out).To(Say(`create security group dns with 1 rule\(s\)`))
+				Expect(testUI.Out).To(Say(`Dry run: 3 change\(s\) not applied.`))
+				Expect(fakeActor.ApplySecurityGroupChangeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when applying a change fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("apply error")
+				fakeActor.ApplySecurityGroupChangeReturnsOnCall(1, v2action.Warnings{"apply-warning"}, expectedErr)
+			})
+
+			It("stops at the failed change", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeActor.ApplySecurityGroupChangeCallCount()).To(Equal(2))
+				Expect(testUI.Out).ToNot(Say("unbind security group"))
+			})
+		})
+	})
+
+	Context("when the API does not support staging space bindings", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("2.67.0")
+		})
+
+		It("compares without staging space bindings", func() {
+			_, includeStaging := fakeActor.GetSecurityGroupChangesArgsForCall(0)
+			Expect(includeStaging).To(BeFalse())
+		})
+	})
+
+	Context("when there are no changes", func() {
+		It("displays that the security groups are in sync", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Security groups are already in sync."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Context("when comparing fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("changes error")
+			fakeActor.GetSecurityGroupChangesReturns(nil, v2action.Warnings{"changes-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("changes-warning"))
+		})
+	})
+})
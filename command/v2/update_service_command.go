@@ -1,27 +1,110 @@
 package v2
 
 import (
-	"os"
+	"time"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . UpdateServiceActor
+
+type UpdateServiceActor interface {
+	GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	GetServicePlanByNameAndServiceInstance(planName string, serviceInstance v2action.ServiceInstance) (v2action.ServicePlan, v2action.Warnings, error)
+	PollServiceInstanceOperation(serviceInstance v2action.ServiceInstance, timeout time.Duration) (<-chan v2action.ServiceInstance, <-chan string, <-chan error)
+	UpdateServiceInstance(serviceInstance v2action.ServiceInstance, servicePlanGUID string, parameters map[string]interface{}, tags *[]string) (v2action.ServiceInstance, v2action.Warnings, error)
+}
+
 type UpdateServiceCommand struct {
-	RequiredArgs     flag.ServiceInstance `positional-args:"yes"`
-	ParametersAsJSON flag.Path            `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
-	Plan             string               `short:"p" description:"Change service plan for a service instance"`
-	Tags             string               `short:"t" description:"User provided tags"`
-	usage            interface{}          `usage:"CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON] [-t TAGS]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line.\n   CF_NAME update-service -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. \n   The path to the parameters file can be an absolute or relative path to a file.\n   CF_NAME update-service -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"cluster_nodes\": {\n         \"count\": 5,\n         \"memory_mb\": 1024\n      }\n   }\n\n   Optionally provide a list of comma-delimited tags that will be written to the VCAP_SERVICES environment variable for any bound applications.\n\nEXAMPLES:\n   CF_NAME update-service mydb -p gold\n   CF_NAME update-service mydb -c '{\"ram_gb\":4}'\n   CF_NAME update-service mydb -c ~/workspace/tmp/instance_config.json\n   CF_NAME update-service mydb -t \"list, of, tags\""`
-	relatedCommands  interface{}          `related_commands:"rename-service, services, update-user-provided-service"`
+	RequiredArgs     flag.ServiceInstance          `positional-args:"yes"`
+	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
+	Plan             string                        `short:"p" description:"Change service plan for a service instance"`
+	Tags             flag.Tags                     `short:"t" description:"User provided tags"`
+	Wait             bool                          `long:"wait" description:"Wait for the service broker to finish updating the service instance, showing each change of its status"`
+	Timeout          int                           `long:"timeout" description:"Seconds to wait when using --wait before giving up (Default: no limit)"`
+	usage            interface{}                   `usage:"CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON] [-t TAGS] [--wait [--timeout SECONDS]]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line.\n   CF_NAME update-service -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. \n   The path to the parameters file can be an absolute or relative path to a file.\n   CF_NAME update-service -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"cluster_nodes\": {\n         \"count\": 5,\n         \"memory_mb\": 1024\n      }\n   }\n\n   Optionally provide a list of comma-delimited tags that will be written to the VCAP_SERVICES environment variable for any bound applications.\n\nEXAMPLES:\n   CF_NAME update-service mydb -p gold\n   CF_NAME update-service mydb -c '{\"ram_gb\":4}'\n   CF_NAME update-service mydb -c ~/workspace/tmp/instance_config.json\n   CF_NAME update-service mydb -t \"list, of, tags\"\n   CF_NAME update-service mydb -p gold --wait --timeout 600"`
+	relatedCommands  interface{}                   `related_commands:"rename-service, services, update-user-provided-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UpdateServiceActor
 }
 
-func (UpdateServiceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *UpdateServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (UpdateServiceCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
-	return nil
+func (cmd UpdateServiceCommand) Execute(args []string) error {
+	if cmd.Timeout < 0 {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--timeout",
+			ExpectedType: "integer greater than or equal to 0",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Plan == "" && cmd.ParametersAsJSON == nil && !cmd.Tags.IsSet {
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayText("No changes were made")
+		return nil
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	instance, warnings, err := cmd.Actor.GetServiceInstanceByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	var planGUID string
+	if cmd.Plan != "" {
+		plan, planWarnings, planErr := cmd.Actor.GetServicePlanByNameAndServiceInstance(cmd.Plan, instance)
+		cmd.UI.DisplayWarnings(planWarnings)
+		if planErr != nil {
+			return shared.HandleError(planErr)
+		}
+		planGUID = plan.GUID
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating service instance {{.ServiceName}} as {{.UserName}}...", map[string]interface{}{
+		"ServiceName": cmd.RequiredArgs.ServiceInstance,
+		"UserName":    user.Name,
+	})
+
+	var tags *[]string
+	if cmd.Tags.IsSet {
+		tags = &cmd.Tags.Value
+	}
+
+	updatedInstance, warnings, err := cmd.Actor.UpdateServiceInstance(instance, planGUID, cmd.ParametersAsJSON, tags)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	return displayServiceInstanceOperation(cmd.UI, cmd.Config, cmd.Actor, updatedInstance, cmd.Wait, cmd.Timeout)
 }
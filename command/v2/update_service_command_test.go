@@ -0,0 +1,365 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-service Command", func() {
+	var (
+		cmd             UpdateServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUpdateServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUpdateServiceActor)
+
+		cmd = UpdateServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.ServiceInstance = "some-instance"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the timeout is negative", func() {
+		BeforeEach(func() {
+			cmd.Wait = true
+			cmd.Timeout = -1
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--timeout",
+				ExpectedType: "integer greater than or equal to 0",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and an org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+				GUID: "some-org-guid",
+				Name: "some-org",
+			})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{
+				GUID: "some-space-guid",
+				Name: "some-space",
+			})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+			fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+				v2action.ServiceInstance{GUID: "some-instance-guid", Name: "some-instance"},
+				v2action.Warnings{"get-warning"},
+				nil)
+			fakeActor.UpdateServiceInstanceReturns(
+				v2action.ServiceInstance{
+					GUID:          "some-instance-guid",
+					Name:          "some-instance",
+					LastOperation: ccv2.LastOperation{Type: "update", State: ccv2.LastOperationSucceeded},
+				},
+				v2action.Warnings{"update-warning"},
+				nil)
+		})
+
+		Context("when no plan, parameters or tags are provided", func() {
+			It("displays that no changes were made without updating the service instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("No changes were made"))
+
+				Expect(fakeActor.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(0))
+				Expect(fakeActor.UpdateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a new plan is provided", func() {
+			BeforeEach(func() {
+				cmd.Plan = "some-plan"
+				fakeActor.GetServicePlanByNameAndServiceInstanceReturns(
+					v2action.ServicePlan{GUID: "some-plan-guid", Name: "some-plan"},
+					v2action.Warnings{"plan-warning"},
+					nil)
+			})
+
+			It("updates the service instance to the plan", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Updating service instance some-instance as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("get-warning"))
+				Expect(testUI.Err).To(Say("plan-warning"))
+				Expect(testUI.Err).To(Say("update-warning"))
+
+				Expect(fakeActor.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(1))
+				name, spaceGUID := fakeActor.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+				Expect(name).To(Equal("some-instance"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeActor.GetServicePlanByNameAndServiceInstanceCallCount()).To(Equal(1))
+				planName, planInstance := fakeActor.GetServicePlanByNameAndServiceInstanceArgsForCall(0)
+				Expect(planName).To(Equal("some-plan"))
+				Expect(planInstance.GUID).To(Equal("some-instance-guid"))
+
+				Expect(fakeActor.UpdateServiceInstanceCallCount()).To(Equal(1))
+				instance, planGUID, parameters, tags := fakeActor.UpdateServiceInstanceArgsForCall(0)
+				Expect(instance.GUID).To(Equal("some-instance-guid"))
+				Expect(planGUID).To(Equal("some-plan-guid"))
+				Expect(parameters).To(BeNil())
+				Expect(tags).To(BeNil())
+
+				Expect(fakeActor.PollServiceInstanceOperationCallCount()).To(Equal(0))
+			})
+
+			Context("when the plan cannot be found", func() {
+				BeforeEach(func() {
+					fakeActor.GetServicePlanByNameAndServiceInstanceReturns(
+						v2action.ServicePlan{},
+						v2action.Warnings{"plan-warning"},
+						v2action.ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"})
+				})
+
+				It("displays warnings and returns a ServicePlanNotFoundError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"}))
+					Expect(testUI.Err).To(Say("plan-warning"))
+
+					Expect(fakeActor.UpdateServiceInstanceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when parameters and tags are provided", func() {
+			BeforeEach(func() {
+				cmd.ParametersAsJSON = map[string]interface{}{"some-parameter": "some-value"}
+				cmd.Tags.IsSet = true
+				cmd.Tags.Value = []string{}
+			})
+
+			It("updates the parameters and clears the tags without changing the plan", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetServicePlanByNameAndServiceInstanceCallCount()).To(Equal(0))
+
+				Expect(fakeActor.UpdateServiceInstanceCallCount()).To(Equal(1))
+				_, planGUID, parameters, tags := fakeActor.UpdateServiceInstanceArgsForCall(0)
+				Expect(planGUID).To(BeEmpty())
+				Expect(parameters).To(Equal(map[string]interface{}{"some-parameter": "some-value"}))
+				Expect(tags).ToNot(BeNil())
+				Expect(*tags).To(BeEmpty())
+			})
+		})
+
+		Context("when changes are requested", func() {
+			BeforeEach(func() {
+				cmd.Tags.IsSet = true
+				cmd.Tags.Value = []string{"tag-1"}
+			})
+
+			Context("when getting the current user returns an error", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("got bananapants??")
+					fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+				})
+			})
+
+			Context("when the service instance does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+						v2action.ServiceInstance{},
+						v2action.Warnings{"get-warning"},
+						v2action.ServiceInstanceNotFoundError{Name: "some-instance"})
+				})
+
+				It("displays warnings and returns a ServiceInstanceNotFoundError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceNotFoundError{Name: "some-instance"}))
+					Expect(testUI.Err).To(Say("get-warning"))
+
+					Expect(fakeActor.UpdateServiceInstanceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when updating the service instance fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("update failed")
+					fakeActor.UpdateServiceInstanceReturns(v2action.ServiceInstance{}, v2action.Warnings{"update-warning"}, expectedErr)
+				})
+
+				It("displays warnings and returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("update-warning"))
+					Expect(testUI.Out).ToNot(Say("OK"))
+				})
+			})
+
+			Context("when the service broker updates the instance asynchronously", func() {
+				var instance v2action.ServiceInstance
+
+				BeforeEach(func() {
+					instance = v2action.ServiceInstance{
+						GUID:          "some-instance-guid",
+						Name:          "some-instance",
+						LastOperation: ccv2.LastOperation{Type: "update", State: ccv2.LastOperationInProgress},
+					}
+					fakeActor.UpdateServiceInstanceReturns(instance, nil, nil)
+				})
+
+				Context("when --wait is not provided", func() {
+					It("tells the user how to check on the operation", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).To(Say("Update in progress. Use 'faceman services' or 'faceman service some-instance' to check operation status."))
+
+						Expect(fakeActor.PollServiceInstanceOperationCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when --wait is provided", func() {
+					var (
+						instances   chan v2action.ServiceInstance
+						apiWarnings chan string
+						apiErrs     chan error
+					)
+
+					BeforeEach(func() {
+						cmd.Wait = true
+
+						instances = make(chan v2action.ServiceInstance, 2)
+						apiWarnings = make(chan string, 1)
+						apiErrs = make(chan error, 1)
+						fakeActor.PollServiceInstanceOperationReturns(instances, apiWarnings, apiErrs)
+					})
+
+					Context("when the operation succeeds", func() {
+						BeforeEach(func() {
+							instances <- v2action.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "update", State: ccv2.LastOperationInProgress, Description: "resizing"}}
+							instances <- v2action.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "update", State: ccv2.LastOperationSucceeded}}
+							apiWarnings <- "poll-warning"
+							close(instances)
+							close(apiWarnings)
+							close(apiErrs)
+						})
+
+						It("streams each status change and displays OK", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("update in progress: resizing"))
+							Expect(testUI.Out).To(Say("update succeeded"))
+							Expect(testUI.Out).To(Say("OK"))
+							Expect(testUI.Err).To(Say("poll-warning"))
+
+							Expect(fakeActor.PollServiceInstanceOperationCallCount()).To(Equal(1))
+							polledInstance, timeout := fakeActor.PollServiceInstanceOperationArgsForCall(0)
+							Expect(polledInstance).To(Equal(instance))
+							Expect(timeout).To(Equal(time.Minute))
+						})
+					})
+
+					Context("when a timeout is provided", func() {
+						BeforeEach(func() {
+							cmd.Timeout = 600
+							close(instances)
+							close(apiWarnings)
+							close(apiErrs)
+						})
+
+						It("polls with the given timeout", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							_, timeout := fakeActor.PollServiceInstanceOperationArgsForCall(0)
+							Expect(timeout).To(Equal(600 * time.Second))
+						})
+					})
+
+					Context("when the operation fails", func() {
+						BeforeEach(func() {
+							apiErrs <- v2action.ServiceInstanceOperationFailedError{
+								Name:        "some-instance",
+								Operation:   "update",
+								Description: "plan change not allowed",
+							}
+						})
+
+						It("returns a ServiceInstanceOperationFailedError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceOperationFailedError{
+								Name:        "some-instance",
+								Operation:   "update",
+								Description: "plan change not allowed",
+							}))
+							Expect(testUI.Out).ToNot(Say("OK"))
+						})
+					})
+
+					Context("when the operation times out", func() {
+						BeforeEach(func() {
+							apiErrs <- v2action.ServiceInstanceOperationTimeoutError{Name: "some-instance", Timeout: 10 * time.Minute}
+						})
+
+						It("returns a ServiceInstanceOperationTimeoutError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceOperationTimeoutError{
+								Name:       "some-instance",
+								BinaryName: "faceman",
+							}))
+						})
+					})
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,310 @@
+package v2
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+// nearQuotaLimitPercentage is the share of a quota limit from which usage is
+// highlighted.
+const nearQuotaLimitPercentage = 80
+
+//go:generate counterfeiter . UsageActor
+
+type UsageActor interface {
+	GetOrganizationResourceUsageByName(orgName string) (v2action.OrganizationResourceUsage, v2action.Warnings, error)
+	GetSpaceResourceUsageByOrganizationAndName(orgGUID string, spaceName string) (v2action.SpaceResourceUsage, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . UsageActorV3
+
+type UsageActorV3 interface {
+	CloudControllerAPIVersion() string
+	GetRunningTaskCountBySpace(spaceGUID string) (int, v3action.Warnings, error)
+}
+
+type UsageCommand struct {
+	Space           string      `short:"s" description:"Show the usage of a space in the targeted org"`
+	usage           interface{} `usage:"CF_NAME usage [-s SPACE]\n\n   Compares the memory and instances of started apps, routes, managed service instances and running tasks\n   with the quota of the targeted org, or with the space quota of a space.\n   Resources using at least 80% of their limit are highlighted."`
+	relatedCommands interface{} `related_commands:"org, quota, space, space-quota"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UsageActor
+	ActorV3     UsageActorV3
+}
+
+func (cmd *UsageCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
+	return nil
+}
+
+func (cmd UsageCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Space != "" {
+		return cmd.displaySpaceUsage(user.Name)
+	}
+	return cmd.displayOrgUsage(user.Name)
+}
+
+func (cmd UsageCommand) displayOrgUsage(username string) error {
+	orgName := cmd.Config.TargetedOrganization().Name
+
+	cmd.UI.DisplayTextWithFlavor("Getting resource usage for org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  orgName,
+		"Username": username,
+	})
+	cmd.UI.DisplayNewline()
+
+	orgUsage, warnings, err := cmd.Actor.GetOrganizationResourceUsageByName(orgName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	countTasks := cmd.canCountTasks()
+	if countTasks {
+		for i, space := range orgUsage.Spaces {
+			count, warnings, err := cmd.ActorV3.GetRunningTaskCountBySpace(space.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return shared.HandleError(err)
+			}
+			orgUsage.Spaces[i].AppTasks.Used = count
+			orgUsage.AppTasks.Used += count
+		}
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("quota:"), orgUsage.QuotaName},
+	}, 3)
+	cmd.UI.DisplayNewline()
+	cmd.displayResourceUsages(orgUsage.ResourceUsages, countTasks)
+
+	if nearLimit := cmd.nearLimitResources(orgUsage.ResourceUsages, countTasks); len(nearLimit) > 0 {
+		cmd.UI.DisplayWarning("Org {{.OrgName}} is close to its quota: {{.Resources}}", map[string]interface{}{
+			"OrgName":   orgUsage.Name,
+			"Resources": strings.Join(nearLimit, ", "),
+		})
+	}
+
+	if len(orgUsage.Spaces) == 0 {
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+
+	header := []string{
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("space quota"),
+		cmd.UI.TranslateText("memory"),
+		cmd.UI.TranslateText("app instances"),
+		cmd.UI.TranslateText("routes"),
+		cmd.UI.TranslateText("service instances"),
+	}
+	if countTasks {
+		header = append(header, cmd.UI.TranslateText("app tasks"))
+	}
+	header = append(header, cmd.UI.TranslateText("close to limit"))
+	table := [][]string{header}
+
+	var nearLimitSpaces []string
+	for _, space := range orgUsage.Spaces {
+		row := []string{
+			space.Name,
+			space.QuotaName,
+			cmd.formatSpaceUsage(space.Memory, cmd.formatMemory),
+			cmd.formatSpaceUsage(space.AppInstances, strconv.Itoa),
+			cmd.formatSpaceUsage(space.Routes, strconv.Itoa),
+			cmd.formatSpaceUsage(space.ServiceInstances, strconv.Itoa),
+		}
+		if countTasks {
+			row = append(row, cmd.formatSpaceUsage(space.AppTasks, strconv.Itoa))
+		}
+
+		nearLimit := cmd.nearLimitResources(space.ResourceUsages, countTasks)
+		if len(nearLimit) > 0 {
+			nearLimitSpaces = append(nearLimitSpaces, space.Name)
+		}
+		row = append(row, strings.Join(nearLimit, ", "))
+
+		table = append(table, row)
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if len(nearLimitSpaces) > 0 {
+		cmd.UI.DisplayWarning("{{.Count}} space(s) close to their space quota: {{.SpaceNames}}", map[string]interface{}{
+			"Count":      len(nearLimitSpaces),
+			"SpaceNames": strings.Join(nearLimitSpaces, ", "),
+		})
+	}
+
+	return nil
+}
+
+func (cmd UsageCommand) displaySpaceUsage(username string) error {
+	org := cmd.Config.TargetedOrganization()
+
+	cmd.UI.DisplayTextWithFlavor("Getting resource usage for space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"SpaceName": cmd.Space,
+		"OrgName":   org.Name,
+		"Username":  username,
+	})
+	cmd.UI.DisplayNewline()
+
+	spaceUsage, warnings, err := cmd.Actor.GetSpaceResourceUsageByOrganizationAndName(org.GUID, cmd.Space)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	countTasks := cmd.canCountTasks()
+	if countTasks {
+		count, warnings, err := cmd.ActorV3.GetRunningTaskCountBySpace(spaceUsage.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		spaceUsage.AppTasks.Used = count
+	}
+
+	quotaName := spaceUsage.QuotaName
+	if quotaName == "" {
+		quotaName = cmd.UI.TranslateText("none, limited by the org quota")
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("space quota:"), quotaName},
+	}, 3)
+	cmd.UI.DisplayNewline()
+	cmd.displayResourceUsages(spaceUsage.ResourceUsages, countTasks)
+
+	if nearLimit := cmd.nearLimitResources(spaceUsage.ResourceUsages, countTasks); len(nearLimit) > 0 {
+		cmd.UI.DisplayWarning("Space {{.SpaceName}} is close to its space quota: {{.Resources}}", map[string]interface{}{
+			"SpaceName": spaceUsage.Name,
+			"Resources": strings.Join(nearLimit, ", "),
+		})
+	}
+
+	return nil
+}
+
+// canCountTasks returns true when running tasks can be retrieved, which
+// requires the V3 API.
+func (cmd UsageCommand) canCountTasks() bool {
+	return cmd.ActorV3 != nil &&
+		command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), command.MinVersionRunTaskV3) == nil
+}
+
+func (cmd UsageCommand) displayResourceUsages(usages v2action.ResourceUsages, countTasks bool) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("resource"),
+			cmd.UI.TranslateText("used"),
+			cmd.UI.TranslateText("limit"),
+			cmd.UI.TranslateText("usage"),
+		},
+	}
+
+	for _, resource := range cmd.resources(usages, countTasks) {
+		format := strconv.Itoa
+		if resource.memory {
+			format = cmd.formatMemory
+		}
+
+		limit := cmd.UI.TranslateText("unlimited")
+		if resource.usage.Limit.IsSet {
+			limit = format(resource.usage.Limit.Value)
+		}
+
+		var percentage string
+		if value, ok := resource.usage.Percentage(); ok {
+			percentage = fmt.Sprintf("%d%%", value)
+		}
+
+		table = append(table, []string{resource.name, format(resource.usage.Used), limit, percentage})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
+
+// nearLimitResources returns the resources using at least
+// nearQuotaLimitPercentage of their limit, with their usage.
+func (cmd UsageCommand) nearLimitResources(usages v2action.ResourceUsages, countTasks bool) []string {
+	var nearLimit []string
+	for _, resource := range cmd.resources(usages, countTasks) {
+		if value, ok := resource.usage.Percentage(); ok && value >= nearQuotaLimitPercentage {
+			nearLimit = append(nearLimit, fmt.Sprintf("%s %d%%", resource.name, value))
+		}
+	}
+	return nearLimit
+}
+
+type usageResource struct {
+	name   string
+	usage  v2action.ResourceUsage
+	memory bool
+}
+
+func (cmd UsageCommand) resources(usages v2action.ResourceUsages, countTasks bool) []usageResource {
+	resources := []usageResource{
+		{name: cmd.UI.TranslateText("memory"), usage: usages.Memory, memory: true},
+		{name: cmd.UI.TranslateText("app instances"), usage: usages.AppInstances},
+		{name: cmd.UI.TranslateText("routes"), usage: usages.Routes},
+		{name: cmd.UI.TranslateText("service instances"), usage: usages.ServiceInstances},
+	}
+	if countTasks {
+		resources = append(resources, usageResource{name: cmd.UI.TranslateText("app tasks"), usage: usages.AppTasks})
+	}
+	return resources
+}
+
+func (cmd UsageCommand) formatSpaceUsage(usage v2action.ResourceUsage, format func(int) string) string {
+	if !usage.Limit.IsSet {
+		return format(usage.Used)
+	}
+	return fmt.Sprintf("%s/%s", format(usage.Used), format(usage.Limit.Value))
+}
+
+func (UsageCommand) formatMemory(megabytes int) string {
+	return bytefmt.ByteSize(uint64(megabytes) * bytefmt.MEGABYTE)
+}
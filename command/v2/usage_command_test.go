@@ -0,0 +1,251 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("usage Command", func() {
+	var (
+		cmd             UsageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUsageActor
+		fakeActorV3     *v2fakes.FakeUsageActorV3
+		binaryName      string
+		executeErr      error
+	)
+
+	limit := func(value int) types.NullInt {
+		return types.NullInt{Value: value, IsSet: true}
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUsageActor)
+		fakeActorV3 = new(v2fakes.FakeUsageActorV3)
+
+		cmd = UsageCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV3:     fakeActorV3,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeActorV3.CloudControllerAPIVersionReturns("3.0.0")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when no space is provided", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationResourceUsageByNameReturns(
+				v2action.OrganizationResourceUsage{
+					Organization: v2action.Organization{GUID: "some-org-guid", Name: "some-org"},
+					QuotaName:    "default",
+					ResourceUsages: v2action.ResourceUsages{
+						Memory:           v2action.ResourceUsage{Used: 1536, Limit: limit(10240)},
+						AppInstances:     v2action.ResourceUsage{Used: 3},
+						Routes:           v2action.ResourceUsage{Used: 90, Limit: limit(100)},
+						ServiceInstances: v2action.ResourceUsage{Used: 1},
+						AppTasks:         v2action.ResourceUsage{Limit: limit(10)},
+					},
+					Spaces: []v2action.SpaceResourceUsage{
+						{
+							Space:     v2action.Space{GUID: "space-1-guid", Name: "space-1"},
+							QuotaName: "small",
+							ResourceUsages: v2action.ResourceUsages{
+								Memory:           v2action.ResourceUsage{Used: 1024, Limit: limit(1024)},
+								AppInstances:     v2action.ResourceUsage{Used: 2},
+								Routes:           v2action.ResourceUsage{Used: 60},
+								ServiceInstances: v2action.ResourceUsage{Used: 1, Limit: limit(5)},
+							},
+						},
+						{
+							Space: v2action.Space{GUID: "space-2-guid", Name: "space-2"},
+							ResourceUsages: v2action.ResourceUsages{
+								Memory:       v2action.ResourceUsage{Used: 512},
+								AppInstances: v2action.ResourceUsage{Used: 1},
+								Routes:       v2action.ResourceUsage{Used: 30},
+							},
+						},
+					},
+				},
+				v2action.Warnings{"usage-warning"},
+				nil)
+			fakeActorV3.GetRunningTaskCountBySpaceStub = func(spaceGUID string) (int, v3action.Warnings, error) {
+				if spaceGUID == "space-1-guid" {
+					return 2, v3action.Warnings{"tasks-warning"}, nil
+				}
+				return 1, nil, nil
+			}
+		})
+
+		It("displays the usage of the targeted org and its spaces", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetOrganizationResourceUsageByNameArgsForCall(0)).To(Equal("some-org"))
+
+			Expect(testUI.Out).To(Say("Getting resource usage for org some-org as some-user..."))
+			Expect(testUI.Out).To(Say(`quota:\s+default`))
+			Expect(testUI.Out).To(Say(`resource\s+used\s+limit\s+usage`))
+			Expect(testUI.Out).To(Say(`memory\s+1.5G\s+10G\s+%d%%`, 15))
+			Expect(testUI.Out).To(Say(`app instances\s+3\s+unlimited`))
+			Expect(testUI.Out).To(Say(`routes\s+90\s+100\s+%d%%`, 90))
+			Expect(testUI.Out).To(Say(`service instances\s+1\s+unlimited`))
+			Expect(testUI.Out).To(Say(`app tasks\s+3\s+10\s+%d%%`, 30))
+			Expect(testUI.Out).To(Say(`space\s+space quota\s+memory\s+app instances\s+routes\s+service instances\s+app tasks\s+close to limit`))
+			Expect(testUI.Out).To(Say(`space-1\s+small\s+1G/1G\s+2\s+60\s+1/5\s+2\s+memory %d%%`, 100))
+			Expect(testUI.Out).To(Say(`space-2\s+512M\s+1\s+30\s+0\s+1`))
+
+			Expect(testUI.Err).To(Say("usage-warning"))
+			Expect(testUI.Err).To(Say("tasks-warning"))
+			Expect(testUI.Err).To(Say("Org some-org is close to its quota: routes %d%%", 90))
+			Expect(testUI.Err).To(Say(`1 space\(s\) close to their space quota: space-1`))
+		})
+
+		Context("when the API does not support tasks", func() {
+			BeforeEach(func() {
+				cmd.ActorV3 = nil
+			})
+
+			It("leaves out app tasks", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("app tasks"))
+			})
+		})
+
+		Context("when getting the usage fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("usage error")
+				fakeActor.GetOrganizationResourceUsageByNameReturns(v2action.OrganizationResourceUsage{}, v2action.Warnings{"usage-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("usage-warning"))
+			})
+		})
+
+		Context("when counting tasks fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("tasks error")
+				fakeActorV3.GetRunningTaskCountBySpaceStub = nil
+				fakeActorV3.GetRunningTaskCountBySpaceReturns(0, v3action.Warnings{"tasks-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("tasks-warning"))
+			})
+		})
+	})
+
+	Context("when a space is provided", func() {
+		BeforeEach(func() {
+			cmd.Space = "space-1"
+			fakeActor.GetSpaceResourceUsageByOrganizationAndNameReturns(
+				v2action.SpaceResourceUsage{
+					Space:     v2action.Space{GUID: "space-1-guid", Name: "space-1"},
+					QuotaName: "small",
+					ResourceUsages: v2action.ResourceUsages{
+						Memory:           v2action.ResourceUsage{Used: 512, Limit: limit(1024)},
+						AppInstances:     v2action.ResourceUsage{Used: 2},
+						Routes:           v2action.ResourceUsage{Used: 2},
+						ServiceInstances: v2action.ResourceUsage{Used: 4, Limit: limit(5)},
+						AppTasks:         v2action.ResourceUsage{Limit: limit(2)},
+					},
+				},
+				v2action.Warnings{"usage-warning"},
+				nil)
+			fakeActorV3.GetRunningTaskCountBySpaceReturns(2, v3action.Warnings{"tasks-warning"}, nil)
+		})
+
+		It("displays the usage of the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			orgGUID, spaceName := fakeActor.GetSpaceResourceUsageByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("space-1"))
+			Expect(fakeActorV3.GetRunningTaskCountBySpaceArgsForCall(0)).To(Equal("space-1-guid"))
+
+			Expect(testUI.Out).To(Say("Getting resource usage for space space-1 in org some-org as some-user..."))
+			Expect(testUI.Out).To(Say(`space quota:\s+small`))
+			Expect(testUI.Out).To(Say(`memory\s+512M\s+1G\s+%d%%`, 50))
+			Expect(testUI.Out).To(Say(`service instances\s+4\s+5\s+%d%%`, 80))
+			Expect(testUI.Out).To(Say(`app tasks\s+2\s+2\s+%d%%`, 100))
+
+			Expect(testUI.Err).To(Say("usage-warning"))
+			Expect(testUI.Err).To(Say("tasks-warning"))
+			Expect(testUI.Err).To(Say("Space space-1 is close to its space quota: service instances %d%%, app tasks %d%%", 80, 100))
+		})
+
+		Context("when the space has no space quota", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceResourceUsageByOrganizationAndNameReturns(
+					v2action.SpaceResourceUsage{Space: v2action.Space{GUID: "space-1-guid", Name: "space-1"}},
+					nil,
+					nil)
+			})
+
+			It("displays that the space is only limited by the org quota", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`space quota:\s+none, limited by the org quota`))
+				Expect(testUI.Err).ToNot(Say("close to its space quota"))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceResourceUsageByOrganizationAndNameReturns(v2action.SpaceResourceUsage{}, v2action.Warnings{"usage-warning"}, v2action.SpaceNotFoundError{Name: "space-1"})
+			})
+
+			It("returns a translatable error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "space-1"}))
+				Expect(testUI.Err).To(Say("usage-warning"))
+				Expect(fakeActorV3.GetRunningTaskCountBySpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package v2
 import (
 	"os"
 	"path/filepath"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
@@ -30,9 +31,13 @@ type ProgressBar interface {
 
 type V2PushActor interface {
 	Apply(config pushaction.ApplicationConfig, progressBar pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
+	ApplyServiceInstance(config pushaction.ServiceInstanceConfig) (v2action.ServiceInstance, pushaction.Warnings, error)
 	ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	ConvertToServiceInstanceConfigs(spaceGUID string, serviceInstances []manifest.ServiceInstance) ([]pushaction.ServiceInstanceConfig, pushaction.Warnings, error)
 	MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
+	PollServiceInstanceOperation(serviceInstance v2action.ServiceInstance, timeout time.Duration) (<-chan v2action.ServiceInstance, <-chan string, <-chan error)
 	ReadManifest(pathToManifest string) ([]manifest.Application, error)
+	ReadManifestServiceInstances(pathToManifest string) ([]manifest.ServiceInstance, error)
 }
 
 type V2PushCommand struct {
@@ -114,7 +119,7 @@ func (cmd V2PushCommand) Execute(args []string) error {
 	}
 
 	log.Info("checking manifest")
-	rawApps, err := cmd.findAndReadManifest(cliSettings)
+	rawApps, serviceInstances, err := cmd.findAndReadManifest(cliSettings)
 	if err != nil {
 		log.Errorln("reading manifest:", err)
 		return shared.HandleError(err)
@@ -127,6 +132,13 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if len(serviceInstances) > 0 {
+		err = cmd.applyServiceInstances(serviceInstances)
+		if err != nil {
+			return err
+		}
+	}
+
 	cmd.UI.DisplayText("Getting app info...")
 
 	log.Info("converting manifests to ApplicationConfigs")
@@ -231,13 +243,13 @@ func (cmd V2PushCommand) GetCommandLineSettings() (pushaction.CommandLineSetting
 	return config, nil
 }
 
-func (cmd V2PushCommand) findAndReadManifest(settings pushaction.CommandLineSettings) ([]manifest.Application, error) {
+func (cmd V2PushCommand) findAndReadManifest(settings pushaction.CommandLineSettings) ([]manifest.Application, []manifest.ServiceInstance, error) {
 	var pathToManifest string
 
 	switch {
 	case cmd.NoManifest:
 		log.Debug("skipping reading of manifest")
-		return nil, nil
+		return nil, nil, nil
 	case cmd.PathToManifest != "":
 		log.Debug("using specified manifest file")
 		pathToManifest = string(cmd.PathToManifest)
@@ -252,7 +264,7 @@ func (cmd V2PushCommand) findAndReadManifest(settings pushaction.CommandLineSett
 			pathToManifest = filepath.Join(settings.CurrentDirectory, "manifest.yaml")
 			if _, err := os.Stat(pathToManifest); os.IsNotExist(err) {
 				log.WithField("pathToManifest", pathToManifest).Debug("could not find")
-				return nil, nil
+				return nil, nil, nil
 			}
 		}
 	}
@@ -261,7 +273,61 @@ func (cmd V2PushCommand) findAndReadManifest(settings pushaction.CommandLineSett
 	cmd.UI.DisplayText("Using manifest file {{.Path}}", map[string]interface{}{
 		"Path": pathToManifest,
 	})
-	return cmd.Actor.ReadManifest(pathToManifest)
+	apps, err := cmd.Actor.ReadManifest(pathToManifest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serviceInstances, err := cmd.Actor.ReadManifestServiceInstances(pathToManifest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return apps, serviceInstances, nil
+}
+
+// applyServiceInstances creates or updates the service instances declared in
+// the manifest services block and waits for the service brokers to finish, so
+// the applications can be bound to them afterwards.
+func (cmd V2PushCommand) applyServiceInstances(serviceInstances []manifest.ServiceInstance) error {
+	log.Info("converting manifest services to ServiceInstanceConfigs")
+	configs, warnings, err := cmd.Actor.ConvertToServiceInstanceConfigs(cmd.Config.TargetedSpace().GUID, serviceInstances)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		log.Errorln("converting manifest services:", err)
+		return shared.HandleError(err)
+	}
+
+	for _, config := range configs {
+		switch {
+		case config.CreatingServiceInstance():
+			cmd.UI.DisplayTextWithFlavor("Creating service instance {{.ServiceInstanceName}}...", map[string]interface{}{
+				"ServiceInstanceName": config.DesiredServiceInstance.Name,
+			})
+		case config.UpdatingServiceInstance():
+			cmd.UI.DisplayTextWithFlavor("Updating service instance {{.ServiceInstanceName}}...", map[string]interface{}{
+				"ServiceInstanceName": config.DesiredServiceInstance.Name,
+			})
+		default:
+			log.Debugln("skipping unchanged service instance:", config.DesiredServiceInstance.Name)
+			continue
+		}
+
+		instance, warnings, err := cmd.Actor.ApplyServiceInstance(config)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			log.Errorln("applying service instance:", err)
+			return shared.HandleError(err)
+		}
+
+		err = displayServiceInstanceOperation(cmd.UI, cmd.Config, cmd.Actor, instance, true, 0)
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayNewline()
+	}
+
+	return nil
 }
 
 func (cmd V2PushCommand) processApplyStreams(
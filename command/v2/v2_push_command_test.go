@@ -341,6 +341,132 @@ var _ = Describe("v2-push Command", func() {
 										CurrentDirectory: tmpDir,
 									}))
 									Expect(manifestApps).To(BeNil())
+									Expect(fakeActor.ReadManifestServiceInstancesCallCount()).To(Equal(0))
+								})
+							})
+
+							Context("when the manifest declares service instances", func() {
+								var serviceInstances []manifest.ServiceInstance
+
+								BeforeEach(func() {
+									serviceInstances = []manifest.ServiceInstance{
+										{Name: "new-instance", Service: "some-service", Plan: "some-plan"},
+										{Name: "changed-instance", Service: "some-service", Plan: "some-plan", Update: true},
+										{Name: "unchanged-instance", Service: "some-service", Plan: "some-plan"},
+									}
+									fakeActor.ReadManifestServiceInstancesReturns(serviceInstances, nil)
+
+									fakeActor.ConvertToServiceInstanceConfigsReturns([]pushaction.ServiceInstanceConfig{
+										{
+											DesiredServiceInstance: v2action.ServiceInstance{Name: "new-instance", ServicePlanGUID: "some-plan-guid"},
+											TargetedSpaceGUID:      "some-space-guid",
+										},
+										{
+											CurrentServiceInstance: v2action.ServiceInstance{GUID: "changed-guid", Name: "changed-instance", ServicePlanGUID: "old-plan-guid"},
+											DesiredServiceInstance: v2action.ServiceInstance{GUID: "changed-guid", Name: "changed-instance", ServicePlanGUID: "some-plan-guid"},
+											TargetedSpaceGUID:      "some-space-guid",
+										},
+										{
+											CurrentServiceInstance: v2action.ServiceInstance{GUID: "unchanged-guid", Name: "unchanged-instance"},
+											DesiredServiceInstance: v2action.ServiceInstance{GUID: "unchanged-guid", Name: "unchanged-instance"},
+											TargetedSpaceGUID:      "some-space-guid",
+										},
+									}, pushaction.Warnings{"service-config-warning"}, nil)
+
+									fakeActor.ApplyServiceInstanceStub = func(config pushaction.ServiceInstanceConfig) (v2action.ServiceInstance, pushaction.Warnings, error) {
+										instance := config.DesiredServiceInstance
+										if config.CreatingServiceInstance() {
+											instance.LastOperation = ccv2.LastOperation{Type: "create", State: ccv2.LastOperationInProgress}
+										}
+										return instance, pushaction.Warnings{"apply-" + instance.Name + "-warning"}, nil
+									}
+
+									fakeActor.PollServiceInstanceOperationStub = func(instance v2action.ServiceInstance, _ time.Duration) (<-chan v2action.ServiceInstance, <-chan string, <-chan error) {
+										instances := make(chan v2action.ServiceInstance, 1)
+										warnings := make(chan string, 1)
+										errs := make(chan error)
+
+										instance.LastOperation.State = ccv2.LastOperationSucceeded
+										instances <- instance
+										warnings <- "poll-warning"
+										close(instances)
+										close(warnings)
+										close(errs)
+
+										return instances, warnings, errs
+									}
+									fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+								})
+
+								It("creates and updates the service instances before the apps", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+
+									Expect(fakeActor.ReadManifestServiceInstancesCallCount()).To(Equal(1))
+									Expect(fakeActor.ReadManifestServiceInstancesArgsForCall(0)).To(Equal(pathToManifest))
+
+									Expect(fakeActor.ConvertToServiceInstanceConfigsCallCount()).To(Equal(1))
+									spaceGUID, manifestInstances := fakeActor.ConvertToServiceInstanceConfigsArgsForCall(0)
+									Expect(spaceGUID).To(Equal("some-space-guid"))
+									Expect(manifestInstances).To(Equal(serviceInstances))
+
+									Expect(testUI.Err).To(Say("service-config-warning"))
+									Expect(testUI.Out).To(Say("Creating service instance new-instance\\.\\.\\."))
+									Expect(testUI.Out).To(Say("create succeeded"))
+									Expect(testUI.Out).To(Say("OK"))
+									Expect(testUI.Out).To(Say("Updating service instance changed-instance\\.\\.\\."))
+									Expect(testUI.Out).To(Say("OK"))
+									Expect(testUI.Out).ToNot(Say("unchanged-instance"))
+									Expect(testUI.Out).To(Say("Getting app info\\.\\.\\."))
+
+									Expect(testUI.Err).To(Say("apply-new-instance-warning"))
+									Expect(testUI.Err).To(Say("poll-warning"))
+									Expect(testUI.Err).To(Say("apply-changed-instance-warning"))
+
+									Expect(fakeActor.ApplyServiceInstanceCallCount()).To(Equal(2))
+									Expect(fakeActor.PollServiceInstanceOperationCallCount()).To(Equal(1))
+									polledInstance, timeout := fakeActor.PollServiceInstanceOperationArgsForCall(0)
+									Expect(polledInstance.Name).To(Equal("new-instance"))
+									Expect(timeout).To(Equal(time.Minute))
+								})
+
+								Context("when reading the service instances errors", func() {
+									BeforeEach(func() {
+										fakeActor.ReadManifestServiceInstancesReturns(nil, manifest.InvalidServiceInstanceParametersError{Name: "new-instance"})
+									})
+
+									It("returns a translated error", func() {
+										Expect(executeErr).To(MatchError(translatableerror.ManifestServiceInstanceParametersError{Name: "new-instance"}))
+										Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(0))
+									})
+								})
+
+								Context("when converting the service instances errors", func() {
+									BeforeEach(func() {
+										fakeActor.ConvertToServiceInstanceConfigsReturns(nil, pushaction.Warnings{"service-config-warning"}, pushaction.MissingServiceInstanceFieldError{Name: "new-instance", Field: "plan"})
+									})
+
+									It("returns a translated error and outputs the warnings", func() {
+										Expect(executeErr).To(MatchError(translatableerror.ManifestServiceInstanceMissingFieldError{Name: "new-instance", Field: "plan"}))
+										Expect(testUI.Err).To(Say("service-config-warning"))
+										Expect(fakeActor.ConvertToApplicationConfigsCallCount()).To(Equal(0))
+									})
+								})
+
+								Context("when applying a service instance errors", func() {
+									var expectedErr error
+
+									BeforeEach(func() {
+										expectedErr = errors.New("apply error")
+										fakeActor.ApplyServiceInstanceStub = nil
+										fakeActor.ApplyServiceInstanceReturns(v2action.ServiceInstance{}, pushaction.Warnings{"apply-warning"}, expectedErr)
+									})
+
+									It("returns the error and outputs the warnings", func() {
+										Expect(executeErr).To(MatchError(expectedErr))
+										Expect(testUI.Err).To(Say("apply-warning"))
+										Expect(fakeActor.ApplyServiceInstanceCallCount()).To(Equal(1))
+										Expect(fakeActor.ConvertToApplicationConfigsCallCount()).To(Equal(0))
+									})
 								})
 							})
 						})
@@ -3,9 +3,11 @@ package v2fakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/v2"
 )
 
@@ -28,6 +30,21 @@ type FakeV2PushActor struct {
 		result3 <-chan pushaction.Warnings
 		result4 <-chan error
 	}
+	ApplyServiceInstanceStub        func(config pushaction.ServiceInstanceConfig) (v2action.ServiceInstance, pushaction.Warnings, error)
+	applyServiceInstanceMutex       sync.RWMutex
+	applyServiceInstanceArgsForCall []struct {
+		config pushaction.ServiceInstanceConfig
+	}
+	applyServiceInstanceReturns struct {
+		result1 v2action.ServiceInstance
+		result2 pushaction.Warnings
+		result3 error
+	}
+	applyServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 pushaction.Warnings
+		result3 error
+	}
 	ConvertToApplicationConfigsStub        func(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
 	convertToApplicationConfigsMutex       sync.RWMutex
 	convertToApplicationConfigsArgsForCall []struct {
@@ -46,6 +63,22 @@ type FakeV2PushActor struct {
 		result2 pushaction.Warnings
 		result3 error
 	}
+	ConvertToServiceInstanceConfigsStub        func(spaceGUID string, serviceInstances []manifest.ServiceInstance) ([]pushaction.ServiceInstanceConfig, pushaction.Warnings, error)
+	convertToServiceInstanceConfigsMutex       sync.RWMutex
+	convertToServiceInstanceConfigsArgsForCall []struct {
+		spaceGUID        string
+		serviceInstances []manifest.ServiceInstance
+	}
+	convertToServiceInstanceConfigsReturns struct {
+		result1 []pushaction.ServiceInstanceConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
+	convertToServiceInstanceConfigsReturnsOnCall map[int]struct {
+		result1 []pushaction.ServiceInstanceConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
 	MergeAndValidateSettingsAndManifestsStub        func(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
 	mergeAndValidateSettingsAndManifestsMutex       sync.RWMutex
 	mergeAndValidateSettingsAndManifestsArgsForCall []struct {
@@ -60,6 +93,22 @@ type FakeV2PushActor struct {
 		result1 []manifest.Application
 		result2 error
 	}
+	PollServiceInstanceOperationStub        func(serviceInstance v2action.ServiceInstance, timeout time.Duration) (<-chan v2action.ServiceInstance, <-chan string, <-chan error)
+	pollServiceInstanceOperationMutex       sync.RWMutex
+	pollServiceInstanceOperationArgsForCall []struct {
+		serviceInstance v2action.ServiceInstance
+		timeout         time.Duration
+	}
+	pollServiceInstanceOperationReturns struct {
+		result1 <-chan v2action.ServiceInstance
+		result2 <-chan string
+		result3 <-chan error
+	}
+	pollServiceInstanceOperationReturnsOnCall map[int]struct {
+		result1 <-chan v2action.ServiceInstance
+		result2 <-chan string
+		result3 <-chan error
+	}
 	ReadManifestStub        func(pathToManifest string) ([]manifest.Application, error)
 	readManifestMutex       sync.RWMutex
 	readManifestArgsForCall []struct {
@@ -73,6 +122,19 @@ type FakeV2PushActor struct {
 		result1 []manifest.Application
 		result2 error
 	}
+	ReadManifestServiceInstancesStub        func(pathToManifest string) ([]manifest.ServiceInstance, error)
+	readManifestServiceInstancesMutex       sync.RWMutex
+	readManifestServiceInstancesArgsForCall []struct {
+		pathToManifest string
+	}
+	readManifestServiceInstancesReturns struct {
+		result1 []manifest.ServiceInstance
+		result2 error
+	}
+	readManifestServiceInstancesReturnsOnCall map[int]struct {
+		result1 []manifest.ServiceInstance
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -135,6 +197,60 @@ func (fake *FakeV2PushActor) ApplyReturnsOnCall(i int, result1 <-chan pushaction
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeV2PushActor) ApplyServiceInstance(config pushaction.ServiceInstanceConfig) (v2action.ServiceInstance, pushaction.Warnings, error) {
+	fake.applyServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.applyServiceInstanceReturnsOnCall[len(fake.applyServiceInstanceArgsForCall)]
+	fake.applyServiceInstanceArgsForCall = append(fake.applyServiceInstanceArgsForCall, struct {
+		config pushaction.ServiceInstanceConfig
+	}{config})
+	fake.recordInvocation("ApplyServiceInstance", []interface{}{config})
+	fake.applyServiceInstanceMutex.Unlock()
+	if fake.ApplyServiceInstanceStub != nil {
+		return fake.ApplyServiceInstanceStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.applyServiceInstanceReturns.result1, fake.applyServiceInstanceReturns.result2, fake.applyServiceInstanceReturns.result3
+}
+
+func (fake *FakeV2PushActor) ApplyServiceInstanceCallCount() int {
+	fake.applyServiceInstanceMutex.RLock()
+	defer fake.applyServiceInstanceMutex.RUnlock()
+	return len(fake.applyServiceInstanceArgsForCall)
+}
+
+func (fake *FakeV2PushActor) ApplyServiceInstanceArgsForCall(i int) pushaction.ServiceInstanceConfig {
+	fake.applyServiceInstanceMutex.RLock()
+	defer fake.applyServiceInstanceMutex.RUnlock()
+	return fake.applyServiceInstanceArgsForCall[i].config
+}
+
+func (fake *FakeV2PushActor) ApplyServiceInstanceReturns(result1 v2action.ServiceInstance, result2 pushaction.Warnings, result3 error) {
+	fake.ApplyServiceInstanceStub = nil
+	fake.applyServiceInstanceReturns = struct {
+		result1 v2action.ServiceInstance
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2PushActor) ApplyServiceInstanceReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 pushaction.Warnings, result3 error) {
+	fake.ApplyServiceInstanceStub = nil
+	if fake.applyServiceInstanceReturnsOnCall == nil {
+		fake.applyServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.applyServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2PushActor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error) {
 	var appsCopy []manifest.Application
 	if apps != nil {
@@ -197,6 +313,66 @@ func (fake *FakeV2PushActor) ConvertToApplicationConfigsReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2PushActor) ConvertToServiceInstanceConfigs(spaceGUID string, serviceInstances []manifest.ServiceInstance) ([]pushaction.ServiceInstanceConfig, pushaction.Warnings, error) {
+	var serviceInstancesCopy []manifest.ServiceInstance
+	if serviceInstances != nil {
+		serviceInstancesCopy = make([]manifest.ServiceInstance, len(serviceInstances))
+		copy(serviceInstancesCopy, serviceInstances)
+	}
+	fake.convertToServiceInstanceConfigsMutex.Lock()
+	ret, specificReturn := fake.convertToServiceInstanceConfigsReturnsOnCall[len(fake.convertToServiceInstanceConfigsArgsForCall)]
+	fake.convertToServiceInstanceConfigsArgsForCall = append(fake.convertToServiceInstanceConfigsArgsForCall, struct {
+		spaceGUID        string
+		serviceInstances []manifest.ServiceInstance
+	}{spaceGUID, serviceInstancesCopy})
+	fake.recordInvocation("ConvertToServiceInstanceConfigs", []interface{}{spaceGUID, serviceInstancesCopy})
+	fake.convertToServiceInstanceConfigsMutex.Unlock()
+	if fake.ConvertToServiceInstanceConfigsStub != nil {
+		return fake.ConvertToServiceInstanceConfigsStub(spaceGUID, serviceInstances)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.convertToServiceInstanceConfigsReturns.result1, fake.convertToServiceInstanceConfigsReturns.result2, fake.convertToServiceInstanceConfigsReturns.result3
+}
+
+func (fake *FakeV2PushActor) ConvertToServiceInstanceConfigsCallCount() int {
+	fake.convertToServiceInstanceConfigsMutex.RLock()
+	defer fake.convertToServiceInstanceConfigsMutex.RUnlock()
+	return len(fake.convertToServiceInstanceConfigsArgsForCall)
+}
+
+func (fake *FakeV2PushActor) ConvertToServiceInstanceConfigsArgsForCall(i int) (string, []manifest.ServiceInstance) {
+	fake.convertToServiceInstanceConfigsMutex.RLock()
+	defer fake.convertToServiceInstanceConfigsMutex.RUnlock()
+	return fake.convertToServiceInstanceConfigsArgsForCall[i].spaceGUID, fake.convertToServiceInstanceConfigsArgsForCall[i].serviceInstances
+}
+
+func (fake *FakeV2PushActor) ConvertToServiceInstanceConfigsReturns(result1 []pushaction.ServiceInstanceConfig, result2 pushaction.Warnings, result3 error) {
+	fake.ConvertToServiceInstanceConfigsStub = nil
+	fake.convertToServiceInstanceConfigsReturns = struct {
+		result1 []pushaction.ServiceInstanceConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2PushActor) ConvertToServiceInstanceConfigsReturnsOnCall(i int, result1 []pushaction.ServiceInstanceConfig, result2 pushaction.Warnings, result3 error) {
+	fake.ConvertToServiceInstanceConfigsStub = nil
+	if fake.convertToServiceInstanceConfigsReturnsOnCall == nil {
+		fake.convertToServiceInstanceConfigsReturnsOnCall = make(map[int]struct {
+			result1 []pushaction.ServiceInstanceConfig
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.convertToServiceInstanceConfigsReturnsOnCall[i] = struct {
+		result1 []pushaction.ServiceInstanceConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2PushActor) MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error) {
 	var appsCopy []manifest.Application
 	if apps != nil {
@@ -254,6 +430,61 @@ func (fake *FakeV2PushActor) MergeAndValidateSettingsAndManifestsReturnsOnCall(i
 	}{result1, result2}
 }
 
+func (fake *FakeV2PushActor) PollServiceInstanceOperation(serviceInstance v2action.ServiceInstance, timeout time.Duration) (<-chan v2action.ServiceInstance, <-chan string, <-chan error) {
+	fake.pollServiceInstanceOperationMutex.Lock()
+	ret, specificReturn := fake.pollServiceInstanceOperationReturnsOnCall[len(fake.pollServiceInstanceOperationArgsForCall)]
+	fake.pollServiceInstanceOperationArgsForCall = append(fake.pollServiceInstanceOperationArgsForCall, struct {
+		serviceInstance v2action.ServiceInstance
+		timeout         time.Duration
+	}{serviceInstance, timeout})
+	fake.recordInvocation("PollServiceInstanceOperation", []interface{}{serviceInstance, timeout})
+	fake.pollServiceInstanceOperationMutex.Unlock()
+	if fake.PollServiceInstanceOperationStub != nil {
+		return fake.PollServiceInstanceOperationStub(serviceInstance, timeout)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.pollServiceInstanceOperationReturns.result1, fake.pollServiceInstanceOperationReturns.result2, fake.pollServiceInstanceOperationReturns.result3
+}
+
+func (fake *FakeV2PushActor) PollServiceInstanceOperationCallCount() int {
+	fake.pollServiceInstanceOperationMutex.RLock()
+	defer fake.pollServiceInstanceOperationMutex.RUnlock()
+	return len(fake.pollServiceInstanceOperationArgsForCall)
+}
+
+func (fake *FakeV2PushActor) PollServiceInstanceOperationArgsForCall(i int) (v2action.ServiceInstance, time.Duration) {
+	fake.pollServiceInstanceOperationMutex.RLock()
+	defer fake.pollServiceInstanceOperationMutex.RUnlock()
+	return fake.pollServiceInstanceOperationArgsForCall[i].serviceInstance, fake.pollServiceInstanceOperationArgsForCall[i].timeout
+}
+
+func (fake *FakeV2PushActor) PollServiceInstanceOperationReturns(result1 <-chan v2action.ServiceInstance, result2 <-chan string, result3 <-chan error) {
+	fake.PollServiceInstanceOperationStub = nil
+	fake.pollServiceInstanceOperationReturns = struct {
+		result1 <-chan v2action.ServiceInstance
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2PushActor) PollServiceInstanceOperationReturnsOnCall(i int, result1 <-chan v2action.ServiceInstance, result2 <-chan string, result3 <-chan error) {
+	fake.PollServiceInstanceOperationStub = nil
+	if fake.pollServiceInstanceOperationReturnsOnCall == nil {
+		fake.pollServiceInstanceOperationReturnsOnCall = make(map[int]struct {
+			result1 <-chan v2action.ServiceInstance
+			result2 <-chan string
+			result3 <-chan error
+		})
+	}
+	fake.pollServiceInstanceOperationReturnsOnCall[i] = struct {
+		result1 <-chan v2action.ServiceInstance
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2PushActor) ReadManifest(pathToManifest string) ([]manifest.Application, error) {
 	fake.readManifestMutex.Lock()
 	ret, specificReturn := fake.readManifestReturnsOnCall[len(fake.readManifestArgsForCall)]
@@ -305,17 +536,76 @@ func (fake *FakeV2PushActor) ReadManifestReturnsOnCall(i int, result1 []manifest
 	}{result1, result2}
 }
 
+func (fake *FakeV2PushActor) ReadManifestServiceInstances(pathToManifest string) ([]manifest.ServiceInstance, error) {
+	fake.readManifestServiceInstancesMutex.Lock()
+	ret, specificReturn := fake.readManifestServiceInstancesReturnsOnCall[len(fake.readManifestServiceInstancesArgsForCall)]
+	fake.readManifestServiceInstancesArgsForCall = append(fake.readManifestServiceInstancesArgsForCall, struct {
+		pathToManifest string
+	}{pathToManifest})
+	fake.recordInvocation("ReadManifestServiceInstances", []interface{}{pathToManifest})
+	fake.readManifestServiceInstancesMutex.Unlock()
+	if fake.ReadManifestServiceInstancesStub != nil {
+		return fake.ReadManifestServiceInstancesStub(pathToManifest)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.readManifestServiceInstancesReturns.result1, fake.readManifestServiceInstancesReturns.result2
+}
+
+func (fake *FakeV2PushActor) ReadManifestServiceInstancesCallCount() int {
+	fake.readManifestServiceInstancesMutex.RLock()
+	defer fake.readManifestServiceInstancesMutex.RUnlock()
+	return len(fake.readManifestServiceInstancesArgsForCall)
+}
+
+func (fake *FakeV2PushActor) ReadManifestServiceInstancesArgsForCall(i int) string {
+	fake.readManifestServiceInstancesMutex.RLock()
+	defer fake.readManifestServiceInstancesMutex.RUnlock()
+	return fake.readManifestServiceInstancesArgsForCall[i].pathToManifest
+}
+
+func (fake *FakeV2PushActor) ReadManifestServiceInstancesReturns(result1 []manifest.ServiceInstance, result2 error) {
+	fake.ReadManifestServiceInstancesStub = nil
+	fake.readManifestServiceInstancesReturns = struct {
+		result1 []manifest.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) ReadManifestServiceInstancesReturnsOnCall(i int, result1 []manifest.ServiceInstance, result2 error) {
+	fake.ReadManifestServiceInstancesStub = nil
+	if fake.readManifestServiceInstancesReturnsOnCall == nil {
+		fake.readManifestServiceInstancesReturnsOnCall = make(map[int]struct {
+			result1 []manifest.ServiceInstance
+			result2 error
+		})
+	}
+	fake.readManifestServiceInstancesReturnsOnCall[i] = struct {
+		result1 []manifest.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.applyMutex.RLock()
 	defer fake.applyMutex.RUnlock()
+	fake.applyServiceInstanceMutex.RLock()
+	defer fake.applyServiceInstanceMutex.RUnlock()
 	fake.convertToApplicationConfigsMutex.RLock()
 	defer fake.convertToApplicationConfigsMutex.RUnlock()
+	fake.convertToServiceInstanceConfigsMutex.RLock()
+	defer fake.convertToServiceInstanceConfigsMutex.RUnlock()
 	fake.mergeAndValidateSettingsAndManifestsMutex.RLock()
 	defer fake.mergeAndValidateSettingsAndManifestsMutex.RUnlock()
+	fake.pollServiceInstanceOperationMutex.RLock()
+	defer fake.pollServiceInstanceOperationMutex.RUnlock()
 	fake.readManifestMutex.RLock()
 	defer fake.readManifestMutex.RUnlock()
+	fake.readManifestServiceInstancesMutex.RLock()
+	defer fake.readManifestServiceInstancesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,180 @@
+package v3
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . IsolationSegmentActor
+
+type IsolationSegmentActor interface {
+	CloudControllerAPIVersion() string
+	GetIsolationSegmentUsageByName(name string) (v3action.IsolationSegmentUsage, v3action.Warnings, error)
+}
+
+type IsolationSegmentCommand struct {
+	RequiredArgs    flag.IsolationSegmentName `positional-args:"yes"`
+	JSON            bool                      `long:"json" description:"Output the usage report as JSON"`
+	usage           interface{}               `usage:"CF_NAME isolation-segment SEGMENT_NAME [--json]"`
+	relatedCommands interface{}               `related_commands:"isolation-segments, set-org-default-isolation-segment, set-space-isolation-segment"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       IsolationSegmentActor
+}
+
+type isolationSegmentUsageJSON struct {
+	Name             string                      `json:"name"`
+	GUID             string                      `json:"guid"`
+	EntitledOrgs     []string                    `json:"entitled_orgs"`
+	DefaultForOrgs   []string                    `json:"default_for_orgs"`
+	Apps             int                         `json:"apps"`
+	DesiredInstances int                         `json:"desired_instances"`
+	Spaces           []isolationSegmentSpaceJSON `json:"spaces"`
+}
+
+type isolationSegmentSpaceJSON struct {
+	Name             string `json:"name"`
+	GUID             string `json:"guid"`
+	Org              string `json:"org"`
+	InheritedFromOrg bool   `json:"inherited_from_org"`
+	Apps             int    `json:"apps"`
+	StartedApps      int    `json:"started_apps"`
+	DesiredInstances int    `json:"desired_instances"`
+}
+
+func (cmd *IsolationSegmentCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd IsolationSegmentCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), command.MinVersionIsolationSegmentV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if !cmd.JSON {
+		cmd.UI.DisplayTextWithFlavor("Getting isolation segment {{.SegmentName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"SegmentName": cmd.RequiredArgs.IsolationSegmentName,
+			"CurrentUser": user.Name,
+		})
+	}
+
+	usage, warnings, err := cmd.Actor.GetIsolationSegmentUsageByName(cmd.RequiredArgs.IsolationSegmentName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.JSON {
+		return cmd.displayJSON(usage)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("name:"), usage.Name},
+		{cmd.UI.TranslateText("entitled orgs:"), strings.Join(usage.EntitledOrgs, ", ")},
+		{cmd.UI.TranslateText("default for orgs:"), strings.Join(usage.DefaultForOrgs, ", ")},
+		{cmd.UI.TranslateText("apps:"), strconv.Itoa(usage.Apps())},
+		{cmd.UI.TranslateText("desired instances:"), strconv.Itoa(usage.DesiredInstances())},
+	}, 3)
+	cmd.UI.DisplayNewline()
+
+	if len(usage.Spaces) == 0 {
+		cmd.UI.DisplayText("No spaces are running on this isolation segment.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("assigned by"),
+			cmd.UI.TranslateText("apps"),
+			cmd.UI.TranslateText("started apps"),
+			cmd.UI.TranslateText("desired instances"),
+		},
+	}
+
+	for _, space := range usage.Spaces {
+		assignedBy := cmd.UI.TranslateText("space")
+		if space.InheritedFromOrg {
+			assignedBy = cmd.UI.TranslateText("org default")
+		}
+
+		table = append(table, []string{
+			space.Name,
+			space.OrganizationName,
+			assignedBy,
+			strconv.Itoa(space.Apps),
+			strconv.Itoa(space.StartedApps),
+			strconv.Itoa(space.DesiredInstances),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	return nil
+}
+
+func (cmd IsolationSegmentCommand) displayJSON(usage v3action.IsolationSegmentUsage) error {
+	output := isolationSegmentUsageJSON{
+		Name:             usage.Name,
+		GUID:             usage.GUID,
+		EntitledOrgs:     usage.EntitledOrgs,
+		DefaultForOrgs:   usage.DefaultForOrgs,
+		Apps:             usage.Apps(),
+		DesiredInstances: usage.DesiredInstances(),
+		Spaces:           []isolationSegmentSpaceJSON{},
+	}
+
+	for _, space := range usage.Spaces {
+		output.Spaces = append(output.Spaces, isolationSegmentSpaceJSON{
+			Name:             space.Name,
+			GUID:             space.GUID,
+			Org:              space.OrganizationName,
+			InheritedFromOrg: space.InheritedFromOrg,
+			Apps:             space.Apps,
+			StartedApps:      space.StartedApps,
+			DesiredInstances: space.DesiredInstances,
+		})
+	}
+
+	raw, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(raw))
+	return err
+}
@@ -0,0 +1,237 @@
+package v3_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("isolation-segment Command", func() {
+	var (
+		cmd             v3.IsolationSegmentCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeIsolationSegmentActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeIsolationSegmentActor)
+
+		cmd = v3.IsolationSegmentCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.IsolationSegmentName = "some-iso-seg"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns(command.MinVersionIsolationSegmentV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: command.MinVersionIsolationSegmentV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the user is logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		})
+
+		Context("when getting the isolation segment usage succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.GetIsolationSegmentUsageByNameReturns(
+					v3action.IsolationSegmentUsage{
+						Name:           "some-iso-seg",
+						GUID:           "some-iso-guid",
+						EntitledOrgs:   []string{"org-1", "org-2"},
+						DefaultForOrgs: []string{"org-1"},
+						Spaces: []v3action.IsolationSegmentSpaceUsage{
+							{
+								Name:             "space-1",
+								GUID:             "space-guid-1",
+								OrganizationName: "org-1",
+								InheritedFromOrg: true,
+								Apps:             1,
+								StartedApps:      1,
+								DesiredInstances: 1,
+							},
+							{
+								Name:             "space-2",
+								GUID:             "space-guid-2",
+								OrganizationName: "org-2",
+								Apps:             2,
+								StartedApps:      1,
+								DesiredInstances: 5,
+							},
+						},
+					},
+					v3action.Warnings{"warning-1", "warning-2"},
+					nil,
+				)
+			})
+
+			It("displays the usage report and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Getting isolation segment some-iso-seg as banana..."))
+				Expect(testUI.Out).To(Say("OK\n\n"))
+				Expect(testUI.Out).To(Say(`name:\s+some-iso-seg`))
+				Expect(testUI.Out).To(Say(`entitled orgs:\s+org-1, org-2`))
+				Expect(testUI.Out).To(Say(`default for orgs:\s+org-1`))
+				Expect(testUI.Out).To(Say(`apps:\s+3`))
+				Expect(testUI.Out).To(Say(`desired instances:\s+6`))
+				Expect(testUI.Out).To(Say(`space\s+org\s+assigned by\s+apps\s+started apps\s+desired instances`))
+				Expect(testUI.Out).To(Say(`space-1\s+org-1\s+org default\s+1\s+1\s+1`))
+				Expect(testUI.Out).To(Say(`space-2\s+org-2\s+space\s+2\s+1\s+5`))
+
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("warning-2"))
+
+				Expect(fakeActor.GetIsolationSegmentUsageByNameCallCount()).To(Equal(1))
+				Expect(fakeActor.GetIsolationSegmentUsageByNameArgsForCall(0)).To(Equal("some-iso-seg"))
+			})
+
+			Context("when the --json flag is provided", func() {
+				BeforeEach(func() {
+					cmd.JSON = true
+				})
+
+				It("outputs only the usage report as JSON", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).ToNot(Say("Getting isolation segment"))
+
+					var output map[string]interface{}
+					Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &output)).To(Succeed())
+					Expect(output).To(Equal(map[string]interface{}{
+						"name":              "some-iso-seg",
+						"guid":              "some-iso-guid",
+						"entitled_orgs":     []interface{}{"org-1", "org-2"},
+						"default_for_orgs":  []interface{}{"org-1"},
+						"apps":              float64(3),
+						"desired_instances": float64(6),
+						"spaces": []interface{}{
+							map[string]interface{}{
+								"name":               "space-1",
+								"guid":               "space-guid-1",
+								"org":                "org-1",
+								"inherited_from_org": true,
+								"apps":               float64(1),
+								"started_apps":       float64(1),
+								"desired_instances":  float64(1),
+							},
+							map[string]interface{}{
+								"name":               "space-2",
+								"guid":               "space-guid-2",
+								"org":                "org-2",
+								"inherited_from_org": false,
+								"apps":               float64(2),
+								"started_apps":       float64(1),
+								"desired_instances":  float64(5),
+							},
+						},
+					}))
+
+					Expect(testUI.Err).To(Say("warning-1"))
+				})
+			})
+		})
+
+		Context("when no spaces run on the isolation segment", func() {
+			BeforeEach(func() {
+				fakeActor.GetIsolationSegmentUsageByNameReturns(
+					v3action.IsolationSegmentUsage{
+						Name:           "some-iso-seg",
+						EntitledOrgs:   []string{},
+						DefaultForOrgs: []string{},
+						Spaces:         []v3action.IsolationSegmentSpaceUsage{},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("displays a message instead of the spaces table", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`desired instances:\s+0`))
+				Expect(testUI.Out).To(Say("No spaces are running on this isolation segment."))
+			})
+		})
+
+		Context("when the isolation segment does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetIsolationSegmentUsageByNameReturns(
+					v3action.IsolationSegmentUsage{},
+					v3action.Warnings{"warning-1"},
+					v3action.IsolationSegmentNotFoundError{Name: "some-iso-seg"},
+				)
+			})
+
+			It("returns an IsolationSegmentNotFoundError and displays warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.IsolationSegmentNotFoundError{Name: "some-iso-seg"}))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+
+		Context("when getting the isolation segment usage fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeActor.GetIsolationSegmentUsageByNameReturns(v3action.IsolationSegmentUsage{}, v3action.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+	})
+})
@@ -0,0 +1,123 @@
+package v3
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/cron"
+)
+
+//go:generate counterfeiter . ScheduleTaskActor
+
+type ScheduleTaskActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+}
+
+type ScheduleTaskCommand struct {
+	RequiredArgs    flag.RunTaskArgs `positional-args:"yes"`
+	Cron            string           `long:"cron" required:"true" description:"Cron expression describing when to run the task (e.g. \"0 2 * * *\", \"*/15 * * * *\", @daily)"`
+	Disk            flag.Megabytes   `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	Memory          flag.Megabytes   `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	Name            string           `long:"name" required:"true" description:"Name of the schedule, also used as the name of every task it runs"`
+	usage           interface{}      `usage:"CF_NAME schedule-task APP_NAME COMMAND --name SCHEDULE_NAME --cron CRON_EXPRESSION [-k DISK] [-m MEMORY]\n\nTIP:\n   Scheduled tasks only run while 'CF_NAME task-scheduler run' is running. It picks up schedule changes within a minute.\n\nEXAMPLES:\n   CF_NAME schedule-task my-app \"bundle exec rake db:migrate\" --name nightly-migrate --cron \"0 2 * * *\""`
+	relatedCommands interface{}      `related_commands:"run-task, scheduled-tasks, task-scheduler, unschedule-task"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ScheduleTaskActor
+}
+
+func (cmd *ScheduleTaskCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd ScheduleTaskCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), command.MinVersionRunTaskV3)
+	if err != nil {
+		return err
+	}
+
+	schedule, err := cron.Parse(cmd.Cron)
+	if err != nil {
+		if e, ok := err.(cron.InvalidExpressionError); ok {
+			return translatableerror.InvalidCronExpressionError{Expression: e.Expression, Reason: e.Reason}
+		}
+		return err
+	}
+
+	err = cmd.Config.LoadScheduledTasksConfig()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Scheduling task {{.ScheduleName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ScheduleName": cmd.Name,
+		"AppName":      cmd.RequiredArgs.AppName,
+		"OrgName":      cmd.Config.TargetedOrganization().Name,
+		"SpaceName":    space.Name,
+		"CurrentUser":  user.Name,
+	})
+
+	_, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.Config.AddScheduledTask(configv3.ScheduledTask{
+		Name:           cmd.Name,
+		AppName:        cmd.RequiredArgs.AppName,
+		OrgName:        cmd.Config.TargetedOrganization().Name,
+		SpaceName:      space.Name,
+		SpaceGUID:      space.GUID,
+		Command:        cmd.RequiredArgs.Command,
+		CronExpression: cmd.Cron,
+		MemoryInMB:     cmd.Memory.Size,
+		DiskInMB:       cmd.Disk.Size,
+	})
+
+	err = cmd.Config.WriteScheduledTasksConfig()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("schedule name:"), cmd.Name},
+		{cmd.UI.TranslateText("cron:"), schedule.String()},
+		{cmd.UI.TranslateText("next run:"), cmd.UI.UserFriendlyDate(schedule.Next(time.Now()))},
+	}, 3)
+
+	return nil
+}
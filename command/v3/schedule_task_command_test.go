@@ -0,0 +1,211 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("schedule-task Command", func() {
+	var (
+		cmd             v3.ScheduleTaskCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeScheduleTaskActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeScheduleTaskActor)
+
+		cmd = v3.ScheduleTaskCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app-name"
+		cmd.RequiredArgs.Command = "some command"
+		cmd.Name = "nightly"
+		cmd.Cron = "0 2 * * *"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns(command.MinVersionRunTaskV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: command.MinVersionRunTaskV3,
+			}))
+		})
+	})
+
+	Context("when the cron expression is invalid", func() {
+		BeforeEach(func() {
+			cmd.Cron = "0 2 * *"
+		})
+
+		It("returns an InvalidCronExpressionError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidCronExpressionError{
+				Expression: "0 2 * *",
+				Reason:     "expected 5 fields, found 4",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when loading the schedule file fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("load error")
+			fakeConfig.LoadScheduledTasksConfigReturns(expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and a space and org are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+				GUID: "some-org-guid",
+				Name: "some-org",
+			})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{
+				GUID: "some-space-guid",
+				Name: "some-space",
+			})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when getting the current user returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("got bananapants??")
+				fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					v3action.Application{},
+					v3action.Warnings{"get-application-warning"},
+					v3action.ApplicationNotFoundError{Name: "some-app-name"})
+			})
+
+			It("returns an ApplicationNotFoundError and does not save the schedule", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(testUI.Err).To(Say("get-application-warning"))
+				Expect(fakeConfig.AddScheduledTaskCallCount()).To(Equal(0))
+				Expect(fakeConfig.WriteScheduledTasksConfigCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					v3action.Application{GUID: "some-app-guid"},
+					v3action.Warnings{"get-application-warning"},
+					nil)
+				cmd.Memory.Size = 256
+				cmd.Disk.Size = 512
+			})
+
+			It("saves the scheduled task", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app-name"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeConfig.AddScheduledTaskCallCount()).To(Equal(1))
+				Expect(fakeConfig.AddScheduledTaskArgsForCall(0)).To(Equal(configv3.ScheduledTask{
+					Name:           "nightly",
+					AppName:        "some-app-name",
+					OrgName:        "some-org",
+					SpaceName:      "some-space",
+					SpaceGUID:      "some-space-guid",
+					Command:        "some command",
+					CronExpression: "0 2 * * *",
+					MemoryInMB:     256,
+					DiskInMB:       512,
+				}))
+				Expect(fakeConfig.WriteScheduledTasksConfigCallCount()).To(Equal(1))
+
+				Expect(testUI.Out).To(Say("Scheduling task nightly for app some-app-name in org some-org / space some-space as some-user..."))
+				Expect(testUI.Err).To(Say("get-application-warning"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`schedule name:\s+nightly`))
+				Expect(testUI.Out).To(Say(`cron:\s+0 2 \* \* \*`))
+				Expect(testUI.Out).To(Say(`next run:\s+\w+`))
+			})
+
+			Context("when writing the schedule file fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("write error")
+					fakeConfig.WriteScheduledTasksConfigReturns(expectedErr)
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Out).ToNot(Say("OK"))
+				})
+			})
+		})
+	})
+})
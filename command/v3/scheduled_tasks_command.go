@@ -0,0 +1,70 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/command"
+)
+
+type ScheduledTasksCommand struct {
+	usage           interface{} `usage:"CF_NAME scheduled-tasks"`
+	relatedCommands interface{} `related_commands:"schedule-task, task-scheduler, unschedule-task"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *ScheduledTasksCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd ScheduledTasksCommand) Execute(args []string) error {
+	err := cmd.Config.LoadScheduledTasksConfig()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Listing scheduled tasks...")
+	cmd.UI.DisplayNewline()
+
+	scheduledTasks := cmd.Config.ScheduledTasks()
+	if len(scheduledTasks) == 0 {
+		cmd.UI.DisplayText("No scheduled tasks found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("cron"),
+			cmd.UI.TranslateText("command"),
+			cmd.UI.TranslateText("last run"),
+			cmd.UI.TranslateText("last status"),
+		},
+	}
+	for _, scheduledTask := range scheduledTasks {
+		var lastRun, lastStatus string
+		if !scheduledTask.LastRun.Time.IsZero() {
+			lastRun = cmd.UI.UserFriendlyDate(scheduledTask.LastRun.Time)
+			lastStatus = cmd.UI.TranslateText(scheduledTask.LastRun.Status)
+		}
+
+		table = append(table, []string{
+			scheduledTask.Name,
+			scheduledTask.AppName,
+			scheduledTask.OrgName,
+			scheduledTask.SpaceName,
+			scheduledTask.CronExpression,
+			scheduledTask.Command,
+			lastRun,
+			lastStatus,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
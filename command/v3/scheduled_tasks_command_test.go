@@ -0,0 +1,98 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("scheduled-tasks Command", func() {
+	var (
+		cmd        v3.ScheduledTasksCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = v3.ScheduledTasksCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when loading the schedule file fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("load error")
+			fakeConfig.LoadScheduledTasksConfigReturns(expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(fakeConfig.ScheduledTasksCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when there are no scheduled tasks", func() {
+		BeforeEach(func() {
+			fakeConfig.ScheduledTasksReturns([]configv3.ScheduledTask{})
+		})
+
+		It("displays that there are no scheduled tasks", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Listing scheduled tasks..."))
+			Expect(testUI.Out).To(Say("No scheduled tasks found."))
+		})
+	})
+
+	Context("when there are scheduled tasks", func() {
+		BeforeEach(func() {
+			fakeConfig.ScheduledTasksReturns([]configv3.ScheduledTask{
+				{
+					Name:           "hourly",
+					AppName:        "app-1",
+					OrgName:        "some-org",
+					SpaceName:      "some-space",
+					Command:        "bin/cleanup",
+					CronExpression: "@hourly",
+				},
+				{
+					Name:           "nightly",
+					AppName:        "app-2",
+					OrgName:        "some-org",
+					SpaceName:      "some-space",
+					Command:        "bin/migrate",
+					CronExpression: "0 2 * * *",
+					LastRun: configv3.ScheduledTaskRun{
+						Time:   time.Date(2017, time.August, 16, 2, 0, 0, 0, time.UTC),
+						Status: "submitted",
+					},
+				},
+			})
+		})
+
+		It("displays the scheduled tasks with their last run", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Listing scheduled tasks..."))
+			Expect(testUI.Out).To(Say(`name\s+app\s+org\s+space\s+cron\s+command\s+last run\s+last status`))
+			Expect(testUI.Out).To(Say(`hourly\s+app-1\s+some-org\s+some-space\s+@hourly\s+bin/cleanup\s*\n`))
+			Expect(testUI.Out).To(Say(`nightly\s+app-2\s+some-org\s+some-space\s+0 2 \* \* \*\s+bin/migrate\s+%s\s+submitted`, testUI.UserFriendlyDate(time.Date(2017, time.August, 16, 2, 0, 0, 0, time.UTC))))
+		})
+	})
+})
@@ -0,0 +1,141 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . ShareServiceActor
+
+type ShareServiceActor interface {
+	CloudControllerAPIVersion() string
+	ShareServiceInstanceToSpace(serviceInstanceGUID string, spaceGUID string) (v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . ShareServiceActorV2
+
+type ShareServiceActorV2 interface {
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+}
+
+type ShareServiceCommand struct {
+	RequiredArgs     flag.ServiceInstance `positional-args:"yes"`
+	SpaceName        string               `short:"s" required:"true" description:"Space to share the service instance into"`
+	OrganizationName string               `short:"o" description:"Org of the other space (Default: targeted org)"`
+	usage            interface{}          `usage:"CF_NAME share-service SERVICE_INSTANCE -s OTHER_SPACE [-o OTHER_ORG]"`
+	relatedCommands  interface{}          `related_commands:"bind-service, service, services, unshare-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ShareServiceActor
+	ActorV2     ShareServiceActorV2
+}
+
+func (cmd *ShareServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.ActorV2 = v2action.NewActor(ccClientV2, uaaClientV2, config)
+
+	return nil
+}
+
+func (cmd ShareServiceCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), command.MinVersionShareServiceV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	orgName := cmd.OrganizationName
+	if orgName == "" {
+		orgName = cmd.Config.TargetedOrganization().Name
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Sharing service instance {{.ServiceInstanceName}} into org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":             orgName,
+		"SpaceName":           cmd.SpaceName,
+		"Username":            user.Name,
+	})
+
+	serviceInstance, spaceGUID, err := lookupServiceInstanceAndSharingSpace(cmd.UI, cmd.Config, cmd.ActorV2, cmd.RequiredArgs.ServiceInstance, cmd.OrganizationName, cmd.SpaceName)
+	if err != nil {
+		return sharedV2.HandleError(err)
+	}
+
+	warnings, err := cmd.Actor.ShareServiceInstanceToSpace(serviceInstance.GUID, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// sharingSpaceLookupActor is the part of the V2 actor needed to find a
+// service instance in the targeted space and the space it is shared with.
+type sharingSpaceLookupActor interface {
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+}
+
+// lookupServiceInstanceAndSharingSpace returns the named service instance in
+// the targeted space and the GUID of the space named by the -s and -o flags.
+// The targeted org is used when no org name is given.
+func lookupServiceInstanceAndSharingSpace(ui command.UI, config command.Config, actor sharingSpaceLookupActor, serviceInstanceName string, orgName string, spaceName string) (v2action.ServiceInstance, string, error) {
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, config.TargetedSpace().GUID)
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		return v2action.ServiceInstance{}, "", err
+	}
+
+	orgGUID := config.TargetedOrganization().GUID
+	if orgName != "" {
+		org, orgWarnings, orgErr := actor.GetOrganizationByName(orgName)
+		ui.DisplayWarnings(orgWarnings)
+		if orgErr != nil {
+			return v2action.ServiceInstance{}, "", orgErr
+		}
+		orgGUID = org.GUID
+	}
+
+	space, warnings, err := actor.GetSpaceByOrganizationAndName(orgGUID, spaceName)
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		return v2action.ServiceInstance{}, "", err
+	}
+
+	return serviceInstance, space.GUID, nil
+}
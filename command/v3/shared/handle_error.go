@@ -46,6 +46,10 @@ func HandleError(err error) error {
 		return translatableerror.ProcessNotFoundError(e)
 	case v3action.ProcessInstanceNotFoundError:
 		return translatableerror.ProcessInstanceNotFoundError(e)
+	case v3action.ServiceInstanceNotShareableError:
+		return translatableerror.ServiceInstanceNotShareableError{}
+	case v3action.ServiceInstanceSharingDisabledError:
+		return translatableerror.ServiceInstanceSharingDisabledError{}
 	case v3action.StagingTimeoutError:
 		return translatableerror.StagingTimeoutError(e)
 	case v3action.TaskWorkersUnavailableError:
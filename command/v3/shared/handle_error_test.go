@@ -89,6 +89,14 @@ var _ = Describe("HandleError", func() {
 			v3action.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42},
 			translatableerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42}),
 
+		Entry("v3action.ServiceInstanceNotShareableError -> ServiceInstanceNotShareableError",
+			v3action.ServiceInstanceNotShareableError{},
+			translatableerror.ServiceInstanceNotShareableError{}),
+
+		Entry("v3action.ServiceInstanceSharingDisabledError -> ServiceInstanceSharingDisabledError",
+			v3action.ServiceInstanceSharingDisabledError{},
+			translatableerror.ServiceInstanceSharingDisabledError{}),
+
 		Entry("v3action.StagingTimeoutError -> StagingTimeoutError",
 			v3action.StagingTimeoutError{AppName: "some-app", Timeout: time.Nanosecond},
 			translatableerror.StagingTimeoutError{AppName: "some-app", Timeout: time.Nanosecond}),
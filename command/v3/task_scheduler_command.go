@@ -0,0 +1,202 @@
+package v3
+
+import (
+	"os"
+	"os/signal"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/cron"
+	"code.cloudfoundry.org/clock"
+)
+
+//go:generate counterfeiter . TaskSchedulerActor
+
+type TaskSchedulerActor interface {
+	CloudControllerAPIVersion() string
+	RunTaskScheduler(scheduledTasks []v3action.ScheduledTask, reload func() ([]v3action.ScheduledTask, error), clk clock.Clock, stop <-chan struct{}) <-chan v3action.ScheduledTaskRun
+}
+
+type TaskSchedulerCommand struct {
+	RequiredArgs    flag.TaskSchedulerArgs `positional-args:"yes"`
+	usage           interface{}            `usage:"CF_NAME task-scheduler run\n\nTIP:\n   The scheduler runs in the foreground until interrupted, picks up changes to the schedule at least once a minute and records the outcome of every run. Only one scheduler should run per schedule file."`
+	relatedCommands interface{}            `related_commands:"schedule-task, scheduled-tasks, tasks, unschedule-task"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       TaskSchedulerActor
+	Clock       clock.Clock
+}
+
+func (cmd *TaskSchedulerCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+	cmd.Clock = clock.NewClock()
+
+	client, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd TaskSchedulerCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), command.MinVersionRunTaskV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	scheduledTasks, err := cmd.loadScheduledTasks()
+	if err != nil {
+		return err
+	}
+
+	if len(scheduledTasks) == 0 {
+		cmd.UI.DisplayText("No scheduled tasks found.")
+		return nil
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Running task scheduler for {{.Count}} scheduled task(s) as {{.CurrentUser}}...", map[string]interface{}{
+		"Count":       len(scheduledTasks),
+		"CurrentUser": user.Name,
+	})
+	cmd.UI.DisplayText("Press Ctrl-C to stop.")
+	cmd.UI.DisplayNewline()
+
+	stop := make(chan struct{})
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt)
+	defer signal.Stop(interrupted)
+
+	runs := cmd.Actor.RunTaskScheduler(scheduledTasks, cmd.loadScheduledTasks, cmd.Clock, stop)
+	for {
+		select {
+		case <-interrupted:
+			close(stop)
+			interrupted = nil
+		case run, ok := <-runs:
+			if !ok {
+				cmd.UI.DisplayNewline()
+				cmd.UI.DisplayText("Task scheduler stopped.")
+				return nil
+			}
+
+			err = cmd.recordRun(run)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// loadScheduledTasks reads the schedule file. It is also called by the
+// scheduler while it runs, so it must not display anything.
+func (cmd TaskSchedulerCommand) loadScheduledTasks() ([]v3action.ScheduledTask, error) {
+	err := cmd.Config.LoadScheduledTasksConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	var scheduledTasks []v3action.ScheduledTask
+	for _, scheduledTask := range cmd.Config.ScheduledTasks() {
+		schedule, err := cron.Parse(scheduledTask.CronExpression)
+		if err != nil {
+			if e, ok := err.(cron.InvalidExpressionError); ok {
+				return nil, translatableerror.InvalidCronExpressionError{Expression: e.Expression, Reason: e.Reason}
+			}
+			return nil, err
+		}
+
+		scheduledTasks = append(scheduledTasks, v3action.ScheduledTask{
+			Name:      scheduledTask.Name,
+			AppName:   scheduledTask.AppName,
+			SpaceGUID: scheduledTask.SpaceGUID,
+			Task: v3action.Task{
+				Name:       scheduledTask.Name,
+				Command:    scheduledTask.Command,
+				MemoryInMB: scheduledTask.MemoryInMB,
+				DiskInMB:   scheduledTask.DiskInMB,
+			},
+			Schedule: schedule,
+		})
+	}
+
+	return scheduledTasks, nil
+}
+
+func (cmd TaskSchedulerCommand) recordRun(run v3action.ScheduledTaskRun) error {
+	cmd.UI.DisplayWarnings(run.Warnings)
+
+	if run.Status == v3action.ScheduleReloadFailed {
+		cmd.UI.DisplayWarning("{{.Time}} Unable to reload scheduled tasks, keeping the current schedule: {{.Error}}", map[string]interface{}{
+			"Time":  cmd.UI.UserFriendlyDate(run.Time),
+			"Error": cmd.errorMessage(run.Err),
+		})
+		return nil
+	}
+
+	values := map[string]interface{}{
+		"Time":         cmd.UI.UserFriendlyDate(run.Time),
+		"ScheduleName": run.ScheduledTask.Name,
+		"AppName":      run.ScheduledTask.AppName,
+		"TaskID":       run.Task.SequenceID,
+		"TaskState":    run.Task.State,
+	}
+
+	lastRun := configv3.ScheduledTaskRun{
+		Time:           run.Time,
+		Status:         string(run.Status),
+		TaskSequenceID: run.Task.SequenceID,
+	}
+
+	switch run.Status {
+	case v3action.ScheduledTaskSubmitted:
+		cmd.UI.DisplayText("{{.Time}} {{.ScheduleName}}: submitted task {{.TaskID}} on app {{.AppName}}", values)
+	case v3action.ScheduledTaskSkipped:
+		cmd.UI.DisplayText("{{.Time}} {{.ScheduleName}}: skipped, task {{.TaskID}} on app {{.AppName}} is still {{.TaskState}}", values)
+	case v3action.ScheduledTaskFailed:
+		values["Error"] = run.Err.Error()
+		cmd.UI.DisplayWarning("{{.Time}} {{.ScheduleName}}: failed to run task on app {{.AppName}}: {{.Error}}", values)
+		lastRun.Message = run.Err.Error()
+	}
+
+	return cmd.Config.RecordScheduledTaskRun(run.ScheduledTask.Name, lastRun)
+}
+
+// errorMessage renders err the way it would be displayed had the command
+// failed with it.
+func (cmd TaskSchedulerCommand) errorMessage(err error) string {
+	translatableErr, ok := err.(translatableerror.TranslatableError)
+	if !ok {
+		return err.Error()
+	}
+
+	return translatableErr.Translate(func(template string, templateValues ...interface{}) string {
+		var values []map[string]interface{}
+		for _, templateValue := range templateValues {
+			if value, ok := templateValue.(map[string]interface{}); ok {
+				values = append(values, value)
+			}
+		}
+		return cmd.UI.TranslateText(template, values...)
+	})
+}
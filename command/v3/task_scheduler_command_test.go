@@ -0,0 +1,274 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("task-scheduler Command", func() {
+	var (
+		cmd             v3.TaskSchedulerCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeTaskSchedulerActor
+		fakeClock       *fakeclock.FakeClock
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeTaskSchedulerActor)
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+
+		cmd = v3.TaskSchedulerCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Clock:       fakeClock,
+		}
+		cmd.RequiredArgs.Action.Action = "run"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(command.MinVersionRunTaskV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: command.MinVersionRunTaskV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when loading the schedule file fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("load error")
+			fakeConfig.LoadScheduledTasksConfigReturns(expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(fakeActor.RunTaskSchedulerCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when there are no scheduled tasks", func() {
+		It("displays that there are no scheduled tasks and returns", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No scheduled tasks found."))
+			Expect(fakeActor.RunTaskSchedulerCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a scheduled task has an invalid cron expression", func() {
+		BeforeEach(func() {
+			fakeConfig.ScheduledTasksReturns([]configv3.ScheduledTask{
+				{Name: "broken", CronExpression: "not a cron"},
+			})
+		})
+
+		It("returns an InvalidCronExpressionError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidCronExpressionError{
+				Expression: "not a cron",
+				Reason:     "expected 5 fields, found 3",
+			}))
+		})
+	})
+
+	Context("when there are scheduled tasks", func() {
+		var (
+			nightly   configv3.ScheduledTask
+			runTime   time.Time
+			scheduled []v3action.ScheduledTask
+		)
+
+		BeforeEach(func() {
+			nightly = configv3.ScheduledTask{
+				Name:           "nightly",
+				AppName:        "some-app",
+				SpaceGUID:      "some-space-guid",
+				Command:        "bin/migrate",
+				CronExpression: "0 2 * * *",
+				MemoryInMB:     256,
+			}
+			fakeConfig.ScheduledTasksReturns([]configv3.ScheduledTask{nightly})
+
+			runTime = time.Date(2017, time.August, 16, 2, 0, 0, 0, time.UTC)
+
+			fakeActor.RunTaskSchedulerStub = func(scheduledTasks []v3action.ScheduledTask, _ func() ([]v3action.ScheduledTask, error), _ clock.Clock, _ <-chan struct{}) <-chan v3action.ScheduledTaskRun {
+				scheduled = scheduledTasks
+
+				runs := make(chan v3action.ScheduledTaskRun, 3)
+				runs <- v3action.ScheduledTaskRun{
+					ScheduledTask: scheduledTasks[0],
+					Time:          runTime,
+					Status:        v3action.ScheduledTaskSubmitted,
+					Task:          v3action.Task{SequenceID: 3},
+					Warnings:      v3action.Warnings{"run-warning"},
+				}
+				runs <- v3action.ScheduledTaskRun{
+					ScheduledTask: scheduledTasks[0],
+					Time:          runTime,
+					Status:        v3action.ScheduledTaskSkipped,
+					Task:          v3action.Task{SequenceID: 3, State: "RUNNING"},
+				}
+				runs <- v3action.ScheduledTaskRun{
+					ScheduledTask: scheduledTasks[0],
+					Time:          runTime,
+					Status:        v3action.ScheduledTaskFailed,
+					Err:           errors.New("some-error"),
+				}
+				close(runs)
+				return runs
+			}
+		})
+
+		It("runs the scheduler with the provided clock", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.RunTaskSchedulerCallCount()).To(Equal(1))
+			_, _, clk, _ := fakeActor.RunTaskSchedulerArgsForCall(0)
+			Expect(clk).To(Equal(fakeClock))
+
+			Expect(scheduled).To(HaveLen(1))
+			Expect(scheduled[0].Name).To(Equal("nightly"))
+			Expect(scheduled[0].AppName).To(Equal("some-app"))
+			Expect(scheduled[0].SpaceGUID).To(Equal("some-space-guid"))
+			Expect(scheduled[0].Task).To(Equal(v3action.Task{Name: "nightly", Command: "bin/migrate", MemoryInMB: 256}))
+			Expect(scheduled[0].Schedule.String()).To(Equal("0 2 * * *"))
+		})
+
+		It("reloads the schedule file when the scheduler asks for it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeConfig.LoadScheduledTasksConfigCallCount()).To(Equal(1))
+
+			weekly := nightly
+			weekly.Name = "weekly"
+			weekly.CronExpression = "@weekly"
+			fakeConfig.ScheduledTasksReturns([]configv3.ScheduledTask{nightly, weekly})
+
+			_, reload, _, _ := fakeActor.RunTaskSchedulerArgsForCall(0)
+			reloaded, err := reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConfig.LoadScheduledTasksConfigCallCount()).To(Equal(2))
+			Expect(reloaded).To(HaveLen(2))
+			Expect(reloaded[1].Name).To(Equal("weekly"))
+			Expect(reloaded[1].Schedule.String()).To(Equal("@weekly"))
+		})
+
+		It("displays the outcome of every run", func() {
+			date := testUI.UserFriendlyDate(runTime)
+
+			Expect(testUI.Out).To(Say("Running task scheduler for 1 scheduled task\\(s\\) as some-user..."))
+			Expect(testUI.Out).To(Say("Press Ctrl-C to stop."))
+			Expect(testUI.Out).To(Say("%s nightly: submitted task 3 on app some-app", date))
+			Expect(testUI.Out).To(Say("%s nightly: skipped, task 3 on app some-app is still RUNNING", date))
+			Expect(testUI.Out).To(Say("Task scheduler stopped."))
+
+			Expect(testUI.Err).To(Say("run-warning"))
+			Expect(testUI.Err).To(Say("%s nightly: failed to run task on app some-app: some-error", date))
+		})
+
+		It("records the outcome of every run without rewriting the schedule file", func() {
+			Expect(fakeConfig.RecordScheduledTaskRunCallCount()).To(Equal(3))
+
+			name, lastRun := fakeConfig.RecordScheduledTaskRunArgsForCall(0)
+			Expect(name).To(Equal("nightly"))
+			Expect(lastRun).To(Equal(configv3.ScheduledTaskRun{Time: runTime, Status: "submitted", TaskSequenceID: 3}))
+
+			name, lastRun = fakeConfig.RecordScheduledTaskRunArgsForCall(1)
+			Expect(name).To(Equal("nightly"))
+			Expect(lastRun).To(Equal(configv3.ScheduledTaskRun{Time: runTime, Status: "skipped", TaskSequenceID: 3}))
+
+			name, lastRun = fakeConfig.RecordScheduledTaskRunArgsForCall(2)
+			Expect(name).To(Equal("nightly"))
+			Expect(lastRun).To(Equal(configv3.ScheduledTaskRun{Time: runTime, Status: "failed", Message: "some-error"}))
+
+			Expect(fakeConfig.AddScheduledTaskCallCount()).To(Equal(0))
+			Expect(fakeConfig.WriteScheduledTasksConfigCallCount()).To(Equal(0))
+		})
+
+		Context("when recording a run fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("write error")
+				fakeConfig.RecordScheduledTaskRunReturns(expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeConfig.RecordScheduledTaskRunCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when reloading the schedule fails", func() {
+			BeforeEach(func() {
+				fakeActor.RunTaskSchedulerStub = func(scheduledTasks []v3action.ScheduledTask, _ func() ([]v3action.ScheduledTask, error), _ clock.Clock, _ <-chan struct{}) <-chan v3action.ScheduledTaskRun {
+					runs := make(chan v3action.ScheduledTaskRun, 1)
+					runs <- v3action.ScheduledTaskRun{
+						Time:   runTime,
+						Status: v3action.ScheduleReloadFailed,
+						Err:    translatableerror.InvalidCronExpressionError{Expression: "not a cron", Reason: "expected 5 fields, found 3"},
+					}
+					close(runs)
+					return runs
+				}
+			})
+
+			It("displays a warning and does not record a run", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("%s Unable to reload scheduled tasks, keeping the current schedule: Incorrect Usage: Invalid cron expression 'not a cron': expected 5 fields, found 3", testUI.UserFriendlyDate(runTime)))
+				Expect(fakeConfig.RecordScheduledTaskRunCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
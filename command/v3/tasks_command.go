@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3/shared"
 )
 
@@ -23,14 +24,19 @@ const (
 
 type TasksActor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
-	GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
+	GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder, filter v3action.TaskFilter) ([]v3action.Task, v3action.Warnings, error)
 	CloudControllerAPIVersion() string
 }
 
 type TasksCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME tasks APP_NAME"`
-	relatedCommands interface{}  `related_commands:"apps, logs, run-task, terminate-task"`
+	RequiredArgs    flag.AppName     `positional-args:"yes"`
+	States          []flag.TaskState `long:"state" description:"Only show tasks in this state (PENDING, RUNNING, SUCCEEDED, FAILED or CANCELING); may be repeated"`
+	Name            string           `long:"name" description:"Only show tasks with this name"`
+	Since           flag.Since       `long:"since" description:"Only show tasks created after this duration ago (e.g. 24h) or RFC3339 timestamp"`
+	Limit           int              `long:"limit" description:"Maximum number of tasks to show"`
+	Sort            string           `long:"sort" choice:"asc" choice:"desc" default:"desc" description:"Sort tasks by creation time in ascending or descending order"`
+	usage           interface{}      `usage:"CF_NAME tasks APP_NAME [--state STATE] [--name TASK_NAME] [--since (DURATION | TIMESTAMP)] [--limit COUNT] [--sort (asc | desc)]"`
+	relatedCommands interface{}      `related_commands:"apps, logs, run-task, terminate-task"`
 
 	UI          command.UI
 	Config      command.Config
@@ -58,6 +64,13 @@ func (cmd TasksCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Limit < 0 {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--limit",
+			ExpectedType: "a positive integer",
+		}
+	}
+
 	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -83,7 +96,12 @@ func (cmd TasksCommand) Execute(args []string) error {
 		"CurrentUser": user.Name,
 	})
 
-	tasks, warnings, err := cmd.Actor.GetApplicationTasks(application.GUID, v3action.Descending)
+	sortOrder := v3action.Descending
+	if cmd.Sort == "asc" {
+		sortOrder = v3action.Ascending
+	}
+
+	tasks, warnings, err := cmd.Actor.GetApplicationTasks(application.GUID, sortOrder, cmd.taskFilter())
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
@@ -124,3 +142,17 @@ func (cmd TasksCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd TasksCommand) taskFilter() v3action.TaskFilter {
+	filter := v3action.TaskFilter{
+		CreatedAfter: cmd.Since.Time,
+		Limit:        cmd.Limit,
+	}
+	for _, state := range cmd.States {
+		filter.States = append(filter.States, state.State)
+	}
+	if cmd.Name != "" {
+		filter.Names = []string{cmd.Name}
+	}
+	return filter
+}
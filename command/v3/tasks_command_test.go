@@ -2,12 +2,14 @@ package v3_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
@@ -66,6 +68,20 @@ var _ = Describe("tasks Command", func() {
 		})
 	})
 
+	Context("when the limit is negative", func() {
+		BeforeEach(func() {
+			cmd.Limit = -1
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--limit",
+				ExpectedType: "a positive integer",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
@@ -163,9 +179,10 @@ var _ = Describe("tasks Command", func() {
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 
 					Expect(fakeActor.GetApplicationTasksCallCount()).To(Equal(1))
-					guid, order := fakeActor.GetApplicationTasksArgsForCall(0)
+					guid, order, filter := fakeActor.GetApplicationTasksArgsForCall(0)
 					Expect(guid).To(Equal("some-app-guid"))
 					Expect(order).To(Equal(v3action.Descending))
+					Expect(filter).To(Equal(v3action.TaskFilter{}))
 
 					Expect(testUI.Out).To(Say(`Getting tasks for app some-app-name in org some-org / space some-space as some-user...
 OK
@@ -180,6 +197,33 @@ get-application-warning-2
 get-tasks-warning-1`))
 				})
 
+				Context("when filter, limit and sort flags are provided", func() {
+					var since time.Time
+
+					BeforeEach(func() {
+						since = time.Date(2017, time.August, 16, 10, 30, 0, 0, time.UTC)
+						cmd.States = []flag.TaskState{{State: "RUNNING"}, {State: "FAILED"}}
+						cmd.Name = "some-task-name"
+						cmd.Since = flag.Since{Time: since}
+						cmd.Limit = 5
+						cmd.Sort = "asc"
+					})
+
+					It("passes the filter and sort order to the actor", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.GetApplicationTasksCallCount()).To(Equal(1))
+						_, order, filter := fakeActor.GetApplicationTasksArgsForCall(0)
+						Expect(order).To(Equal(v3action.Ascending))
+						Expect(filter).To(Equal(v3action.TaskFilter{
+							States:       []string{"RUNNING", "FAILED"},
+							Names:        []string{"some-task-name"},
+							CreatedAfter: since,
+							Limit:        5,
+						}))
+					})
+				})
+
 				Context("when the tasks' command fields are returned as empty strings", func() {
 					BeforeEach(func() {
 						fakeActor.GetApplicationTasksReturns(
@@ -0,0 +1,52 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type UnscheduleTaskCommand struct {
+	RequiredArgs    flag.ScheduledTaskName `positional-args:"yes"`
+	usage           interface{}            `usage:"CF_NAME unschedule-task SCHEDULE_NAME"`
+	relatedCommands interface{}            `related_commands:"schedule-task, scheduled-tasks, task-scheduler"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *UnscheduleTaskCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd UnscheduleTaskCommand) Execute(args []string) error {
+	scheduleName := cmd.RequiredArgs.ScheduledTaskName
+
+	err := cmd.Config.LoadScheduledTasksConfig()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Removing scheduled task {{.ScheduleName}}...", map[string]interface{}{
+		"ScheduleName": scheduleName,
+	})
+
+	if _, exists := cmd.Config.GetScheduledTask(scheduleName); !exists {
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayWarning("Scheduled task {{.ScheduleName}} does not exist.", map[string]interface{}{
+			"ScheduleName": scheduleName,
+		})
+		return nil
+	}
+
+	cmd.Config.RemoveScheduledTask(scheduleName)
+	err = cmd.Config.WriteScheduledTasksConfig()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unschedule-task Command", func() {
+	var (
+		cmd        v3.UnscheduleTaskCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = v3.UnscheduleTaskCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.ScheduledTaskName = "nightly"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when loading the schedule file fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("load error")
+			fakeConfig.LoadScheduledTasksConfigReturns(expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(fakeConfig.RemoveScheduledTaskCallCount()).To(Equal(0))
+			Expect(fakeConfig.WriteScheduledTasksConfigCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the scheduled task does not exist", func() {
+		BeforeEach(func() {
+			fakeConfig.GetScheduledTaskReturns(configv3.ScheduledTask{}, false)
+		})
+
+		It("displays a warning and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Removing scheduled task nightly..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("Scheduled task nightly does not exist."))
+			Expect(fakeConfig.WriteScheduledTasksConfigCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the scheduled task exists", func() {
+		BeforeEach(func() {
+			fakeConfig.GetScheduledTaskReturns(configv3.ScheduledTask{Name: "nightly"}, true)
+		})
+
+		It("removes the scheduled task", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Removing scheduled task nightly..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeConfig.GetScheduledTaskArgsForCall(0)).To(Equal("nightly"))
+			Expect(fakeConfig.RemoveScheduledTaskCallCount()).To(Equal(1))
+			Expect(fakeConfig.RemoveScheduledTaskArgsForCall(0)).To(Equal("nightly"))
+			Expect(fakeConfig.WriteScheduledTasksConfigCallCount()).To(Equal(1))
+		})
+
+		Context("when writing the schedule file fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("write error")
+				fakeConfig.WriteScheduledTasksConfigReturns(expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
@@ -0,0 +1,203 @@
+package v3_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unshare-service Command", func() {
+	var (
+		cmd             v3.UnshareServiceCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeUnshareServiceActor
+		fakeActorV2     *v3fakes.FakeUnshareServiceActorV2
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeUnshareServiceActor)
+		fakeActorV2 = new(v3fakes.FakeUnshareServiceActorV2)
+
+		cmd = v3.UnshareServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV2:     fakeActorV2,
+		}
+
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+		cmd.SpaceName = "other-space"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns(command.MinVersionShareServiceV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.35.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "3.35.0",
+				MinimumVersion: command.MinVersionShareServiceV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the user is logged in and a space is targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+			fakeActorV2.GetServiceInstanceByNameAndSpaceReturns(
+				v2action.ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"},
+				v2action.Warnings{"instance-warning"},
+				nil)
+			fakeActorV2.GetSpaceByOrganizationAndNameReturns(
+				v2action.Space{GUID: "other-space-guid", Name: "other-space"},
+				v2action.Warnings{"space-warning"},
+				nil)
+		})
+
+		Context("when the user does not confirm", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("warns about removed bindings and cancels the unshare", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Err).To(Say("WARNING: Unsharing this service instance will remove any service bindings that exist in any spaces that this instance is shared into\\. This could cause applications to stop working\\."))
+				Expect(testUI.Out).To(Say("Really unshare the service instance\\?"))
+				Expect(testUI.Out).To(Say("Unshare cancelled"))
+
+				Expect(fakeActorV2.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(0))
+				Expect(fakeActor.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when the service instance is shared with the space", func() {
+				BeforeEach(func() {
+					fakeActorV2.GetServiceInstanceSharedTosByServiceInstanceReturns(
+						[]v2action.ServiceInstanceSharedTo{{SpaceGUID: "other-space-guid"}},
+						v2action.Warnings{"shared-to-warning"},
+						nil)
+				})
+
+				Context("when unsharing succeeds", func() {
+					BeforeEach(func() {
+						fakeActor.UnshareServiceInstanceFromSpaceReturns(v3action.Warnings{"unshare-warning"}, nil)
+					})
+
+					It("unshares the service instance from the space", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Unsharing service instance some-service-instance from org some-org / space other-space as some-user\\.\\.\\."))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Err).To(Say("instance-warning"))
+						Expect(testUI.Err).To(Say("space-warning"))
+						Expect(testUI.Err).To(Say("shared-to-warning"))
+						Expect(testUI.Err).To(Say("unshare-warning"))
+
+						Expect(fakeActorV2.GetServiceInstanceSharedTosByServiceInstanceArgsForCall(0)).To(Equal("some-service-instance-guid"))
+						serviceInstanceGUID, spaceGUID := fakeActor.UnshareServiceInstanceFromSpaceArgsForCall(0)
+						Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+						Expect(spaceGUID).To(Equal("other-space-guid"))
+					})
+				})
+
+				Context("when the service_instance_sharing feature flag is disabled", func() {
+					BeforeEach(func() {
+						fakeActor.UnshareServiceInstanceFromSpaceReturns(v3action.Warnings{"unshare-warning"}, v3action.ServiceInstanceSharingDisabledError{})
+					})
+
+					It("returns a ServiceInstanceSharingDisabledError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ServiceInstanceSharingDisabledError{}))
+						Expect(testUI.Err).To(Say("unshare-warning"))
+					})
+				})
+			})
+
+			Context("when the service instance is not shared with the space", func() {
+				BeforeEach(func() {
+					fakeActorV2.GetServiceInstanceSharedTosByServiceInstanceReturns(nil, v2action.Warnings{"shared-to-warning"}, nil)
+				})
+
+				It("says so and does not unshare", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Service instance some-service-instance is not shared with space other-space in org some-org\\."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(fakeActor.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the -f flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+				cmd.OrganizationName = "other-org"
+				fakeActorV2.GetOrganizationByNameReturns(v2action.Organization{GUID: "other-org-guid", Name: "other-org"}, nil, nil)
+				fakeActorV2.GetServiceInstanceSharedTosByServiceInstanceReturns(
+					[]v2action.ServiceInstanceSharedTo{{SpaceGUID: "other-space-guid"}}, nil, nil)
+			})
+
+			It("unshares without prompting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Really unshare the service instance\\?"))
+				Expect(testUI.Out).To(Say("Unsharing service instance some-service-instance from org other-org / space other-space as some-user\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActorV2.GetOrganizationByNameArgsForCall(0)).To(Equal("other-org"))
+				orgGUID, _ := fakeActorV2.GetSpaceByOrganizationAndNameArgsForCall(0)
+				Expect(orgGUID).To(Equal("other-org-guid"))
+				Expect(fakeActor.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,143 @@
+package v3
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . V3CreateAppManifestActor
+
+type V3CreateAppManifestActor interface {
+	GetApplicationEnvironmentVariables(appGUID string) (v3action.EnvironmentVariables, v3action.Warnings, error)
+	GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string) (v3action.ApplicationSummary, v3action.Warnings, error)
+}
+
+type V3CreateAppManifestCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	FilePath        flag.Path    `short:"p" description:"Specify a path for file creation. If path not specified, manifest file is created in current working directory."`
+	usage           interface{}  `usage:"CF_NAME v3-create-app-manifest APP_NAME [-p /path/to/<app-name>-manifest.yml]"`
+	relatedCommands interface{}  `related_commands:"v3-app, v3-push"`
+
+	UI              command.UI
+	Config          command.Config
+	SharedActor     command.SharedActor
+	Actor           V3CreateAppManifestActor
+	V2AppRouteActor shared.V2AppRouteActor
+}
+
+func (cmd *V3CreateAppManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config)
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.V2AppRouteActor = v2action.NewActor(ccClientV2, uaaClientV2, config)
+
+	return nil
+}
+
+func (cmd V3CreateAppManifestCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating an app manifest from current settings of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	summary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	envVars, warnings, err := cmd.Actor.GetApplicationEnvironmentVariables(summary.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	routes, routeWarnings, err := cmd.V2AppRouteActor.GetApplicationRoutes(summary.GUID)
+	cmd.UI.DisplayWarnings(routeWarnings)
+	if err != nil {
+		return sharedV2.HandleError(err)
+	}
+
+	manifestApp := manifest.Application{
+		Name:       summary.Name,
+		Buildpacks: summary.Buildpacks,
+		StackName:  summary.CurrentDroplet.Stack,
+	}
+
+	if len(manifestApp.Buildpacks) == 0 {
+		for _, buildpack := range summary.CurrentDroplet.Buildpacks {
+			manifestApp.Buildpacks = append(manifestApp.Buildpacks, buildpack.Name)
+		}
+	}
+
+	if len(envVars) > 0 {
+		manifestApp.EnvironmentVariables = envVars
+	}
+
+	for _, route := range routes {
+		manifestApp.Routes = append(manifestApp.Routes, route.String())
+	}
+
+	summary.Processes.Sort()
+	for _, process := range summary.Processes {
+		manifestApp.Processes = append(manifestApp.Processes, manifest.Process{
+			Type:                    process.Type,
+			Command:                 process.Command,
+			DiskQuota:               uint64(process.DiskInMB),
+			HealthCheckHTTPEndpoint: process.HealthCheckEndpoint,
+			HealthCheckTimeout:      process.HealthCheckTimeout,
+			HealthCheckType:         process.HealthCheckType,
+			Instances:               process.DesiredInstances,
+			Memory:                  uint64(process.MemoryInMB),
+		})
+	}
+
+	pathToManifest := cmd.FilePath.String()
+	if pathToManifest == "" {
+		pathToManifest = fmt.Sprintf("./%s_manifest.yml", summary.Name)
+	}
+
+	err = manifest.WriteApplicationManifest(pathToManifest, []manifest.Application{manifestApp})
+	if err != nil {
+		return translatableerror.ManifestCreationError{Err: err}
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Manifest file created successfully at {{.FilePath}}", map[string]interface{}{
+		"FilePath": pathToManifest,
+	})
+
+	return nil
+}
@@ -0,0 +1,265 @@
+package v3_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-create-app-manifest Command", func() {
+	var (
+		cmd             v3.V3CreateAppManifestCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3CreateAppManifestActor
+		fakeV2Actor     *sharedfakes.FakeV2AppRouteActor
+		binaryName      string
+		executeErr      error
+		app             string
+		tmpDir          string
+		pathToManifest  string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3CreateAppManifestActor)
+		fakeV2Actor = new(sharedfakes.FakeV2AppRouteActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		app = "some-app"
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "v3-create-app-manifest")
+		Expect(err).ToNot(HaveOccurred())
+		pathToManifest = filepath.Join(tmpDir, "manifest.yml")
+
+		cmd = v3.V3CreateAppManifestCommand{
+			RequiredArgs: flag.AppName{AppName: app},
+			FilePath:     flag.Path(pathToManifest),
+
+			UI:              testUI,
+			Config:          fakeConfig,
+			SharedActor:     fakeSharedActor,
+			Actor:           fakeActor,
+			V2AppRouteActor: fakeV2Actor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is not logged in", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("return an error", func() {
+			Expect(executeErr).To(Equal(expectedErr))
+		})
+	})
+
+	Context("when getting the application summary returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSummaryByNameAndSpaceReturns(v3action.ApplicationSummary{}, v3action.Warnings{"warning-1", "warning-2"}, v3action.ApplicationNotFoundError{Name: app})
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(Equal(translatableerror.ApplicationNotFoundError{Name: app}))
+
+			Expect(testUI.Out).To(Say("Creating an app manifest from current settings of app some-app in org some-org / space some-space as steve\\.\\.\\."))
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+		})
+	})
+
+	Context("when the application summary is retrieved", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSummaryByNameAndSpaceReturns(v3action.ApplicationSummary{
+				Application: v3action.Application{
+					Name:       "some-app",
+					GUID:       "some-app-guid",
+					Buildpacks: []string{"ruby_buildpack"},
+				},
+				CurrentDroplet: v3action.Droplet{
+					Stack: "cflinuxfs2",
+				},
+				Processes: v3action.Processes{
+					{
+						Type:             "worker",
+						Command:          "bundle exec worker",
+						DesiredInstances: 1,
+						MemoryInMB:       256,
+						DiskInMB:         512,
+						HealthCheckType:  "process",
+					},
+					{
+						Type:                "web",
+						Command:             "bundle exec rackup",
+						DesiredInstances:    2,
+						MemoryInMB:          128,
+						DiskInMB:            1024,
+						HealthCheckType:     "http",
+						HealthCheckEndpoint: "/health",
+						HealthCheckTimeout:  90,
+					},
+				},
+			}, v3action.Warnings{"summary-warning"}, nil)
+		})
+
+		Context("when getting the environment variables returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("env var error")
+				fakeActor.GetApplicationEnvironmentVariablesReturns(nil, v3action.Warnings{"env-warning"}, expectedErr)
+			})
+
+			It("returns the error and prints warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+
+				Expect(testUI.Err).To(Say("summary-warning"))
+				Expect(testUI.Err).To(Say("env-warning"))
+
+				Expect(fakeActor.GetApplicationEnvironmentVariablesCallCount()).To(Equal(1))
+				Expect(fakeActor.GetApplicationEnvironmentVariablesArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when getting the routes returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("route error")
+				fakeV2Actor.GetApplicationRoutesReturns(nil, v2action.Warnings{"route-warning"}, expectedErr)
+			})
+
+			It("returns the error and prints warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+
+				Expect(testUI.Err).To(Say("route-warning"))
+
+				Expect(fakeV2Actor.GetApplicationRoutesCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.GetApplicationRoutesArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the environment variables and routes are retrieved", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationEnvironmentVariablesReturns(v3action.EnvironmentVariables{"FOO": "bar"}, v3action.Warnings{"env-warning"}, nil)
+				fakeV2Actor.GetApplicationRoutesReturns(v2action.Routes{
+					{Host: "some-app", Domain: v2action.Domain{Name: "example.com"}},
+				}, v2action.Warnings{"route-warning"}, nil)
+			})
+
+			It("writes the manifest to the provided path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Creating an app manifest from current settings of app some-app in org some-org / space some-space as steve\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Manifest file created successfully at %s", pathToManifest))
+
+				Expect(testUI.Err).To(Say("summary-warning"))
+				Expect(testUI.Err).To(Say("env-warning"))
+				Expect(testUI.Err).To(Say("route-warning"))
+
+				Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID := fakeActor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				manifestBytes, err := ioutil.ReadFile(pathToManifest)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(manifestBytes)).To(Equal(`---
+applications:
+- name: some-app
+  buildpacks:
+  - ruby_buildpack
+  env:
+    FOO: bar
+  processes:
+  - type: web
+    command: bundle exec rackup
+    disk_quota: 1024M
+    health-check-http-endpoint: /health
+    health-check-type: http
+    instances: 2
+    memory: 128M
+    timeout: 90
+  - type: worker
+    command: bundle exec worker
+    disk_quota: 512M
+    health-check-type: process
+    instances: 1
+    memory: 256M
+  routes:
+  - route: some-app.example.com
+  stack: cflinuxfs2
+`))
+			})
+
+			Context("when the manifest cannot be written", func() {
+				BeforeEach(func() {
+					cmd.FilePath = flag.Path(filepath.Join(tmpDir, "does-not-exist", "manifest.yml"))
+				})
+
+				It("returns a ManifestCreationError", func() {
+					Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.ManifestCreationError{}))
+				})
+			})
+		})
+	})
+})
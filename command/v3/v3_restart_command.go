@@ -1,24 +1,36 @@
 package v3
 
 import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3/shared"
 )
 
 //go:generate counterfeiter . V3RestartActor
 
 type V3RestartActor interface {
+	DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, instanceIndex int) (v3action.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetProcessInstancesByApplicationAndProcessType(appGUID string, processType string) ([]v3action.Instance, v3action.Warnings, error)
+	PollProcessInstances(appGUID string, processType string, instanceIndexes []int, restartedAt time.Time) (v3action.Warnings, error)
 	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
 	StopApplication(appGUID string) (v3action.Warnings, error)
 }
 
 type V3RestartCommand struct {
 	RequiredArgs        flag.AppName `positional-args:"yes"`
-	usage               interface{}  `usage:"CF_NAME v3-restart APP_NAME"`
+	Rolling             bool         `long:"rolling" description:"Restart running instances in batches, waiting for each batch to be running before continuing"`
+	BatchSize           int          `long:"batch-size" default:"1" description:"Number of instances to restart at a time when using --rolling (Default: 1)"`
+	ProcessType         string       `long:"process" default:"web" description:"Process to restart when using --rolling"`
+	usage               interface{}  `usage:"CF_NAME v3-restart APP_NAME [--rolling [--batch-size COUNT] [--process PROCESS]]"`
 	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
 	UI          command.UI
@@ -42,6 +54,13 @@ func (cmd *V3RestartCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd V3RestartCommand) Execute(args []string) error {
+	if cmd.BatchSize < 1 {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--batch-size",
+			ExpectedType: "integer greater than 0",
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -58,6 +77,10 @@ func (cmd V3RestartCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if app.Started() && cmd.Rolling {
+		return cmd.rollingRestart(app, user.Name)
+	}
+
 	if app.Started() {
 		cmd.UI.DisplayTextWithFlavor("Stopping app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 			"AppName":   cmd.RequiredArgs.AppName,
@@ -93,3 +116,77 @@ func (cmd V3RestartCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd V3RestartCommand) rollingRestart(app v3action.Application, userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Restarting instances of process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ProcessType": cmd.ProcessType,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    userName,
+	})
+
+	instances, warnings, err := cmd.Actor.GetProcessInstancesByApplicationAndProcessType(app.GUID, cmd.ProcessType)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	var indexes []int
+	for _, instance := range instances {
+		indexes = append(indexes, instance.Index)
+	}
+	sort.Ints(indexes)
+
+	for start := 0; start < len(indexes); start += cmd.BatchSize {
+		end := start + cmd.BatchSize
+		if end > len(indexes) {
+			end = len(indexes)
+		}
+		batch := indexes[start:end]
+
+		var batchIndexes []string
+		for _, index := range batch {
+			batchIndexes = append(batchIndexes, strconv.Itoa(index))
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Restarting instance(s) {{.InstanceIndexes}}...", map[string]interface{}{
+			"InstanceIndexes": strings.Join(batchIndexes, ", "),
+		})
+
+		restartedAt := time.Now()
+		for _, index := range batch {
+			warnings, err = cmd.Actor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.ProcessType, index)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return shared.HandleError(err)
+			}
+		}
+
+		cmd.UI.DisplayText("Waiting for instance(s) to start...")
+		warnings, err = cmd.Actor.PollProcessInstances(app.GUID, cmd.ProcessType, batch, restartedAt)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			switch err.(type) {
+			case v3action.ProcessInstanceCrashedError:
+				return translatableerror.UnsuccessfulStartError{
+					AppName:    cmd.RequiredArgs.AppName,
+					BinaryName: cmd.Config.BinaryName(),
+				}
+			case v3action.StartupTimeoutError:
+				return translatableerror.StartupTimeoutError{
+					AppName:    cmd.RequiredArgs.AppName,
+					BinaryName: cmd.Config.BinaryName(),
+				}
+			default:
+				return shared.HandleError(err)
+			}
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -41,6 +41,7 @@ var _ = Describe("v3-restart Command", func() {
 
 		cmd = v3.V3RestartCommand{
 			RequiredArgs: flag.AppName{AppName: app},
+			BatchSize:    1,
 
 			UI:          testUI,
 			Config:      fakeConfig,
@@ -53,6 +54,20 @@ var _ = Describe("v3-restart Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when the batch size is less than 1", func() {
+		BeforeEach(func() {
+			cmd.BatchSize = 0
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--batch-size",
+				ExpectedType: "integer greater than 0",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: binaryName})
@@ -257,6 +272,127 @@ var _ = Describe("v3-restart Command", func() {
 			})
 		})
 
+		Context("when --rolling is provided", func() {
+			BeforeEach(func() {
+				cmd.Rolling = true
+				cmd.ProcessType = "web"
+				fakeConfig.BinaryNameReturns(binaryName)
+				fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid", State: "STARTED"}, v3action.Warnings{"get-warning"}, nil)
+				fakeActor.GetProcessInstancesByApplicationAndProcessTypeReturns([]v3action.Instance{{Index: 2}, {Index: 0}, {Index: 1}}, v3action.Warnings{"instances-warning"}, nil)
+				fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns(v3action.Warnings{"delete-warning"}, nil)
+				fakeActor.PollProcessInstancesReturns(v3action.Warnings{"poll-warning"}, nil)
+			})
+
+			It("restarts the instances one at a time without stopping the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Restarting instances of process web of app some-app in org some-org / space some-space as steve\\.\\.\\."))
+				Expect(testUI.Out).To(Say("Restarting instance\\(s\\) 0\\.\\.\\."))
+				Expect(testUI.Out).To(Say("Waiting for instance\\(s\\) to start\\.\\.\\."))
+				Expect(testUI.Out).To(Say("Restarting instance\\(s\\) 1\\.\\.\\."))
+				Expect(testUI.Out).To(Say("Restarting instance\\(s\\) 2\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(testUI.Err).To(Say("get-warning"))
+				Expect(testUI.Err).To(Say("instances-warning"))
+				Expect(testUI.Err).To(Say("delete-warning"))
+				Expect(testUI.Err).To(Say("poll-warning"))
+
+				Expect(fakeActor.StopApplicationCallCount()).To(BeZero(), "Expected StopApplication to not be called")
+				Expect(fakeActor.StartApplicationCallCount()).To(BeZero(), "Expected StartApplication to not be called")
+
+				Expect(fakeActor.GetProcessInstancesByApplicationAndProcessTypeCallCount()).To(Equal(1))
+				appGUID, processType := fakeActor.GetProcessInstancesByApplicationAndProcessTypeArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(processType).To(Equal("web"))
+
+				Expect(fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(3))
+				for i := 0; i < 3; i++ {
+					appName, spaceGUID, processType, index := fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall(i)
+					Expect(appName).To(Equal("some-app"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(processType).To(Equal("web"))
+					Expect(index).To(Equal(i))
+				}
+
+				Expect(fakeActor.PollProcessInstancesCallCount()).To(Equal(3))
+				for i := 0; i < 3; i++ {
+					appGUID, processType, indexes, _ := fakeActor.PollProcessInstancesArgsForCall(i)
+					Expect(appGUID).To(Equal("some-app-guid"))
+					Expect(processType).To(Equal("web"))
+					Expect(indexes).To(Equal([]int{i}))
+				}
+			})
+
+			Context("when a batch size is provided", func() {
+				BeforeEach(func() {
+					cmd.BatchSize = 2
+				})
+
+				It("restarts the instances in batches", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Restarting instance\\(s\\) 0, 1\\.\\.\\."))
+					Expect(testUI.Out).To(Say("Restarting instance\\(s\\) 2\\.\\.\\."))
+
+					Expect(fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(3))
+					Expect(fakeActor.PollProcessInstancesCallCount()).To(Equal(2))
+					_, _, indexes, _ := fakeActor.PollProcessInstancesArgsForCall(0)
+					Expect(indexes).To(Equal([]int{0, 1}))
+					_, _, indexes, _ = fakeActor.PollProcessInstancesArgsForCall(1)
+					Expect(indexes).To(Equal([]int{2}))
+				})
+			})
+
+			Context("when the app is not started", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid", State: "STOPPED"}, v3action.Warnings{"get-warning"}, nil)
+				})
+
+				It("starts the app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Starting app some-app in org some-org / space some-space as steve\\.\\.\\."))
+					Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+					Expect(fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when deleting an instance returns an error", func() {
+				BeforeEach(func() {
+					fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns(v3action.Warnings{"delete-warning"}, v3action.ProcessInstanceNotFoundError{ProcessType: "web", InstanceIndex: 0})
+				})
+
+				It("returns the translated error", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ProcessInstanceNotFoundError{ProcessType: "web", InstanceIndex: 0}))
+					Expect(testUI.Err).To(Say("delete-warning"))
+					Expect(fakeActor.PollProcessInstancesCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when a restarted instance crashes", func() {
+				BeforeEach(func() {
+					fakeActor.PollProcessInstancesReturns(v3action.Warnings{"poll-warning"}, v3action.ProcessInstanceCrashedError{ProcessType: "web", InstanceIndex: 0})
+				})
+
+				It("aborts the restart and returns an UnsuccessfulStartError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.UnsuccessfulStartError{AppName: "some-app", BinaryName: binaryName}))
+					Expect(testUI.Err).To(Say("poll-warning"))
+					Expect(fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when a restarted instance does not start in time", func() {
+				BeforeEach(func() {
+					fakeActor.PollProcessInstancesReturns(v3action.Warnings{"poll-warning"}, v3action.StartupTimeoutError{})
+				})
+
+				It("returns a StartupTimeoutError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{AppName: "some-app", BinaryName: binaryName}))
+					Expect(fakeActor.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(1))
+				})
+			})
+		})
+
 		Context("when the stop app call returns an error", func() {
 			BeforeEach(func() {
 				fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid", State: "STARTED"}, v3action.Warnings{"get-warning-1", "get-warning-2"}, nil)
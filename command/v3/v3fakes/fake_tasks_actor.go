@@ -25,11 +25,12 @@ type FakeTasksActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
-	GetApplicationTasksStub        func(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
+	GetApplicationTasksStub        func(appGUID string, sortOrder v3action.SortOrder, filter v3action.TaskFilter) ([]v3action.Task, v3action.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
 		appGUID   string
 		sortOrder v3action.SortOrder
+		filter    v3action.TaskFilter
 	}
 	getApplicationTasksReturns struct {
 		result1 []v3action.Task
@@ -109,17 +110,18 @@ func (fake *FakeTasksActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTasksActor) GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error) {
+func (fake *FakeTasksActor) GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder, filter v3action.TaskFilter) ([]v3action.Task, v3action.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
 	fake.getApplicationTasksArgsForCall = append(fake.getApplicationTasksArgsForCall, struct {
 		appGUID   string
 		sortOrder v3action.SortOrder
-	}{appGUID, sortOrder})
-	fake.recordInvocation("GetApplicationTasks", []interface{}{appGUID, sortOrder})
+		filter    v3action.TaskFilter
+	}{appGUID, sortOrder, filter})
+	fake.recordInvocation("GetApplicationTasks", []interface{}{appGUID, sortOrder, filter})
 	fake.getApplicationTasksMutex.Unlock()
 	if fake.GetApplicationTasksStub != nil {
-		return fake.GetApplicationTasksStub(appGUID, sortOrder)
+		return fake.GetApplicationTasksStub(appGUID, sortOrder, filter)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -133,10 +135,10 @@ func (fake *FakeTasksActor) GetApplicationTasksCallCount() int {
 	return len(fake.getApplicationTasksArgsForCall)
 }
 
-func (fake *FakeTasksActor) GetApplicationTasksArgsForCall(i int) (string, v3action.SortOrder) {
+func (fake *FakeTasksActor) GetApplicationTasksArgsForCall(i int) (string, v3action.SortOrder, v3action.TaskFilter) {
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
-	return fake.getApplicationTasksArgsForCall[i].appGUID, fake.getApplicationTasksArgsForCall[i].sortOrder
+	return fake.getApplicationTasksArgsForCall[i].appGUID, fake.getApplicationTasksArgsForCall[i].sortOrder, fake.getApplicationTasksArgsForCall[i].filter
 }
 
 func (fake *FakeTasksActor) GetApplicationTasksReturns(result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
@@ -3,12 +3,29 @@ package v3fakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command/v3"
 )
 
 type FakeV3RestartActor struct {
+	DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexStub        func(appName string, spaceGUID string, processType string, instanceIndex int) (v3action.Warnings, error)
+	deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex       sync.RWMutex
+	deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall []struct {
+		appName       string
+		spaceGUID     string
+		processType   string
+		instanceIndex int
+	}
+	deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	GetApplicationByNameAndSpaceStub        func(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -25,6 +42,38 @@ type FakeV3RestartActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetProcessInstancesByApplicationAndProcessTypeStub        func(appGUID string, processType string) ([]v3action.Instance, v3action.Warnings, error)
+	getProcessInstancesByApplicationAndProcessTypeMutex       sync.RWMutex
+	getProcessInstancesByApplicationAndProcessTypeArgsForCall []struct {
+		appGUID     string
+		processType string
+	}
+	getProcessInstancesByApplicationAndProcessTypeReturns struct {
+		result1 []v3action.Instance
+		result2 v3action.Warnings
+		result3 error
+	}
+	getProcessInstancesByApplicationAndProcessTypeReturnsOnCall map[int]struct {
+		result1 []v3action.Instance
+		result2 v3action.Warnings
+		result3 error
+	}
+	PollProcessInstancesStub        func(appGUID string, processType string, instanceIndexes []int, restartedAt time.Time) (v3action.Warnings, error)
+	pollProcessInstancesMutex       sync.RWMutex
+	pollProcessInstancesArgsForCall []struct {
+		appGUID         string
+		processType     string
+		instanceIndexes []int
+		restartedAt     time.Time
+	}
+	pollProcessInstancesReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	pollProcessInstancesReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	StartApplicationStub        func(appGUID string) (v3action.Application, v3action.Warnings, error)
 	startApplicationMutex       sync.RWMutex
 	startApplicationArgsForCall []struct {
@@ -57,6 +106,60 @@ type FakeV3RestartActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeV3RestartActor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, instanceIndex int) (v3action.Warnings, error) {
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.Lock()
+	ret, specificReturn := fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall[len(fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall)]
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall = append(fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall, struct {
+		appName       string
+		spaceGUID     string
+		processType   string
+		instanceIndex int
+	}{appName, spaceGUID, processType, instanceIndex})
+	fake.recordInvocation("DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex", []interface{}{appName, spaceGUID, processType, instanceIndex})
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.Unlock()
+	if fake.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexStub != nil {
+		return fake.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexStub(appName, spaceGUID, processType, instanceIndex)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns.result1, fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns.result2
+}
+
+func (fake *FakeV3RestartActor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexCallCount() int {
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
+	defer fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	return len(fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall)
+}
+
+func (fake *FakeV3RestartActor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall(i int) (string, string, string, int) {
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
+	defer fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	return fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall[i].appName, fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall[i].spaceGUID, fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall[i].processType, fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexArgsForCall[i].instanceIndex
+}
+
+func (fake *FakeV3RestartActor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns(result1 v3action.Warnings, result2 error) {
+	fake.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexStub = nil
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3RestartActor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.DeleteInstanceByApplicationNameSpaceProcessTypeAndIndexStub = nil
+	if fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall == nil {
+		fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3RestartActor) GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -112,6 +215,120 @@ func (fake *FakeV3RestartActor) GetApplicationByNameAndSpaceReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3RestartActor) GetProcessInstancesByApplicationAndProcessType(appGUID string, processType string) ([]v3action.Instance, v3action.Warnings, error) {
+	fake.getProcessInstancesByApplicationAndProcessTypeMutex.Lock()
+	ret, specificReturn := fake.getProcessInstancesByApplicationAndProcessTypeReturnsOnCall[len(fake.getProcessInstancesByApplicationAndProcessTypeArgsForCall)]
+	fake.getProcessInstancesByApplicationAndProcessTypeArgsForCall = append(fake.getProcessInstancesByApplicationAndProcessTypeArgsForCall, struct {
+		appGUID     string
+		processType string
+	}{appGUID, processType})
+	fake.recordInvocation("GetProcessInstancesByApplicationAndProcessType", []interface{}{appGUID, processType})
+	fake.getProcessInstancesByApplicationAndProcessTypeMutex.Unlock()
+	if fake.GetProcessInstancesByApplicationAndProcessTypeStub != nil {
+		return fake.GetProcessInstancesByApplicationAndProcessTypeStub(appGUID, processType)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getProcessInstancesByApplicationAndProcessTypeReturns.result1, fake.getProcessInstancesByApplicationAndProcessTypeReturns.result2, fake.getProcessInstancesByApplicationAndProcessTypeReturns.result3
+}
+
+func (fake *FakeV3RestartActor) GetProcessInstancesByApplicationAndProcessTypeCallCount() int {
+	fake.getProcessInstancesByApplicationAndProcessTypeMutex.RLock()
+	defer fake.getProcessInstancesByApplicationAndProcessTypeMutex.RUnlock()
+	return len(fake.getProcessInstancesByApplicationAndProcessTypeArgsForCall)
+}
+
+func (fake *FakeV3RestartActor) GetProcessInstancesByApplicationAndProcessTypeArgsForCall(i int) (string, string) {
+	fake.getProcessInstancesByApplicationAndProcessTypeMutex.RLock()
+	defer fake.getProcessInstancesByApplicationAndProcessTypeMutex.RUnlock()
+	return fake.getProcessInstancesByApplicationAndProcessTypeArgsForCall[i].appGUID, fake.getProcessInstancesByApplicationAndProcessTypeArgsForCall[i].processType
+}
+
+func (fake *FakeV3RestartActor) GetProcessInstancesByApplicationAndProcessTypeReturns(result1 []v3action.Instance, result2 v3action.Warnings, result3 error) {
+	fake.GetProcessInstancesByApplicationAndProcessTypeStub = nil
+	fake.getProcessInstancesByApplicationAndProcessTypeReturns = struct {
+		result1 []v3action.Instance
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3RestartActor) GetProcessInstancesByApplicationAndProcessTypeReturnsOnCall(i int, result1 []v3action.Instance, result2 v3action.Warnings, result3 error) {
+	fake.GetProcessInstancesByApplicationAndProcessTypeStub = nil
+	if fake.getProcessInstancesByApplicationAndProcessTypeReturnsOnCall == nil {
+		fake.getProcessInstancesByApplicationAndProcessTypeReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Instance
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getProcessInstancesByApplicationAndProcessTypeReturnsOnCall[i] = struct {
+		result1 []v3action.Instance
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3RestartActor) PollProcessInstances(appGUID string, processType string, instanceIndexes []int, restartedAt time.Time) (v3action.Warnings, error) {
+	var instanceIndexesCopy []int
+	if instanceIndexes != nil {
+		instanceIndexesCopy = make([]int, len(instanceIndexes))
+		copy(instanceIndexesCopy, instanceIndexes)
+	}
+	fake.pollProcessInstancesMutex.Lock()
+	ret, specificReturn := fake.pollProcessInstancesReturnsOnCall[len(fake.pollProcessInstancesArgsForCall)]
+	fake.pollProcessInstancesArgsForCall = append(fake.pollProcessInstancesArgsForCall, struct {
+		appGUID         string
+		processType     string
+		instanceIndexes []int
+		restartedAt     time.Time
+	}{appGUID, processType, instanceIndexesCopy, restartedAt})
+	fake.recordInvocation("PollProcessInstances", []interface{}{appGUID, processType, instanceIndexesCopy, restartedAt})
+	fake.pollProcessInstancesMutex.Unlock()
+	if fake.PollProcessInstancesStub != nil {
+		return fake.PollProcessInstancesStub(appGUID, processType, instanceIndexes, restartedAt)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.pollProcessInstancesReturns.result1, fake.pollProcessInstancesReturns.result2
+}
+
+func (fake *FakeV3RestartActor) PollProcessInstancesCallCount() int {
+	fake.pollProcessInstancesMutex.RLock()
+	defer fake.pollProcessInstancesMutex.RUnlock()
+	return len(fake.pollProcessInstancesArgsForCall)
+}
+
+func (fake *FakeV3RestartActor) PollProcessInstancesArgsForCall(i int) (string, string, []int, time.Time) {
+	fake.pollProcessInstancesMutex.RLock()
+	defer fake.pollProcessInstancesMutex.RUnlock()
+	return fake.pollProcessInstancesArgsForCall[i].appGUID, fake.pollProcessInstancesArgsForCall[i].processType, fake.pollProcessInstancesArgsForCall[i].instanceIndexes, fake.pollProcessInstancesArgsForCall[i].restartedAt
+}
+
+func (fake *FakeV3RestartActor) PollProcessInstancesReturns(result1 v3action.Warnings, result2 error) {
+	fake.PollProcessInstancesStub = nil
+	fake.pollProcessInstancesReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3RestartActor) PollProcessInstancesReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.PollProcessInstancesStub = nil
+	if fake.pollProcessInstancesReturnsOnCall == nil {
+		fake.pollProcessInstancesReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.pollProcessInstancesReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3RestartActor) StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error) {
 	fake.startApplicationMutex.Lock()
 	ret, specificReturn := fake.startApplicationReturnsOnCall[len(fake.startApplicationArgsForCall)]
@@ -220,8 +437,14 @@ func (fake *FakeV3RestartActor) StopApplicationReturnsOnCall(i int, result1 v3ac
 func (fake *FakeV3RestartActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
+	defer fake.deleteInstanceByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getProcessInstancesByApplicationAndProcessTypeMutex.RLock()
+	defer fake.getProcessInstancesByApplicationAndProcessTypeMutex.RUnlock()
+	fake.pollProcessInstancesMutex.RLock()
+	defer fake.pollProcessInstancesMutex.RUnlock()
 	fake.startApplicationMutex.RLock()
 	defer fake.startApplicationMutex.RUnlock()
 	fake.stopApplicationMutex.RLock()
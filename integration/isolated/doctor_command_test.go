@@ -0,0 +1,52 @@
+package isolated
+
+import (
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("doctor command", func() {
+	Describe("help", func() {
+		It("displays command usage to output", func() {
+			session := helpers.CF("doctor", "--help")
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Say("doctor - Check the target, login and local setup for common problems"))
+			Eventually(session).Should(Say("USAGE:"))
+			Eventually(session).Should(Say("cf doctor"))
+			Eventually(session).Should(Say("SEE ALSO:"))
+			Eventually(session).Should(Say("api, login, plugins, version"))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+
+	Context("when no API endpoint is set", func() {
+		BeforeEach(func() {
+			helpers.UnsetAPI()
+		})
+
+		It("reports the missing endpoint and fails", func() {
+			session := helpers.CF("doctor")
+			Eventually(session).Should(Say(`API endpoint\s+FAILED\s+No API endpoint set\.`))
+			Eventually(session).Should(Say(`access token\s+FAILED\s+Not logged in\.`))
+			Eventually(session).Should(Say("FAILED"))
+			Eventually(session.Err).Should(Say(`2 of 9 checks failed`))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+
+	Context("when logged in", func() {
+		BeforeEach(func() {
+			helpers.LoginCF()
+		})
+
+		It("checks the target", func() {
+			session := helpers.CF("doctor")
+			Eventually(session).Should(Say(`API endpoint\s+ok`))
+			Eventually(session).Should(Say(`access token\s+ok`))
+			Eventually(session).Should(Exit())
+		})
+	})
+})
@@ -0,0 +1,97 @@
+package isolated
+
+import (
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("isolation-segment command", func() {
+	Describe("help", func() {
+		Context("when --help flag is set", func() {
+			It("Displays command usage to output", func() {
+				session := helpers.CF("isolation-segment", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("isolation-segment - Show usage of an isolation segment by orgs, spaces and apps"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf isolation-segment SEGMENT_NAME \[--json\]`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--json\s+Output the usage report as JSON`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("isolation-segments, set-org-default-isolation-segment, set-space-isolation-segment"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	Context("when the environment is not setup correctly", func() {
+		Context("when no API endpoint is set", func() {
+			BeforeEach(func() {
+				helpers.UnsetAPI()
+			})
+
+			It("fails with no API endpoint set message", func() {
+				session := helpers.CF("isolation-segment", "some-segment")
+				Eventually(session).Should(Say("FAILED"))
+				Eventually(session.Err).Should(Say("No API endpoint set. Use 'cf login' or 'cf api' to target an endpoint."))
+				Eventually(session).Should(Exit(1))
+			})
+		})
+
+		Context("when not logged in", func() {
+			BeforeEach(func() {
+				helpers.LogoutCF()
+			})
+
+			It("fails with not logged in message", func() {
+				session := helpers.CF("isolation-segment", "some-segment")
+				Eventually(session).Should(Say("FAILED"))
+				Eventually(session.Err).Should(Say("Not logged in. Use 'cf login' to log in."))
+				Eventually(session).Should(Exit(1))
+			})
+		})
+	})
+
+	Context("when the environment is set up correctly", func() {
+		BeforeEach(func() {
+			helpers.LoginCF()
+		})
+
+		Context("when the isolation segment does not exist", func() {
+			It("fails with isolation segment not found message", func() {
+				session := helpers.CF("isolation-segment", "some-segment-that-does-not-exist")
+				Eventually(session).Should(Say("FAILED"))
+				Eventually(session.Err).Should(Say("Isolation segment 'some-segment-that-does-not-exist' not found."))
+				Eventually(session).Should(Exit(1))
+			})
+		})
+
+		Context("when the isolation segment exists and is entitled to an org", func() {
+			var (
+				isolationSegmentName string
+				orgName              string
+			)
+
+			BeforeEach(func() {
+				isolationSegmentName = helpers.NewIsolationSegmentName()
+				orgName = helpers.NewOrgName()
+
+				Eventually(helpers.CF("create-isolation-segment", isolationSegmentName)).Should(Exit(0))
+				Eventually(helpers.CF("create-org", orgName)).Should(Exit(0))
+				Eventually(helpers.CF("enable-org-isolation", orgName, isolationSegmentName)).Should(Exit(0))
+			})
+
+			It("displays the entitled org", func() {
+				session := helpers.CF("isolation-segment", isolationSegmentName)
+				userName, _ := helpers.GetCredentials()
+				Eventually(session).Should(Say("Getting isolation segment %s as %s...", isolationSegmentName, userName))
+				Eventually(session).Should(Say("OK"))
+				Eventually(session).Should(Say(`entitled orgs:\s+%s`, orgName))
+				Eventually(session).Should(Say("No spaces are running on this isolation segment."))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+})
@@ -18,9 +18,17 @@ var _ = Describe("Logs Command", func() {
 			Eventually(session).Should(Say("NAME:"))
 			Eventually(session).Should(Say("logs - Tail or show recent logs for an app"))
 			Eventually(session).Should(Say("USAGE:"))
-			Eventually(session).Should(Say("cf logs APP_NAME"))
+			Eventually(session).Should(Say(`cf logs \(APP_NAME\.\.\. \| --space\) \[--recent\] \[--source SOURCE\] \[--instance INDEX\] \[--type \(out \| err\)\] \[--grep REGEX\] \[--format \(text \| json\)\] \[--output-file PATH \[--output-file-max-size SIZE\]\]`))
 			Eventually(session).Should(Say("OPTIONS:"))
+			Eventually(session).Should(Say(`--format\s+Show logs as text or as one JSON object per log message \(Default: text\)`))
+			Eventually(session).Should(Say(`--grep\s+Only show logs whose message matches this regular expression`))
+			Eventually(session).Should(Say(`--instance\s+Only show logs from this instance index`))
+			Eventually(session).Should(Say(`--output-file\s+Write logs to this file instead of the terminal`))
+			Eventually(session).Should(Say(`--output-file-max-size\s+Size after which the output file is rotated, with a unit of measurement like M or G \(Default: 10M\)`))
 			Eventually(session).Should(Say("--recent\\s+Dump recent logs instead of tailing"))
+			Eventually(session).Should(Say(`--source\s+Only show logs from this source \(APP, RTR, STG, CELL or API\)`))
+			Eventually(session).Should(Say(`--space\s+Show logs for every app in the targeted space`))
+			Eventually(session).Should(Say(`--type\s+Only show logs of this type \(out or err\)`))
 			Eventually(session).Should(Say("SEE ALSO:"))
 			Eventually(session).Should(Say("app, apps, ssh"))
 			Eventually(session).Should(Exit(0))
@@ -104,7 +112,7 @@ var _ = Describe("Logs Command", func() {
 					Eventually(session).Should(Say("NAME:"))
 					Eventually(session).Should(Say("logs - Tail or show recent logs for an app"))
 					Eventually(session).Should(Say("USAGE:"))
-					Eventually(session).Should(Say("cf logs APP_NAME"))
+					Eventually(session).Should(Say(`cf logs \(APP_NAME\.\.\. \| --space\)`))
 					Eventually(session).Should(Say("OPTIONS:"))
 					Eventually(session).Should(Say("--recent\\s+Dump recent logs instead of tailing"))
 					Eventually(session).Should(Say("SEE ALSO:"))
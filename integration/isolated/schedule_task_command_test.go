@@ -0,0 +1,42 @@
+package isolated
+
+import (
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("schedule-task command", func() {
+	Context("when --help flag is set", func() {
+		It("Displays command usage to output", func() {
+			session := helpers.CF("schedule-task", "--help")
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Say("   schedule-task - Run a task on an app on a recurring cron schedule"))
+			Eventually(session).Should(Say("USAGE:"))
+			Eventually(session).Should(Say("   cf schedule-task APP_NAME COMMAND --name SCHEDULE_NAME --cron CRON_EXPRESSION \\[-k DISK\\] \\[-m MEMORY\\]"))
+			Eventually(session).Should(Say("TIP:"))
+			Eventually(session).Should(Say("   Scheduled tasks only run while 'cf task-scheduler run' is running. It picks up schedule changes within a minute."))
+			Eventually(session).Should(Say("EXAMPLES:"))
+			Eventually(session).Should(Say(`   cf schedule-task my-app "bundle exec rake db:migrate" --name nightly-migrate --cron "0 2 \* \* \*"`))
+			Eventually(session).Should(Say("OPTIONS:"))
+			Eventually(session).Should(Say(`   --cron      Cron expression describing when to run the task \(e\.g\. "0 2 \* \* \*", "\*/15 \* \* \* \*", @daily\)`))
+			Eventually(session).Should(Say("   -k          Disk limit \\(e\\.g\\. 256M, 1024M, 1G\\)"))
+			Eventually(session).Should(Say("   -m          Memory limit \\(e\\.g\\. 256M, 1024M, 1G\\)"))
+			Eventually(session).Should(Say("   --name      Name of the schedule, also used as the name of every task it runs"))
+			Eventually(session).Should(Say("SEE ALSO:"))
+			Eventually(session).Should(Say("   run-task, scheduled-tasks, task-scheduler, unschedule-task"))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+
+	Context("when the cron expression is invalid", func() {
+		It("fails with an incorrect usage message", func() {
+			session := helpers.CF("schedule-task", "app-name", "some command", "--name", "nightly", "--cron", "0 2 * *")
+			Eventually(session.Err).Should(Say("Incorrect Usage: Invalid cron expression '0 2 \\* \\*': expected 5 fields, found 4"))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
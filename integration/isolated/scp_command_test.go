@@ -0,0 +1,44 @@
+package isolated
+
+import (
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("scp command", func() {
+	Describe("help", func() {
+		It("displays command usage to output", func() {
+			session := helpers.CF("scp", "--help")
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Say("scp - Copy files and directories to or from an application container instance"))
+			Eventually(session).Should(Say("USAGE:"))
+			Eventually(session).Should(Say(`cf scp \[-i app-instance-index\] \[-r\] \[-p\] \[-q\] \[--skip-host-validation\] \[--reset-host-key\] SOURCE TARGET`))
+			Eventually(session).Should(Say("Exactly one of SOURCE and TARGET is a path on the app instance, written as APP_NAME:PATH"))
+			Eventually(session).Should(Say("EXAMPLES:"))
+			Eventually(session).Should(Say(`cf scp \./config\.yml my-app:app/config\.yml`))
+			Eventually(session).Should(Say(`cf scp -r -i 1 my-app:logs \./logs`))
+			Eventually(session).Should(Say("OPTIONS:"))
+			Eventually(session).Should(Say(`--app-instance-index, -i\s+Application instance index \(Default: 0\)`))
+			Eventually(session).Should(Say(`--preserve, -p\s+Preserve modification times and modes of the copied files`))
+			Eventually(session).Should(Say(`--quiet, -q\s+Do not display the progress of the copy`))
+			Eventually(session).Should(Say(`--recursive, -r\s+Recursively copy directories`))
+			Eventually(session).Should(Say(`--reset-host-key\s+Replace the pinned host key of the SSH proxy with the key it presents`))
+			Eventually(session).Should(Say(`--skip-host-validation, -k\s+Skip host key validation`))
+			Eventually(session).Should(Say("SEE ALSO:"))
+			Eventually(session).Should(Say("sftp, ssh, ssh-enabled"))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+
+	Context("when neither argument refers to an app", func() {
+		It("fails with an incorrect usage message", func() {
+			session := helpers.CF("scp", "./a", "./b")
+			Eventually(session.Err).Should(Say("Incorrect Usage: exactly one of SOURCE and TARGET must be of the form APP_NAME:PATH"))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
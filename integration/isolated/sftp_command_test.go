@@ -0,0 +1,30 @@
+package isolated
+
+import (
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("sftp command", func() {
+	Describe("help", func() {
+		It("displays command usage to output", func() {
+			session := helpers.CF("sftp", "--help")
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Say("sftp - Relay the SFTP protocol to an application container instance"))
+			Eventually(session).Should(Say("USAGE:"))
+			Eventually(session).Should(Say(`cf sftp APP_NAME \[-i app-instance-index\] \[--skip-host-validation\] \[--reset-host-key\]`))
+			Eventually(session).Should(Say("EXAMPLES:"))
+			Eventually(session).Should(Say(`sftp -D "cf sftp my-app"`))
+			Eventually(session).Should(Say("OPTIONS:"))
+			Eventually(session).Should(Say(`--app-instance-index, -i\s+Application instance index \(Default: 0\)`))
+			Eventually(session).Should(Say(`--reset-host-key\s+Replace the pinned host key of the SSH proxy with the key it presents`))
+			Eventually(session).Should(Say(`--skip-host-validation, -k\s+Skip host key validation`))
+			Eventually(session).Should(Say("SEE ALSO:"))
+			Eventually(session).Should(Say("scp, ssh, ssh-enabled"))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+})
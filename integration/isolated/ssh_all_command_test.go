@@ -0,0 +1,33 @@
+package isolated
+
+import (
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("ssh-all command", func() {
+	Describe("help", func() {
+		It("displays command usage to output", func() {
+			session := helpers.CF("ssh-all", "--help")
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Say("ssh-all - Run a command on all instances of an application over SSH"))
+			Eventually(session).Should(Say("USAGE:"))
+			Eventually(session).Should(Say(`cf ssh-all APP_NAME -c COMMAND \[-i app-instance-index\]\.\.\. \[--concurrency LIMIT\] \[--timeout SECONDS\] \[--skip-host-validation\] \[--reset-host-key\]`))
+			Eventually(session).Should(Say("EXAMPLES:"))
+			Eventually(session).Should(Say(`cf ssh-all my-app -c "df -h /home/vcap"`))
+			Eventually(session).Should(Say("OPTIONS:"))
+			Eventually(session).Should(Say(`--app-instance-index, -i\s+Application instance index to run the command on, can be repeated \(Default: all instances\)`))
+			Eventually(session).Should(Say(`--command, -c\s+Command to run on each instance`))
+			Eventually(session).Should(Say(`--concurrency\s+Maximum number of instances to run the command on at the same time`))
+			Eventually(session).Should(Say(`--reset-host-key\s+Replace the pinned host key of the SSH proxy with the key it presents`))
+			Eventually(session).Should(Say(`--skip-host-validation, -k\s+Skip host key validation`))
+			Eventually(session).Should(Say(`--timeout\s+Seconds to wait for the command on each instance before giving up \(Default: no limit\)`))
+			Eventually(session).Should(Say("SEE ALSO:"))
+			Eventually(session).Should(Say("app, ssh, ssh-enabled"))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+})
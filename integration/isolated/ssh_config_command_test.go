@@ -0,0 +1,78 @@
+package isolated
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("ssh-config command", func() {
+	Describe("help", func() {
+		It("displays command usage to output", func() {
+			session := helpers.CF("ssh-config", "--help")
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Say("ssh-config - Print an OpenSSH configuration block for an application container instance"))
+			Eventually(session).Should(Say("USAGE:"))
+			Eventually(session).Should(Say(`cf ssh-config APP_NAME \[-i app-instance-index\] \[--host HOST\] \[--skip-host-validation\]`))
+			Eventually(session).Should(Say("EXAMPLES:"))
+			Eventually(session).Should(Say(`cf ssh-config my-app >> ~/.ssh/config`))
+			Eventually(session).Should(Say("OPTIONS:"))
+			Eventually(session).Should(Say(`--app-instance-index, -i\s+Application instance index \(Default: 0\)`))
+			Eventually(session).Should(Say(`--host\s+Host name to use with ssh \(Default: APP_NAME\)`))
+			Eventually(session).Should(Say(`--skip-host-validation, -k\s+Skip host key validation`))
+			Eventually(session).Should(Say("SEE ALSO:"))
+			Eventually(session).Should(Say("scp, sftp, ssh"))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+
+	Context("when an application has been pushed", func() {
+		var (
+			appName   string
+			orgName   string
+			spaceName string
+			configDir string
+		)
+
+		BeforeEach(func() {
+			orgName = helpers.NewOrgName()
+			spaceName = helpers.NewSpaceName()
+			setupCF(orgName, spaceName)
+
+			appName = helpers.PrefixedRandomName("app")
+			helpers.WithHelloWorldApp(func(appDir string) {
+				Eventually(helpers.CF("push", appName, "-p", appDir, "-b", "staticfile_buildpack", "--random-route")).Should(Exit(0))
+			})
+
+			var err error
+			configDir, err = ioutil.TempDir("", "ssh-config")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(configDir)).To(Succeed())
+			helpers.QuickDeleteOrg(orgName)
+		})
+
+		It("lets the OpenSSH client run commands on the app instance", func() {
+			session := helpers.CF("ssh-config", appName)
+			Eventually(session).Should(Exit(0))
+
+			configPath := filepath.Join(configDir, "config")
+			Expect(ioutil.WriteFile(configPath, session.Out.Contents(), 0600)).To(Succeed())
+
+			command := exec.Command("ssh", "-F", configPath, appName, "echo", "hello from the app instance")
+			sshSession, err := Start(command, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(sshSession).Should(Say("hello from the app instance"))
+			Eventually(sshSession).Should(Exit(0))
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package isolated
+
+import (
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("task-scheduler command", func() {
+	Context("when --help flag is set", func() {
+		It("Displays command usage to output", func() {
+			session := helpers.CF("task-scheduler", "--help")
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Say("   task-scheduler - Run scheduled tasks in the foreground"))
+			Eventually(session).Should(Say("USAGE:"))
+			Eventually(session).Should(Say("   cf task-scheduler run"))
+			Eventually(session).Should(Say("TIP:"))
+			Eventually(session).Should(Say("   The scheduler runs in the foreground until interrupted and records the outcome of every run in the schedule file. Only one scheduler should run per schedule file."))
+			Eventually(session).Should(Say("SEE ALSO:"))
+			Eventually(session).Should(Say("   schedule-task, scheduled-tasks, tasks, unschedule-task"))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+
+	Context("when the action is not 'run'", func() {
+		It("fails with an incorrect usage message", func() {
+			session := helpers.CF("task-scheduler", "banana")
+			Eventually(session.Err).Should(Say(`Incorrect Usage: ACTION must be "run"`))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
@@ -17,7 +17,13 @@ var _ = Describe("tasks command", func() {
 			Eventually(session.Out).Should(Say("NAME:"))
 			Eventually(session.Out).Should(Say("   tasks - List tasks of an app"))
 			Eventually(session.Out).Should(Say("USAGE:"))
-			Eventually(session.Out).Should(Say("   cf tasks APP_NAME"))
+			Eventually(session.Out).Should(Say(`   cf tasks APP_NAME \[--state STATE\] \[--name TASK_NAME\] \[--since \(DURATION \| TIMESTAMP\)\] \[--limit COUNT\] \[--sort \(asc \| desc\)\]`))
+			Eventually(session.Out).Should(Say("OPTIONS:"))
+			Eventually(session.Out).Should(Say(`--limit\s+Maximum number of tasks to show`))
+			Eventually(session.Out).Should(Say(`--name\s+Only show tasks with this name`))
+			Eventually(session.Out).Should(Say(`--since\s+Only show tasks created after this duration ago \(e.g. 24h\) or RFC3339 timestamp`))
+			Eventually(session.Out).Should(Say(`--sort\s+Sort tasks by creation time in ascending or descending order \(Default: desc\)`))
+			Eventually(session.Out).Should(Say(`--state\s+Only show tasks in this state \(PENDING, RUNNING, SUCCEEDED, FAILED or CANCELING\); may be repeated`))
 			Eventually(session.Out).Should(Say("SEE ALSO:"))
 			Eventually(session.Out).Should(Say("   apps, logs, run-task, terminate-task"))
 			Eventually(session).Should(Exit(0))
@@ -0,0 +1,40 @@
+package isolated
+
+import (
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("v3-create-app-manifest command", func() {
+	Describe("help", func() {
+		Context("when --help flag is set", func() {
+			It("Displays command usage to output", func() {
+				session := helpers.CF("v3-create-app-manifest", "--help")
+
+				Eventually(session.Out).Should(Say("NAME:"))
+				Eventually(session.Out).Should(Say("v3-create-app-manifest - \\*\\*EXPERIMENTAL\\*\\* Create an app manifest for an app that has been pushed successfully"))
+				Eventually(session.Out).Should(Say("USAGE:"))
+				Eventually(session.Out).Should(Say("cf v3-create-app-manifest APP_NAME \\[-p /path/to/<app-name>-manifest.yml\\]"))
+				Eventually(session.Out).Should(Say("OPTIONS:"))
+				Eventually(session.Out).Should(Say("-p\\s+Specify a path for file creation. If path not specified, manifest file is created in current working directory."))
+				Eventually(session.Out).Should(Say("SEE ALSO:"))
+				Eventually(session.Out).Should(Say("v3-app, v3-push"))
+
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	Context("when the app name is not provided", func() {
+		It("tells the user that the app name is required, prints help text, and exits 1", func() {
+			session := helpers.CF("v3-create-app-manifest")
+
+			Eventually(session.Err).Should(Say("Incorrect Usage: the required argument `APP_NAME` was not provided"))
+			Eventually(session.Out).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
@@ -29,7 +29,11 @@ var _ = Describe("v3-restart command", func() {
 				Eventually(session.Out).Should(Say("NAME:"))
 				Eventually(session.Out).Should(Say("v3-restart - Stop all instances of the app, then start them again\\. This may cause downtime\\."))
 				Eventually(session.Out).Should(Say("USAGE:"))
-				Eventually(session.Out).Should(Say("cf v3-restart APP_NAME"))
+				Eventually(session.Out).Should(Say("cf v3-restart APP_NAME \\[--rolling \\[--batch-size COUNT\\] \\[--process PROCESS\\]\\]"))
+				Eventually(session.Out).Should(Say("OPTIONS:"))
+				Eventually(session.Out).Should(Say("--batch-size\\s+Number of instances to restart at a time when using --rolling \\(Default: 1\\)"))
+				Eventually(session.Out).Should(Say("--process\\s+Process to restart when using --rolling \\(Default: web\\)"))
+				Eventually(session.Out).Should(Say("--rolling\\s+Restart running instances in batches, waiting for each batch to be running before continuing"))
 				Eventually(session.Out).Should(Say("ENVIRONMENT:"))
 				Eventually(session.Out).Should(Say("CF_STARTUP_TIMEOUT=5\\s+Max wait time for app instance startup, in minutes"))
 
@@ -227,6 +227,8 @@ type Config struct {
 	detectedSettings detectedSettings
 
 	pluginsConfig PluginsConfig
+
+	scheduledTasksConfig ScheduledTasksConfig
 }
 
 // CFConfig represents .cf/config.json
@@ -349,6 +351,34 @@ func (config *Config) RefreshToken() string {
 	return config.ConfigFile.RefreshToken
 }
 
+// KnownHostsFilePath returns the location of the file that pins the host
+// keys of SSH proxies, which lives alongside config.json in the .cf
+// directory.
+func (config *Config) KnownHostsFilePath() string {
+	return filepath.Join(configDirectory(), "known_hosts")
+}
+
+// SSHRelayHostKeyFilePath returns the location of the host key that CF_NAME
+// presents to OpenSSH clients configured by ssh-config, which lives alongside
+// config.json in the .cf directory.
+func (config *Config) SSHRelayHostKeyFilePath() string {
+	return filepath.Join(configDirectory(), "ssh_relay_host_key")
+}
+
+// SSHRelayKnownHostsFilePath returns the location of the known hosts file
+// that pins the key returned by SSHRelayHostKeyFilePath for OpenSSH clients,
+// which lives alongside config.json in the .cf directory.
+func (config *Config) SSHRelayKnownHostsFilePath() string {
+	return filepath.Join(configDirectory(), "ssh_relay_known_hosts")
+}
+
+// ServiceKeyRotationsFilePath returns the location of the file that records
+// every rotate-service-key run, which lives alongside config.json in the .cf
+// directory.
+func (config *Config) ServiceKeyRotationsFilePath() string {
+	return filepath.Join(configDirectory(), "service_key_rotations.json")
+}
+
 // SSHOAuthClient returns the OAuth client id used for SSHing into
 // application/process containers
 func (config *Config) SSHOAuthClient() string {
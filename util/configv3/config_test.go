@@ -63,6 +63,10 @@ var _ = Describe("Config", func() {
 				Expect(config.SkipSSLValidation()).To(BeFalse())
 				Expect(config.ColorEnabled()).To(Equal(ColorEnabled))
 				Expect(config.PluginHome()).To(Equal(filepath.Join(homeDir, ".cf", "plugins")))
+				Expect(config.KnownHostsFilePath()).To(Equal(filepath.Join(homeDir, ".cf", "known_hosts")))
+				Expect(config.ServiceKeyRotationsFilePath()).To(Equal(filepath.Join(homeDir, ".cf", "service_key_rotations.json")))
+				Expect(config.SSHRelayHostKeyFilePath()).To(Equal(filepath.Join(homeDir, ".cf", "ssh_relay_host_key")))
+				Expect(config.SSHRelayKnownHostsFilePath()).To(Equal(filepath.Join(homeDir, ".cf", "ssh_relay_known_hosts")))
 				Expect(config.StagingTimeout()).To(Equal(DefaultStagingTimeout))
 				Expect(config.StartupTimeout()).To(Equal(DefaultStartupTimeout))
 				Expect(config.Locale()).To(BeEmpty())
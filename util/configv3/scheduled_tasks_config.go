@@ -0,0 +1,207 @@
+package configv3
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// ScheduledTasksConfig represents .cf/scheduled_tasks.json
+type ScheduledTasksConfig struct {
+	ScheduledTasks map[string]ScheduledTask `json:"ScheduledTasks"`
+}
+
+// ScheduledTask describes a task that the task scheduler runs on an app
+// according to a cron expression, along with the outcome of its last run.
+type ScheduledTask struct {
+	Name           string `json:"-"`
+	AppName        string `json:"AppName"`
+	OrgName        string `json:"OrgName"`
+	SpaceName      string `json:"SpaceName"`
+	SpaceGUID      string `json:"SpaceGUID"`
+	Command        string `json:"Command"`
+	CronExpression string `json:"CronExpression"`
+	MemoryInMB     uint64 `json:"MemoryInMB,omitempty"`
+	DiskInMB       uint64 `json:"DiskInMB,omitempty"`
+
+	// LastRun is read from scheduled_task_runs.json, which only the task
+	// scheduler writes.
+	LastRun ScheduledTaskRun `json:"-"`
+}
+
+// ScheduledTaskRun records the outcome of a single firing of a scheduled
+// task.
+type ScheduledTaskRun struct {
+	Time           time.Time `json:"Time"`
+	Status         string    `json:"Status"`
+	TaskSequenceID int       `json:"TaskSequenceID,omitempty"`
+	Message        string    `json:"Message,omitempty"`
+}
+
+// ScheduledTaskRunsConfig represents .cf/scheduled_task_runs.json
+type ScheduledTaskRunsConfig struct {
+	LastRuns map[string]ScheduledTaskRun `json:"LastRuns"`
+}
+
+// ScheduledTasksFilePath returns the location of the scheduled tasks file,
+// which lives alongside config.json in the .cf directory.
+func ScheduledTasksFilePath() string {
+	return filepath.Join(configDirectory(), "scheduled_tasks.json")
+}
+
+// ScheduledTaskRunsFilePath returns the location of the file recording the
+// last run of every scheduled task. It is kept separate from the scheduled
+// tasks file so the task scheduler never overwrites changes to the schedule.
+func ScheduledTaskRunsFilePath() string {
+	return filepath.Join(configDirectory(), "scheduled_task_runs.json")
+}
+
+// LoadScheduledTasksConfig reads scheduled_tasks.json from the .cf
+// directory. It is not read by LoadConfig so that a broken schedule file only
+// affects the commands that use it. A missing file results in an empty
+// schedule.
+func (config *Config) LoadScheduledTasksConfig() error {
+	scheduledTasksConfig, err := loadScheduledTasksConfig()
+	if err != nil {
+		return translatableerror.InvalidScheduledTasksConfigError{
+			FilePath: ScheduledTasksFilePath(),
+			Message:  err.Error(),
+		}
+	}
+
+	config.scheduledTasksConfig = scheduledTasksConfig
+	return nil
+}
+
+func loadScheduledTasksConfig() (ScheduledTasksConfig, error) {
+	scheduledTasksConfig := ScheduledTasksConfig{
+		ScheduledTasks: make(map[string]ScheduledTask),
+	}
+
+	file, err := ioutil.ReadFile(ScheduledTasksFilePath())
+	if os.IsNotExist(err) {
+		return scheduledTasksConfig, nil
+	} else if err != nil {
+		return scheduledTasksConfig, err
+	}
+
+	err = json.Unmarshal(file, &scheduledTasksConfig)
+	if err != nil {
+		return scheduledTasksConfig, err
+	}
+
+	if scheduledTasksConfig.ScheduledTasks == nil {
+		scheduledTasksConfig.ScheduledTasks = make(map[string]ScheduledTask)
+	}
+	runsConfig, err := loadScheduledTaskRunsConfig()
+	if err != nil {
+		return scheduledTasksConfig, err
+	}
+
+	for name, scheduledTask := range scheduledTasksConfig.ScheduledTasks {
+		scheduledTask.Name = name
+		scheduledTask.LastRun = runsConfig.LastRuns[name]
+		scheduledTasksConfig.ScheduledTasks[name] = scheduledTask
+	}
+
+	return scheduledTasksConfig, nil
+}
+
+func loadScheduledTaskRunsConfig() (ScheduledTaskRunsConfig, error) {
+	runsConfig := ScheduledTaskRunsConfig{
+		LastRuns: make(map[string]ScheduledTaskRun),
+	}
+
+	file, err := ioutil.ReadFile(ScheduledTaskRunsFilePath())
+	if os.IsNotExist(err) {
+		return runsConfig, nil
+	} else if err != nil {
+		return runsConfig, err
+	}
+
+	err = json.Unmarshal(file, &runsConfig)
+	if err != nil {
+		return runsConfig, err
+	}
+
+	if runsConfig.LastRuns == nil {
+		runsConfig.LastRuns = make(map[string]ScheduledTaskRun)
+	}
+
+	return runsConfig, nil
+}
+
+// ScheduledTasks returns the scheduled tasks from the config sorted by name.
+func (config *Config) ScheduledTasks() []ScheduledTask {
+	scheduledTasks := []ScheduledTask{}
+	for _, scheduledTask := range config.scheduledTasksConfig.ScheduledTasks {
+		scheduledTasks = append(scheduledTasks, scheduledTask)
+	}
+	sort.Slice(scheduledTasks, func(i, j int) bool {
+		return scheduledTasks[i].Name < scheduledTasks[j].Name
+	})
+	return scheduledTasks
+}
+
+// GetScheduledTask returns the requested scheduled task and true if it exists.
+func (config *Config) GetScheduledTask(name string) (ScheduledTask, bool) {
+	scheduledTask, exists := config.scheduledTasksConfig.ScheduledTasks[name]
+	return scheduledTask, exists
+}
+
+// AddScheduledTask adds or replaces the specified scheduled task.
+func (config *Config) AddScheduledTask(scheduledTask ScheduledTask) {
+	if config.scheduledTasksConfig.ScheduledTasks == nil {
+		config.scheduledTasksConfig.ScheduledTasks = make(map[string]ScheduledTask)
+	}
+	config.scheduledTasksConfig.ScheduledTasks[scheduledTask.Name] = scheduledTask
+}
+
+// RemoveScheduledTask removes the specified scheduled task idempotently.
+func (config *Config) RemoveScheduledTask(name string) {
+	delete(config.scheduledTasksConfig.ScheduledTasks, name)
+}
+
+// WriteScheduledTasksConfig writes the scheduled tasks to
+// scheduled_tasks.json in the .cf directory.
+func (config *Config) WriteScheduledTasksConfig() error {
+	rawConfig, err := json.MarshalIndent(config.scheduledTasksConfig, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(configDirectory(), 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(ScheduledTasksFilePath(), rawConfig, 0600)
+}
+
+// RecordScheduledTaskRun stores run as the last run of the named scheduled
+// task in scheduled_task_runs.json. The file is re-read before it is written
+// and the scheduled tasks file is left untouched.
+func (config *Config) RecordScheduledTaskRun(name string, run ScheduledTaskRun) error {
+	runsConfig, err := loadScheduledTaskRunsConfig()
+	if err != nil {
+		return err
+	}
+	runsConfig.LastRuns[name] = run
+
+	rawConfig, err := json.MarshalIndent(runsConfig, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(configDirectory(), 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(ScheduledTaskRunsFilePath(), rawConfig, 0600)
+}
@@ -0,0 +1,219 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ScheduledTasksConfig", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	Context("when the scheduled tasks file does not exist", func() {
+		It("returns no scheduled tasks", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.LoadScheduledTasksConfig()).To(Succeed())
+			Expect(config.ScheduledTasks()).To(BeEmpty())
+		})
+	})
+
+	Context("when the scheduled tasks file is malformed", func() {
+		BeforeEach(func() {
+			err := os.MkdirAll(filepath.Join(homeDir, ".cf"), 0700)
+			Expect(err).ToNot(HaveOccurred())
+			err = ioutil.WriteFile(filepath.Join(homeDir, ".cf", "scheduled_tasks.json"), []byte("{ not json"), 0600)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not prevent the config from loading", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.ScheduledTasks()).To(BeEmpty())
+		})
+
+		It("returns an InvalidScheduledTasksConfigError when the schedule is loaded", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = config.LoadScheduledTasksConfig()
+			Expect(err).To(MatchError(translatableerror.InvalidScheduledTasksConfigError{
+				FilePath: filepath.Join(homeDir, ".cf", "scheduled_tasks.json"),
+				Message:  "invalid character 'n' looking for beginning of object key string",
+			}))
+		})
+	})
+
+	Context("when the scheduled tasks file exists", func() {
+		var config *Config
+
+		BeforeEach(func() {
+			rawConfig := `
+{
+	"ScheduledTasks": {
+		"nightly-migrate": {
+			"AppName": "some-app",
+			"OrgName": "some-org",
+			"SpaceName": "some-space",
+			"SpaceGUID": "some-space-guid",
+			"Command": "bundle exec rake db:migrate",
+			"CronExpression": "0 2 * * *",
+			"MemoryInMB": 256
+		},
+		"hourly-cleanup": {
+			"AppName": "some-app",
+			"Command": "bin/cleanup",
+			"CronExpression": "@hourly"
+		}
+	}
+}`
+			err := os.MkdirAll(filepath.Join(homeDir, ".cf"), 0700)
+			Expect(err).ToNot(HaveOccurred())
+			err = ioutil.WriteFile(filepath.Join(homeDir, ".cf", "scheduled_tasks.json"), []byte(rawConfig), 0600)
+			Expect(err).ToNot(HaveOccurred())
+
+			rawRuns := `
+{
+	"LastRuns": {
+		"nightly-migrate": {
+			"Time": "2017-08-16T02:00:00Z",
+			"Status": "submitted",
+			"TaskSequenceID": 3
+		},
+		"removed-schedule": {
+			"Time": "2017-08-16T02:00:00Z",
+			"Status": "failed"
+		}
+	}
+}`
+			err = ioutil.WriteFile(filepath.Join(homeDir, ".cf", "scheduled_task_runs.json"), []byte(rawRuns), 0600)
+			Expect(err).ToNot(HaveOccurred())
+
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.LoadScheduledTasksConfig()).To(Succeed())
+		})
+
+		It("returns the scheduled tasks sorted by name with their last run", func() {
+			scheduledTasks := config.ScheduledTasks()
+			Expect(scheduledTasks).To(HaveLen(2))
+			Expect(scheduledTasks[0].Name).To(Equal("hourly-cleanup"))
+			Expect(scheduledTasks[1]).To(Equal(ScheduledTask{
+				Name:           "nightly-migrate",
+				AppName:        "some-app",
+				OrgName:        "some-org",
+				SpaceName:      "some-space",
+				SpaceGUID:      "some-space-guid",
+				Command:        "bundle exec rake db:migrate",
+				CronExpression: "0 2 * * *",
+				MemoryInMB:     256,
+				LastRun: ScheduledTaskRun{
+					Time:           time.Date(2017, time.August, 16, 2, 0, 0, 0, time.UTC),
+					Status:         "submitted",
+					TaskSequenceID: 3,
+				},
+			}))
+		})
+
+		Describe("GetScheduledTask", func() {
+			It("returns the scheduled task and true when it exists", func() {
+				scheduledTask, exists := config.GetScheduledTask("hourly-cleanup")
+				Expect(exists).To(BeTrue())
+				Expect(scheduledTask.Command).To(Equal("bin/cleanup"))
+			})
+
+			It("returns false when it does not exist", func() {
+				_, exists := config.GetScheduledTask("does-not-exist")
+				Expect(exists).To(BeFalse())
+			})
+		})
+
+		Describe("WriteScheduledTasksConfig", func() {
+			It("persists added and removed scheduled tasks", func() {
+				config.RemoveScheduledTask("hourly-cleanup")
+				config.AddScheduledTask(ScheduledTask{
+					Name:           "weekly-report",
+					AppName:        "other-app",
+					Command:        "bin/report",
+					CronExpression: "@weekly",
+				})
+
+				Expect(config.WriteScheduledTasksConfig()).To(Succeed())
+
+				newConfig, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(newConfig.LoadScheduledTasksConfig()).To(Succeed())
+
+				scheduledTasks := newConfig.ScheduledTasks()
+				Expect(scheduledTasks).To(HaveLen(2))
+				Expect(scheduledTasks[0].Name).To(Equal("nightly-migrate"))
+				Expect(scheduledTasks[1].Name).To(Equal("weekly-report"))
+				Expect(scheduledTasks[1].AppName).To(Equal("other-app"))
+			})
+		})
+
+		Describe("RecordScheduledTaskRun", func() {
+			var lastRun ScheduledTaskRun
+
+			BeforeEach(func() {
+				lastRun = ScheduledTaskRun{
+					Time:           time.Date(2017, time.August, 16, 3, 0, 0, 0, time.UTC),
+					Status:         "submitted",
+					TaskSequenceID: 4,
+				}
+			})
+
+			It("records the run without rewriting the scheduled tasks file", func() {
+				before, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "scheduled_tasks.json"))
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.RecordScheduledTaskRun("hourly-cleanup", lastRun)).To(Succeed())
+
+				after, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "scheduled_tasks.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(after).To(Equal(before))
+
+				newConfig, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(newConfig.LoadScheduledTasksConfig()).To(Succeed())
+
+				scheduledTask, _ := newConfig.GetScheduledTask("hourly-cleanup")
+				Expect(scheduledTask.LastRun).To(Equal(lastRun))
+				scheduledTask, _ = newConfig.GetScheduledTask("nightly-migrate")
+				Expect(scheduledTask.LastRun.TaskSequenceID).To(Equal(3))
+			})
+
+			It("keeps scheduled tasks added by other commands since the config was loaded", func() {
+				otherConfig, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(otherConfig.LoadScheduledTasksConfig()).To(Succeed())
+				otherConfig.AddScheduledTask(ScheduledTask{
+					Name:           "weekly-report",
+					AppName:        "other-app",
+					Command:        "bin/report",
+					CronExpression: "@weekly",
+				})
+				Expect(otherConfig.WriteScheduledTasksConfig()).To(Succeed())
+
+				Expect(config.RecordScheduledTaskRun("nightly-migrate", lastRun)).To(Succeed())
+
+				Expect(config.LoadScheduledTasksConfig()).To(Succeed())
+				Expect(config.ScheduledTasks()).To(HaveLen(3))
+			})
+		})
+	})
+})
@@ -0,0 +1,95 @@
+package configv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ServiceKeyRotationRecord is the local record kept of every service key
+// rotation in .cf/service_key_rotations.json, one JSON object per line.
+type ServiceKeyRotationRecord struct {
+	RotatedAt            time.Time `json:"rotated_at"`
+	API                  string    `json:"api"`
+	Organization         string    `json:"organization"`
+	Space                string    `json:"space"`
+	ServiceInstance      string    `json:"service_instance"`
+	OldServiceKeyName    string    `json:"old_service_key_name"`
+	OldServiceKeyGUID    string    `json:"old_service_key_guid"`
+	NewServiceKeyName    string    `json:"new_service_key_name"`
+	NewServiceKeyGUID    string    `json:"new_service_key_guid"`
+	OldServiceKeyDeleted bool      `json:"old_service_key_deleted"`
+}
+
+// AppendServiceKeyRotationRecord appends the record to
+// service_key_rotations.json, creating the file if needed. The file is only
+// readable by the current user since it names service keys.
+func (config *Config) AppendServiceKeyRotationRecord(record ServiceKeyRotationRecord) error {
+	path := config.ServiceKeyRotationsFilePath()
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return json.NewEncoder(file).Encode(record)
+}
+
+// MarkServiceKeyRotationOldKeyDeleted sets OldServiceKeyDeleted on the
+// records of the rotation that created the service key with
+// newServiceKeyGUID, rewriting service_key_rotations.json.
+func (config *Config) MarkServiceKeyRotationOldKeyDeleted(newServiceKeyGUID string) error {
+	path := config.ServiceKeyRotationsFilePath()
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var records []ServiceKeyRotationRecord
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	for decoder.More() {
+		var record ServiceKeyRotationRecord
+		err = decoder.Decode(&record)
+		if err != nil {
+			return err
+		}
+
+		if record.NewServiceKeyGUID == newServiceKeyGUID {
+			record.OldServiceKeyDeleted = true
+		}
+		records = append(records, record)
+	}
+
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	for _, record := range records {
+		err = encoder.Encode(record)
+		if err != nil {
+			return err
+		}
+	}
+
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), "service_key_rotations")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+
+	_, err = tempFile.Write(buffer.Bytes())
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), path)
+}
@@ -0,0 +1,94 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ServiceKeyRotationsConfig", func() {
+	var (
+		homeDir string
+		config  *Config
+		path    string
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+
+		var err error
+		config, err = LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(homeDir, ".cf", "service_key_rotations.json")
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	readRecords := func() []ServiceKeyRotationRecord {
+		raw, err := ioutil.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+
+		var records []ServiceKeyRotationRecord
+		for _, line := range strings.Split(strings.TrimSpace(string(raw)), "\n") {
+			var record ServiceKeyRotationRecord
+			Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+			records = append(records, record)
+		}
+		return records
+	}
+
+	Describe("AppendServiceKeyRotationRecord", func() {
+		It("appends one JSON line per record to a file only the user can read", func() {
+			Expect(config.AppendServiceKeyRotationRecord(ServiceKeyRotationRecord{NewServiceKeyName: "key-1"})).To(Succeed())
+			Expect(config.AppendServiceKeyRotationRecord(ServiceKeyRotationRecord{NewServiceKeyName: "key-2", OldServiceKeyDeleted: true})).To(Succeed())
+
+			records := readRecords()
+			Expect(records).To(HaveLen(2))
+			Expect(records[0].NewServiceKeyName).To(Equal("key-1"))
+			Expect(records[1].NewServiceKeyName).To(Equal("key-2"))
+			Expect(records[1].OldServiceKeyDeleted).To(BeTrue())
+
+			info, err := os.Stat(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+	})
+
+	Describe("MarkServiceKeyRotationOldKeyDeleted", func() {
+		Context("when the rotation is recorded", func() {
+			BeforeEach(func() {
+				Expect(config.AppendServiceKeyRotationRecord(ServiceKeyRotationRecord{NewServiceKeyName: "key-1", NewServiceKeyGUID: "key-1-guid"})).To(Succeed())
+				Expect(config.AppendServiceKeyRotationRecord(ServiceKeyRotationRecord{NewServiceKeyName: "key-2", NewServiceKeyGUID: "key-2-guid"})).To(Succeed())
+			})
+
+			It("marks the old key of the matching rotation as deleted and keeps the other records", func() {
+				Expect(config.MarkServiceKeyRotationOldKeyDeleted("key-2-guid")).To(Succeed())
+
+				records := readRecords()
+				Expect(records).To(HaveLen(2))
+				Expect(records[0].NewServiceKeyName).To(Equal("key-1"))
+				Expect(records[0].OldServiceKeyDeleted).To(BeFalse())
+				Expect(records[1].NewServiceKeyName).To(Equal("key-2"))
+				Expect(records[1].OldServiceKeyDeleted).To(BeTrue())
+
+				info, err := os.Stat(path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+			})
+		})
+
+		Context("when the file does not exist", func() {
+			It("returns an error", func() {
+				Expect(config.MarkServiceKeyRotationOldKeyDeleted("key-1-guid")).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,220 @@
+// Package cron parses standard five field cron expressions and calculates
+// when they next fire.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// InvalidExpressionError is returned when a cron expression cannot be parsed.
+type InvalidExpressionError struct {
+	Expression string
+	Reason     string
+}
+
+func (e InvalidExpressionError) Error() string {
+	return fmt.Sprintf("invalid cron expression '%s': %s", e.Expression, e.Reason)
+}
+
+type field struct {
+	name string
+	min  int
+	max  int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 6},
+}
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	expression string
+
+	minutes     []bool
+	hours       []bool
+	daysOfMonth []bool
+	months      []bool
+	daysOfWeek  []bool
+
+	// restrictedDays records whether day of month and day of week were both
+	// restricted, in which case a time matches when either of them match.
+	restrictedDays bool
+}
+
+// Parse parses a standard five field cron expression (minute, hour, day of
+// month, month and day of week). Each field supports `*`, single values,
+// ranges (`1-5`), lists (`1,3,5`) and steps (`*/15`, `0-30/10`). The
+// @yearly, @monthly, @weekly, @daily and @hourly shorthands are also
+// accepted.
+func Parse(expression string) (Schedule, error) {
+	normalized := strings.TrimSpace(expression)
+	if macro, ok := macros[strings.ToLower(normalized)]; ok {
+		normalized = macro
+	}
+
+	parts := strings.Fields(normalized)
+	if len(parts) != len(fields) {
+		return Schedule{}, InvalidExpressionError{
+			Expression: expression,
+			Reason:     fmt.Sprintf("expected %d fields, found %d", len(fields), len(parts)),
+		}
+	}
+
+	var sets [][]bool
+	for i, part := range parts {
+		set, err := parseField(part, fields[i])
+		if err != nil {
+			return Schedule{}, InvalidExpressionError{Expression: expression, Reason: err.Error()}
+		}
+		sets = append(sets, set)
+	}
+
+	// Sunday may be written as 7.
+	if sets[4][7] {
+		sets[4][0] = true
+	}
+
+	return Schedule{
+		expression:     expression,
+		minutes:        sets[0],
+		hours:          sets[1],
+		daysOfMonth:    sets[2],
+		months:         sets[3],
+		daysOfWeek:     sets[4][:7],
+		restrictedDays: !strings.HasPrefix(parts[2], "*") && !strings.HasPrefix(parts[4], "*"),
+	}, nil
+}
+
+// String returns the expression the schedule was parsed from.
+func (s Schedule) String() string {
+	return s.expression
+}
+
+// Next returns the first time, truncated to the minute, that is strictly
+// after the provided time and matches the schedule. It returns the zero time
+// if the schedule never fires within the next five years (for example
+// "0 0 30 2 *").
+func (s Schedule) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if !s.months[int(t.Month())] {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if !s.hours[t.Hour()] {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if !s.minutes[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s Schedule) matchesDay(t time.Time) bool {
+	dayOfMonth := s.daysOfMonth[t.Day()]
+	dayOfWeek := s.daysOfWeek[int(t.Weekday())]
+
+	if s.restrictedDays {
+		return dayOfMonth || dayOfWeek
+	}
+	return dayOfMonth && dayOfWeek
+}
+
+func parseField(value string, f field) ([]bool, error) {
+	max := f.max
+	if f.name == "day of week" {
+		max = 7
+	}
+	set := make([]bool, max+1)
+
+	for _, item := range strings.Split(value, ",") {
+		rangePart, step := item, 1
+		if i := strings.Index(item, "/"); i != -1 {
+			var err error
+			rangePart = item[:i]
+			step, err = strconv.Atoi(item[i+1:])
+			if err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step '%s' in %s field", item[i+1:], f.name)
+			}
+		}
+
+		var start, end int
+		switch {
+		case rangePart == "*":
+			start, end = f.min, f.max
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			start, err = parseValue(bounds[0], f, max)
+			if err != nil {
+				return nil, err
+			}
+			end, err = parseValue(bounds[1], f, max)
+			if err != nil {
+				return nil, err
+			}
+			if start > end {
+				return nil, fmt.Errorf("invalid range '%s' in %s field", rangePart, f.name)
+			}
+		default:
+			var err error
+			start, err = parseValue(rangePart, f, max)
+			if err != nil {
+				return nil, err
+			}
+			end = start
+			if step != 1 {
+				end = f.max
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			set[i] = true
+		}
+	}
+
+	return set, nil
+}
+
+func parseValue(value string, f field, max int) (int, error) {
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s' in %s field", value, f.name)
+	}
+	if i < f.min || i > max {
+		return 0, fmt.Errorf("value %d out of range [%d-%d] in %s field", i, f.min, f.max, f.name)
+	}
+	return i, nil
+}
@@ -0,0 +1,69 @@
+package cron_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/cron"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	Describe("Parse", func() {
+		DescribeTable("invalid expressions",
+			func(expression string, reason string) {
+				_, err := Parse(expression)
+				Expect(err).To(MatchError(InvalidExpressionError{Expression: expression, Reason: reason}))
+			},
+			Entry("too few fields", "* * * *", "expected 5 fields, found 4"),
+			Entry("too many fields", "* * * * * *", "expected 5 fields, found 6"),
+			Entry("non numeric value", "a * * * *", "invalid value 'a' in minute field"),
+			Entry("out of range value", "* 24 * * *", "value 24 out of range [0-23] in hour field"),
+			Entry("zero day of month", "* * 0 * *", "value 0 out of range [1-31] in day of month field"),
+			Entry("backwards range", "* * * 5-2 *", "invalid range '5-2' in month field"),
+			Entry("invalid step", "*/0 * * * *", "invalid step '0' in minute field"),
+		)
+
+		It("returns the original expression from String", func() {
+			schedule, err := Parse("@daily")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schedule.String()).To(Equal("@daily"))
+		})
+	})
+
+	Describe("Next", func() {
+		var from time.Time
+
+		BeforeEach(func() {
+			// Wednesday
+			from = time.Date(2017, time.August, 16, 10, 30, 45, 0, time.UTC)
+		})
+
+		DescribeTable("returns the next matching time",
+			func(expression string, expected time.Time) {
+				schedule, err := Parse(expression)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(schedule.Next(from)).To(Equal(expected))
+			},
+			Entry("every minute", "* * * * *", time.Date(2017, time.August, 16, 10, 31, 0, 0, time.UTC)),
+			Entry("every 15 minutes", "*/15 * * * *", time.Date(2017, time.August, 16, 10, 45, 0, 0, time.UTC)),
+			Entry("nightly", "0 2 * * *", time.Date(2017, time.August, 17, 2, 0, 0, 0, time.UTC)),
+			Entry("@hourly", "@hourly", time.Date(2017, time.August, 16, 11, 0, 0, 0, time.UTC)),
+			Entry("list of hours", "0 9,17 * * *", time.Date(2017, time.August, 16, 17, 0, 0, 0, time.UTC)),
+			Entry("range with step", "0 0-12/6 * * *", time.Date(2017, time.August, 16, 12, 0, 0, 0, time.UTC)),
+			Entry("weekdays", "0 8 * * 1-5", time.Date(2017, time.August, 17, 8, 0, 0, 0, time.UTC)),
+			Entry("sunday as 7", "0 0 * * 7", time.Date(2017, time.August, 20, 0, 0, 0, 0, time.UTC)),
+			Entry("first of month", "0 0 1 * *", time.Date(2017, time.September, 1, 0, 0, 0, 0, time.UTC)),
+			Entry("next year", "0 0 1 1 *", time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			Entry("day of month or day of week", "0 0 20 * 5", time.Date(2017, time.August, 18, 0, 0, 0, 0, time.UTC)),
+			Entry("leap day", "0 0 29 2 *", time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC)),
+		)
+
+		It("returns the zero time when the schedule can never fire", func() {
+			schedule, err := Parse("0 0 30 2 *")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schedule.Next(from)).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,337 @@
+// Package securityrules validates and compares Cloud Foundry security group
+// rules.
+package securityrules
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	ProtocolAll  = "all"
+	ProtocolICMP = "icmp"
+	ProtocolTCP  = "tcp"
+	ProtocolUDP  = "udp"
+)
+
+var knownFields = []string{"code", "description", "destination", "log", "ports", "protocol", "type"}
+
+// RuleError describes why a security group rule is invalid. Index is the
+// zero based position of the rule in the rules file.
+type RuleError struct {
+	Index  int
+	Field  string
+	Reason string
+}
+
+func (e RuleError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("rule %d: %s", e.Index+1, e.Reason)
+	}
+	return fmt.Sprintf("rule %d, field '%s': %s", e.Index+1, e.Field, e.Reason)
+}
+
+// IPRange is an inclusive range of IPv4 addresses.
+type IPRange struct {
+	Start uint32
+	End   uint32
+}
+
+// Overlaps returns true if the two ranges share at least one address.
+func (r IPRange) Overlaps(other IPRange) bool {
+	return r.Start <= other.End && other.Start <= r.End
+}
+
+// PortRange is an inclusive range of ports.
+type PortRange struct {
+	Start int
+	End   int
+}
+
+// Overlaps returns true if the two ranges share at least one port.
+func (r PortRange) Overlaps(other PortRange) bool {
+	return r.Start <= other.End && other.Start <= r.End
+}
+
+// Rule is a parsed security group rule, used to compare rules with each
+// other.
+type Rule struct {
+	Protocol    string
+	Destination IPRange
+	Ports       []PortRange
+}
+
+// ParseRule parses the protocol, destination and ports of a rule. Ports are
+// ignored unless the protocol is tcp or udp.
+func ParseRule(protocol string, destination string, ports string) (Rule, error) {
+	rule := Rule{Protocol: strings.ToLower(protocol)}
+	if !isKnownProtocol(rule.Protocol) {
+		return Rule{}, fmt.Errorf("unknown protocol '%s'", protocol)
+	}
+
+	var err error
+	rule.Destination, err = ParseDestination(destination)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	if usesPorts(rule.Protocol) {
+		rule.Ports, err = ParsePorts(ports)
+		if err != nil {
+			return Rule{}, err
+		}
+	}
+
+	return rule, nil
+}
+
+// AllowsAllDestinations returns true if the rule applies to every IPv4
+// address, as 0.0.0.0/0 does.
+func (rule Rule) AllowsAllDestinations() bool {
+	return rule.Destination.Start == 0 && rule.Destination.End == math.MaxUint32
+}
+
+// Overlaps returns true if some traffic is allowed by both rules. ICMP types
+// and codes are not taken into account.
+func (rule Rule) Overlaps(other Rule) bool {
+	if rule.Protocol != other.Protocol && rule.Protocol != ProtocolAll && other.Protocol != ProtocolAll {
+		return false
+	}
+
+	if !rule.Destination.Overlaps(other.Destination) {
+		return false
+	}
+
+	if !usesPorts(rule.Protocol) || !usesPorts(other.Protocol) {
+		return true
+	}
+
+	for _, ports := range rule.Ports {
+		for _, otherPorts := range other.Ports {
+			if ports.Overlaps(otherPorts) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ParseDestination parses a single IPv4 address, a CIDR block such as
+// 10.0.0.0/8 or a range such as 10.0.0.1-10.0.0.255.
+func ParseDestination(destination string) (IPRange, error) {
+	destination = strings.TrimSpace(destination)
+
+	switch {
+	case destination == "":
+		return IPRange{}, fmt.Errorf("destination is empty")
+	case strings.Contains(destination, "/"):
+		ip, network, err := net.ParseCIDR(destination)
+		if err != nil || ip.To4() == nil {
+			return IPRange{}, fmt.Errorf("'%s' is not a valid IPv4 CIDR block", destination)
+		}
+		start := ipToUint32(network.IP.To4())
+		ones, _ := network.Mask.Size()
+		return IPRange{Start: start, End: start | uint32(math.MaxUint32>>uint(ones))}, nil
+	case strings.Contains(destination, "-"):
+		parts := strings.SplitN(destination, "-", 2)
+		start, startErr := parseIPv4(parts[0])
+		end, endErr := parseIPv4(parts[1])
+		if startErr != nil || endErr != nil {
+			return IPRange{}, fmt.Errorf("'%s' is not a valid IPv4 range", destination)
+		}
+		if start > end {
+			return IPRange{}, fmt.Errorf("range '%s' starts after it ends", destination)
+		}
+		return IPRange{Start: start, End: end}, nil
+	default:
+		ip, err := parseIPv4(destination)
+		if err != nil {
+			return IPRange{}, fmt.Errorf("'%s' is not a valid IPv4 address", destination)
+		}
+		return IPRange{Start: ip, End: ip}, nil
+	}
+}
+
+// ParsePorts parses a comma separated list of ports and port ranges, such as
+// 80,443,8080-8090.
+func ParsePorts(ports string) ([]PortRange, error) {
+	if strings.TrimSpace(ports) == "" {
+		return nil, fmt.Errorf("ports are empty")
+	}
+
+	var ranges []PortRange
+	for _, entry := range strings.Split(ports, ",") {
+		entry = strings.TrimSpace(entry)
+
+		var portRange PortRange
+		var err error
+		if strings.Contains(entry, "-") {
+			parts := strings.SplitN(entry, "-", 2)
+			portRange.Start, err = parsePort(parts[0])
+			if err != nil {
+				return nil, err
+			}
+			portRange.End, err = parsePort(parts[1])
+			if err != nil {
+				return nil, err
+			}
+			if portRange.Start > portRange.End {
+				return nil, fmt.Errorf("port range '%s' starts after it ends", entry)
+			}
+		} else {
+			portRange.Start, err = parsePort(entry)
+			if err != nil {
+				return nil, err
+			}
+			portRange.End = portRange.Start
+		}
+		ranges = append(ranges, portRange)
+	}
+
+	return ranges, nil
+}
+
+// ValidateRules checks the rules read from a security group rules file and
+// returns every problem found, in file order.
+func ValidateRules(rules []map[string]interface{}) []RuleError {
+	var ruleErrors []RuleError
+	for i, rule := range rules {
+		ruleErrors = append(ruleErrors, validateRule(i, rule)...)
+	}
+	return ruleErrors
+}
+
+func validateRule(index int, rule map[string]interface{}) []RuleError {
+	var ruleErrors []RuleError
+	addError := func(field string, format string, args ...interface{}) {
+		ruleErrors = append(ruleErrors, RuleError{Index: index, Field: field, Reason: fmt.Sprintf(format, args...)})
+	}
+
+	var unknownFields []string
+	for field := range rule {
+		if !isKnownField(field) {
+			unknownFields = append(unknownFields, field)
+		}
+	}
+	sort.Strings(unknownFields)
+	for _, field := range unknownFields {
+		addError(field, "unknown field; valid fields are %s", strings.Join(knownFields, ", "))
+	}
+
+	protocol, ok := rule["protocol"].(string)
+	validProtocol := ok && isKnownProtocol(protocol)
+	switch {
+	case rule["protocol"] == nil:
+		addError("protocol", "is required and must be one of tcp, udp, icmp or all")
+	case !validProtocol:
+		addError("protocol", "%v is not valid; it must be one of tcp, udp, icmp or all", rule["protocol"])
+	}
+
+	if destination, ok := rule["destination"].(string); !ok {
+		addError("destination", "is required and must be an IPv4 address, CIDR block or range")
+	} else if _, err := ParseDestination(destination); err != nil {
+		addError("destination", "%s", err)
+	}
+
+	if value, present := rule["ports"]; present && (usesPorts(protocol) || !validProtocol) {
+		if ports, ok := value.(string); !ok {
+			addError("ports", "must be a string such as \"80,443\" or \"8000-9000\"")
+		} else if _, err := ParsePorts(ports); err != nil {
+			addError("ports", "%s", err)
+		}
+	} else if present {
+		addError("ports", "is only valid for tcp and udp rules")
+	} else if usesPorts(protocol) {
+		addError("ports", "is required for %s rules", protocol)
+	}
+
+	for _, field := range []string{"type", "code"} {
+		value, present := rule[field]
+		switch {
+		case present && validProtocol && protocol != ProtocolICMP:
+			addError(field, "is only valid for icmp rules")
+		case !present && protocol == ProtocolICMP:
+			addError(field, "is required for icmp rules; use -1 to match any %s", field)
+		case present && !isICMPValue(value):
+			addError(field, "%v is not valid; it must be an integer between -1 and 255", value)
+		}
+	}
+
+	if value, present := rule["log"]; present {
+		if _, ok := value.(bool); !ok {
+			addError("log", "must be true or false")
+		} else if protocol == ProtocolICMP {
+			addError("log", "is not valid for icmp rules")
+		}
+	}
+
+	if value, present := rule["description"]; present {
+		if _, ok := value.(string); !ok {
+			addError("description", "must be a string")
+		}
+	}
+
+	return ruleErrors
+}
+
+func isICMPValue(value interface{}) bool {
+	var number float64
+	switch v := value.(type) {
+	case float64:
+		number = v
+	case int:
+		number = float64(v)
+	default:
+		return false
+	}
+	return number == math.Trunc(number) && number >= -1 && number <= 255
+}
+
+func isKnownField(field string) bool {
+	for _, known := range knownFields {
+		if field == known {
+			return true
+		}
+	}
+	return false
+}
+
+func isKnownProtocol(protocol string) bool {
+	switch protocol {
+	case ProtocolAll, ProtocolICMP, ProtocolTCP, ProtocolUDP:
+		return true
+	}
+	return false
+}
+
+func usesPorts(protocol string) bool {
+	return protocol == ProtocolTCP || protocol == ProtocolUDP
+}
+
+func parseIPv4(address string) (uint32, error) {
+	ip := net.ParseIP(strings.TrimSpace(address)).To4()
+	if ip == nil {
+		return 0, fmt.Errorf("'%s' is not a valid IPv4 address", address)
+	}
+	return ipToUint32(ip), nil
+}
+
+func ipToUint32(ip net.IP) uint32 {
+	return uint32(ip[0])<<24 | uint32(ip[1])<<16 | uint32(ip[2])<<8 | uint32(ip[3])
+}
+
+func parsePort(port string) (int, error) {
+	port = strings.TrimSpace(port)
+	number, err := strconv.Atoi(port)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is not a valid port", port)
+	}
+	if number < 1 || number > 65535 {
+		return 0, fmt.Errorf("port %d is out of range; ports must be between 1 and 65535", number)
+	}
+	return number, nil
+}
@@ -0,0 +1,144 @@
+package securityrules_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/securityrules"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security group rules", func() {
+	DescribeTable("ParseDestination",
+		func(destination string, expectedRange IPRange, expectedErr string) {
+			ipRange, err := ParseDestination(destination)
+			if expectedErr == "" {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ipRange).To(Equal(expectedRange))
+			} else {
+				Expect(err).To(MatchError(expectedErr))
+			}
+		},
+		Entry("single address", "10.0.0.1", IPRange{Start: 0x0A000001, End: 0x0A000001}, ""),
+		Entry("CIDR block", "10.0.0.0/8", IPRange{Start: 0x0A000000, End: 0x0AFFFFFF}, ""),
+		Entry("CIDR block with host bits", "198.41.191.47/1", IPRange{Start: 0x80000000, End: 0xFFFFFFFF}, ""),
+		Entry("everything", "0.0.0.0/0", IPRange{Start: 0, End: 0xFFFFFFFF}, ""),
+		Entry("range", "10.0.0.1-10.0.0.255", IPRange{Start: 0x0A000001, End: 0x0A0000FF}, ""),
+		Entry("empty", "", IPRange{}, "destination is empty"),
+		Entry("invalid address", "10.0.0.256", IPRange{}, "'10.0.0.256' is not a valid IPv4 address"),
+		Entry("invalid CIDR block", "10.0.0.0/33", IPRange{}, "'10.0.0.0/33' is not a valid IPv4 CIDR block"),
+		Entry("IPv6 CIDR block", "::1/128", IPRange{}, "'::1/128' is not a valid IPv4 CIDR block"),
+		Entry("invalid range", "10.0.0.1-nope", IPRange{}, "'10.0.0.1-nope' is not a valid IPv4 range"),
+		Entry("backwards range", "10.0.0.9-10.0.0.1", IPRange{}, "range '10.0.0.9-10.0.0.1' starts after it ends"),
+	)
+
+	DescribeTable("ParsePorts",
+		func(ports string, expectedRanges []PortRange, expectedErr string) {
+			portRanges, err := ParsePorts(ports)
+			if expectedErr == "" {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(portRanges).To(Equal(expectedRanges))
+			} else {
+				Expect(err).To(MatchError(expectedErr))
+			}
+		},
+		Entry("single port", "443", []PortRange{{Start: 443, End: 443}}, ""),
+		Entry("list and range", "80, 443,8000-9000", []PortRange{{Start: 80, End: 80}, {Start: 443, End: 443}, {Start: 8000, End: 9000}}, ""),
+		Entry("empty", " ", nil, "ports are empty"),
+		Entry("not a number", "http", nil, "'http' is not a valid port"),
+		Entry("out of range", "70000", nil, "port 70000 is out of range; ports must be between 1 and 65535"),
+		Entry("zero", "0", nil, "port 0 is out of range; ports must be between 1 and 65535"),
+		Entry("backwards range", "90-80", nil, "port range '90-80' starts after it ends"),
+	)
+
+	Describe("ValidateRules", func() {
+		It("accepts valid rules", func() {
+			Expect(ValidateRules([]map[string]interface{}{
+				{"protocol": "tcp", "destination": "10.0.11.0/24", "ports": "80,443", "log": true, "description": "web"},
+				{"protocol": "udp", "destination": "10.0.0.1-10.0.0.9", "ports": "53", "log": true},
+				{"protocol": "icmp", "destination": "10.0.0.1", "type": float64(-1), "code": float64(0)},
+				{"protocol": "all", "destination": "0.0.0.0/0", "log": false},
+			})).To(BeEmpty())
+		})
+
+		It("reports every problem with the position and field of the rule", func() {
+			Expect(ValidateRules([]map[string]interface{}{
+				{"protocol": "tcp", "destination": "10.0.0.1"},
+				{"protocol": "sctp", "destination": "10.0.0.300", "port": "80"},
+				{"protocol": "icmp", "destination": "10.0.0.1", "ports": "80", "type": float64(256), "log": true},
+				{"protocol": "udp", "destination": "10.0.0.1", "ports": "53", "log": true, "description": 5},
+				{"destination": "10.0.0.1", "code": 1.5},
+			})).To(Equal([]RuleError{
+				{Index: 0, Field: "ports", Reason: "is required for tcp rules"},
+				{Index: 1, Field: "port", Reason: "unknown field; valid fields are code, description, destination, log, ports, protocol, type"},
+				{Index: 1, Field: "protocol", Reason: "sctp is not valid; it must be one of tcp, udp, icmp or all"},
+				{Index: 1, Field: "destination", Reason: "'10.0.0.300' is not a valid IPv4 address"},
+				{Index: 2, Field: "ports", Reason: "is only valid for tcp and udp rules"},
+				{Index: 2, Field: "type", Reason: "256 is not valid; it must be an integer between -1 and 255"},
+				{Index: 2, Field: "code", Reason: "is required for icmp rules; use -1 to match any code"},
+				{Index: 2, Field: "log", Reason: "is not valid for icmp rules"},
+				{Index: 3, Field: "description", Reason: "must be a string"},
+				{Index: 4, Field: "protocol", Reason: "is required and must be one of tcp, udp, icmp or all"},
+				{Index: 4, Field: "code", Reason: "1.5 is not valid; it must be an integer between -1 and 255"},
+			}))
+		})
+	})
+
+	Describe("RuleError", func() {
+		It("numbers rules from one", func() {
+			Expect(RuleError{Index: 1, Field: "ports", Reason: "is required for tcp rules"}.Error()).To(Equal("rule 2, field 'ports': is required for tcp rules"))
+		})
+	})
+
+	Describe("Rule", func() {
+		mustParse := func(protocol string, destination string, ports string) Rule {
+			rule, err := ParseRule(protocol, destination, ports)
+			Expect(err).ToNot(HaveOccurred())
+			return rule
+		}
+
+		It("returns an error for an unknown protocol", func() {
+			_, err := ParseRule("sctp", "10.0.0.1", "")
+			Expect(err).To(MatchError("unknown protocol 'sctp'"))
+		})
+
+		DescribeTable("AllowsAllDestinations",
+			func(destination string, expected bool) {
+				Expect(mustParse("all", destination, "").AllowsAllDestinations()).To(Equal(expected))
+			},
+			Entry("0.0.0.0/0", "0.0.0.0/0", true),
+			Entry("the full range", "0.0.0.0-255.255.255.255", true),
+			Entry("half of the addresses", "0.0.0.0/1", false),
+		)
+
+		DescribeTable("Overlaps",
+			func(rule Rule, other Rule, expected bool) {
+				Expect(rule.Overlaps(other)).To(Equal(expected))
+				Expect(other.Overlaps(rule)).To(Equal(expected))
+			},
+			Entry("same protocol, destination and ports",
+				Rule{Protocol: "tcp", Destination: IPRange{Start: 1, End: 1}, Ports: []PortRange{{Start: 80, End: 80}}},
+				Rule{Protocol: "tcp", Destination: IPRange{Start: 1, End: 1}, Ports: []PortRange{{Start: 80, End: 80}}},
+				true),
+			Entry("intersecting destinations and port ranges",
+				Rule{Protocol: "tcp", Destination: IPRange{Start: 1, End: 10}, Ports: []PortRange{{Start: 80, End: 90}}},
+				Rule{Protocol: "tcp", Destination: IPRange{Start: 10, End: 20}, Ports: []PortRange{{Start: 443, End: 443}, {Start: 90, End: 100}}},
+				true),
+			Entry("disjoint ports",
+				Rule{Protocol: "tcp", Destination: IPRange{Start: 1, End: 1}, Ports: []PortRange{{Start: 80, End: 80}}},
+				Rule{Protocol: "tcp", Destination: IPRange{Start: 1, End: 1}, Ports: []PortRange{{Start: 443, End: 443}}},
+				false),
+			Entry("disjoint destinations",
+				Rule{Protocol: "udp", Destination: IPRange{Start: 1, End: 5}, Ports: []PortRange{{Start: 53, End: 53}}},
+				Rule{Protocol: "udp", Destination: IPRange{Start: 6, End: 9}, Ports: []PortRange{{Start: 53, End: 53}}},
+				false),
+			Entry("different protocols",
+				Rule{Protocol: "tcp", Destination: IPRange{Start: 1, End: 1}, Ports: []PortRange{{Start: 53, End: 53}}},
+				Rule{Protocol: "udp", Destination: IPRange{Start: 1, End: 1}, Ports: []PortRange{{Start: 53, End: 53}}},
+				false),
+			Entry("the all protocol",
+				Rule{Protocol: "all", Destination: IPRange{Start: 0, End: 100}},
+				Rule{Protocol: "udp", Destination: IPRange{Start: 1, End: 1}, Ports: []PortRange{{Start: 53, End: 53}}},
+				true),
+		)
+	})
+})
@@ -0,0 +1,13 @@
+package securityrules_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestSecurityRules(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Security Rules Suite")
+}
@@ -0,0 +1,924 @@
+package fakecf
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/tedsuo/rata"
+)
+
+func (server *Server) ccV2Routes() []route {
+	v2 := func(name string, method string, path string, handler http.HandlerFunc) route {
+		return route{Route: rata.Route{Name: name, Method: method, Path: "/v2" + path}, handler: handler, writeAuthError: writeV2Error}
+	}
+
+	return []route{
+		{Route: rata.Route{Name: "GetInfo", Method: http.MethodGet, Path: "/v2/info"}, handler: server.getV2Info},
+		v2("GetFeatureFlag", http.MethodGet, "/config/feature_flags/:name", server.getV2FeatureFlag),
+		v2("GetJob", http.MethodGet, "/jobs/:guid", server.getV2Job),
+		v2("PostUser", http.MethodPost, "/users", server.postV2User),
+
+		v2("GetOrganizations", http.MethodGet, "/organizations", server.getV2Organizations),
+		v2("PostOrganization", http.MethodPost, "/organizations", server.postV2Organization),
+		v2("GetOrganization", http.MethodGet, "/organizations/:guid", server.getV2Organization),
+		v2("PutOrganization", http.MethodPut, "/organizations/:guid", server.putV2Organization),
+		v2("DeleteOrganization", http.MethodDelete, "/organizations/:guid", server.deleteV2Organization),
+		v2("GetOrganizationSpaces", http.MethodGet, "/organizations/:guid/spaces", server.getV2OrganizationSpaces),
+		v2("GetOrganizationDomains", http.MethodGet, "/organizations/:guid/domains", server.getV2SharedDomains),
+		v2("GetOrganizationPrivateDomains", http.MethodGet, "/organizations/:guid/private_domains", server.getV2EmptyList),
+		v2("PutOrganizationRole", http.MethodPut, "/organizations/:guid/:role", server.putV2OrganizationRole),
+		v2("PutOrganizationUserRole", http.MethodPut, "/organizations/:guid/:role/:user_guid", server.putV2OrganizationRole),
+
+		v2("GetSpaces", http.MethodGet, "/spaces", server.getV2Spaces),
+		v2("PostSpace", http.MethodPost, "/spaces", server.postV2Space),
+		v2("GetSpace", http.MethodGet, "/spaces/:guid", server.getV2Space),
+		v2("DeleteSpace", http.MethodDelete, "/spaces/:guid", server.deleteV2Space),
+		v2("GetSpaceApps", http.MethodGet, "/spaces/:guid/apps", server.getV2SpaceApplications),
+		v2("GetSpaceRoutes", http.MethodGet, "/spaces/:guid/routes", server.getV2SpaceRoutes),
+		v2("GetSpaceServiceInstances", http.MethodGet, "/spaces/:guid/service_instances", server.getV2EmptyList),
+		v2("GetSpaceSummary", http.MethodGet, "/spaces/:guid/summary", server.getV2SpaceSummary),
+		v2("PutSpaceRole", http.MethodPut, "/spaces/:guid/:role", server.putV2SpaceRole),
+		v2("PutSpaceUserRole", http.MethodPut, "/spaces/:guid/:role/:user_guid", server.putV2SpaceRole),
+
+		v2("GetApps", http.MethodGet, "/apps", server.getV2Applications),
+		v2("PostApp", http.MethodPost, "/apps", server.postV2Application),
+		v2("GetApp", http.MethodGet, "/apps/:guid", server.getV2Application),
+		v2("PutApp", http.MethodPut, "/apps/:guid", server.putV2Application),
+		v2("DeleteApp", http.MethodDelete, "/apps/:guid", server.deleteV2Application),
+		v2("GetAppRoutes", http.MethodGet, "/apps/:guid/routes", server.getV2ApplicationRoutes),
+		v2("PutAppRoute", http.MethodPut, "/apps/:guid/routes/:route_guid", server.putV2ApplicationRoute),
+		v2("DeleteAppRoute", http.MethodDelete, "/apps/:guid/routes/:route_guid", server.deleteV2ApplicationRoute),
+
+		v2("GetSharedDomains", http.MethodGet, "/shared_domains", server.getV2SharedDomains),
+		v2("GetSharedDomain", http.MethodGet, "/shared_domains/:guid", server.getV2SharedDomain),
+
+		v2("GetRoutes", http.MethodGet, "/routes", server.getV2Routes),
+		v2("PostRoute", http.MethodPost, "/routes", server.postV2Route),
+		v2("DeleteRoute", http.MethodDelete, "/routes/:guid", server.deleteV2Route),
+		v2("GetRouteApps", http.MethodGet, "/routes/:guid/apps", server.getV2RouteApplications),
+		v2("PutRouteApp", http.MethodPut, "/routes/:guid/apps/:app_guid", server.putV2RouteApplication),
+		v2("DeleteRouteApp", http.MethodDelete, "/routes/:guid/apps/:app_guid", server.deleteV2RouteApplication),
+		v2("GetRouteReserved", http.MethodGet, "/routes/reserved/domain/:guid", server.getV2RouteReserved),
+	}
+}
+
+func (server *Server) getV2Info(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"name":                        "fake-cf",
+		"build":                       "",
+		"support":                     "",
+		"version":                     0,
+		"description":                 "In-process fake Cloud Foundry",
+		"api_version":                 APIVersion,
+		"authorization_endpoint":      server.URL() + uaaPath,
+		"token_endpoint":              server.URL() + uaaPath,
+		"doppler_logging_endpoint":    server.dopplerURL(),
+		"app_ssh_endpoint":            "",
+		"app_ssh_oauth_client":        "ssh-proxy",
+		"routing_endpoint":            "",
+		"min_cli_version":             nil,
+		"min_recommended_cli_version": nil,
+	})
+}
+
+// getV2FeatureFlag reports every feature flag as disabled.
+func (server *Server) getV2FeatureFlag(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"name":    rata.Param(r, "name"),
+		"enabled": false,
+	})
+}
+
+// getV2Job reports every job as finished; the Server does all of its work
+// synchronously.
+func (server *Server) getV2Job(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, server.v2Job(rata.Param(r, "guid")))
+}
+
+func (server *Server) postV2User(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		GUID string `json:"guid"`
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeV2ParseError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, v2Resource("users", body.GUID, map[string]interface{}{
+		"admin":  false,
+		"active": true,
+	}))
+}
+
+func (server *Server) getV2EmptyList(w http.ResponseWriter, r *http.Request) {
+	writeV2List(w, nil)
+}
+
+func (server *Server) getV2Organizations(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	filter := parseV2Filter(r)
+	var resources []interface{}
+	for _, org := range server.orgs {
+		if filter.matches(map[string]string{"name": org.Name}) {
+			resources = append(resources, server.v2Organization(org, r))
+		}
+	}
+	writeV2List(w, resources)
+}
+
+func (server *Server) postV2Organization(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name string `json:"name"`
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeV2ParseError(w, err)
+		return
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	for _, org := range server.orgs {
+		if strings.EqualFold(org.Name, body.Name) {
+			writeV2Error(w, http.StatusBadRequest, 30002, "CF-OrganizationNameTaken", "The organization name is taken: "+body.Name)
+			return
+		}
+	}
+
+	org := server.addOrganization(body.Name)
+	writeJSON(w, http.StatusCreated, server.v2Organization(org, r))
+}
+
+func (server *Server) getV2Organization(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	i, ok := server.findOrganization(rata.Param(r, "guid"))
+	if !ok {
+		writeV2NotFound(w, "organization", 30003, "CF-OrganizationNotFound")
+		return
+	}
+	writeJSON(w, http.StatusOK, server.v2Organization(server.orgs[i], r))
+}
+
+func (server *Server) putV2Organization(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name string `json:"name"`
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeV2ParseError(w, err)
+		return
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	i, ok := server.findOrganization(rata.Param(r, "guid"))
+	if !ok {
+		writeV2NotFound(w, "organization", 30003, "CF-OrganizationNotFound")
+		return
+	}
+	if body.Name != "" {
+		server.orgs[i].Name = body.Name
+	}
+	writeJSON(w, http.StatusCreated, server.v2Organization(server.orgs[i], r))
+}
+
+func (server *Server) deleteV2Organization(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	guid := rata.Param(r, "guid")
+	if _, ok := server.findOrganization(guid); !ok {
+		writeV2NotFound(w, "organization", 30003, "CF-OrganizationNotFound")
+		return
+	}
+	server.deleteOrganization(guid)
+	server.writeV2Deleted(w, r)
+}
+
+func (server *Server) getV2OrganizationSpaces(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	orgGUID := rata.Param(r, "guid")
+	if _, ok := server.findOrganization(orgGUID); !ok {
+		writeV2NotFound(w, "organization", 30003, "CF-OrganizationNotFound")
+		return
+	}
+	server.writeV2Spaces(w, r, orgGUID)
+}
+
+// putV2OrganizationRole accepts role assignments without recording them; the
+// Server does not model permissions.
+func (server *Server) putV2OrganizationRole(w http.ResponseWriter, r *http.Request) {
+	server.getV2Organization(w, r)
+}
+
+func (server *Server) getV2Spaces(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.writeV2Spaces(w, r, "")
+}
+
+func (server *Server) postV2Space(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name             string `json:"name"`
+		OrganizationGUID string `json:"organization_guid"`
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeV2ParseError(w, err)
+		return
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if _, ok := server.findOrganization(body.OrganizationGUID); !ok {
+		writeV2NotFound(w, "organization", 30003, "CF-OrganizationNotFound")
+		return
+	}
+	for _, space := range server.spaces {
+		if space.OrganizationGUID == body.OrganizationGUID && strings.EqualFold(space.Name, body.Name) {
+			writeV2Error(w, http.StatusBadRequest, 40002, "CF-SpaceNameTaken", "The app space name is taken: "+body.Name)
+			return
+		}
+	}
+
+	space := server.addSpace(body.OrganizationGUID, body.Name)
+	writeJSON(w, http.StatusCreated, server.v2Space(space))
+}
+
+func (server *Server) getV2Space(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	i, ok := server.findSpace(rata.Param(r, "guid"))
+	if !ok {
+		writeV2NotFound(w, "space", 40004, "CF-SpaceNotFound")
+		return
+	}
+	writeJSON(w, http.StatusOK, server.v2Space(server.spaces[i]))
+}
+
+func (server *Server) deleteV2Space(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	guid := rata.Param(r, "guid")
+	if _, ok := server.findSpace(guid); !ok {
+		writeV2NotFound(w, "space", 40004, "CF-SpaceNotFound")
+		return
+	}
+	server.deleteSpace(guid)
+	server.writeV2Deleted(w, r)
+}
+
+func (server *Server) getV2SpaceApplications(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	spaceGUID := rata.Param(r, "guid")
+	if _, ok := server.findSpace(spaceGUID); !ok {
+		writeV2NotFound(w, "space", 40004, "CF-SpaceNotFound")
+		return
+	}
+	server.writeV2Applications(w, r, spaceGUID)
+}
+
+func (server *Server) getV2SpaceRoutes(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	spaceGUID := rata.Param(r, "guid")
+	if _, ok := server.findSpace(spaceGUID); !ok {
+		writeV2NotFound(w, "space", 40004, "CF-SpaceNotFound")
+		return
+	}
+
+	var resources []interface{}
+	for _, route := range server.routes {
+		if route.SpaceGUID == spaceGUID {
+			resources = append(resources, server.v2Route(route, r))
+		}
+	}
+	writeV2List(w, resources)
+}
+
+// getV2SpaceSummary serves the space summary used by the legacy apps command.
+func (server *Server) getV2SpaceSummary(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	i, ok := server.findSpace(rata.Param(r, "guid"))
+	if !ok {
+		writeV2NotFound(w, "space", 40004, "CF-SpaceNotFound")
+		return
+	}
+	space := server.spaces[i]
+
+	apps := []interface{}{}
+	for _, app := range server.apps {
+		if app.SpaceGUID != space.GUID {
+			continue
+		}
+
+		routes := []interface{}{}
+		for _, route := range server.routes {
+			if !containsString(route.AppGUIDs, app.GUID) {
+				continue
+			}
+			domain, _ := server.findDomain(route.DomainGUID)
+			routes = append(routes, map[string]interface{}{
+				"guid": route.GUID,
+				"host": route.Host,
+				"path": route.Path,
+				"domain": map[string]interface{}{
+					"guid": domain.GUID,
+					"name": domain.Name,
+				},
+			})
+		}
+
+		runningInstances := 0
+		if app.State == ApplicationStarted {
+			runningInstances = app.Instances
+		}
+		apps = append(apps, map[string]interface{}{
+			"guid":              app.GUID,
+			"name":              app.Name,
+			"state":             app.State,
+			"instances":         app.Instances,
+			"running_instances": runningInstances,
+			"memory":            app.Memory,
+			"disk_quota":        app.DiskQuota,
+			"routes":            routes,
+			"service_names":     []string{},
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"guid":     space.GUID,
+		"name":     space.Name,
+		"apps":     apps,
+		"services": []interface{}{},
+	})
+}
+
+// putV2SpaceRole accepts role assignments without recording them; the Server
+// does not model permissions.
+func (server *Server) putV2SpaceRole(w http.ResponseWriter, r *http.Request) {
+	server.getV2Space(w, r)
+}
+
+func (server *Server) getV2Applications(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.writeV2Applications(w, r, "")
+}
+
+func (server *Server) postV2Application(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name      string `json:"name"`
+		SpaceGUID string `json:"space_guid"`
+		State     string `json:"state"`
+		Command   string `json:"command"`
+		Instances int    `json:"instances"`
+		Memory    uint64 `json:"memory"`
+		DiskQuota uint64 `json:"disk_quota"`
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeV2ParseError(w, err)
+		return
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if _, ok := server.findSpace(body.SpaceGUID); !ok {
+		writeV2NotFound(w, "space", 40004, "CF-SpaceNotFound")
+		return
+	}
+	if server.applicationNameTaken(body.SpaceGUID, body.Name) {
+		writeV2Error(w, http.StatusBadRequest, 100002, "CF-AppNameTaken", "The app name is taken: "+body.Name)
+		return
+	}
+
+	app := server.addApplication(Application{
+		Name:      body.Name,
+		SpaceGUID: body.SpaceGUID,
+		State:     body.State,
+		Command:   body.Command,
+		Instances: body.Instances,
+		Memory:    body.Memory,
+		DiskQuota: body.DiskQuota,
+	})
+	writeJSON(w, http.StatusCreated, server.v2Application(app))
+}
+
+func (server *Server) getV2Application(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	i, ok := server.findApplication(rata.Param(r, "guid"))
+	if !ok {
+		writeV2NotFound(w, "app", 100004, "CF-AppNotFound")
+		return
+	}
+	writeJSON(w, http.StatusOK, server.v2Application(server.apps[i]))
+}
+
+func (server *Server) putV2Application(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name      *string `json:"name"`
+		State     *string `json:"state"`
+		Command   *string `json:"command"`
+		Instances *int    `json:"instances"`
+		Memory    *uint64 `json:"memory"`
+		DiskQuota *uint64 `json:"disk_quota"`
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeV2ParseError(w, err)
+		return
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	i, ok := server.findApplication(rata.Param(r, "guid"))
+	if !ok {
+		writeV2NotFound(w, "app", 100004, "CF-AppNotFound")
+		return
+	}
+
+	app := &server.apps[i]
+	if body.Name != nil && *body.Name != app.Name {
+		if server.applicationNameTaken(app.SpaceGUID, *body.Name) {
+			writeV2Error(w, http.StatusBadRequest, 100002, "CF-AppNameTaken", "The app name is taken: "+*body.Name)
+			return
+		}
+		app.Name = *body.Name
+	}
+	if body.State != nil {
+		app.State = *body.State
+	}
+	if body.Command != nil {
+		app.Command = *body.Command
+	}
+	if body.Instances != nil {
+		app.Instances = *body.Instances
+	}
+	if body.Memory != nil {
+		app.Memory = *body.Memory
+	}
+	if body.DiskQuota != nil {
+		app.DiskQuota = *body.DiskQuota
+	}
+	writeJSON(w, http.StatusCreated, server.v2Application(*app))
+}
+
+func (server *Server) deleteV2Application(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	guid := rata.Param(r, "guid")
+	if _, ok := server.findApplication(guid); !ok {
+		writeV2NotFound(w, "app", 100004, "CF-AppNotFound")
+		return
+	}
+	server.deleteApplication(guid)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *Server) getV2ApplicationRoutes(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	appGUID := rata.Param(r, "guid")
+	if _, ok := server.findApplication(appGUID); !ok {
+		writeV2NotFound(w, "app", 100004, "CF-AppNotFound")
+		return
+	}
+
+	var resources []interface{}
+	for _, route := range server.routes {
+		if containsString(route.AppGUIDs, appGUID) {
+			resources = append(resources, server.v2Route(route, r))
+		}
+	}
+	writeV2List(w, resources)
+}
+
+func (server *Server) getV2SharedDomains(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	filter := parseV2Filter(r)
+	var resources []interface{}
+	for _, domain := range server.domains {
+		if filter.matches(map[string]string{"name": domain.Name}) {
+			resources = append(resources, server.v2Domain(domain))
+		}
+	}
+	writeV2List(w, resources)
+}
+
+func (server *Server) getV2SharedDomain(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	domain, ok := server.findDomain(rata.Param(r, "guid"))
+	if !ok {
+		writeV2NotFound(w, "domain", 130002, "CF-DomainNotFound")
+		return
+	}
+	writeJSON(w, http.StatusOK, server.v2Domain(domain))
+}
+
+func (server *Server) getV2Routes(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	filter := parseV2Filter(r)
+	var resources []interface{}
+	for _, route := range server.routes {
+		if filter.matches(map[string]string{
+			"host":        route.Host,
+			"path":        route.Path,
+			"domain_guid": route.DomainGUID,
+			"space_guid":  route.SpaceGUID,
+		}) {
+			resources = append(resources, server.v2Route(route, r))
+		}
+	}
+	writeV2List(w, resources)
+}
+
+func (server *Server) postV2Route(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Host       string `json:"host"`
+		Path       string `json:"path"`
+		DomainGUID string `json:"domain_guid"`
+		SpaceGUID  string `json:"space_guid"`
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeV2ParseError(w, err)
+		return
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if _, ok := server.findSpace(body.SpaceGUID); !ok {
+		writeV2NotFound(w, "space", 40004, "CF-SpaceNotFound")
+		return
+	}
+	if _, ok := server.findDomain(body.DomainGUID); !ok {
+		writeV2NotFound(w, "domain", 130002, "CF-DomainNotFound")
+		return
+	}
+	if _, ok := server.findRouteByURL(body.Host, body.DomainGUID, body.Path); ok {
+		writeV2Error(w, http.StatusBadRequest, 210003, "CF-RouteHostTaken", "The host is taken: "+body.Host)
+		return
+	}
+
+	route := server.addRoute(Route{
+		Host:       body.Host,
+		Path:       body.Path,
+		DomainGUID: body.DomainGUID,
+		SpaceGUID:  body.SpaceGUID,
+	})
+	writeJSON(w, http.StatusCreated, server.v2Route(route, r))
+}
+
+func (server *Server) deleteV2Route(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	i, ok := server.findRoute(rata.Param(r, "guid"))
+	if !ok {
+		writeV2NotFound(w, "route", 210002, "CF-RouteNotFound")
+		return
+	}
+	server.routes = append(server.routes[:i], server.routes[i+1:]...)
+	server.writeV2Deleted(w, r)
+}
+
+func (server *Server) getV2RouteApplications(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	i, ok := server.findRoute(rata.Param(r, "guid"))
+	if !ok {
+		writeV2NotFound(w, "route", 210002, "CF-RouteNotFound")
+		return
+	}
+
+	var resources []interface{}
+	for _, app := range server.apps {
+		if containsString(server.routes[i].AppGUIDs, app.GUID) {
+			resources = append(resources, server.v2Application(app))
+		}
+	}
+	writeV2List(w, resources)
+}
+
+func (server *Server) putV2RouteApplication(w http.ResponseWriter, r *http.Request) {
+	server.mapRoute(w, r, rata.Param(r, "guid"), rata.Param(r, "app_guid"))
+}
+
+func (server *Server) deleteV2RouteApplication(w http.ResponseWriter, r *http.Request) {
+	server.unmapRoute(w, rata.Param(r, "guid"), rata.Param(r, "app_guid"))
+}
+
+func (server *Server) putV2ApplicationRoute(w http.ResponseWriter, r *http.Request) {
+	server.mapRoute(w, r, rata.Param(r, "route_guid"), rata.Param(r, "guid"))
+}
+
+func (server *Server) deleteV2ApplicationRoute(w http.ResponseWriter, r *http.Request) {
+	server.unmapRoute(w, rata.Param(r, "route_guid"), rata.Param(r, "guid"))
+}
+
+func (server *Server) mapRoute(w http.ResponseWriter, r *http.Request, routeGUID string, appGUID string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	i, ok := server.findRoute(routeGUID)
+	if !ok {
+		writeV2NotFound(w, "route", 210002, "CF-RouteNotFound")
+		return
+	}
+	if _, ok := server.findApplication(appGUID); !ok {
+		writeV2NotFound(w, "app", 100004, "CF-AppNotFound")
+		return
+	}
+
+	route := &server.routes[i]
+	if !containsString(route.AppGUIDs, appGUID) {
+		route.AppGUIDs = append(route.AppGUIDs, appGUID)
+	}
+	writeJSON(w, http.StatusCreated, server.v2Route(*route, r))
+}
+
+func (server *Server) unmapRoute(w http.ResponseWriter, routeGUID string, appGUID string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	i, ok := server.findRoute(routeGUID)
+	if !ok {
+		writeV2NotFound(w, "route", 210002, "CF-RouteNotFound")
+		return
+	}
+	server.routes[i].AppGUIDs = removeString(server.routes[i].AppGUIDs, appGUID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *Server) getV2RouteReserved(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	query := r.URL.Query()
+	if _, ok := server.findRouteByURL(query.Get("host"), rata.Param(r, "guid"), query.Get("path")); !ok {
+		writeV2NotFound(w, "route", 210002, "CF-RouteNotFound")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *Server) writeV2Spaces(w http.ResponseWriter, r *http.Request, orgGUID string) {
+	filter := parseV2Filter(r)
+	var resources []interface{}
+	for _, space := range server.spaces {
+		if orgGUID != "" && space.OrganizationGUID != orgGUID {
+			continue
+		}
+		if filter.matches(map[string]string{"name": space.Name, "organization_guid": space.OrganizationGUID}) {
+			resources = append(resources, server.v2Space(space))
+		}
+	}
+	writeV2List(w, resources)
+}
+
+func (server *Server) writeV2Applications(w http.ResponseWriter, r *http.Request, spaceGUID string) {
+	filter := parseV2Filter(r)
+	var resources []interface{}
+	for _, app := range server.apps {
+		if spaceGUID != "" && app.SpaceGUID != spaceGUID {
+			continue
+		}
+		if filter.matches(map[string]string{"name": app.Name, "space_guid": app.SpaceGUID}) {
+			resources = append(resources, server.v2Application(app))
+		}
+	}
+	writeV2List(w, resources)
+}
+
+// writeV2Deleted answers a DELETE the way the Cloud Controller does: with a
+// job when the client asked for the deletion to happen asynchronously.
+func (server *Server) writeV2Deleted(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("async") == "true" {
+		writeJSON(w, http.StatusAccepted, server.v2Job(newGUID()))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *Server) applicationNameTaken(spaceGUID string, name string) bool {
+	for _, app := range server.apps {
+		if app.SpaceGUID == spaceGUID && strings.EqualFold(app.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (server *Server) findRouteByURL(host string, domainGUID string, path string) (Route, bool) {
+	for _, route := range server.routes {
+		if route.Host == host && route.DomainGUID == domainGUID && route.Path == path {
+			return route, true
+		}
+	}
+	return Route{}, false
+}
+
+func (server *Server) v2Job(guid string) interface{} {
+	return v2Resource("jobs", guid, map[string]interface{}{
+		"guid":   guid,
+		"status": "finished",
+	})
+}
+
+// v2Organization renders org, inlining its spaces and domains when the
+// request asks for inline relations as the legacy commands do.
+func (server *Server) v2Organization(org Organization, r *http.Request) interface{} {
+	entity := map[string]interface{}{
+		"name":                  org.Name,
+		"status":                "active",
+		"quota_definition_guid": "",
+		"spaces_url":            "/v2/organizations/" + org.GUID + "/spaces",
+	}
+
+	if r.URL.Query().Get("inline-relations-depth") != "" {
+		spaces := []interface{}{}
+		for _, space := range server.spaces {
+			if space.OrganizationGUID == org.GUID {
+				spaces = append(spaces, server.v2Space(space))
+			}
+		}
+		domains := []interface{}{}
+		for _, domain := range server.domains {
+			domains = append(domains, server.v2Domain(domain))
+		}
+		entity["spaces"] = spaces
+		entity["domains"] = domains
+	}
+
+	return v2Resource("organizations", org.GUID, entity)
+}
+
+func (server *Server) v2Space(space Space) interface{} {
+	return v2Resource("spaces", space.GUID, map[string]interface{}{
+		"name":              space.Name,
+		"organization_guid": space.OrganizationGUID,
+		"allow_ssh":         space.AllowSSH,
+		"organization_url":  "/v2/organizations/" + space.OrganizationGUID,
+	})
+}
+
+func (server *Server) v2Application(app Application) interface{} {
+	return v2Resource("apps", app.GUID, map[string]interface{}{
+		"name":              app.Name,
+		"space_guid":        app.SpaceGUID,
+		"state":             app.State,
+		"command":           app.Command,
+		"instances":         app.Instances,
+		"memory":            app.Memory,
+		"disk_quota":        app.DiskQuota,
+		"diego":             true,
+		"health_check_type": "port",
+		"package_state":     "STAGED",
+		"environment_json":  map[string]interface{}{},
+		"space_url":         "/v2/spaces/" + app.SpaceGUID,
+	})
+}
+
+// v2Route renders route, inlining its domain when the request asks for inline
+// relations as the legacy commands do.
+func (server *Server) v2Route(route Route, r *http.Request) interface{} {
+	entity := map[string]interface{}{
+		"host":        route.Host,
+		"path":        route.Path,
+		"domain_guid": route.DomainGUID,
+		"space_guid":  route.SpaceGUID,
+	}
+
+	if r.URL.Query().Get("inline-relations-depth") != "" {
+		domain, _ := server.findDomain(route.DomainGUID)
+		entity["domain"] = server.v2Domain(domain)
+		if i, ok := server.findSpace(route.SpaceGUID); ok {
+			entity["space"] = server.v2Space(server.spaces[i])
+		}
+		apps := []interface{}{}
+		for _, app := range server.apps {
+			if containsString(route.AppGUIDs, app.GUID) {
+				apps = append(apps, server.v2Application(app))
+			}
+		}
+		entity["apps"] = apps
+	}
+
+	return v2Resource("routes", route.GUID, entity)
+}
+
+func (server *Server) v2Domain(domain Domain) interface{} {
+	return v2Resource("shared_domains", domain.GUID, map[string]interface{}{
+		"name": domain.Name,
+	})
+}
+
+func v2Resource(collection string, guid string, entity map[string]interface{}) interface{} {
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"guid": guid,
+			"url":  "/v2/" + collection + "/" + guid,
+		},
+		"entity": entity,
+	}
+}
+
+// writeV2List writes resources as a single page of a V2 paginated list.
+func writeV2List(w http.ResponseWriter, resources []interface{}) {
+	if resources == nil {
+		resources = []interface{}{}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"total_results": len(resources),
+		"total_pages":   1,
+		"prev_url":      nil,
+		"next_url":      nil,
+		"resources":     resources,
+	})
+}
+
+func writeV2NotFound(w http.ResponseWriter, resource string, code int, errorCode string) {
+	writeV2Error(w, http.StatusNotFound, code, errorCode, "The "+resource+" could not be found")
+}
+
+func writeV2ParseError(w http.ResponseWriter, err error) {
+	writeV2Error(w, http.StatusBadRequest, 1001, "CF-MessageParseError", "Request invalid due to parse error: "+err.Error())
+}
+
+// v2Filter holds the "q" query parameters of a V2 list request, such as
+// name:foo or name IN foo,bar. The legacy commands join several filters into
+// one parameter with semicolons.
+type v2Filter map[string][]string
+
+func parseV2Filter(r *http.Request) v2Filter {
+	filter := v2Filter{}
+	for _, q := range r.URL.Query()["q"] {
+		for _, term := range strings.Split(q, ";") {
+			if parts := strings.SplitN(term, " IN ", 2); len(parts) == 2 {
+				filter[parts[0]] = strings.Split(parts[1], ",")
+			} else if parts := strings.SplitN(term, ":", 2); len(parts) == 2 {
+				filter[parts[0]] = []string{parts[1]}
+			}
+		}
+	}
+	return filter
+}
+
+// matches reports whether the resource with the given fields satisfies every
+// filter on those fields. Filters on other fields are ignored.
+func (filter v2Filter) matches(fields map[string]string) bool {
+	for key, values := range filter {
+		value, ok := fields[key]
+		if !ok {
+			continue
+		}
+
+		found := false
+		for _, want := range values {
+			if strings.EqualFold(value, want) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,382 @@
+package fakecf
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tedsuo/rata"
+)
+
+func (server *Server) ccV3Routes() []route {
+	v3 := func(name string, method string, path string, handler http.HandlerFunc) route {
+		return route{Route: rata.Route{Name: name, Method: method, Path: "/v3" + path}, handler: handler, writeAuthError: writeV3Error}
+	}
+
+	return []route{
+		{Route: rata.Route{Name: "GetV3", Method: http.MethodGet, Path: "/v3"}, handler: server.getV3},
+
+		v3("GetV3Organizations", http.MethodGet, "/organizations", server.getV3Organizations),
+		v3("GetV3Spaces", http.MethodGet, "/spaces", server.getV3Spaces),
+
+		v3("GetV3Apps", http.MethodGet, "/apps", server.getV3Applications),
+		v3("PostV3App", http.MethodPost, "/apps", server.postV3Application),
+		v3("GetV3App", http.MethodGet, "/apps/:guid", server.getV3Application),
+		v3("DeleteV3App", http.MethodDelete, "/apps/:guid", server.deleteV3Application),
+		v3("GetV3AppTasks", http.MethodGet, "/apps/:guid/tasks", server.getV3ApplicationTasks),
+		v3("PostV3AppTasks", http.MethodPost, "/apps/:guid/tasks", server.postV3ApplicationTask),
+
+		v3("GetV3Task", http.MethodGet, "/tasks/:guid", server.getV3Task),
+		v3("PutV3TaskCancel", http.MethodPut, "/tasks/:guid/cancel", server.cancelV3Task),
+		v3("PostV3TaskCancel", http.MethodPost, "/tasks/:guid/actions/cancel", server.cancelV3Task),
+	}
+}
+
+func (server *Server) getV3(w http.ResponseWriter, r *http.Request) {
+	links := map[string]interface{}{
+		"self": map[string]string{"href": server.URL() + "/v3"},
+	}
+	for _, resource := range []string{"apps", "organizations", "spaces", "tasks"} {
+		links[resource] = map[string]string{"href": server.URL() + "/v3/" + resource}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"links": links})
+}
+
+func (server *Server) getV3Organizations(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	filter := parseV3Filter(r)
+	var resources []interface{}
+	for _, org := range server.orgs {
+		if filter.matches(map[string]string{"names": org.Name, "guids": org.GUID}) {
+			resources = append(resources, map[string]interface{}{
+				"guid": org.GUID,
+				"name": org.Name,
+			})
+		}
+	}
+	writeV3List(w, resources)
+}
+
+func (server *Server) getV3Spaces(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	filter := parseV3Filter(r)
+	var resources []interface{}
+	for _, space := range server.spaces {
+		if filter.matches(map[string]string{"names": space.Name, "guids": space.GUID, "organization_guids": space.OrganizationGUID}) {
+			resources = append(resources, map[string]interface{}{
+				"guid": space.GUID,
+				"name": space.Name,
+				"relationships": map[string]interface{}{
+					"organization": v3Relationship(space.OrganizationGUID),
+				},
+			})
+		}
+	}
+	writeV3List(w, resources)
+}
+
+func (server *Server) getV3Applications(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	filter := parseV3Filter(r)
+	var resources []interface{}
+	for _, app := range server.apps {
+		if filter.matches(map[string]string{"names": app.Name, "guids": app.GUID, "space_guids": app.SpaceGUID}) {
+			resources = append(resources, server.v3Application(app))
+		}
+	}
+	writeV3List(w, resources)
+}
+
+func (server *Server) postV3Application(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name          string `json:"name"`
+		Relationships struct {
+			Space struct {
+				Data struct {
+					GUID string `json:"guid"`
+				} `json:"data"`
+			} `json:"space"`
+		} `json:"relationships"`
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeV3ParseError(w, err)
+		return
+	}
+	spaceGUID := body.Relationships.Space.Data.GUID
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if _, ok := server.findSpace(spaceGUID); !ok {
+		writeV3Error(w, http.StatusUnprocessableEntity, 10008, "CF-UnprocessableEntity", "Invalid space. Ensure that the space exists and you have access to it.")
+		return
+	}
+	if server.applicationNameTaken(spaceGUID, body.Name) {
+		writeV3Error(w, http.StatusUnprocessableEntity, 10008, "CF-UnprocessableEntity", "name must be unique in space")
+		return
+	}
+
+	app := server.addApplication(Application{Name: body.Name, SpaceGUID: spaceGUID})
+	writeJSON(w, http.StatusCreated, server.v3Application(app))
+}
+
+func (server *Server) getV3Application(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	i, ok := server.findApplication(rata.Param(r, "guid"))
+	if !ok {
+		writeV3NotFound(w, "App")
+		return
+	}
+	writeJSON(w, http.StatusOK, server.v3Application(server.apps[i]))
+}
+
+func (server *Server) deleteV3Application(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	guid := rata.Param(r, "guid")
+	if _, ok := server.findApplication(guid); !ok {
+		writeV3NotFound(w, "App")
+		return
+	}
+	server.deleteApplication(guid)
+
+	w.Header().Set("Location", server.URL()+"/v3/jobs/"+newGUID())
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (server *Server) getV3ApplicationTasks(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	appGUID := rata.Param(r, "guid")
+	if _, ok := server.findApplication(appGUID); !ok {
+		writeV3NotFound(w, "App")
+		return
+	}
+
+	filter := parseV3Filter(r)
+	var resources []interface{}
+	for _, task := range server.tasks {
+		if task.AppGUID != appGUID {
+			continue
+		}
+		if filter.matches(map[string]string{
+			"names":        task.Name,
+			"guids":        task.GUID,
+			"states":       task.State,
+			"sequence_ids": strconv.Itoa(task.SequenceID),
+		}) {
+			resources = append(resources, v3Task(task))
+		}
+	}
+
+	// Tasks are kept in creation order, so sorting by creation time only
+	// needs to handle the descending case.
+	if r.URL.Query().Get("order_by") == "-created_at" {
+		for i, j := 0, len(resources)-1; i < j; i, j = i+1, j-1 {
+			resources[i], resources[j] = resources[j], resources[i]
+		}
+	}
+	if perPage, err := strconv.Atoi(r.URL.Query().Get("per_page")); err == nil && perPage > 0 && perPage < len(resources) {
+		resources = resources[:perPage]
+	}
+
+	writeV3List(w, resources)
+}
+
+func (server *Server) postV3ApplicationTask(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name       string `json:"name"`
+		Command    string `json:"command"`
+		MemoryInMB uint64 `json:"memory_in_mb"`
+		DiskInMB   uint64 `json:"disk_in_mb"`
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeV3ParseError(w, err)
+		return
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	appGUID := rata.Param(r, "guid")
+	if _, ok := server.findApplication(appGUID); !ok {
+		writeV3NotFound(w, "App")
+		return
+	}
+	if body.Command == "" {
+		writeV3Error(w, http.StatusUnprocessableEntity, 10008, "CF-UnprocessableEntity", "The request is semantically invalid: command presence")
+		return
+	}
+
+	task := Task{
+		GUID:       newGUID(),
+		AppGUID:    appGUID,
+		SequenceID: 1,
+		Name:       body.Name,
+		Command:    body.Command,
+		State:      TaskRunning,
+		MemoryInMB: body.MemoryInMB,
+		DiskInMB:   body.DiskInMB,
+		CreatedAt:  time.Now().UTC(),
+	}
+	for _, existing := range server.tasks {
+		if existing.AppGUID == appGUID && existing.SequenceID >= task.SequenceID {
+			task.SequenceID = existing.SequenceID + 1
+		}
+	}
+	if task.Name == "" {
+		task.Name = strings.Split(task.GUID, "-")[0]
+	}
+	if task.MemoryInMB == 0 {
+		task.MemoryInMB = 256
+	}
+	if task.DiskInMB == 0 {
+		task.DiskInMB = 1024
+	}
+
+	server.tasks = append(server.tasks, task)
+	writeJSON(w, http.StatusAccepted, v3Task(task))
+}
+
+func (server *Server) getV3Task(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	i, ok := server.findTask(rata.Param(r, "guid"))
+	if !ok {
+		writeV3NotFound(w, "Task")
+		return
+	}
+	writeJSON(w, http.StatusOK, v3Task(server.tasks[i]))
+}
+
+func (server *Server) cancelV3Task(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	i, ok := server.findTask(rata.Param(r, "guid"))
+	if !ok {
+		writeV3NotFound(w, "Task")
+		return
+	}
+
+	task := &server.tasks[i]
+	if task.State != TaskRunning {
+		writeV3Error(w, http.StatusUnprocessableEntity, 10008, "CF-UnprocessableEntity", "Task state is "+task.State+" and therefore cannot be canceled")
+		return
+	}
+	task.State = TaskCanceling
+	writeJSON(w, http.StatusAccepted, v3Task(*task))
+}
+
+func (server *Server) v3Application(app Application) interface{} {
+	return map[string]interface{}{
+		"guid":  app.GUID,
+		"name":  app.Name,
+		"state": app.State,
+		"lifecycle": map[string]interface{}{
+			"type": "buildpack",
+			"data": map[string]interface{}{
+				"buildpacks": []string{},
+				"stack":      "cflinuxfs2",
+			},
+		},
+		"relationships": map[string]interface{}{
+			"space": v3Relationship(app.SpaceGUID),
+		},
+		"links": map[string]interface{}{
+			"self": map[string]string{"href": server.URL() + "/v3/apps/" + app.GUID},
+		},
+	}
+}
+
+func v3Task(task Task) interface{} {
+	return map[string]interface{}{
+		"guid":         task.GUID,
+		"sequence_id":  task.SequenceID,
+		"name":         task.Name,
+		"command":      task.Command,
+		"state":        task.State,
+		"memory_in_mb": task.MemoryInMB,
+		"disk_in_mb":   task.DiskInMB,
+		"created_at":   task.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func v3Relationship(guid string) interface{} {
+	return map[string]interface{}{
+		"data": map[string]string{"guid": guid},
+	}
+}
+
+// writeV3List writes resources as a single page of a V3 paginated list.
+func writeV3List(w http.ResponseWriter, resources []interface{}) {
+	if resources == nil {
+		resources = []interface{}{}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"pagination": map[string]interface{}{
+			"total_results": len(resources),
+			"total_pages":   1,
+			"next":          nil,
+		},
+		"resources": resources,
+	})
+}
+
+func writeV3Error(w http.ResponseWriter, status int, code int, title string, detail string) {
+	writeJSON(w, status, map[string]interface{}{
+		"errors": []interface{}{
+			map[string]interface{}{
+				"code":   code,
+				"title":  title,
+				"detail": detail,
+			},
+		},
+	})
+}
+
+func writeV3NotFound(w http.ResponseWriter, resource string) {
+	writeV3Error(w, http.StatusNotFound, 10010, "CF-ResourceNotFound", resource+" not found")
+}
+
+func writeV3ParseError(w http.ResponseWriter, err error) {
+	writeV3Error(w, http.StatusBadRequest, 1001, "CF-MessageParseError", "Request invalid due to parse error: "+err.Error())
+}
+
+// v3Filter holds the comma separated list filters of a V3 list request, such
+// as names=foo,bar.
+type v3Filter map[string][]string
+
+func parseV3Filter(r *http.Request) v3Filter {
+	filter := v3Filter{}
+	for key, values := range r.URL.Query() {
+		if len(values) > 0 && values[0] != "" {
+			filter[key] = strings.Split(values[0], ",")
+		}
+	}
+	return filter
+}
+
+// matches reports whether the resource with the given fields satisfies every
+// filter on those fields. Other query parameters are ignored.
+func (filter v3Filter) matches(fields map[string]string) bool {
+	for key, values := range filter {
+		value, ok := fields[key]
+		if ok && !containsString(values, value) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,414 @@
+package fakecf
+
+import (
+	"time"
+
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+// DefaultUsername and DefaultPassword are the credentials of the user every
+// new Server starts with.
+const (
+	DefaultUsername = "admin"
+	DefaultPassword = "admin"
+)
+
+// DefaultDomainName is the name of the shared domain every new Server starts
+// with.
+const DefaultDomainName = "fake-cf.example.com"
+
+// Application states.
+const (
+	ApplicationStarted = "STARTED"
+	ApplicationStopped = "STOPPED"
+)
+
+// Task states.
+const (
+	TaskRunning   = "RUNNING"
+	TaskSucceeded = "SUCCEEDED"
+	TaskFailed    = "FAILED"
+	TaskCanceling = "CANCELING"
+)
+
+// User is a UAA user that can log in to the Server.
+type User struct {
+	GUID     string
+	Username string
+	Password string
+}
+
+// Organization is a Cloud Controller organization.
+type Organization struct {
+	GUID string
+	Name string
+}
+
+// Space is a Cloud Controller space.
+type Space struct {
+	GUID             string
+	Name             string
+	OrganizationGUID string
+	AllowSSH         bool
+}
+
+// Application is a Cloud Controller application.
+type Application struct {
+	GUID      string
+	Name      string
+	SpaceGUID string
+	State     string
+	Command   string
+	Instances int
+	Memory    uint64
+	DiskQuota uint64
+}
+
+// Domain is a Cloud Controller shared domain.
+type Domain struct {
+	GUID string
+	Name string
+}
+
+// Route is a Cloud Controller route. AppGUIDs lists the applications the route
+// is mapped to.
+type Route struct {
+	GUID       string
+	Host       string
+	Path       string
+	DomainGUID string
+	SpaceGUID  string
+	AppGUIDs   []string
+}
+
+// Task is a Cloud Controller V3 task. New tasks start in the TaskRunning
+// state; use SetTaskState to move them on.
+type Task struct {
+	GUID       string
+	AppGUID    string
+	SequenceID int
+	Name       string
+	Command    string
+	State      string
+	MemoryInMB uint64
+	DiskInMB   uint64
+	CreatedAt  time.Time
+}
+
+// Policy is a container networking policy allowing traffic from the source
+// application to the destination application.
+type Policy struct {
+	SourceGUID      string
+	DestinationGUID string
+	Protocol        string
+	StartPort       int
+	EndPort         int
+}
+
+// AddUser creates a user that can log in with the given credentials.
+func (server *Server) AddUser(username string, password string) User {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return server.addUser(username, password)
+}
+
+// AddOrganization creates an organization.
+func (server *Server) AddOrganization(name string) Organization {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return server.addOrganization(name)
+}
+
+// AddSpace creates a space in the given organization.
+func (server *Server) AddSpace(orgGUID string, name string) Space {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return server.addSpace(orgGUID, name)
+}
+
+// AddApplication creates a stopped application in the given space.
+func (server *Server) AddApplication(spaceGUID string, name string) Application {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return server.addApplication(Application{Name: name, SpaceGUID: spaceGUID})
+}
+
+// AddRoute creates a route for host on the default shared domain in the given
+// space.
+func (server *Server) AddRoute(spaceGUID string, host string) Route {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return server.addRoute(Route{Host: host, DomainGUID: server.domains[0].GUID, SpaceGUID: spaceGUID})
+}
+
+// Organizations returns every organization in creation order.
+func (server *Server) Organizations() []Organization {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return append([]Organization{}, server.orgs...)
+}
+
+// Spaces returns every space in creation order.
+func (server *Server) Spaces() []Space {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return append([]Space{}, server.spaces...)
+}
+
+// Applications returns every application in creation order.
+func (server *Server) Applications() []Application {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return append([]Application{}, server.apps...)
+}
+
+// Routes returns every route in creation order.
+func (server *Server) Routes() []Route {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	routes := make([]Route, 0, len(server.routes))
+	for _, route := range server.routes {
+		route.AppGUIDs = append([]string{}, route.AppGUIDs...)
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+// Tasks returns every task in creation order.
+func (server *Server) Tasks() []Task {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return append([]Task{}, server.tasks...)
+}
+
+// Policies returns every network policy in creation order.
+func (server *Server) Policies() []Policy {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return append([]Policy{}, server.policies...)
+}
+
+// SetTaskState changes the state of the task with the given GUID. It returns
+// false when there is no such task.
+func (server *Server) SetTaskState(taskGUID string, state string) bool {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	for i := range server.tasks {
+		if server.tasks[i].GUID == taskGUID {
+			server.tasks[i].State = state
+			return true
+		}
+	}
+	return false
+}
+
+// The unexported helpers below expect the caller to hold server.mutex.
+
+func (server *Server) addUser(username string, password string) User {
+	user := User{GUID: newGUID(), Username: username, Password: password}
+	server.users = append(server.users, user)
+	return user
+}
+
+func (server *Server) addOrganization(name string) Organization {
+	org := Organization{GUID: newGUID(), Name: name}
+	server.orgs = append(server.orgs, org)
+	return org
+}
+
+func (server *Server) addSpace(orgGUID string, name string) Space {
+	space := Space{GUID: newGUID(), Name: name, OrganizationGUID: orgGUID, AllowSSH: true}
+	server.spaces = append(server.spaces, space)
+	return space
+}
+
+func (server *Server) addApplication(app Application) Application {
+	app.GUID = newGUID()
+	if app.State == "" {
+		app.State = ApplicationStopped
+	}
+	if app.Instances == 0 {
+		app.Instances = 1
+	}
+	if app.Memory == 0 {
+		app.Memory = 1024
+	}
+	if app.DiskQuota == 0 {
+		app.DiskQuota = 1024
+	}
+	server.apps = append(server.apps, app)
+	return app
+}
+
+func (server *Server) addRoute(route Route) Route {
+	route.GUID = newGUID()
+	server.routes = append(server.routes, route)
+	return route
+}
+
+func (server *Server) findUser(username string) (User, bool) {
+	for _, user := range server.users {
+		if user.Username == username {
+			return user, true
+		}
+	}
+	return User{}, false
+}
+
+func (server *Server) findOrganization(guid string) (int, bool) {
+	for i, org := range server.orgs {
+		if org.GUID == guid {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (server *Server) findSpace(guid string) (int, bool) {
+	for i, space := range server.spaces {
+		if space.GUID == guid {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (server *Server) findApplication(guid string) (int, bool) {
+	for i, app := range server.apps {
+		if app.GUID == guid {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (server *Server) findRoute(guid string) (int, bool) {
+	for i, route := range server.routes {
+		if route.GUID == guid {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (server *Server) findDomain(guid string) (Domain, bool) {
+	for _, domain := range server.domains {
+		if domain.GUID == guid {
+			return domain, true
+		}
+	}
+	return Domain{}, false
+}
+
+func (server *Server) findTask(guid string) (int, bool) {
+	for i, task := range server.tasks {
+		if task.GUID == guid {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// deleteOrganization removes the organization and everything in it.
+func (server *Server) deleteOrganization(guid string) {
+	var orgs []Organization
+	for _, org := range server.orgs {
+		if org.GUID != guid {
+			orgs = append(orgs, org)
+		}
+	}
+	server.orgs = orgs
+
+	for _, space := range append([]Space{}, server.spaces...) {
+		if space.OrganizationGUID == guid {
+			server.deleteSpace(space.GUID)
+		}
+	}
+}
+
+// deleteSpace removes the space along with its applications and routes.
+func (server *Server) deleteSpace(guid string) {
+	var spaces []Space
+	for _, space := range server.spaces {
+		if space.GUID != guid {
+			spaces = append(spaces, space)
+		}
+	}
+	server.spaces = spaces
+
+	for _, app := range append([]Application{}, server.apps...) {
+		if app.SpaceGUID == guid {
+			server.deleteApplication(app.GUID)
+		}
+	}
+
+	var routes []Route
+	for _, route := range server.routes {
+		if route.SpaceGUID != guid {
+			routes = append(routes, route)
+		}
+	}
+	server.routes = routes
+}
+
+// deleteApplication removes the application along with its tasks, route
+// mappings and network policies.
+func (server *Server) deleteApplication(guid string) {
+	var apps []Application
+	for _, app := range server.apps {
+		if app.GUID != guid {
+			apps = append(apps, app)
+		}
+	}
+	server.apps = apps
+
+	var tasks []Task
+	for _, task := range server.tasks {
+		if task.AppGUID != guid {
+			tasks = append(tasks, task)
+		}
+	}
+	server.tasks = tasks
+
+	for i := range server.routes {
+		server.routes[i].AppGUIDs = removeString(server.routes[i].AppGUIDs, guid)
+	}
+
+	var policies []Policy
+	for _, policy := range server.policies {
+		if policy.SourceGUID != guid && policy.DestinationGUID != guid {
+			policies = append(policies, policy)
+		}
+	}
+	server.policies = policies
+}
+
+func newGUID() string {
+	guid, err := uuid.NewV4()
+	if err != nil {
+		panic(err)
+	}
+	return guid.String()
+}
+
+func removeString(list []string, value string) []string {
+	var result []string
+	for _, item := range list {
+		if item != value {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,139 @@
+package fakecf
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/tedsuo/rata"
+)
+
+const networkingPath = "/networking/v1/external"
+
+type networkingPolicy struct {
+	Source struct {
+		ID string `json:"id"`
+	} `json:"source"`
+	Destination struct {
+		ID       string `json:"id"`
+		Protocol string `json:"protocol"`
+		Ports    struct {
+			Start int `json:"start"`
+			End   int `json:"end"`
+		} `json:"ports"`
+	} `json:"destination"`
+}
+
+type networkingPolicyList struct {
+	TotalPolicies int                `json:"total_policies"`
+	Policies      []networkingPolicy `json:"policies"`
+}
+
+func (server *Server) networkingRoutes() []route {
+	return []route{
+		{Route: rata.Route{Name: "ListPolicies", Method: http.MethodGet, Path: networkingPath + "/policies"}, handler: server.listPolicies, writeAuthError: writeNetworkingError},
+		{Route: rata.Route{Name: "CreatePolicies", Method: http.MethodPost, Path: networkingPath + "/policies"}, handler: server.createPolicies, writeAuthError: writeNetworkingError},
+		{Route: rata.Route{Name: "DeletePolicies", Method: http.MethodPost, Path: networkingPath + "/policies/delete"}, handler: server.deletePolicies, writeAuthError: writeNetworkingError},
+	}
+}
+
+// listPolicies lists every policy, or only those involving one of the
+// applications in the id query parameter.
+func (server *Server) listPolicies(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	var ids []string
+	if id := r.URL.Query().Get("id"); id != "" {
+		ids = strings.Split(id, ",")
+	}
+
+	list := networkingPolicyList{Policies: []networkingPolicy{}}
+	for _, policy := range server.policies {
+		if ids == nil || containsString(ids, policy.SourceGUID) || containsString(ids, policy.DestinationGUID) {
+			list.Policies = append(list.Policies, toNetworkingPolicy(policy))
+		}
+	}
+	list.TotalPolicies = len(list.Policies)
+	writeJSON(w, http.StatusOK, list)
+}
+
+func (server *Server) createPolicies(w http.ResponseWriter, r *http.Request) {
+	var body networkingPolicyList
+	if err := readJSON(r, &body); err != nil {
+		writeNetworkingError(w, http.StatusBadRequest, 0, "", "invalid request body: "+err.Error())
+		return
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	for _, p := range body.Policies {
+		policy := fromNetworkingPolicy(p)
+		if policy.SourceGUID == "" || policy.DestinationGUID == "" {
+			writeNetworkingError(w, http.StatusBadRequest, 0, "", "missing source or destination id")
+			return
+		}
+		if !containsPolicy(server.policies, policy) {
+			server.policies = append(server.policies, policy)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (server *Server) deletePolicies(w http.ResponseWriter, r *http.Request) {
+	var body networkingPolicyList
+	if err := readJSON(r, &body); err != nil {
+		writeNetworkingError(w, http.StatusBadRequest, 0, "", "invalid request body: "+err.Error())
+		return
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	var deleted []Policy
+	for _, p := range body.Policies {
+		deleted = append(deleted, fromNetworkingPolicy(p))
+	}
+
+	var policies []Policy
+	for _, policy := range server.policies {
+		if !containsPolicy(deleted, policy) {
+			policies = append(policies, policy)
+		}
+	}
+	server.policies = policies
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func fromNetworkingPolicy(p networkingPolicy) Policy {
+	return Policy{
+		SourceGUID:      p.Source.ID,
+		DestinationGUID: p.Destination.ID,
+		Protocol:        p.Destination.Protocol,
+		StartPort:       p.Destination.Ports.Start,
+		EndPort:         p.Destination.Ports.End,
+	}
+}
+
+func toNetworkingPolicy(policy Policy) networkingPolicy {
+	var p networkingPolicy
+	p.Source.ID = policy.SourceGUID
+	p.Destination.ID = policy.DestinationGUID
+	p.Destination.Protocol = policy.Protocol
+	p.Destination.Ports.Start = policy.StartPort
+	p.Destination.Ports.End = policy.EndPort
+	return p
+}
+
+func containsPolicy(policies []Policy, policy Policy) bool {
+	for _, p := range policies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
+
+func writeNetworkingError(w http.ResponseWriter, status int, _ int, _ string, detail string) {
+	writeJSON(w, status, map[string]string{"error": detail})
+}
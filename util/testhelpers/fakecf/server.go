@@ -0,0 +1,197 @@
+// Package fakecf provides an in-process fake of the Cloud Controller, UAA and
+// container networking APIs.
+//
+// A Server keeps a small, consistent in-memory model of users, organizations,
+// spaces, applications, routes, tasks and network policies. Objects created
+// through the API are visible to every later request, and the model can be
+// seeded and inspected directly from Go, so the cf binary and plugins can be
+// exercised end to end without a real foundation:
+//
+//	server := fakecf.NewServer()
+//	defer server.Close()
+//
+//	cf api <server.URL()> --skip-ssl-validation
+//	cf auth admin admin
+//
+// Only the endpoints the CLI needs for its common workflows are served;
+// anything else responds with a Cloud Controller style 404.
+package fakecf
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/tedsuo/rata"
+)
+
+// APIVersion is the Cloud Controller V2 API version the Server reports.
+const APIVersion = "2.92.0"
+
+// APIV3Version is the Cloud Controller V3 API version the Server reports.
+const APIV3Version = "3.27.0"
+
+// Server is a running fake Cloud Controller, UAA and networking server. All
+// of its methods are safe for concurrent use.
+type Server struct {
+	server *httptest.Server
+
+	mutex sync.Mutex
+
+	tokenKey      []byte
+	accessTokens  map[string]string
+	refreshTokens map[string]string
+
+	users    []User
+	orgs     []Organization
+	spaces   []Space
+	apps     []Application
+	domains  []Domain
+	routes   []Route
+	tasks    []Task
+	policies []Policy
+}
+
+// NewServer starts a TLS server with a self-signed certificate, seeded with
+// the DefaultUsername user and the DefaultDomainName shared domain. The
+// caller should call Close when finished.
+func NewServer() *Server {
+	server := &Server{
+		tokenKey:      []byte(newGUID()),
+		accessTokens:  map[string]string{},
+		refreshTokens: map[string]string{},
+	}
+	server.addUser(DefaultUsername, DefaultPassword)
+	server.domains = append(server.domains, Domain{GUID: newGUID(), Name: DefaultDomainName})
+
+	server.server = httptest.NewTLSServer(server.newRouter())
+	return server
+}
+
+// URL returns the API endpoint of the server, e.g. https://127.0.0.1:12345.
+func (server *Server) URL() string {
+	return server.server.URL
+}
+
+// Close shuts the server down and blocks until all outstanding requests have
+// completed.
+func (server *Server) Close() {
+	server.server.Close()
+}
+
+// errorWriter writes an error response in the format of one of the APIs.
+type errorWriter func(w http.ResponseWriter, status int, code int, title string, detail string)
+
+// route is a served endpoint. When writeAuthError is set, the endpoint
+// requires an access token and rejects requests without one using it.
+type route struct {
+	rata.Route
+	handler        http.HandlerFunc
+	writeAuthError errorWriter
+}
+
+func (server *Server) newRouter() http.Handler {
+	var routes []route
+	routes = append(routes, server.uaaRoutes()...)
+	routes = append(routes, server.ccV2Routes()...)
+	routes = append(routes, server.ccV3Routes()...)
+	routes = append(routes, server.networkingRoutes()...)
+	// The root route must come last: its pattern matches every path that the
+	// routes above do not.
+	routes = append(routes, route{Route: rata.Route{Name: "GetRoot", Method: http.MethodGet, Path: "/"}, handler: server.getRoot})
+
+	rataRoutes := rata.Routes{}
+	handlers := rata.Handlers{}
+	for _, r := range routes {
+		rataRoutes = append(rataRoutes, r.Route)
+		handler := r.handler
+		if r.writeAuthError != nil {
+			handler = server.authenticate(handler, r.writeAuthError)
+		}
+		handlers[r.Name] = handler
+	}
+
+	router, err := rata.NewRouter(rataRoutes, handlers)
+	if err != nil {
+		panic(err)
+	}
+	return router
+}
+
+func (server *Server) getRoot(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		writeV2Error(w, http.StatusNotFound, 10000, "CF-NotFound", "Unknown request")
+		return
+	}
+
+	link := func(path string) map[string]interface{} {
+		return map[string]interface{}{"href": server.URL() + path}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"links": map[string]interface{}{
+			"self": link(""),
+			"cloud_controller_v2": map[string]interface{}{
+				"href": server.URL() + "/v2",
+				"meta": map[string]string{"version": APIVersion},
+			},
+			"cloud_controller_v3": map[string]interface{}{
+				"href": server.URL() + "/v3",
+				"meta": map[string]string{"version": APIV3Version},
+			},
+			"network_policy_v1": link(networkingPath),
+			"uaa":               link(uaaPath),
+			"logging":           map[string]string{"href": server.dopplerURL()},
+		},
+	})
+}
+
+// authenticate rejects requests that do not carry an access token issued by
+// the server.
+func (server *Server) authenticate(handler http.HandlerFunc, writeError errorWriter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var ok bool
+		token := r.Header.Get("Authorization")
+		if strings.HasPrefix(strings.ToLower(token), "bearer ") {
+			server.mutex.Lock()
+			_, ok = server.accessTokens[token[len("bearer "):]]
+			server.mutex.Unlock()
+		}
+
+		if !ok {
+			writeError(w, http.StatusUnauthorized, 1000, "CF-InvalidAuthToken", "Invalid Auth Token")
+			return
+		}
+		handler(w, r)
+	}
+}
+
+func (server *Server) dopplerURL() string {
+	return "wss" + strings.TrimPrefix(server.URL(), "https")
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeV2Error(w http.ResponseWriter, status int, code int, errorCode string, description string) {
+	writeJSON(w, status, map[string]interface{}{
+		"code":        code,
+		"description": description,
+		"error_code":  errorCode,
+	})
+}
+
+// readJSON decodes the request body, if there is one, into body.
+func readJSON(r *http.Request, body interface{}) error {
+	raw, err := ioutil.ReadAll(r.Body)
+	if err != nil || len(raw) == 0 {
+		return err
+	}
+	return json.Unmarshal(raw, body)
+}
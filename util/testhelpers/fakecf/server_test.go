@@ -0,0 +1,271 @@
+package fakecf_test
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cfnetworking/cfnetv1"
+	netWrapper "code.cloudfoundry.org/cli/api/cfnetworking/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa"
+	. "code.cloudfoundry.org/cli/util/testhelpers/fakecf"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type tokenCache struct {
+	accessToken  string
+	refreshToken string
+}
+
+func (cache *tokenCache) AccessToken() string          { return cache.accessToken }
+func (cache *tokenCache) RefreshToken() string         { return cache.refreshToken }
+func (cache *tokenCache) SetAccessToken(token string)  { cache.accessToken = token }
+func (cache *tokenCache) SetRefreshToken(token string) { cache.refreshToken = token }
+
+var _ = Describe("Server", func() {
+	var (
+		server    *Server
+		cache     *tokenCache
+		uaaClient *uaa.Client
+		v2Client  *ccv2.Client
+		v3Client  *ccv3.Client
+		netClient *cfnetv1.Client
+	)
+
+	BeforeEach(func() {
+		server = NewServer()
+		cache = &tokenCache{}
+
+		uaaClient = uaa.NewClient(uaa.Config{ClientID: "cf", SkipSSLValidation: true})
+
+		v2Client = ccv2.NewClient(ccv2.Config{
+			JobPollingTimeout:  time.Second,
+			JobPollingInterval: time.Millisecond,
+			Wrappers:           []ccv2.ConnectionWrapper{ccWrapper.NewUAAAuthentication(uaaClient, cache)},
+		})
+		_, err := v2Client.TargetCF(ccv2.TargetSettings{URL: server.URL(), SkipSSLValidation: true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(uaaClient.SetupResources(v2Client.AuthorizationEndpoint())).To(Succeed())
+
+		v3Client = ccv3.NewClient(ccv3.Config{
+			Wrappers: []ccv3.ConnectionWrapper{ccWrapper.NewUAAAuthentication(uaaClient, cache)},
+		})
+		_, err = v3Client.TargetCF(ccv3.TargetSettings{URL: server.URL(), SkipSSLValidation: true})
+		Expect(err).ToNot(HaveOccurred())
+
+		netClient = cfnetv1.NewClient(cfnetv1.Config{
+			SkipSSLValidation: true,
+			URL:               v3Client.NetworkPolicyV1(),
+			Wrappers:          []cfnetv1.ConnectionWrapper{netWrapper.NewUAAAuthentication(uaaClient, cache)},
+		})
+
+		accessToken, refreshToken, err := uaaClient.Authenticate(DefaultUsername, DefaultPassword)
+		Expect(err).ToNot(HaveOccurred())
+		cache.SetAccessToken("bearer " + accessToken)
+		cache.SetRefreshToken(refreshToken)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("authentication", func() {
+		It("only accepts known users", func() {
+			_, _, err := uaaClient.Authenticate(DefaultUsername, "wrong")
+			Expect(err).To(HaveOccurred())
+
+			server.AddUser("some-user", "some-password")
+			accessToken, _, err := uaaClient.Authenticate("some-user", "some-password")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(accessToken).ToNot(BeEmpty())
+		})
+
+		It("rejects requests without an access token", func() {
+			client := ccv2.NewClient(ccv2.Config{})
+			_, err := client.TargetCF(ccv2.TargetSettings{URL: server.URL(), SkipSSLValidation: true})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = client.GetOrganizations(nil)
+			Expect(err).To(MatchError(ccerror.InvalidAuthTokenError{Message: "Invalid Auth Token"}))
+		})
+
+		It("lets clients refresh expired access tokens", func() {
+			oldToken := cache.AccessToken()
+			server.ExpireAccessTokens()
+
+			_, _, err := v2Client.GetOrganizations(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cache.AccessToken()).ToNot(Equal(oldToken))
+		})
+	})
+
+	Describe("organizations and spaces", func() {
+		var org Organization
+
+		BeforeEach(func() {
+			org = server.AddOrganization("some-org")
+			server.AddOrganization("other-org")
+			server.AddSpace(org.GUID, "some-space")
+		})
+
+		It("serves the seeded objects to both API versions", func() {
+			orgs, _, err := v2Client.GetOrganizations([]ccv2.Query{{Filter: ccv2.NameFilter, Operator: ccv2.EqualOperator, Value: "some-org"}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(orgs).To(HaveLen(1))
+			Expect(orgs[0].GUID).To(Equal(org.GUID))
+
+			spaces, _, err := v2Client.GetSpaces([]ccv2.Query{{Filter: ccv2.OrganizationGUIDFilter, Operator: ccv2.EqualOperator, Value: org.GUID}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(spaces).To(HaveLen(1))
+			Expect(spaces[0].Name).To(Equal("some-space"))
+
+			v3Orgs, _, err := v3Client.GetOrganizations(url.Values{ccv3.NameFilter: {"other-org"}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(v3Orgs).To(HaveLen(1))
+			Expect(v3Orgs[0].Name).To(Equal("other-org"))
+		})
+
+		It("deletes everything in an organization along with it", func() {
+			job, _, err := v2Client.DeleteOrganization(org.GUID)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = v2Client.PollJob(job)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(server.Organizations()).To(HaveLen(1))
+			Expect(server.Spaces()).To(BeEmpty())
+
+			_, _, err = v2Client.GetOrganization(org.GUID)
+			Expect(err).To(BeAssignableToTypeOf(ccerror.ResourceNotFoundError{}))
+		})
+	})
+
+	Describe("applications and routes", func() {
+		var space Space
+
+		BeforeEach(func() {
+			org := server.AddOrganization("some-org")
+			space = server.AddSpace(org.GUID, "some-space")
+		})
+
+		It("keeps applications consistent across API versions", func() {
+			app, _, err := v2Client.CreateApplication(ccv2.Application{Name: "some-app", SpaceGUID: space.GUID})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(app.State).To(Equal(ccv2.ApplicationStopped))
+
+			_, _, err = v2Client.UpdateApplication(ccv2.Application{GUID: app.GUID, State: ccv2.ApplicationStarted})
+			Expect(err).ToNot(HaveOccurred())
+
+			apps, _, err := v3Client.GetApplications(url.Values{ccv3.NameFilter: {"some-app"}, ccv3.SpaceGUIDFilter: {space.GUID}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apps).To(HaveLen(1))
+			Expect(apps[0].GUID).To(Equal(app.GUID))
+			Expect(apps[0].State).To(Equal(ApplicationStarted))
+
+			_, _, err = v2Client.CreateApplication(ccv2.Application{Name: "some-app", SpaceGUID: space.GUID})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("maps routes to applications", func() {
+			app := server.AddApplication(space.GUID, "some-app")
+
+			domains, _, err := v2Client.GetSharedDomains()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domains).To(HaveLen(1))
+			Expect(domains[0].Name).To(Equal(DefaultDomainName))
+
+			route, _, err := v2Client.CreateRoute(ccv2.Route{Host: "some-host", DomainGUID: domains[0].GUID, SpaceGUID: space.GUID}, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			exists, _, err := v2Client.CheckRoute(ccv2.Route{Host: "some-host", DomainGUID: domains[0].GUID})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+
+			_, _, err = v2Client.BindRouteToApplication(route.GUID, app.GUID)
+			Expect(err).ToNot(HaveOccurred())
+
+			routes, _, err := v2Client.GetApplicationRoutes(app.GUID, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(routes).To(HaveLen(1))
+			Expect(routes[0].Host).To(Equal("some-host"))
+			Expect(server.Routes()[0].AppGUIDs).To(ConsistOf(app.GUID))
+		})
+	})
+
+	Describe("tasks", func() {
+		var app Application
+
+		BeforeEach(func() {
+			org := server.AddOrganization("some-org")
+			space := server.AddSpace(org.GUID, "some-space")
+			app = server.AddApplication(space.GUID, "some-app")
+		})
+
+		It("runs, lists and cancels tasks", func() {
+			first, _, err := v3Client.CreateApplicationTask(app.GUID, ccv3.Task{Command: "echo hi"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(first.SequenceID).To(Equal(1))
+			Expect(first.State).To(Equal(TaskRunning))
+
+			second, _, err := v3Client.CreateApplicationTask(app.GUID, ccv3.Task{Command: "sleep 10", Name: "some-task"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(second.SequenceID).To(Equal(2))
+
+			Expect(server.SetTaskState(first.GUID, TaskSucceeded)).To(BeTrue())
+
+			tasks, _, err := v3Client.GetApplicationTasks(app.GUID, url.Values{ccv3.OrderBy: {"-created_at"}}, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tasks).To(HaveLen(2))
+			Expect(tasks[0].Name).To(Equal("some-task"))
+			Expect(tasks[1].State).To(Equal(TaskSucceeded))
+
+			canceled, _, err := v3Client.UpdateTask(second.GUID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(canceled.State).To(Equal(TaskCanceling))
+			Expect(server.Tasks()[1].State).To(Equal(TaskCanceling))
+		})
+
+		It("reports unknown applications the way the Cloud Controller does", func() {
+			_, _, err := v3Client.CreateApplicationTask("no-such-app", ccv3.Task{Command: "echo hi"})
+			Expect(err).To(MatchError(ccerror.ApplicationNotFoundError{}))
+		})
+	})
+
+	Describe("network policies", func() {
+		It("stores created policies", func() {
+			err := netClient.CreatePolicies([]cfnetv1.Policy{{
+				Source: cfnetv1.PolicySource{ID: "source-guid"},
+				Destination: cfnetv1.PolicyDestination{
+					ID:       "destination-guid",
+					Protocol: cfnetv1.PolicyProtocolTCP,
+					Ports:    cfnetv1.Ports{Start: 8080, End: 8080},
+				},
+			}})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(server.Policies()).To(ConsistOf(Policy{
+				SourceGUID:      "source-guid",
+				DestinationGUID: "destination-guid",
+				Protocol:        "tcp",
+				StartPort:       8080,
+				EndPort:         8080,
+			}))
+		})
+	})
+
+	Describe("unknown endpoints", func() {
+		It("responds with a Cloud Controller style 404", func() {
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+			response, err := client.Get(server.URL() + "/v2/no-such-thing")
+			Expect(err).ToNot(HaveOccurred())
+			defer response.Body.Close()
+			Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+		})
+	})
+})
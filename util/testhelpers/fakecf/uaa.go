@@ -0,0 +1,171 @@
+package fakecf
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/tedsuo/rata"
+)
+
+const uaaPath = "/uaa"
+
+// TokenLifetime is how long access tokens issued by the Server claim to be
+// valid for. The Server itself only rejects tokens revoked by
+// ExpireAccessTokens.
+const TokenLifetime = time.Hour
+
+// ExpireAccessTokens revokes every access token issued so far. Refresh tokens
+// stay valid, so clients that refresh their token carry on working.
+func (server *Server) ExpireAccessTokens() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.accessTokens = map[string]string{}
+}
+
+func (server *Server) uaaRoutes() []route {
+	return []route{
+		{Route: rata.Route{Name: "GetUAALogin", Method: http.MethodGet, Path: uaaPath + "/login"}, handler: server.getUAALogin},
+		{Route: rata.Route{Name: "PostOAuthToken", Method: http.MethodPost, Path: uaaPath + "/oauth/token"}, handler: server.postOAuthToken},
+		{Route: rata.Route{Name: "GetOAuthAuthorize", Method: http.MethodGet, Path: uaaPath + "/oauth/authorize"}, handler: server.getOAuthAuthorize, writeAuthError: writeUAAAuthError},
+		{Route: rata.Route{Name: "PostUAAUser", Method: http.MethodPost, Path: uaaPath + "/Users"}, handler: server.postUAAUser, writeAuthError: writeUAAAuthError},
+	}
+}
+
+func (server *Server) getUAALogin(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"app": map[string]string{"version": "4.7.0"},
+		"links": map[string]string{
+			"uaa":   server.URL() + uaaPath,
+			"login": server.URL() + uaaPath,
+		},
+		"prompts": map[string][]string{
+			"username": {"text", "Email"},
+			"password": {"password", "Password"},
+		},
+	})
+}
+
+func (server *Server) postOAuthToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeUAAError(w, http.StatusBadRequest, 0, "invalid_request", err.Error())
+		return
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	var (
+		user User
+		ok   bool
+	)
+	switch r.PostForm.Get("grant_type") {
+	case "password":
+		user, ok = server.findUser(r.PostForm.Get("username"))
+		if !ok || user.Password != r.PostForm.Get("password") {
+			writeUAAError(w, http.StatusUnauthorized, 0, "unauthorized", "Bad credentials")
+			return
+		}
+	case "refresh_token":
+		var username string
+		username, ok = server.refreshTokens[r.PostForm.Get("refresh_token")]
+		if ok {
+			user, ok = server.findUser(username)
+		}
+		if !ok {
+			writeUAAError(w, http.StatusUnauthorized, 0, "invalid_token", "Invalid refresh token")
+			return
+		}
+	default:
+		writeUAAError(w, http.StatusBadRequest, 0, "unsupported_grant_type", "Unsupported grant type")
+		return
+	}
+
+	accessToken := server.newAccessToken(user)
+	refreshToken := newGUID() + "-r"
+	server.accessTokens[accessToken] = user.Username
+	server.refreshTokens[refreshToken] = user.Username
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":  accessToken,
+		"refresh_token": refreshToken,
+		"token_type":    "bearer",
+		"expires_in":    int(TokenLifetime.Seconds()),
+		"scope":         "cloud_controller.read cloud_controller.write cloud_controller.admin openid",
+		"jti":           newGUID(),
+	})
+}
+
+// getOAuthAuthorize hands out one time codes, as used by cf ssh-code.
+func (server *Server) getOAuthAuthorize(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Location", server.URL()+uaaPath+"/login?code="+newGUID())
+	w.WriteHeader(http.StatusFound)
+}
+
+func (server *Server) postUAAUser(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Username string `json:"userName"`
+		Password string `json:"password"`
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeUAAError(w, http.StatusBadRequest, 0, "invalid_scim_resource", err.Error())
+		return
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if _, ok := server.findUser(body.Username); ok {
+		writeUAAError(w, http.StatusConflict, 0, "scim_resource_already_exists", "Username already in use: "+body.Username)
+		return
+	}
+
+	user := server.addUser(body.Username, body.Password)
+	writeJSON(w, http.StatusCreated, map[string]interface{}{
+		"id":       user.GUID,
+		"userName": user.Username,
+	})
+}
+
+// newAccessToken returns a signed JWT carrying the claims the CLI reads.
+func (server *Server) newAccessToken(user User) string {
+	now := time.Now()
+	claims := map[string]interface{}{
+		"jti":       newGUID(),
+		"sub":       user.GUID,
+		"user_id":   user.GUID,
+		"user_name": user.Username,
+		"email":     user.Username,
+		"origin":    "uaa",
+		"client_id": "cf",
+		"cid":       "cf",
+		"scope":     []string{"cloud_controller.read", "cloud_controller.write", "cloud_controller.admin", "openid"},
+		"iat":       now.Unix(),
+		"exp":       now.Add(TokenLifetime).Unix(),
+		"iss":       server.URL() + uaaPath + "/oauth/token",
+	}
+
+	header, _ := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, server.tokenKey)
+	_, _ = mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func writeUAAError(w http.ResponseWriter, status int, _ int, title string, detail string) {
+	writeJSON(w, status, map[string]string{
+		"error":             title,
+		"error_description": detail,
+	})
+}
+
+// writeUAAAuthError reports a rejected access token the way UAA does.
+func writeUAAAuthError(w http.ResponseWriter, status int, code int, _ string, detail string) {
+	writeUAAError(w, status, code, "invalid_token", detail)
+}
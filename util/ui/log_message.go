@@ -0,0 +1,52 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// jsonLogMessage is the representation of a log message used by the JSON log
+// output.
+type jsonLogMessage struct {
+	Timestamp      time.Time `json:"timestamp"`
+	App            string    `json:"app"`
+	SourceType     string    `json:"source_type"`
+	SourceInstance string    `json:"source_instance"`
+	Stream         string    `json:"stream"`
+	Message        string    `json:"message"`
+}
+
+// formatLogMessage returns the lines of the message, each prefixed with the
+// log header when displayHeader is true.
+func formatLogMessage(message LogMessage, location *time.Location, displayHeader bool) []string {
+	var header string
+	if displayHeader {
+		time := message.Timestamp().In(location).Format(LogTimestampFormat)
+
+		header = fmt.Sprintf("%s [%s/%s] %s ",
+			time,
+			message.SourceType(),
+			message.SourceInstance(),
+			message.Type(),
+		)
+	}
+
+	var lines []string
+	for _, line := range strings.Split(message.Message(), "\n") {
+		lines = append(lines, fmt.Sprintf("%s%s", header, strings.TrimRight(line, "\r\n")))
+	}
+	return lines
+}
+
+func marshalLogMessage(appName string, message LogMessage) ([]byte, error) {
+	return json.Marshal(jsonLogMessage{
+		Timestamp:      message.Timestamp().UTC(),
+		App:            appName,
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+		Stream:         strings.ToLower(message.Type()),
+		Message:        message.Message(),
+	})
+}
@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// LogMessageFileBackups is the number of rotated log files kept by a
+// LogMessageFileWriter in addition to the file currently written to.
+const LogMessageFileBackups = 5
+
+// LogMessageFileWriter writes log messages to a file, either in the log format
+// used on the terminal (without colors) or as one JSON object per line. Once
+// the file would grow beyond the maximum size it is rotated: FILE is renamed to
+// FILE.1, FILE.1 to FILE.2 and so on, and writing continues in a new FILE.
+type LogMessageFileWriter struct {
+	ui       *UI
+	lock     *sync.Mutex
+	filePath string
+	maxSize  int64
+	asJSON   bool
+
+	file *os.File
+	size int64
+}
+
+func newLogMessageFileWriter(ui *UI, filePath string, maxSize int64, asJSON bool) *LogMessageFileWriter {
+	return &LogMessageFileWriter{
+		ui:       ui,
+		lock:     &sync.Mutex{},
+		filePath: filePath,
+		maxSize:  maxSize,
+		asJSON:   asJSON,
+	}
+}
+
+// Start creates the log file, and any missing parent directories, or opens it
+// for appending if it already exists.
+func (writer *LogMessageFileWriter) Start() error {
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+
+	err := os.MkdirAll(filepath.Dir(writer.filePath), os.ModeDir|os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	return writer.open()
+}
+
+// Stop closes the log file.
+func (writer *LogMessageFileWriter) Stop() error {
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+
+	if writer.file == nil {
+		return nil
+	}
+
+	err := writer.file.Close()
+	writer.file = nil
+	return err
+}
+
+// WriteLogMessage writes the message to the log file, rotating the file first
+// if the message does not fit in it anymore. In the text format every line is
+// labeled with the app name.
+func (writer *LogMessageFileWriter) WriteLogMessage(appName string, message LogMessage) error {
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+
+	buff := new(bytes.Buffer)
+	if writer.asJSON {
+		body, err := marshalLogMessage(appName, message)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(buff, "%s\n", body)
+	} else {
+		for _, line := range formatLogMessage(message, writer.ui.TimezoneLocation, true) {
+			fmt.Fprintf(buff, "%s | %s\n", appName, line)
+		}
+	}
+
+	if writer.size > 0 && writer.size+int64(buff.Len()) > writer.maxSize {
+		err := writer.rotate()
+		if err != nil {
+			return err
+		}
+	}
+
+	written, err := writer.file.Write(buff.Bytes())
+	writer.size += int64(written)
+	return err
+}
+
+func (writer *LogMessageFileWriter) open() error {
+	file, err := os.OpenFile(writer.filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	writer.file = file
+	writer.size = info.Size()
+	return nil
+}
+
+func (writer *LogMessageFileWriter) rotate() error {
+	err := writer.file.Close()
+	if err != nil {
+		return err
+	}
+
+	for i := LogMessageFileBackups - 1; i > 0; i-- {
+		err = os.Rename(writer.backupPath(i), writer.backupPath(i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	err = os.Rename(writer.filePath, writer.backupPath(1))
+	if err != nil {
+		return err
+	}
+
+	return writer.open()
+}
+
+func (writer *LogMessageFileWriter) backupPath(index int) string {
+	return fmt.Sprintf("%s.%d", writer.filePath, index)
+}
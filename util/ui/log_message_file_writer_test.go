@@ -0,0 +1,139 @@
+package ui_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Log Message File Writer", func() {
+	var (
+		testUI  *UI
+		writer  *LogMessageFileWriter
+		tmpdir  string
+		logFile string
+		maxSize int64
+		asJSON  bool
+		message *uifakes.FakeLogMessage
+	)
+
+	BeforeEach(func() {
+		testUI = NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+
+		var err error
+		tmpdir, err = ioutil.TempDir("", "log_message_file_writer")
+		Expect(err).ToNot(HaveOccurred())
+		logFile = filepath.Join(tmpdir, "sub", "dir", "app.log")
+
+		maxSize = 1024
+		asJSON = false
+
+		message = new(uifakes.FakeLogMessage)
+		message.MessageReturns("This is a log message\nThis is also a log message")
+		message.TypeReturns("OUT")
+		message.TimestampReturns(time.Unix(1468969692, 0)) // "2016-07-19T23:08:12Z"
+		message.SourceTypeReturns("APP/PROC/WEB")
+		message.SourceInstanceReturns("12")
+	})
+
+	JustBeforeEach(func() {
+		writer = testUI.LogMessageFileWriter(logFile, maxSize, asJSON)
+		Expect(writer.Start()).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpdir)).ToNot(HaveOccurred())
+	})
+
+	readFile := func(path string) string {
+		contents, err := ioutil.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		return string(contents)
+	}
+
+	It("creates the intermediate dirs and writes every line labeled with the app name", func() {
+		Expect(writer.WriteLogMessage("api-app", message)).To(Succeed())
+		Expect(writer.Stop()).To(Succeed())
+
+		Expect(readFile(logFile)).To(Equal(
+			"api-app | 2016-07-19T23:08:12.00+0000 [APP/PROC/WEB/12] OUT This is a log message\n" +
+				"api-app | 2016-07-19T23:08:12.00+0000 [APP/PROC/WEB/12] OUT This is also a log message\n"))
+	})
+
+	Context("when the file already exists", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(logFile), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(logFile, []byte("previous session\n"), 0600)).To(Succeed())
+		})
+
+		It("appends to it", func() {
+			Expect(writer.WriteLogMessage("api-app", message)).To(Succeed())
+			Expect(writer.Stop()).To(Succeed())
+
+			Expect(readFile(logFile)).To(HavePrefix("previous session\napi-app | "))
+		})
+	})
+
+	Context("when writing JSON", func() {
+		BeforeEach(func() {
+			asJSON = true
+		})
+
+		It("writes one JSON object per message", func() {
+			Expect(writer.WriteLogMessage("api-app", message)).To(Succeed())
+			Expect(writer.WriteLogMessage("worker-app", message)).To(Succeed())
+			Expect(writer.Stop()).To(Succeed())
+
+			lines := strings.Split(strings.TrimSuffix(readFile(logFile), "\n"), "\n")
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(MatchJSON(`{
+				"timestamp": "2016-07-19T23:08:12Z",
+				"app": "api-app",
+				"source_type": "APP/PROC/WEB",
+				"source_instance": "12",
+				"stream": "out",
+				"message": "This is a log message\nThis is also a log message"
+			}`))
+			Expect(lines[1]).To(ContainSubstring(`"app":"worker-app"`))
+		})
+	})
+
+	Context("when the file grows beyond the maximum size", func() {
+		BeforeEach(func() {
+			message.MessageReturns("a message of about fifty bytes")
+			maxSize = 200
+		})
+
+		It("rotates the file", func() {
+			for i := 0; i < 3; i++ {
+				Expect(writer.WriteLogMessage("api-app", message)).To(Succeed())
+			}
+			Expect(writer.Stop()).To(Succeed())
+
+			Expect(strings.Count(readFile(logFile+".1"), "\n")).To(Equal(2))
+			Expect(strings.Count(readFile(logFile), "\n")).To(Equal(1))
+		})
+
+		It("keeps at most LogMessageFileBackups rotated files", func() {
+			for i := 0; i < 2*(LogMessageFileBackups+2); i++ {
+				Expect(writer.WriteLogMessage("api-app", message)).To(Succeed())
+			}
+			Expect(writer.Stop()).To(Succeed())
+
+			for i := 1; i <= LogMessageFileBackups; i++ {
+				Expect(fmt.Sprintf("%s.%d", logFile, i)).To(BeAnExistingFile())
+			}
+			Expect(fmt.Sprintf("%s.%d", logFile, LogMessageFileBackups+1)).ToNot(BeAnExistingFile())
+		})
+	})
+})
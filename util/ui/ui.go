@@ -7,6 +7,7 @@ package ui
 
 import (
 	"fmt"
+	"hash/fnv"
 	"io"
 	"os"
 	"strings"
@@ -183,24 +184,41 @@ func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	var header string
-	if displayHeader {
-		time := message.Timestamp().In(ui.TimezoneLocation).Format(LogTimestampFormat)
+	ui.displayLogMessage("", message, displayHeader)
+}
+
+// DisplayLabeledLogMessage formats and outputs a given log message with each
+// line prefixed by the label. The label is colored the same way every time it
+// is displayed, so messages from different sources are easy to tell apart.
+func (ui *UI) DisplayLabeledLogMessage(label string, message LogMessage, displayHeader bool) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	prefix := fmt.Sprintf("%s | ", ui.modifyColor(label, labelColor(label)))
+	ui.displayLogMessage(prefix, message, displayHeader)
+}
+
+// DisplayJSONLogMessage outputs a given log message as a single line JSON
+// object, labeled with the name of the app the message belongs to.
+func (ui *UI) DisplayJSONLogMessage(appName string, message LogMessage) error {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
 
-		header = fmt.Sprintf("%s [%s/%s] %s ",
-			time,
-			message.SourceType(),
-			message.SourceInstance(),
-			message.Type(),
-		)
+	body, err := marshalLogMessage(appName, message)
+	if err != nil {
+		return err
 	}
 
-	for _, line := range strings.Split(message.Message(), "\n") {
-		logLine := fmt.Sprintf("%s%s", header, strings.TrimRight(line, "\r\n"))
+	_, err = fmt.Fprintf(ui.Out, "%s\n", body)
+	return err
+}
+
+func (ui *UI) displayLogMessage(prefix string, message LogMessage, displayHeader bool) {
+	for _, logLine := range formatLogMessage(message, ui.TimezoneLocation, displayHeader) {
 		if message.Type() == "ERR" {
 			logLine = ui.modifyColor(logLine, color.New(color.FgRed))
 		}
-		fmt.Fprintf(ui.Out, "   %s\n", logLine)
+		fmt.Fprintf(ui.Out, "   %s%s\n", prefix, logLine)
 	}
 }
 
@@ -320,6 +338,12 @@ func (ui *UI) DisplayWarnings(warnings []string) {
 	}
 }
 
+// LogMessageFileWriter returns a LogMessageFileWriter that writes to
+// filePath, rotating it once it would grow beyond maxSize bytes.
+func (ui *UI) LogMessageFileWriter(filePath string, maxSize int64, asJSON bool) *LogMessageFileWriter {
+	return newLogMessageFileWriter(ui, filePath, maxSize, asJSON)
+}
+
 // RequestLoggerFileWriter returns a RequestLoggerFileWriter that cannot
 // overwrite another RequestLoggerFileWriter.
 func (ui *UI) RequestLoggerFileWriter(filePaths []string) *RequestLoggerFileWriter {
@@ -429,6 +453,22 @@ func (ui *UI) modifyColor(text string, colorPrinter *color.Color) string {
 	return colorPrinter.SprintFunc()(text)
 }
 
+// labelColors are the colors used for log message labels. Red is left out
+// because it marks ERR messages.
+var labelColors = []color.Attribute{
+	color.FgCyan,
+	color.FgMagenta,
+	color.FgYellow,
+	color.FgGreen,
+	color.FgBlue,
+}
+
+func labelColor(label string) *color.Color {
+	hash := fnv.New32a()
+	hash.Write([]byte(label))
+	return color.New(labelColors[hash.Sum32()%uint32(len(labelColors))], color.Bold)
+}
+
 func sum(intSlice []int) int {
 	sum := 0
 
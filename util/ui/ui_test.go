@@ -276,6 +276,74 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayJSONLogMessage", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a log message\nThis is also a log message")
+			message.TypeReturns("ERR")
+			message.TimestampReturns(time.Unix(1468969692, 0)) // "2016-07-19T16:08:12-07:00"
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		It("outputs the message as a single line JSON object", func() {
+			err := ui.DisplayJSONLogMessage("api-app", message)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out.Contents()).To(MatchJSON(`{
+				"timestamp": "2016-07-19T23:08:12Z",
+				"app": "api-app",
+				"source_type": "APP/PROC/WEB",
+				"source_instance": "12",
+				"stream": "err",
+				"message": "This is a log message\nThis is also a log message"
+			}`))
+			Expect(string(out.Contents())).To(HaveSuffix("}\n"))
+			Expect(strings.Count(string(out.Contents()), "\n")).To(Equal(1))
+		})
+	})
+
+	Describe("DisplayLabeledLogMessage", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			var err error
+			ui.TimezoneLocation, err = time.LoadLocation("America/Los_Angeles")
+			Expect(err).NotTo(HaveOccurred())
+
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a log message\nThis is also a log message")
+			message.TypeReturns("OUT")
+			message.TimestampReturns(time.Unix(1468969692, 0)) // "2016-07-19T16:08:12-07:00"
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		It("prefixes every line with the colored label", func() {
+			ui.DisplayLabeledLogMessage("api-app", message, true)
+			Expect(ui.Out).To(Say("   \x1b\\[33;1mapi-app\x1b\\[0m \\| 2016-07-19T16:08:12.00-0700 \\[APP/PROC/WEB/12\\] OUT This is a log message\n"))
+			Expect(ui.Out).To(Say("   \x1b\\[33;1mapi-app\x1b\\[0m \\| 2016-07-19T16:08:12.00-0700 \\[APP/PROC/WEB/12\\] OUT This is also a log message\n"))
+		})
+
+		It("uses a different color for a different label", func() {
+			ui.DisplayLabeledLogMessage("worker-app", message, false)
+			Expect(ui.Out).To(Say("   \x1b\\[36;1mworker-app\x1b\\[0m \\| This is a log message\n"))
+		})
+
+		Context("error log lines", func() {
+			BeforeEach(func() {
+				message.MessageReturns("This is a log message")
+				message.TypeReturns("ERR")
+			})
+
+			It("colors the line red after the label", func() {
+				ui.DisplayLabeledLogMessage("worker-app", message, false)
+				Expect(ui.Out).To(Say("   \x1b\\[36;1mworker-app\x1b\\[0m \\| \x1b\\[31mThis is a log message\x1b\\[0m\n"))
+			})
+		})
+	})
+
 	Describe("DisplayNewline", func() {
 		It("displays a new line", func() {
 			ui.DisplayNewline()